@@ -0,0 +1,357 @@
+package truenas
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ACL entry tags
+const (
+	ACLTagOwner      = "owner@"    // NFSv4 file owner
+	ACLTagGroupOwner = "group@"    // NFSv4 file group
+	ACLTagEveryone   = "everyone@" // NFSv4 everyone
+	ACLTagUser       = "USER"      // A user, by ID or name
+	ACLTagGroup      = "GROUP"     // A group, by ID or name
+	ACLTagUserObj    = "USER_OBJ"  // POSIX1E file owner
+	ACLTagGroupObj   = "GROUP_OBJ" // POSIX1E file group
+	ACLTagOther      = "OTHER"     // POSIX1E everyone else
+	ACLTagMask       = "MASK"      // POSIX1E mask
+)
+
+// ACL entry types
+const (
+	ACLEntryAllow = "ALLOW"
+	ACLEntryDeny  = "DENY"
+)
+
+// ACLBasicPerm is an NFSv4 basic permission set
+type ACLBasicPerm string
+
+const (
+	ACLBasicFullControl ACLBasicPerm = "FULL_CONTROL"
+	ACLBasicModify      ACLBasicPerm = "MODIFY"
+	ACLBasicRead        ACLBasicPerm = "READ"
+	ACLBasicTraverse    ACLBasicPerm = "TRAVERSE"
+)
+
+// ACLBasicFlag is an NFSv4 basic inheritance flag set
+type ACLBasicFlag string
+
+const (
+	ACLBasicInherit   ACLBasicFlag = "INHERIT"
+	ACLBasicNoInherit ACLBasicFlag = "NOINHERIT"
+)
+
+// ACLPerms holds the permissions of an ACL entry: an NFSv4 basic permission set, NFSv4 advanced
+// permissions or POSIX1E permissions. Only the first one set is sent.
+type ACLPerms struct {
+	Basic    ACLBasicPerm
+	Advanced *NFS4Perms
+	POSIX    *POSIXPerms
+
+	raw json.RawMessage // Perms in any other form, sent back unchanged
+}
+
+// NFS4Perms represents NFSv4 advanced permissions
+type NFS4Perms struct {
+	ReadData        bool `json:"READ_DATA"`
+	WriteData       bool `json:"WRITE_DATA"`
+	AppendData      bool `json:"APPEND_DATA"`
+	ReadNamedAttrs  bool `json:"READ_NAMED_ATTRS"`
+	WriteNamedAttrs bool `json:"WRITE_NAMED_ATTRS"`
+	Execute         bool `json:"EXECUTE"`
+	DeleteChild     bool `json:"DELETE_CHILD"`
+	ReadAttributes  bool `json:"READ_ATTRIBUTES"`
+	WriteAttributes bool `json:"WRITE_ATTRIBUTES"`
+	Delete          bool `json:"DELETE"`
+	ReadACL         bool `json:"READ_ACL"`
+	WriteACL        bool `json:"WRITE_ACL"`
+	WriteOwner      bool `json:"WRITE_OWNER"`
+	Synchronize     bool `json:"SYNCHRONIZE"`
+}
+
+// POSIXPerms represents POSIX1E permissions
+type POSIXPerms struct {
+	Read    bool `json:"READ"`
+	Write   bool `json:"WRITE"`
+	Execute bool `json:"EXECUTE"`
+}
+
+// Common NFSv4 permission sets
+var (
+	FullControl    = ACLPerms{Basic: ACLBasicFullControl}
+	ModifyAccess   = ACLPerms{Basic: ACLBasicModify}
+	ReadAccess     = ACLPerms{Basic: ACLBasicRead}
+	TraverseAccess = ACLPerms{Basic: ACLBasicTraverse}
+)
+
+// MarshalJSON implements json.Marshaler
+func (p ACLPerms) MarshalJSON() ([]byte, error) {
+	switch {
+	case p.Basic != "":
+		return json.Marshal(map[string]ACLBasicPerm{"BASIC": p.Basic})
+	case p.Advanced != nil:
+		return json.Marshal(p.Advanced)
+	case p.POSIX != nil:
+		return json.Marshal(p.POSIX)
+	case p.raw != nil:
+		return p.raw, nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *ACLPerms) UnmarshalJSON(data []byte) error {
+	*p = ACLPerms{}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil || fields == nil {
+		p.raw = bytes.Clone(data)
+		return nil
+	}
+	if _, ok := fields["BASIC"]; ok {
+		return unmarshalBasic(fields, &p.Basic)
+	}
+	if _, ok := fields["READ"]; ok {
+		p.POSIX = &POSIXPerms{}
+		return json.Unmarshal(data, p.POSIX)
+	}
+	p.Advanced = &NFS4Perms{}
+	return json.Unmarshal(data, p.Advanced)
+}
+
+// ACLFlags holds the inheritance flags of an NFSv4 ACL entry: a basic flag set or advanced flags.
+// Only the first one set is sent.
+type ACLFlags struct {
+	Basic    ACLBasicFlag
+	Advanced *NFS4Flags
+
+	raw json.RawMessage // Flags in any other form, sent back unchanged
+}
+
+// NFS4Flags represents NFSv4 advanced inheritance flags
+type NFS4Flags struct {
+	FileInherit        bool `json:"FILE_INHERIT"`
+	DirectoryInherit   bool `json:"DIRECTORY_INHERIT"`
+	NoPropagateInherit bool `json:"NO_PROPAGATE_INHERIT"`
+	InheritOnly        bool `json:"INHERIT_ONLY"`
+	Inherited          bool `json:"INHERITED"`
+}
+
+// Common NFSv4 inheritance flag sets
+var (
+	InheritAll = ACLFlags{Basic: ACLBasicInherit}
+	NoInherit  = ACLFlags{Basic: ACLBasicNoInherit}
+)
+
+// IsZero reports whether no flags are set, so that they are omitted from entries
+func (f ACLFlags) IsZero() bool {
+	return f.Basic == "" && f.Advanced == nil && f.raw == nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (f ACLFlags) MarshalJSON() ([]byte, error) {
+	switch {
+	case f.Basic != "":
+		return json.Marshal(map[string]ACLBasicFlag{"BASIC": f.Basic})
+	case f.Advanced != nil:
+		return json.Marshal(f.Advanced)
+	case f.raw != nil:
+		return f.raw, nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (f *ACLFlags) UnmarshalJSON(data []byte) error {
+	*f = ACLFlags{}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(data, &fields) != nil || fields == nil {
+		if !bytes.Equal(data, []byte("null")) {
+			f.raw = bytes.Clone(data)
+		}
+		return nil
+	}
+	if _, ok := fields["BASIC"]; ok {
+		return unmarshalBasic(fields, &f.Basic)
+	}
+	f.Advanced = &NFS4Flags{}
+	return json.Unmarshal(data, f.Advanced)
+}
+
+func unmarshalBasic[T ~string](fields map[string]json.RawMessage, v *T) error {
+	var basic *string
+	if err := json.Unmarshal(fields["BASIC"], &basic); err != nil {
+		return err
+	}
+	if basic != nil {
+		*v = T(*basic)
+	}
+	return nil
+}
+
+// ACLWho identifies the user or group an ACL entry applies to
+type ACLWho struct {
+	Tag  string
+	ID   *int
+	Name string
+}
+
+// Principals of NFSv4 ACL entries
+var (
+	ACLOwner      = ACLWho{Tag: ACLTagOwner}
+	ACLGroupOwner = ACLWho{Tag: ACLTagGroupOwner}
+	ACLEveryone   = ACLWho{Tag: ACLTagEveryone}
+)
+
+// ACLUser identifies a user by name
+func ACLUser(name string) ACLWho {
+	return ACLWho{Tag: ACLTagUser, Name: name}
+}
+
+// ACLUserID identifies a user by UID
+func ACLUserID(uid int) ACLWho {
+	return ACLWho{Tag: ACLTagUser, ID: &uid}
+}
+
+// ACLGroup identifies a group by name
+func ACLGroup(name string) ACLWho {
+	return ACLWho{Tag: ACLTagGroup, Name: name}
+}
+
+// ACLGroupID identifies a group by GID
+func ACLGroupID(gid int) ACLWho {
+	return ACLWho{Tag: ACLTagGroup, ID: &gid}
+}
+
+// NewAllowEntry creates an NFSv4 entry allowing who the given permissions, e.g.
+// NewAllowEntry(ACLGroup("staff"), FullControl, InheritAll)
+func NewAllowEntry(who ACLWho, perms ACLPerms, flags ACLFlags) ACLEntry {
+	return newACLEntry(ACLEntryAllow, who, perms, flags)
+}
+
+// NewDenyEntry creates an NFSv4 entry denying who the given permissions
+func NewDenyEntry(who ACLWho, perms ACLPerms, flags ACLFlags) ACLEntry {
+	return newACLEntry(ACLEntryDeny, who, perms, flags)
+}
+
+func newACLEntry(entryType string, who ACLWho, perms ACLPerms, flags ACLFlags) ACLEntry {
+	return ACLEntry{
+		Tag:   who.Tag,
+		ID:    who.ID,
+		Type:  entryType,
+		Perms: perms,
+		Flags: flags,
+		Who:   who.Name,
+	}
+}
+
+// NewPOSIXEntry creates a POSIX1E entry for a tag such as ACLTagUserObj. A default entry is
+// inherited by new files and directories.
+func NewPOSIXEntry(tag string, id *int, perms POSIXPerms, isDefault bool) ACLEntry {
+	return ACLEntry{
+		Tag:     tag,
+		ID:      id,
+		Type:    ACLEntryAllow,
+		Perms:   ACLPerms{POSIX: &perms},
+		Default: isDefault,
+	}
+}
+
+// nfs4BasicPerms expands the NFSv4 basic permission sets into the permissions they grant
+var nfs4BasicPerms = map[ACLBasicPerm]NFS4Perms{
+	ACLBasicFullControl: {
+		ReadData: true, WriteData: true, AppendData: true, ReadNamedAttrs: true, WriteNamedAttrs: true,
+		Execute: true, DeleteChild: true, ReadAttributes: true, WriteAttributes: true, Delete: true,
+		ReadACL: true, WriteACL: true, WriteOwner: true, Synchronize: true,
+	},
+	ACLBasicModify: {
+		ReadData: true, WriteData: true, AppendData: true, ReadNamedAttrs: true, WriteNamedAttrs: true,
+		Execute: true, DeleteChild: true, ReadAttributes: true, WriteAttributes: true, Delete: true,
+		ReadACL: true, Synchronize: true,
+	},
+	ACLBasicRead: {
+		ReadData: true, ReadNamedAttrs: true, Execute: true, ReadAttributes: true, ReadACL: true, Synchronize: true,
+	},
+	ACLBasicTraverse: {
+		ReadNamedAttrs: true, Execute: true, ReadAttributes: true, ReadACL: true, Synchronize: true,
+	},
+}
+
+// aclEntryAccess is the access an ACL entry grants or denies in a canonical form, so that
+// entries compare equal whether their permissions and flags are basic sets or advanced ones
+type aclEntryAccess struct {
+	tag       string
+	entryType string
+	isDefault bool
+	nfs4      NFS4Perms
+	posix     POSIXPerms
+	flags     NFS4Flags
+	raw       string // Perms or flags in a form that cannot be expanded
+}
+
+func (e ACLEntry) access() aclEntryAccess {
+	a := aclEntryAccess{tag: e.Tag, entryType: e.Type, isDefault: e.Default}
+	switch p := e.Perms; {
+	case p.Basic != "":
+		perms, ok := nfs4BasicPerms[p.Basic]
+		if !ok {
+			a.raw = "perms " + string(p.Basic)
+		}
+		a.nfs4 = perms
+	case p.Advanced != nil:
+		a.nfs4 = *p.Advanced
+	case p.POSIX != nil:
+		a.posix = *p.POSIX
+	default:
+		a.raw = "perms " + string(p.raw)
+	}
+	switch f := e.Flags; {
+	case f.Basic == ACLBasicInherit:
+		a.flags = NFS4Flags{FileInherit: true, DirectoryInherit: true}
+	case f.Basic != "" && f.Basic != ACLBasicNoInherit:
+		a.raw += " flags " + string(f.Basic)
+	case f.Advanced != nil:
+		a.flags = *f.Advanced
+		a.flags.Inherited = false // Set by the filesystem, not requested
+	case f.raw != nil:
+		a.raw += " flags " + string(f.raw)
+	}
+	return a
+}
+
+// samePrincipal reports whether two entries apply to the same user or group. Named users and
+// groups are compared by ID if both entries have one, or else by name.
+func (e ACLEntry) samePrincipal(other ACLEntry) bool {
+	if e.Tag != ACLTagUser && e.Tag != ACLTagGroup {
+		return true
+	}
+	if e.ID != nil && other.ID != nil && *e.ID >= 0 && *other.ID >= 0 {
+		return *e.ID == *other.ID
+	}
+	return e.Who != "" && e.Who == other.Who
+}
+
+// DiffACLEntries compares the entries of an ACL with desired ones, ignoring their order, the
+// form their permissions and flags are written in and the INHERITED flag. It returns the
+// desired entries missing from current and the current entries that are not desired.
+func DiffACLEntries(current, desired []ACLEntry) (added, removed []ACLEntry) {
+	matched := make([]bool, len(current))
+	for _, want := range desired {
+		access, found := want.access(), false
+		for i, have := range current {
+			if !matched[i] && have.access() == access && want.samePrincipal(have) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			added = append(added, want)
+		}
+	}
+	for i, have := range current {
+		if !matched[i] {
+			removed = append(removed, have)
+		}
+	}
+	return added, removed
+}
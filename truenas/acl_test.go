@@ -0,0 +1,94 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAllowEntry(t *testing.T) {
+	t.Parallel()
+	entries := []ACLEntry{
+		NewAllowEntry(ACLOwner, FullControl, InheritAll),
+		NewAllowEntry(ACLGroup("staff"), ModifyAccess, NoInherit),
+		NewDenyEntry(ACLUserID(1001), ACLPerms{Advanced: &NFS4Perms{WriteData: true, Delete: true}}, ACLFlags{}),
+		NewPOSIXEntry(ACLTagUserObj, nil, POSIXPerms{Read: true, Write: true, Execute: true}, true),
+	}
+	data, err := json.Marshal(entries)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[
+		{"tag": "owner@", "type": "ALLOW", "perms": {"BASIC": "FULL_CONTROL"}, "flags": {"BASIC": "INHERIT"}},
+		{"tag": "GROUP", "type": "ALLOW", "perms": {"BASIC": "MODIFY"}, "flags": {"BASIC": "NOINHERIT"}, "who": "staff"},
+		{"tag": "USER", "id": 1001, "type": "DENY", "perms": {
+			"READ_DATA": false, "WRITE_DATA": true, "APPEND_DATA": false, "READ_NAMED_ATTRS": false,
+			"WRITE_NAMED_ATTRS": false, "EXECUTE": false, "DELETE_CHILD": false, "READ_ATTRIBUTES": false,
+			"WRITE_ATTRIBUTES": false, "DELETE": true, "READ_ACL": false, "WRITE_ACL": false,
+			"WRITE_OWNER": false, "SYNCHRONIZE": false
+		}},
+		{"tag": "USER_OBJ", "type": "ALLOW", "perms": {"READ": true, "WRITE": true, "EXECUTE": true}, "default": true}
+	]`, string(data))
+}
+
+func TestACLEntry_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	var entries []ACLEntry
+	require.NoError(t, json.Unmarshal([]byte(`[
+		{"tag": "owner@", "id": null, "type": "ALLOW", "perms": {"BASIC": "FULL_CONTROL"}, "flags": {"BASIC": "INHERIT"}},
+		{"tag": "GROUP", "id": 1000, "type": "ALLOW", "perms": {"READ_DATA": true, "EXECUTE": true}, "flags": {"FILE_INHERIT": true, "DIRECTORY_INHERIT": true}, "who": "staff"},
+		{"tag": "GROUP_OBJ", "id": -1, "perms": {"READ": true, "WRITE": false, "EXECUTE": true}, "default": false},
+		{"tag": "everyone@", "type": "ALLOW", "perms": "rxaRc", "flags": "fd"}
+	]`), &entries))
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, FullControl, entries[0].Perms)
+	assert.Equal(t, InheritAll, entries[0].Flags)
+	assert.Equal(t, &NFS4Perms{ReadData: true, Execute: true}, entries[1].Perms.Advanced)
+	assert.Equal(t, &NFS4Flags{FileInherit: true, DirectoryInherit: true}, entries[1].Flags.Advanced)
+	assert.Equal(t, &POSIXPerms{Read: true, Execute: true}, entries[2].Perms.POSIX)
+	assert.True(t, entries[2].Flags.IsZero())
+
+	// Perms and flags in other forms are sent back as they were received
+	data, err := json.Marshal(entries[3])
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"tag": "everyone@", "type": "ALLOW", "perms": "rxaRc", "flags": "fd"}`, string(data))
+}
+
+func TestDiffACLEntries(t *testing.T) {
+	t.Parallel()
+	staff := 1000
+	current := []ACLEntry{
+		{Tag: ACLTagGroup, ID: &staff, Type: ACLEntryAllow, Who: "staff",
+			Perms: ACLPerms{Advanced: &NFS4Perms{ReadData: true, ReadNamedAttrs: true, Execute: true, ReadAttributes: true, ReadACL: true, Synchronize: true}},
+			Flags: ACLFlags{Advanced: &NFS4Flags{FileInherit: true, DirectoryInherit: true, Inherited: true}}},
+		{Tag: ACLTagOwner, ID: Ptr(-1), Type: ACLEntryAllow, Perms: FullControl, Flags: InheritAll},
+		{Tag: ACLTagEveryone, ID: Ptr(-1), Type: ACLEntryAllow, Perms: TraverseAccess, Flags: NoInherit},
+	}
+
+	// The same entries in another order and form
+	added, removed := DiffACLEntries(current, []ACLEntry{
+		NewAllowEntry(ACLOwner, FullControl, InheritAll),
+		NewAllowEntry(ACLGroup("staff"), ReadAccess, InheritAll),
+		NewAllowEntry(ACLEveryone, TraverseAccess, ACLFlags{}),
+	})
+	assert.Empty(t, added)
+	assert.Empty(t, removed)
+
+	added, removed = DiffACLEntries(current, []ACLEntry{
+		NewAllowEntry(ACLOwner, FullControl, InheritAll),
+		NewAllowEntry(ACLGroupID(1000), ModifyAccess, InheritAll),
+		NewAllowEntry(ACLEveryone, TraverseAccess, NoInherit),
+	})
+	require.Len(t, added, 1)
+	assert.Equal(t, ModifyAccess, added[0].Perms)
+	require.Len(t, removed, 1)
+	assert.Equal(t, "staff", removed[0].Who)
+
+	added, removed = DiffACLEntries(current[1:], []ACLEntry{
+		NewAllowEntry(ACLOwner, FullControl, InheritAll),
+		NewAllowEntry(ACLUser("staff"), TraverseAccess, NoInherit),
+	})
+	assert.Len(t, added, 1)
+	assert.Equal(t, []ACLEntry{current[2]}, removed)
+}
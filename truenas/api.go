@@ -0,0 +1,846 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"iter"
+	"time"
+)
+
+//go:generate go run github.com/vektra/mockery/v2@v2.53.7
+
+// The interfaces below list the methods of each subsystem client, so code using the library can
+// depend on them and substitute the mocks in the mocks package in unit tests. Regenerate the
+// mocks with go generate after changing a client's methods.
+
+// ACLTemplateAPI is implemented by ACLTemplateClient
+type ACLTemplateAPI interface {
+	List(ctx context.Context) ([]ACLTemplate, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]ACLTemplate, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[ACLTemplate, error]
+	Get(ctx context.Context, id int) (*ACLTemplate, error)
+	Create(ctx context.Context, req *ACLTemplateRequest) (*ACLTemplate, error)
+	Update(ctx context.Context, id int, req *ACLTemplateRequest) (*ACLTemplate, error)
+	Delete(ctx context.Context, id int) error
+	ByPath(ctx context.Context, path string, q *Query, opts ACLTemplateByPathOptions) ([]ACLTemplate, error)
+}
+
+// APIKeyAPI is implemented by APIKeyClient
+type APIKeyAPI interface {
+	List(ctx context.Context) ([]APIKey, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]APIKey, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[APIKey, error]
+	Get(ctx context.Context, id int) (*APIKey, error)
+	Create(ctx context.Context, name string) (*APIKey, error)
+	CreateWithRequest(ctx context.Context, req *APIKeyCreateRequest) (*APIKey, error)
+	Update(ctx context.Context, id int, req *APIKeyUpdateRequest) (*APIKey, error)
+	UpdateName(ctx context.Context, id int, name string) (*APIKey, error)
+	Reset(ctx context.Context, id int) (*APIKey, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// AlertAPI is implemented by AlertClient
+type AlertAPI interface {
+	List(ctx context.Context) ([]Alert, error)
+	Dismiss(ctx context.Context, uuid string) error
+	Restore(ctx context.Context, uuid string) error
+	ListCategories(ctx context.Context) ([]AlertCategory, error)
+	ListPolicies(ctx context.Context) ([]AlertPolicy, error)
+	GetAlertClassesConfig(ctx context.Context) (map[string]any, error)
+	UpdateAlertClasses(ctx context.Context, req *AlertClassesUpdateRequest) (map[string]any, error)
+}
+
+// AlertServiceAPI is implemented by AlertServiceClient
+type AlertServiceAPI interface {
+	List(ctx context.Context) ([]AlertService, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]AlertService, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[AlertService, error]
+	Get(ctx context.Context, id int) (*AlertService, error)
+	Create(ctx context.Context, req *AlertServiceCreateRequest) (*AlertService, error)
+	Update(ctx context.Context, id int, req *AlertServiceUpdateRequest) (*AlertService, error)
+	Delete(ctx context.Context, id int) error
+	Test(ctx context.Context, req *AlertServiceCreateRequest) error
+	ListTypes(ctx context.Context) (map[string]any, error)
+}
+
+// AppAPI is implemented by AppClient
+type AppAPI interface {
+	List(ctx context.Context) ([]App, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]App, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[App, error]
+	ListWithOptions(ctx context.Context, options *AppQueryOptions) ([]App, error)
+	Get(ctx context.Context, name string, extra map[string]any) (*App, error)
+	GetByID(ctx context.Context, id string) (*App, error)
+	QueryByState(ctx context.Context, state AppState) ([]App, error)
+	QueryByCatalog(ctx context.Context, catalog string) ([]App, error)
+	QueryWithFilters(ctx context.Context, filters [][]any, options *AppQueryOptions) ([]App, error)
+	ListRunning(ctx context.Context) ([]App, error)
+	ListStopped(ctx context.Context) ([]App, error)
+	ListDeploying(ctx context.Context) ([]App, error)
+	ListCrashed(ctx context.Context) ([]App, error)
+	Create(ctx context.Context, req *AppCreateRequest) (*App, error)
+	Delete(ctx context.Context, name string, options *AppDeleteOptions) error
+	Upgrade(ctx context.Context, name string, options *AppUpgradeOptions) (*App, error)
+	SubscribeStats(ctx context.Context, fn func([]AppStats) error) error
+	UnsubscribeStats(ctx context.Context) error
+	PodChoices(ctx context.Context, name string) (map[string]AppContainerID, error)
+	Logs(ctx context.Context, name, containerID string, follow bool) (<-chan AppLogLine, error)
+}
+
+// AuditAPI is implemented by AuditClient
+type AuditAPI interface {
+	Query(ctx context.Context, filter *AuditFilter) ([]AuditEntry, error)
+	GetConfig(ctx context.Context) (*AuditConfig, error)
+	UpdateConfig(ctx context.Context, req *AuditConfigUpdateRequest) (*AuditConfig, error)
+	Export(ctx context.Context, w io.Writer, filter *AuditFilter, format AuditExportFormat) error
+}
+
+// AuthAPI is implemented by AuthClient
+type AuthAPI interface {
+	Login(ctx context.Context, username, password string) (bool, error)
+	LoginWithAPIKey(ctx context.Context, apiKey string) (bool, error)
+	LoginWithToken(ctx context.Context, token string) (bool, error)
+	Logout(ctx context.Context) error
+	CheckPassword(ctx context.Context, username, password string) (bool, error)
+	GenerateToken(ctx context.Context, req GenerateTokenRequest) (*TokenResponse, error)
+}
+
+// BootAPI is implemented by BootClient
+type BootAPI interface {
+	GetDisks(ctx context.Context) ([]BootDisk, error)
+	GetState(ctx context.Context) (*BootState, error)
+	Attach(ctx context.Context, device string, expand bool) error
+	Detach(ctx context.Context, device string) error
+	Replace(ctx context.Context, label, device string) error
+	Scrub(ctx context.Context) error
+	GetScrubInterval(ctx context.Context) (int, error)
+	SetScrubInterval(ctx context.Context, interval int) error
+}
+
+// CatalogAPI is implemented by CatalogClient
+type CatalogAPI interface {
+	List(ctx context.Context) ([]Catalog, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Catalog, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Catalog, error]
+	Get(ctx context.Context, label string) (*Catalog, error)
+	Create(ctx context.Context, req *CatalogCreateRequest) (*Catalog, error)
+	Update(ctx context.Context, label string, req *CatalogUpdateRequest) (*Catalog, error)
+	Delete(ctx context.Context, label string) error
+	Sync(ctx context.Context, label string) error
+	SyncAll(ctx context.Context) error
+	Items(ctx context.Context, label string, options *CatalogItemsOptions) (map[string]map[string]CatalogItem, error)
+	GetItemDetails(ctx context.Context, item, catalog, train string) (map[string]any, error)
+}
+
+// CertificateAPI is implemented by CertificateClient
+type CertificateAPI interface {
+	List(ctx context.Context) ([]Certificate, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Certificate, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Certificate, error]
+	Get(ctx context.Context, id int) (*Certificate, error)
+	Create(ctx context.Context, req *CertificateCreateRequest) (*Certificate, error)
+	Update(ctx context.Context, id int, req *CertificateUpdateRequest) (*Certificate, error)
+	Delete(ctx context.Context, id int, force bool) error
+	GetCountryChoices(ctx context.Context) (map[string]string, error)
+	GetKeyTypeChoices(ctx context.Context) (map[string]string, error)
+	GetECCurveChoices(ctx context.Context) (map[string]string, error)
+	GetExtendedKeyUsageChoices(ctx context.Context) (map[string]string, error)
+	GetProfiles(ctx context.Context) (map[string]any, error)
+	GetACMEServerChoices(ctx context.Context) (map[string]string, error)
+}
+
+// ChartReleaseAPI is implemented by ChartReleaseClient
+type ChartReleaseAPI interface {
+	List(ctx context.Context) ([]ChartRelease, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]ChartRelease, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[ChartRelease, error]
+	Get(ctx context.Context, name string) (*ChartRelease, error)
+	Create(ctx context.Context, req *ChartReleaseCreateRequest) (*ChartRelease, error)
+	Update(ctx context.Context, name string, req *ChartReleaseUpdateRequest) (*ChartRelease, error)
+	Delete(ctx context.Context, name string, deleteUnusedImages bool) error
+	Upgrade(ctx context.Context, name string, options *ChartReleaseUpgradeOptions) (*ChartRelease, error)
+	Rollback(ctx context.Context, name string, options *ChartReleaseRollbackOptions) (*ChartRelease, error)
+	Scale(ctx context.Context, name string, replicaCount int) error
+	GetVersions(ctx context.Context, name string) (map[string]any, error)
+}
+
+// CloudCredentialAPI is implemented by CloudCredentialClient
+type CloudCredentialAPI interface {
+	List(ctx context.Context) ([]CloudCredential, error)
+	Get(ctx context.Context, id int) (*CloudCredential, error)
+	Create(ctx context.Context, req *CloudCredentialRequest) (*CloudCredential, error)
+	Update(ctx context.Context, id int, req *CloudCredentialRequest) (*CloudCredential, error)
+	Delete(ctx context.Context, id int) error
+	Verify(ctx context.Context, provider CloudProvider, attributes any) (*CloudCredentialVerifyResult, error)
+}
+
+// CloudSyncAPI is implemented by CloudSyncClient
+type CloudSyncAPI interface {
+	List(ctx context.Context) ([]CloudSyncTask, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]CloudSyncTask, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[CloudSyncTask, error]
+	Get(ctx context.Context, id int) (*CloudSyncTask, error)
+	Create(ctx context.Context, req *CloudSyncTaskRequest) (*CloudSyncTask, error)
+	Update(ctx context.Context, id int, req *CloudSyncTaskRequest) (*CloudSyncTask, error)
+	Delete(ctx context.Context, id int) error
+	Sync(ctx context.Context, id int, options *CloudSyncOptions) error
+	Abort(ctx context.Context, id int) error
+}
+
+// CronjobAPI is implemented by CronjobClient
+type CronjobAPI interface {
+	List(ctx context.Context) ([]Cronjob, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Cronjob, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Cronjob, error]
+	Get(ctx context.Context, id int) (*Cronjob, error)
+	Create(ctx context.Context, req *CronjobCreateRequest) (*Cronjob, error)
+	Update(ctx context.Context, id int, req *CronjobUpdateRequest) (*Cronjob, error)
+	Delete(ctx context.Context, id int) error
+	Run(ctx context.Context, id int, skipDisabled bool) error
+	Validate(req *CronjobCreateRequest) error
+}
+
+// DatasetAPI is implemented by DatasetClient
+type DatasetAPI interface {
+	List(ctx context.Context) ([]Dataset, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Dataset, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Dataset, error]
+	Get(ctx context.Context, id string) (*Dataset, error)
+	GetByName(ctx context.Context, name string) (*Dataset, error)
+	Tree(ctx context.Context, root string) (*Dataset, error)
+	Create(ctx context.Context, req *DatasetCreateRequest) (*Dataset, error)
+	Update(ctx context.Context, id string, req DatasetUpdateRequest) (*Dataset, error)
+	UpdateProperties(ctx context.Context, id string, props map[DatasetPropertyName]any) (*Dataset, error)
+	InheritProperty(ctx context.Context, id string, name DatasetPropertyName) (*Dataset, error)
+	Delete(ctx context.Context, id string, req DatasetDeleteRequest) error
+	Lock(ctx context.Context, id string, req DatasetLockRequest) error
+	Unlock(ctx context.Context, id string, req DatasetUnlockRequest) error
+	UnlockWithKeyFile(ctx context.Context, id string, req DatasetUnlockRequest, keyFile io.Reader) (*DatasetUnlockResult, error)
+	ExportKey(ctx context.Context, id string) (string, error)
+	ChangeKey(ctx context.Context, id string, options *DatasetChangeKeyOptions) error
+	ChangeKeyWithKeyFile(ctx context.Context, id string, options DatasetChangeKeyOptions, keyFile io.Reader) error
+	EncryptionSummary(ctx context.Context, id string, options *DatasetEncryptionSummaryOptions) ([]DatasetEncryptionSummary, error)
+	EncryptionSummaryWithKeyFile(ctx context.Context, id string, options DatasetEncryptionSummaryOptions, keyFile io.Reader) ([]DatasetEncryptionSummary, error)
+	Mount(ctx context.Context, id string) error
+	Unmount(ctx context.Context, id string, force bool) error
+	Snapshot(ctx context.Context, req DatasetSnapshotRequest) (any, error)
+	GetSnapshots(ctx context.Context, datasetName string) ([]any, error)
+	Promote(ctx context.Context, id string) error
+	CloneFromSnapshot(ctx context.Context, source, snapName, target string, opts *CloneOptions) (*Dataset, error)
+	PromoteClone(ctx context.Context, id string) (*Dataset, error)
+	GetProcesses(ctx context.Context, id string) (any, error)
+}
+
+// DiskAPI is implemented by DiskClient
+type DiskAPI interface {
+	List(ctx context.Context) ([]Disk, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Disk, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Disk, error]
+	ListWithOptions(ctx context.Context, opts *DiskQueryOptions) ([]Disk, error)
+	Get(ctx context.Context, id string) (*Disk, error)
+	Update(ctx context.Context, id string, req *DiskUpdateRequest) (*Disk, error)
+	GetEncrypted(ctx context.Context, includeUnused bool) ([]EncryptedDevice, error)
+	Decrypt(ctx context.Context, req *DecryptRequest) error
+	GetUnused(ctx context.Context, joinPartitions bool) ([]UnusedDisk, error)
+	ListUnused(ctx context.Context, join bool) ([]UnusedDisk, error)
+	WaitForUnused(ctx context.Context, n int, timeout time.Duration) ([]UnusedDisk, error)
+	LabelToDev(ctx context.Context, label string) (string, error)
+	GetSmartAttributes(ctx context.Context, deviceName string) ([]SmartAttribute, error)
+	GetTemperature(ctx context.Context, deviceName string, powerMode PowerMode) (*DiskTemperature, error)
+	GetTemperatures(ctx context.Context, deviceNames []string, powerMode PowerMode) ([]DiskTemperature, error)
+	Spindown(ctx context.Context, deviceName string) error
+	Overprovision(ctx context.Context, deviceName string, size int64) error
+	Unoverprovision(ctx context.Context, deviceName string) error
+	Wipe(ctx context.Context, req *WipeRequest) error
+	GetSedDevName(ctx context.Context, deviceName string) (string, error)
+}
+
+// DockerAPI is implemented by DockerClient
+type DockerAPI interface {
+	GetConfig(ctx context.Context) (*DockerConfig, error)
+	UpdateConfig(ctx context.Context, req *DockerUpdateRequest) (*DockerConfig, error)
+	Status(ctx context.Context) (*DockerStatus, error)
+}
+
+// DynDNSAPI is implemented by DynDNSClient
+type DynDNSAPI interface {
+	GetConfig(ctx context.Context) (*DynDNSConfig, error)
+	UpdateConfig(ctx context.Context, config *DynDNSConfig) (*DynDNSConfig, error)
+	ProviderChoices(ctx context.Context) (map[DynDNSProvider]string, error)
+	CheckIPChoices(ctx context.Context) (map[string]string, error)
+}
+
+// FilesystemAPI is implemented by FilesystemClient
+type FilesystemAPI interface {
+	Stat(ctx context.Context, path string) (*FilesystemStat, error)
+	Statfs(ctx context.Context, path string) (*FilesystemStatfs, error)
+	ListDir(ctx context.Context, path string) ([]DirEntry, error)
+	ListDirWithOptions(ctx context.Context, dir string, opts ListDirOptions) ([]DirEntry, error)
+	Mkdir(ctx context.Context, path, mode string) (*DirEntry, error)
+	GetACL(ctx context.Context, path string, simplified bool) (*ACL, error)
+	SetACL(ctx context.Context, req *SetACLRequest) error
+	EnsureACL(ctx context.Context, req *SetACLRequest) (*ACLDiff, error)
+	IsACLTrivial(ctx context.Context, path string) (bool, error)
+	GetDefaultACL(ctx context.Context, aclType DefaultACLType, shareType ShareType) (*ACL, error)
+	GetDefaultACLChoices(ctx context.Context) ([]string, error)
+	SetPermissions(ctx context.Context, req *SetPermRequest) error
+	ChangeOwner(ctx context.Context, req *ChownRequest) error
+	GetFile(ctx context.Context, path string) error
+	Download(ctx context.Context, filePath string, w io.Writer) error
+	FS(ctx context.Context, root string) fs.FS
+	PutFile(ctx context.Context, path string, r io.Reader, options *PutFileOptions) error
+	PutFileWithProgress(ctx context.Context, path string, r io.Reader, options *PutFileOptions, fn UploadProgressFunc) error
+	CreateDefaultACL(ctx context.Context, aclType DefaultACLType) (*ACL, error)
+	CreateShareACL(ctx context.Context, shareType ShareType) (*ACL, error)
+	SetSimplePermissions(ctx context.Context, path, mode string, recursive bool) error
+	SetOwnership(ctx context.Context, path string, uid, gid *int, recursive bool) error
+	Walk(ctx context.Context, root string, fn WalkFunc) error
+}
+
+// GroupAPI is implemented by GroupClient
+type GroupAPI interface {
+	List(ctx context.Context) ([]Group, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Group, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Group, error]
+	ListWithDSCache(ctx context.Context) ([]Group, error)
+	Get(ctx context.Context, id int) (*Group, error)
+	GetByName(ctx context.Context, name string) (*Group, error)
+	GetByGID(ctx context.Context, gid int) (*Group, error)
+	Create(ctx context.Context, req *GroupCreateRequest) (*Group, error)
+	Update(ctx context.Context, id int, req *GroupUpdateRequest) (*Group, error)
+	Delete(ctx context.Context, id int, req *GroupDeleteRequest) error
+	GetNextGID(ctx context.Context) (int, error)
+	GetGroupObj(ctx context.Context, req GroupGetRequest) (map[string]any, error)
+}
+
+// JailAPI is implemented by JailClient
+type JailAPI interface {
+	List(ctx context.Context) ([]Jail, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Jail, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Jail, error]
+	Get(ctx context.Context, name string) (*Jail, error)
+	Create(ctx context.Context, req *JailCreateRequest) (*Jail, error)
+	Start(ctx context.Context, name string) error
+	Stop(ctx context.Context, name string, force bool) error
+	Delete(ctx context.Context, name string, force bool) error
+	Exec(ctx context.Context, name string, command []string, opts *JailExecOptions) (string, error)
+}
+
+// JobAPI is implemented by JobClient
+type JobAPI interface {
+	List(ctx context.Context) ([]Job, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Job, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Job, error]
+	Get(ctx context.Context, id int) (*Job, error)
+	Wait(ctx context.Context, jobID int) (*Job, error)
+	WaitWithProgress(ctx context.Context, jobID int, fn JobProgressFunc) (*Job, error)
+}
+
+// JobsAPI is implemented by JobsClient
+type JobsAPI interface {
+	Submit(ctx context.Context, method string, params []any) (*JobHandle, error)
+	Get(ctx context.Context, id int) (*JobHandle, error)
+	List(ctx context.Context, q *Query) ([]*JobHandle, error)
+}
+
+// KerberosAPI is implemented by KerberosClient
+type KerberosAPI interface {
+	GetConfig(ctx context.Context) (*KerberosConfig, error)
+	UpdateConfig(ctx context.Context, req *KerberosConfigUpdateRequest) (*KerberosConfig, error)
+}
+
+// KerberosKeytabAPI is implemented by KerberosKeytabClient
+type KerberosKeytabAPI interface {
+	List(ctx context.Context) ([]KerberosKeytab, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]KerberosKeytab, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[KerberosKeytab, error]
+	Get(ctx context.Context, id int) (*KerberosKeytab, error)
+	Create(ctx context.Context, req *KerberosKeytabRequest) (*KerberosKeytab, error)
+	Update(ctx context.Context, id int, req *KerberosKeytabRequest) (*KerberosKeytab, error)
+	Delete(ctx context.Context, id int) error
+	SystemKeytabList(ctx context.Context) ([]KerberosKeytabEntry, error)
+}
+
+// KerberosRealmAPI is implemented by KerberosRealmClient
+type KerberosRealmAPI interface {
+	List(ctx context.Context) ([]KerberosRealm, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]KerberosRealm, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[KerberosRealm, error]
+	Get(ctx context.Context, id int) (*KerberosRealm, error)
+	Create(ctx context.Context, req *KerberosRealmRequest) (*KerberosRealm, error)
+	Update(ctx context.Context, id int, req *KerberosRealmRequest) (*KerberosRealm, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// KeychainAPI is implemented by KeychainClient
+type KeychainAPI interface {
+	List(ctx context.Context) ([]KeychainCredential, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]KeychainCredential, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[KeychainCredential, error]
+	Get(ctx context.Context, id int) (*KeychainCredential, error)
+	Create(ctx context.Context, req *KeychainCredentialRequest) (*KeychainCredential, error)
+	Update(ctx context.Context, id int, req *KeychainCredentialRequest) (*KeychainCredential, error)
+	Delete(ctx context.Context, id int, options *KeychainCredentialDeleteOptions) error
+	GenerateSSHKeyPair(ctx context.Context) (*SSHKeyPair, error)
+	RemoteSSHHostKeyScan(ctx context.Context, req SSHHostKeyScanRequest) (string, error)
+	SetupSSHConnection(ctx context.Context, req *SSHConnectionSetup) (*KeychainCredential, error)
+}
+
+// KubernetesAPI is implemented by KubernetesClient
+type KubernetesAPI interface {
+	GetConfig(ctx context.Context) (*KubernetesConfig, error)
+	UpdateConfig(ctx context.Context, req *KubernetesUpdateRequest) (*KubernetesConfig, error)
+	Status(ctx context.Context) (*KubernetesStatusResult, error)
+	NodeIP(ctx context.Context) (string, error)
+	BindIPChoices(ctx context.Context) (map[string]string, error)
+	BackupChartReleases(ctx context.Context, name string) (string, error)
+	ListBackups(ctx context.Context) (map[string]any, error)
+	RestoreBackup(ctx context.Context, name string, options *KubernetesRestoreOptions) error
+	DeleteBackup(ctx context.Context, name string) error
+}
+
+// NFSAPI is implemented by NFSClient
+type NFSAPI interface {
+	GetConfig(ctx context.Context) (*NFSConfig, error)
+	UpdateConfig(ctx context.Context, config *NFSConfig) (*NFSConfig, error)
+	BindIPChoices(ctx context.Context) (map[string]string, error)
+}
+
+// NetworkAPI is implemented by NetworkClient
+type NetworkAPI interface {
+	ListInterfaces(ctx context.Context) ([]NetworkInterface, error)
+	ListInterfacesWithQuery(ctx context.Context, q *Query) ([]NetworkInterface, error)
+	GetInterface(ctx context.Context, id int) (*NetworkInterface, error)
+	GetInterfaceByName(ctx context.Context, name string) (*NetworkInterface, error)
+	CreateInterface(ctx context.Context, req *NetworkInterfaceCreateRequest) (*NetworkInterface, error)
+	ValidateInterface(req *NetworkInterfaceCreateRequest) error
+	UpdateInterface(ctx context.Context, id int, req *NetworkInterfaceUpdateRequest) (*NetworkInterface, error)
+	DeleteInterface(ctx context.Context, id int) error
+	GetConfiguration(ctx context.Context) (*NetworkConfiguration, error)
+	UpdateConfiguration(ctx context.Context, config *NetworkConfiguration) (*NetworkConfiguration, error)
+	ListStaticRoutes(ctx context.Context) ([]StaticRoute, error)
+	ListStaticRoutesWithQuery(ctx context.Context, q *Query) ([]StaticRoute, error)
+	GetStaticRoute(ctx context.Context, id int) (*StaticRoute, error)
+	CreateStaticRoute(ctx context.Context, req StaticRouteCreateRequest) (*StaticRoute, error)
+	UpdateStaticRoute(ctx context.Context, id int, req StaticRouteCreateRequest) (*StaticRoute, error)
+	DeleteStaticRoute(ctx context.Context, id int) error
+	GetInterfaceChoices(ctx context.Context) (map[string]string, error)
+	HasPendingChanges(ctx context.Context) (bool, error)
+	CommitPendingChanges(ctx context.Context, rollback bool) error
+	CheckinWaiting(ctx context.Context) (bool, error)
+	Checkin(ctx context.Context) error
+	RollbackPendingChanges(ctx context.Context) error
+}
+
+// PluginAPI is implemented by PluginClient
+type PluginAPI interface {
+	List(ctx context.Context) ([]Plugin, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Plugin, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Plugin, error]
+	Get(ctx context.Context, name string) (*Plugin, error)
+	Create(ctx context.Context, req *PluginCreateRequest) (*Plugin, error)
+	Update(ctx context.Context, name string, req *PluginUpdateRequest) (*Plugin, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// PoolAPI is implemented by PoolClient
+type PoolAPI interface {
+	List(ctx context.Context) ([]Pool, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Pool, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Pool, error]
+	Get(ctx context.Context, id int) (*Pool, error)
+	GetByName(ctx context.Context, name string) (*Pool, error)
+	Create(ctx context.Context, req PoolCreateRequest) (*Pool, error)
+	Update(ctx context.Context, id int, req PoolUpdateRequest) (*Pool, error)
+	Delete(ctx context.Context, id int, cascade bool) error
+	Export(ctx context.Context, id int, req PoolExportRequest) error
+	Import(ctx context.Context, req PoolImportRequest) (*Pool, error)
+	FindImportablePools(ctx context.Context) ([]PoolImportFindResult, error)
+	Scrub(ctx context.Context, id int, action PoolScrubAction) error
+	GetProcesses(ctx context.Context, id int) ([]PoolProcess, error)
+	ListScrubTasks(ctx context.Context) ([]PoolScrubTask, error)
+	ListScrubTasksWithQuery(ctx context.Context, q *Query) ([]PoolScrubTask, error)
+	GetScrubTask(ctx context.Context, id int) (*PoolScrubTask, error)
+	GetScrubTasksByPool(ctx context.Context, poolID int) ([]PoolScrubTask, error)
+	CreateScrubTask(ctx context.Context, req PoolScrubTaskRequest) (*PoolScrubTask, error)
+	UpdateScrubTask(ctx context.Context, id int, req PoolScrubTaskRequest) (*PoolScrubTask, error)
+	DeleteScrubTask(ctx context.Context, id int) error
+	RunScrub(ctx context.Context, poolName, action string) error
+	RunScrubAsync(ctx context.Context, poolName, action string) (int, error)
+	GetResilverConfig(ctx context.Context) (*PoolResilverConfig, error)
+	UpdateResilverConfig(ctx context.Context, req *PoolResilverUpdateRequest) (*PoolResilverConfig, error)
+	Attach(ctx context.Context, id int, req PoolAttachRequest) error
+	Detach(ctx context.Context, id int, label string, wipe bool) error
+	Replace(ctx context.Context, id int, req PoolReplaceRequest) error
+	Offline(ctx context.Context, id int, label string) error
+	Online(ctx context.Context, id int, label string) error
+	Expand(ctx context.Context, id int) error
+}
+
+// PrivilegeAPI is implemented by PrivilegeClient
+type PrivilegeAPI interface {
+	List(ctx context.Context) ([]Privilege, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Privilege, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Privilege, error]
+	Get(ctx context.Context, id int) (*Privilege, error)
+	Create(ctx context.Context, req *PrivilegeCreateRequest) (*Privilege, error)
+	Update(ctx context.Context, id int, req *PrivilegeUpdateRequest) (*Privilege, error)
+	Delete(ctx context.Context, id int) error
+	Roles(ctx context.Context) ([]Role, error)
+}
+
+// ReplicationAPI is implemented by ReplicationClient
+type ReplicationAPI interface {
+	List(ctx context.Context) ([]ReplicationTask, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]ReplicationTask, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[ReplicationTask, error]
+	Get(ctx context.Context, id int) (*ReplicationTask, error)
+	Create(ctx context.Context, req *ReplicationTaskRequest) (*ReplicationTask, error)
+	Update(ctx context.Context, id int, req *ReplicationTaskRequest) (*ReplicationTask, error)
+	Delete(ctx context.Context, id int) error
+	Run(ctx context.Context, id int) error
+	QuickSetup(ctx context.Context, sourceDataset string, targetSystem SSHCredentialID, targetDataset string, schedule Schedule) (*ReplicationSetup, error)
+	QuickSetupWithConnection(ctx context.Context, sourceDataset string, conn SSHConnectionSetup, targetDataset string, schedule Schedule) (*ReplicationSetup, error)
+}
+
+// ReportingAPI is implemented by ReportingClient
+type ReportingAPI interface {
+	GetConfig(ctx context.Context) (*ReportingConfig, error)
+	UpdateConfig(ctx context.Context, req *ReportingConfigUpdateRequest) (*ReportingConfig, error)
+	Graphs(ctx context.Context) ([]ReportingGraph, error)
+	GetData(ctx context.Context, graphs []ReportingGraphRequest, q *ReportingQuery) ([]ReportingData, error)
+	StreamRealtime(ctx context.Context) (<-chan RealtimeSample, error)
+}
+
+// SMBAPI is implemented by SMBClient
+type SMBAPI interface {
+	GetConfig(ctx context.Context) (*SMBConfig, error)
+	UpdateConfig(ctx context.Context, config *SMBConfig) (*SMBConfig, error)
+	BindIPChoices(ctx context.Context) (map[string]string, error)
+	UnixCharsetChoices(ctx context.Context) (map[string]string, error)
+}
+
+// SNMPAPI is implemented by SNMPClient
+type SNMPAPI interface {
+	GetConfig(ctx context.Context) (*SNMPConfig, error)
+	UpdateConfig(ctx context.Context, config *SNMPConfig) (*SNMPConfig, error)
+}
+
+// SSHAPI is implemented by SSHClient
+type SSHAPI interface {
+	GetConfig(ctx context.Context) (*SSHConfig, error)
+	UpdateConfig(ctx context.Context, config *SSHConfig) (*SSHConfig, error)
+}
+
+// ServiceAPI is implemented by ServiceClient
+type ServiceAPI interface {
+	List(ctx context.Context) ([]Service, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Service, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Service, error]
+	Get(ctx context.Context, id int) (*Service, error)
+	GetByName(ctx context.Context, name string) (*Service, error)
+	Update(ctx context.Context, id int, req ServiceUpdateRequest) (*Service, error)
+	Start(ctx context.Context, serviceName string, options ...map[string]any) error
+	Stop(ctx context.Context, serviceName string, options ...map[string]any) error
+	Restart(ctx context.Context, serviceName string, options ...map[string]any) error
+	Reload(ctx context.Context, serviceName string, options ...map[string]any) error
+	Started(ctx context.Context, serviceName string) (bool, error)
+	SetEnabled(ctx context.Context, serviceName string, enable bool) (*Service, error)
+	WaitForState(ctx context.Context, serviceName string, state string) (*Service, error)
+	RestartMany(ctx context.Context, names []string, opts *RestartManyOptions) (ServiceRestartResults, error)
+}
+
+// SharingAFPAPI is implemented by SharingAFPClient
+type SharingAFPAPI interface {
+	List(ctx context.Context) ([]AFPShare, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]AFPShare, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[AFPShare, error]
+	Get(ctx context.Context, id int) (*AFPShare, error)
+	Create(ctx context.Context, req *AFPShareRequest) (*AFPShare, error)
+	Update(ctx context.Context, id int, req *AFPShareRequest) (*AFPShare, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// SharingNFSAPI is implemented by SharingNFSClient
+type SharingNFSAPI interface {
+	List(ctx context.Context) ([]NFSShare, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]NFSShare, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[NFSShare, error]
+	Get(ctx context.Context, id int) (*NFSShare, error)
+	Create(ctx context.Context, req *NFSShareRequest) (*NFSShare, error)
+	Update(ctx context.Context, id int, req *NFSShareRequest) (*NFSShare, error)
+	Diff(ctx context.Context, id int, desired *NFSShareRequest) ([]FieldDiff, error)
+	GetClients(ctx context.Context) ([]NFSConnectedClient, error)
+	Delete(ctx context.Context, id int) error
+	GetHumanIdentifier(ctx context.Context, id int) (string, error)
+	Validate(ctx context.Context, req *NFSShareRequest) error
+	ValidateUpdate(ctx context.Context, id int, req *NFSShareRequest) error
+	Provision(ctx context.Context, req NFSProvisionRequest) (*NFSProvisionResult, error)
+	ProvisionWithProgress(ctx context.Context, req NFSProvisionRequest, fn ProvisionProgressFunc) (*NFSProvisionResult, error)
+}
+
+// SharingSMBAPI is implemented by SharingSMBClient
+type SharingSMBAPI interface {
+	List(ctx context.Context) ([]SMBShare, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]SMBShare, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[SMBShare, error]
+	Get(ctx context.Context, id int) (*SMBShare, error)
+	Create(ctx context.Context, req *SMBShareRequest) (*SMBShare, error)
+	Update(ctx context.Context, id int, req *SMBShareRequest) (*SMBShare, error)
+	Diff(ctx context.Context, id int, desired *SMBShareRequest) ([]FieldDiff, error)
+	Delete(ctx context.Context, id int) error
+	GetPresets(ctx context.Context) ([]SMBPreset, error)
+	CreateTimeMachine(ctx context.Context, path, name string, quotaGiB int) (*SMBShare, error)
+	Provision(ctx context.Context, req SMBProvisionRequest) (*SMBProvisionResult, error)
+	ProvisionWithProgress(ctx context.Context, req SMBProvisionRequest, fn ProvisionProgressFunc) (*SMBProvisionResult, error)
+}
+
+// SharingWebDAVAPI is implemented by SharingWebDAVClient
+type SharingWebDAVAPI interface {
+	List(ctx context.Context) ([]WebDAVShare, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]WebDAVShare, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[WebDAVShare, error]
+	Get(ctx context.Context, id int) (*WebDAVShare, error)
+	Create(ctx context.Context, req *WebDAVShareRequest) (*WebDAVShare, error)
+	Update(ctx context.Context, id int, req *WebDAVShareRequest) (*WebDAVShare, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// SmartAPI is implemented by SmartClient
+type SmartAPI interface {
+	GetConfig(ctx context.Context) (*SmartConfig, error)
+	UpdateConfig(ctx context.Context, config *SmartConfig) (*SmartConfig, error)
+	ListTests(ctx context.Context) ([]SmartTest, error)
+	ListTestsWithQuery(ctx context.Context, q *Query) ([]SmartTest, error)
+	GetTest(ctx context.Context, id int) (*SmartTest, error)
+	CreateTest(ctx context.Context, req *SmartTestCreateRequest) (*SmartTest, error)
+	UpdateTest(ctx context.Context, id int, req *SmartTestCreateRequest) (*SmartTest, error)
+	DeleteTest(ctx context.Context, id int) error
+	GetDiskChoices(ctx context.Context, fullDisk bool) (any, error)
+	RunManualTest(ctx context.Context, tests []SmartManualTestRequest) ([]SmartManualTestResult, error)
+	WaitForTests(ctx context.Context, disks []string) ([]SmartTestResult, error)
+	GetAllTestResults(ctx context.Context) ([]SmartTestResult, error)
+	GetDiskTestResults(ctx context.Context, diskName string) (*SmartTestResult, error)
+	GetDiskAttributes(ctx context.Context, diskName string) ([]SmartAttributes, error)
+	GetDiskSmartOutput(ctx context.Context, diskName string) (json.RawMessage, error)
+	Smartctl(ctx context.Context, diskName string, args ...string) (string, error)
+}
+
+// SnapshotAPI is implemented by SnapshotClient
+type SnapshotAPI interface {
+	List(ctx context.Context) ([]Snapshot, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]Snapshot, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[Snapshot, error]
+	ListByDataset(ctx context.Context, dataset string) ([]Snapshot, error)
+	Get(ctx context.Context, id string) (*Snapshot, error)
+	Create(ctx context.Context, req *SnapshotCreateRequest) (*Snapshot, error)
+	Delete(ctx context.Context, id string, options *SnapshotDeleteOptions) error
+	Clone(ctx context.Context, req *SnapshotCloneRequest) error
+	Rollback(ctx context.Context, id string, options *SnapshotRollbackOptions) error
+	Hold(ctx context.Context, id string, recursive bool) error
+	Release(ctx context.Context, id string, recursive bool) error
+}
+
+// SnapshotTaskAPI is implemented by SnapshotTaskClient
+type SnapshotTaskAPI interface {
+	List(ctx context.Context) ([]SnapshotTask, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]SnapshotTask, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[SnapshotTask, error]
+	Get(ctx context.Context, id int) (*SnapshotTask, error)
+	Create(ctx context.Context, req *SnapshotTaskRequest) (*SnapshotTask, error)
+	Update(ctx context.Context, id int, req *SnapshotTaskRequest) (*SnapshotTask, error)
+	Delete(ctx context.Context, id int) error
+	Run(ctx context.Context, id int) error
+}
+
+// StorageAPI is implemented by StorageClient
+type StorageAPI interface {
+	Usage(ctx context.Context) (*StorageUsage, error)
+}
+
+// SystemAPI is implemented by SystemClient
+type SystemAPI interface {
+	Info(ctx context.Context) (*SystemInfo, error)
+	GetInfo(ctx context.Context) (*SystemInfo, error)
+	GetGeneralConfig(ctx context.Context) (*SystemGeneralConfig, error)
+	UpdateGeneralConfig(ctx context.Context, config *SystemGeneralConfig) (*SystemGeneralConfig, error)
+	SetUICertificate(ctx context.Context, certificateID int) error
+	GetAdvancedConfig(ctx context.Context) (*SystemAdvancedConfig, error)
+	UpdateAdvancedConfig(ctx context.Context, req *SystemAdvancedUpdateRequest) (*SystemAdvancedConfig, error)
+	GetSerialPortChoices(ctx context.Context) (map[string]string, error)
+	ConfigSave(ctx context.Context, w io.Writer, options *ConfigSaveOptions) error
+	ConfigUpload(ctx context.Context, r io.Reader) error
+	ConfigReset(ctx context.Context, reboot bool) error
+	Reboot(ctx context.Context, delay int) error
+	RebootWithOptions(ctx context.Context, options *SystemPowerOptions) error
+	Shutdown(ctx context.Context, delay int) error
+	ShutdownWithOptions(ctx context.Context, options *SystemPowerOptions) error
+	Ready(ctx context.Context) (bool, error)
+	Version(ctx context.Context) (ServerVersion, error)
+	GetVersion(ctx context.Context) (string, error)
+	Hostname(ctx context.Context) (string, error)
+	GetHostname(ctx context.Context) (string, error)
+	SetHostname(ctx context.Context, hostname string) error
+	Product(ctx context.Context) (ProductType, error)
+	FeatureEnabled(ctx context.Context, feature SystemFeature) (bool, error)
+	License(ctx context.Context) (*SystemLicense, error)
+	IsStable(ctx context.Context) (bool, error)
+	ListBootEnvs(ctx context.Context) ([]BootEnv, error)
+	ListBootEnvsWithQuery(ctx context.Context, q *Query) ([]BootEnv, error)
+	CreateBootEnv(ctx context.Context, name, source string) (*BootEnv, error)
+	DeleteBootEnv(ctx context.Context, id string) error
+	ActivateBootEnv(ctx context.Context, id string) error
+	SetBootEnvAttr(ctx context.Context, id string, attrs map[string]any) error
+	GetPendingUpdate(ctx context.Context) (*UpdateInfo, error)
+	ManualUpdate(ctx context.Context, path string, rebootAfter bool) error
+}
+
+// SystemDatasetAPI is implemented by SystemDatasetClient
+type SystemDatasetAPI interface {
+	GetConfig(ctx context.Context) (*SystemDatasetConfig, error)
+	UpdateConfig(ctx context.Context, req *SystemDatasetUpdateRequest) (*SystemDatasetConfig, error)
+	PoolChoices(ctx context.Context, includeCurrentPool bool) (map[string]string, error)
+}
+
+// TrueCommandAPI is implemented by TrueCommandClient
+type TrueCommandAPI interface {
+	GetConfig(ctx context.Context) (*TrueCommandConfig, error)
+	UpdateConfig(ctx context.Context, req *TrueCommandUpdateRequest) (*TrueCommandConfig, error)
+	Connected(ctx context.Context) (*TrueCommandConnection, error)
+}
+
+// UpdateAPI is implemented by UpdateClient
+type UpdateAPI interface {
+	GetConfig(ctx context.Context) (*UpdateConfig, error)
+	SetAutoCheck(ctx context.Context, autoCheck bool) (*UpdateConfig, error)
+	CheckAvailable(ctx context.Context, train string) (*UpdateInfo, error)
+	GetPending(ctx context.Context) ([]UpdatePendingChange, error)
+	Download(ctx context.Context, fn JobProgressFunc) (bool, error)
+	Apply(ctx context.Context, options *UpdateApplyOptions, fn JobProgressFunc) error
+	GetTrains(ctx context.Context) (*UpdateTrains, error)
+	SetTrain(ctx context.Context, train string) error
+}
+
+// UserAPI is implemented by UserClient
+type UserAPI interface {
+	List(ctx context.Context) ([]User, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]User, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[User, error]
+	ListWithDSCache(ctx context.Context) ([]User, error)
+	Get(ctx context.Context, id int) (*User, error)
+	GetByUsername(ctx context.Context, username string) (*User, error)
+	Create(ctx context.Context, req *UserCreateRequest) (*User, error)
+	Update(ctx context.Context, id int, req *UserUpdateRequest) (*User, error)
+	Delete(ctx context.Context, id int, req *UserDeleteRequest) error
+	GetNextUID(ctx context.Context) (int, error)
+	GetUserObj(ctx context.Context, req UserGetRequest) (map[string]any, error)
+	HasRootPassword(ctx context.Context) (bool, error)
+	SetRootPassword(ctx context.Context, req SetRootPasswordRequest) error
+	SetRootPasswordSimple(ctx context.Context, password string) error
+	GetShellChoices(ctx context.Context, userID *int) (map[string]string, error)
+	SetAttribute(ctx context.Context, id int, key string, value any) error
+	PopAttribute(ctx context.Context, id int, key string) error
+}
+
+// VMAPI is implemented by VMClient
+type VMAPI interface {
+	List(ctx context.Context) ([]VM, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]VM, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[VM, error]
+	Get(ctx context.Context, id int) (*VM, error)
+	Create(ctx context.Context, req *VMCreateRequest) (*VM, error)
+	Update(ctx context.Context, id int, req *VMUpdateRequest) (*VM, error)
+	Delete(ctx context.Context, id int, req *VMDeleteRequest) error
+	Clone(ctx context.Context, id int, name string) (*VM, error)
+	Start(ctx context.Context, id int, req *VMStartRequest) error
+	Stop(ctx context.Context, id int, req *VMStopRequest) error
+	PowerOff(ctx context.Context, id int) error
+	Restart(ctx context.Context, id int) error
+	GetStatus(ctx context.Context, id int) (*VMStatus, error)
+	GetFlags(ctx context.Context) (map[string]any, error)
+	GetAvailableMemory(ctx context.Context, overcommit bool) (int, error)
+	GetMemoryInUse(ctx context.Context) (*VMMemoryInfo, error)
+	GetAttachedInterfaces(ctx context.Context, id int) ([]string, error)
+	GetConsole(ctx context.Context, id int) (string, error)
+	GetDisplayDevices(ctx context.Context, id int) ([]VMDevice, error)
+	GetDisplayURL(ctx context.Context, id int) ([]VMDisplayURL, error)
+	GetVNC(ctx context.Context, id int) ([]map[string]any, error)
+	GetVNCWeb(ctx context.Context, id int, host string) ([]string, error)
+	GetVNCIPv4(ctx context.Context) ([]string, error)
+	GetVNCPortWizard(ctx context.Context) (any, error)
+	GenerateRandomMAC(ctx context.Context) (string, error)
+	IdentifyHypervisor(ctx context.Context) (bool, error)
+}
+
+// VMDeviceAPI is implemented by VMDeviceClient
+type VMDeviceAPI interface {
+	List(ctx context.Context) ([]VMDevice, error)
+	ListWithQuery(ctx context.Context, q *Query) ([]VMDevice, error)
+	ListIter(ctx context.Context, q *Query) iter.Seq2[VMDevice, error]
+	Get(ctx context.Context, id int) (*VMDevice, error)
+	Create(ctx context.Context, req *VMDeviceCreateRequest) (*VMDevice, error)
+	Update(ctx context.Context, id int, req *VMDeviceCreateRequest) (*VMDevice, error)
+	Delete(ctx context.Context, id int, req *VMDeviceDeleteRequest) error
+	GetNICAttachChoices(ctx context.Context) (map[string]any, error)
+	GetPPTDevChoices(ctx context.Context) (map[string]any, error)
+	GetVNCBindChoices(ctx context.Context) (map[string]any, error)
+}
+
+var (
+	_ ACLTemplateAPI     = (*ACLTemplateClient)(nil)
+	_ APIKeyAPI          = (*APIKeyClient)(nil)
+	_ AlertAPI           = (*AlertClient)(nil)
+	_ AlertServiceAPI    = (*AlertServiceClient)(nil)
+	_ AppAPI             = (*AppClient)(nil)
+	_ AuditAPI           = (*AuditClient)(nil)
+	_ AuthAPI            = (*AuthClient)(nil)
+	_ BootAPI            = (*BootClient)(nil)
+	_ CatalogAPI         = (*CatalogClient)(nil)
+	_ CertificateAPI     = (*CertificateClient)(nil)
+	_ ChartReleaseAPI    = (*ChartReleaseClient)(nil)
+	_ CloudCredentialAPI = (*CloudCredentialClient)(nil)
+	_ CloudSyncAPI       = (*CloudSyncClient)(nil)
+	_ CronjobAPI         = (*CronjobClient)(nil)
+	_ DatasetAPI         = (*DatasetClient)(nil)
+	_ DiskAPI            = (*DiskClient)(nil)
+	_ DockerAPI          = (*DockerClient)(nil)
+	_ DynDNSAPI          = (*DynDNSClient)(nil)
+	_ FilesystemAPI      = (*FilesystemClient)(nil)
+	_ GroupAPI           = (*GroupClient)(nil)
+	_ JailAPI            = (*JailClient)(nil)
+	_ JobAPI             = (*JobClient)(nil)
+	_ JobsAPI            = (*JobsClient)(nil)
+	_ KeychainAPI        = (*KeychainClient)(nil)
+	_ KerberosAPI        = (*KerberosClient)(nil)
+	_ KerberosKeytabAPI  = (*KerberosKeytabClient)(nil)
+	_ KerberosRealmAPI   = (*KerberosRealmClient)(nil)
+	_ KubernetesAPI      = (*KubernetesClient)(nil)
+	_ NFSAPI             = (*NFSClient)(nil)
+	_ NetworkAPI         = (*NetworkClient)(nil)
+	_ PluginAPI          = (*PluginClient)(nil)
+	_ PoolAPI            = (*PoolClient)(nil)
+	_ PrivilegeAPI       = (*PrivilegeClient)(nil)
+	_ ReplicationAPI     = (*ReplicationClient)(nil)
+	_ ReportingAPI       = (*ReportingClient)(nil)
+	_ SMBAPI             = (*SMBClient)(nil)
+	_ SNMPAPI            = (*SNMPClient)(nil)
+	_ SSHAPI             = (*SSHClient)(nil)
+	_ ServiceAPI         = (*ServiceClient)(nil)
+	_ SharingAFPAPI      = (*SharingAFPClient)(nil)
+	_ SharingNFSAPI      = (*SharingNFSClient)(nil)
+	_ SharingSMBAPI      = (*SharingSMBClient)(nil)
+	_ SharingWebDAVAPI   = (*SharingWebDAVClient)(nil)
+	_ SmartAPI           = (*SmartClient)(nil)
+	_ SnapshotAPI        = (*SnapshotClient)(nil)
+	_ SnapshotTaskAPI    = (*SnapshotTaskClient)(nil)
+	_ StorageAPI         = (*StorageClient)(nil)
+	_ SystemAPI          = (*SystemClient)(nil)
+	_ SystemDatasetAPI   = (*SystemDatasetClient)(nil)
+	_ TrueCommandAPI     = (*TrueCommandClient)(nil)
+	_ UpdateAPI          = (*UpdateClient)(nil)
+	_ UserAPI            = (*UserClient)(nil)
+	_ VMAPI              = (*VMClient)(nil)
+	_ VMDeviceAPI        = (*VMDeviceClient)(nil)
+)
@@ -0,0 +1,85 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Batch collects method calls that are sent together and awaited concurrently,
+// so that a set of independent calls costs one round trip instead of one each
+type Batch struct {
+	client *Client
+	calls  []*BatchCall
+}
+
+// BatchCall is a single call in a Batch. Its fields are set once Batch.Do returns.
+type BatchCall struct {
+	Method string
+	Params []any
+	Result json.RawMessage
+	Err    error
+
+	v any
+}
+
+// Batch creates an empty batch of calls
+func (c *Client) Batch() *Batch {
+	return &Batch{client: c}
+}
+
+// Add queues a call. If v is not nil, the result is unmarshaled into it by Do.
+func (b *Batch) Add(method string, params []any, v any) *BatchCall {
+	call := &BatchCall{Method: method, Params: params, v: v}
+	b.calls = append(b.calls, call)
+	return call
+}
+
+// Len returns the number of queued calls
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Do sends all queued calls without waiting for each other's replies and waits
+// for every reply. Each call's outcome is recorded on its BatchCall; the returned
+// error joins the errors of all calls that failed.
+func (b *Batch) Do(ctx context.Context) error {
+	ctx, cancel := b.client.callContext(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for _, call := range b.calls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			call.do(ctx, b.client)
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, call := range b.calls {
+		if call.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", call.Method, call.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (bc *BatchCall) do(ctx context.Context, c *Client) {
+	params := bc.Params
+	if params == nil {
+		params = []any{}
+	}
+	result, err := c.call(ctx, bc.Method, params)
+	if err != nil {
+		bc.Err = err
+		return
+	}
+	bc.Result = result.Result
+	if bc.v != nil {
+		bc.Err = result.Unmarshal(bc.v)
+	}
+}
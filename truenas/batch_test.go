@@ -0,0 +1,80 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch_Do(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.04.1")
+	server.SetResponse("pool.query", []Pool{{ID: 1, Name: "tank"}})
+	server.SetResponse("service.query", []Service{{ID: 4, Service: "cifs", State: ServiceStateRunning}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	var version string
+	var pools []Pool
+	var services []Service
+	batch := client.Batch()
+	batch.Add("system.version", nil, &version)
+	batch.Add("pool.query", []any{}, &pools)
+	raw := batch.Add("service.query", nil, &services)
+	assert.Equal(t, 3, batch.Len())
+
+	ctx := NewTestContext(t)
+	require.NoError(t, batch.Do(ctx))
+	assert.Equal(t, "TrueNAS-SCALE-24.04.1", version)
+	require.Len(t, pools, 1)
+	assert.Equal(t, "tank", pools[0].Name)
+	require.Len(t, services, 1)
+	assert.JSONEq(t, `[{"id": 4, "service": "cifs", "enable": false, "state": "RUNNING", "pids": null}]`, string(raw.Result))
+}
+
+func TestBatch_Do_PartialFailure(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.04.1")
+	server.SetError("pool.query", 13, "Not authorized")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	var version string
+	batch := client.Batch()
+	ok := batch.Add("system.version", nil, &version)
+	failed := batch.Add("pool.query", nil, nil)
+
+	ctx := NewTestContext(t)
+	err := batch.Do(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pool.query")
+
+	var apiErr *ErrorMsg
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 13, apiErr.Code)
+
+	assert.NoError(t, ok.Err)
+	assert.Equal(t, "TrueNAS-SCALE-24.04.1", version)
+	assert.ErrorAs(t, failed.Err, &apiErr)
+}
+
+func TestBatch_Do_Empty(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	assert.NoError(t, client.Batch().Do(ctx))
+}
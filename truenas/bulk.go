@@ -0,0 +1,95 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// BulkResult is the outcome of one call made by Client.Bulk
+type BulkResult struct {
+	JobID  *int            // Job started by the call, for methods that run as jobs
+	Result json.RawMessage // The call's result, or the job's result for job methods
+	Err    error           // A *BulkError if the call failed
+}
+
+// Unmarshal decodes the call's result into v
+func (r *BulkResult) Unmarshal(v any) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	if err := unmarshalJSON(r.Result, v); err != nil {
+		return fmt.Errorf("unmarshal result: %s: %w", string(r.Result), err)
+	}
+	return nil
+}
+
+// BulkResults holds the outcomes of a Client.Bulk call in the order of its params
+type BulkResults []BulkResult
+
+// Err joins the errors of all calls that failed, or returns nil if none did
+func (r BulkResults) Err() error {
+	var errs []error
+	for _, result := range r {
+		if result.Err != nil {
+			errs = append(errs, result.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// BulkError is the error of a single call made by Client.Bulk
+type BulkError struct {
+	Method  string
+	Index   int // Position of the call's params in the list passed to Bulk
+	Message string
+}
+
+// Error implements the error interface
+func (e *BulkError) Error() string {
+	return fmt.Sprintf("%s [%d]: %s", e.Method, e.Index, e.Message)
+}
+
+// bulkItem is a single entry of the core.bulk result
+type bulkItem struct {
+	JobID  *int            `json:"job_id"`
+	Error  *string         `json:"error"`
+	Result json.RawMessage `json:"result"`
+}
+
+// Bulk calls method once for each entry of paramsList in a single core.bulk job, so
+// that deleting hundreds of snapshots takes one job instead of hundreds of calls. The
+// server makes the calls one after another, waiting for those that start jobs.
+//
+// The returned error reports the bulk job itself failing; the outcome of each call is
+// recorded on its result, and BulkResults.Err joins the errors of those that failed.
+func (c *Client) Bulk(ctx context.Context, method string, paramsList [][]any) (BulkResults, error) {
+	if err := c.checkVersion(method); err != nil {
+		return nil, err
+	}
+	list := make([][]any, len(paramsList))
+	for i, params := range paramsList {
+		if params == nil {
+			params = []any{}
+		}
+		list[i] = params
+	}
+
+	var items []bulkItem
+	if err := c.CallJob(ctx, "core.bulk", []any{method, list}, &items); err != nil {
+		return nil, err
+	}
+	if len(items) != len(list) {
+		return nil, fmt.Errorf("core.bulk: %d results for %d calls", len(items), len(list))
+	}
+
+	results := make(BulkResults, len(items))
+	for i, item := range items {
+		results[i] = BulkResult{JobID: item.JobID, Result: item.Result}
+		if item.Error != nil {
+			results[i].Err = &BulkError{Method: method, Index: i, Message: *item.Error}
+		}
+	}
+	return results, nil
+}
@@ -0,0 +1,68 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Bulk(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("core.bulk", []map[string]any{
+		{"job_id": nil, "error": nil, "result": true},
+		{"job_id": nil, "error": "[ENOENT] Snapshot tank@missing not found", "result": nil},
+		{"job_id": 12, "error": nil, "result": map[string]any{"id": "tank@b"}},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	results, err := client.Bulk(ctx, "zfs.snapshot.delete", [][]any{{"tank@a"}, {"tank@missing"}, nil})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	server.AssertCalled(t, "core.bulk", "zfs.snapshot.delete", [][]any{{"tank@a"}, {"tank@missing"}, {}})
+
+	var deleted bool
+	require.NoError(t, results[0].Unmarshal(&deleted))
+	assert.True(t, deleted)
+	assert.Nil(t, results[0].JobID)
+
+	var bulkErr *BulkError
+	require.ErrorAs(t, results[1].Err, &bulkErr)
+	assert.Equal(t, 1, bulkErr.Index)
+	assert.Equal(t, "zfs.snapshot.delete [1]: [ENOENT] Snapshot tank@missing not found", bulkErr.Error())
+	assert.ErrorIs(t, results[1].Unmarshal(&deleted), results[1].Err)
+
+	var snapshot Snapshot
+	require.NoError(t, results[2].Unmarshal(&snapshot))
+	assert.Equal(t, "tank@b", snapshot.ID)
+	assert.Equal(t, Ptr(12), results[2].JobID)
+
+	assert.ErrorIs(t, results.Err(), results[1].Err)
+	assert.NoError(t, results[:1].Err())
+}
+
+func TestClient_Bulk_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	// A mismatched number of results is reported instead of misattributing outcomes
+	server.SetJobResponse("core.bulk", []map[string]any{})
+	_, err := client.Bulk(NewTestContext(t), "sharing.smb.update", [][]any{{1, map[string]any{"enabled": false}}})
+	assert.ErrorContains(t, err, "0 results for 1 calls")
+
+	server.SetJobError("core.bulk", "Method does not exist")
+	_, err = client.Bulk(NewTestContext(t), "sharing.smb.update", [][]any{{1, map[string]any{"enabled": false}}})
+	var jobErr *JobError
+	require.ErrorAs(t, err, &jobErr)
+	assert.Equal(t, "core.bulk", jobErr.Method)
+}
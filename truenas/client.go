@@ -4,7 +4,10 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -21,38 +24,116 @@ type Options struct {
 	Username            string
 	Password            string
 	APIKey              string
-	Debug               bool
+	Token               string // Short-lived token from auth.generate_token
 	DefaultWriteTimeout time.Duration
-	DefaultLogger       Logger
+	// DefaultCallTimeout bounds each call whose context has no deadline.
+	// Defaults to DefaultWriteTimeout; WithCallTimeout overrides it per call.
+	DefaultCallTimeout time.Duration
+	// DefaultJobTimeout bounds waiting for a job when the context has no
+	// deadline. Zero waits until the job finishes.
+	DefaultJobTimeout time.Duration
+	// JobPollInterval is the first interval between core.get_jobs polls while
+	// waiting for a job, growing by half up to JobPollMaxInterval. Defaults to 500ms.
+	JobPollInterval time.Duration
+	// JobPollMaxInterval caps the job poll interval. Defaults to 5s.
+	JobPollMaxInterval time.Duration
+	// DisableJobEvents waits for jobs by polling alone. By default job updates
+	// arrive through a core.get_jobs subscription, backed up by slow polling.
+	DisableJobEvents bool
+	// PingInterval is how often WebSocket pings are sent. Defaults to 30s;
+	// negative disables keepalive.
+	PingInterval time.Duration
+	// PongTimeout is how long past a ping interval the connection may stay
+	// silent before it is considered dead and reconnected. Defaults to 10s.
+	PongTimeout time.Duration
+	// Connections is the number of WebSocket connections calls are spread
+	// across, each authenticated separately, so slow calls on one do not hold
+	// up the rest. Subscriptions and batches use the first. Defaults to 1.
+	Connections int
+	// OnConnect is called once the initial connection is authenticated.
+	// Callbacks run on the client's connection goroutines and must not block.
+	OnConnect func()
+	// OnDisconnect is called with the cause when the connection is lost,
+	// before the client starts reconnecting.
+	OnDisconnect func(err error)
+	// OnReconnect is called once a reconnect succeeds and subscriptions are restored.
+	OnReconnect func()
+	// Logger receives a record per call with its method, duration, redacted
+	// params and error, connection lifecycle events, and events that subscriptions
+	// drop or fail to handle.
+	Logger *slog.Logger
+	// Debug logs raw protocol messages to DefaultLogger, with secrets redacted.
+	//
+	// Deprecated: use Logger.
+	Debug bool
+	// DefaultLogger receives the output enabled by Debug.
+	//
+	// Deprecated: use Logger.
+	DefaultLogger Logger
+	// RetryPolicy retries calls that fail with transient connection errors.
+	// Nil disables retries; WithRetryPolicy overrides it per call.
+	RetryPolicy *RetryPolicy
+	// RateLimit limits how fast calls are sent. Nil sends them as they are made.
+	RateLimit *RateLimit
+	// Transport selects WebSocket (the default) or the REST v2.0 API.
+	// Event subscriptions are only available over WebSocket.
+	Transport Transport
+	// Interceptors wrap every call, the first being outermost.
+	Interceptors []Interceptor
+	// Protocol selects DDP or JSON-RPC 2.0 over WebSocket. By default it is
+	// detected from the endpoint: /api/current speaks JSON-RPC, /websocket DDP.
+	Protocol Protocol
 }
 
 type Client struct {
 	// Type-safe API clients
-	Auth         *AuthClient
-	Pool         *PoolClient
-	Dataset      *DatasetClient
-	Service      *ServiceClient
-	System       *SystemClient
-	Network      *NetworkClient
-	SMB          *SMBClient
-	NFS          *NFSClient
-	SSH          *SSHClient
-	Smart        *SmartClient
-	VM           *VMClient
-	Job          *JobClient
-	VMDevice     *VMDeviceClient
-	User         *UserClient
-	Group        *GroupClient
-	Alert        *AlertClient
-	AlertService *AlertServiceClient
-	Boot         *BootClient
-	Certificate  *CertificateClient
-	Cronjob      *CronjobClient
-	Disk         *DiskClient
-	APIKey       *APIKeyClient
-	Filesystem   *FilesystemClient
-	Sharing      *SharingClient
-	App          *AppClient
+	Auth          *AuthClient
+	Pool          *PoolClient
+	Dataset       *DatasetClient
+	Service       *ServiceClient
+	System        *SystemClient
+	Network       *NetworkClient
+	SMB           *SMBClient
+	NFS           *NFSClient
+	SSH           *SSHClient
+	SNMP          *SNMPClient
+	DynDNS        *DynDNSClient
+	Smart         *SmartClient
+	VM            *VMClient
+	Job           *JobClient
+	Jobs          *JobsClient
+	VMDevice      *VMDeviceClient
+	User          *UserClient
+	Group         *GroupClient
+	Alert         *AlertClient
+	AlertService  *AlertServiceClient
+	Boot          *BootClient
+	Certificate   *CertificateClient
+	Cronjob       *CronjobClient
+	Disk          *DiskClient
+	APIKey        *APIKeyClient
+	Filesystem    *FilesystemClient
+	Sharing       *SharingClient
+	App           *AppClient
+	Snapshot      *SnapshotClient
+	CloudSync     *CloudSyncClient
+	SnapshotTask  *SnapshotTaskClient
+	Replication   *ReplicationClient
+	Keychain      *KeychainClient
+	Chart         *ChartReleaseClient // Kubernetes apps before SCALE 24.10; use App and Docker on later releases
+	Catalog       *CatalogClient
+	Kubernetes    *KubernetesClient
+	Kerberos      *KerberosClient
+	Reporting     *ReportingClient
+	SystemDataset *SystemDatasetClient
+	Update        *UpdateClient
+	Audit         *AuditClient
+	Storage       *StorageClient
+	Privilege     *PrivilegeClient
+	TrueCommand   *TrueCommandClient
+	Docker        *DockerClient
+	Jail          *JailClient   // iocage jails, CORE only
+	Plugin        *PluginClient // CORE only
 	// Subscription client
 	Subscribe *ClientSubscribe
 
@@ -63,30 +144,83 @@ type Client struct {
 	opts        Options
 	mu          sync.RWMutex
 	msgID       atomic.Int64
+	invoke      Invoker // invokeCall wrapped in Options.Interceptors
 	pending     *xsync.MapOf[string, chan Message]
+	methods     *xsync.MapOf[string, string] // Methods of pending calls keyed by ID, for Options.Debug
+	protocol    Protocol
+	rpcSubs     *xsync.MapOf[string, string] // JSON-RPC subscription IDs keyed by request ID
+	jobs        *jobWatcher
 	writeChan   chan *Message
 	errCh       chan error
 	reconnectCh chan struct{}
 	doneCh      chan struct{} // Signal when client should shut down
+	httpClient  *http.Client  // Used for file transfers over HTTP
 	closed      atomic.Bool
+	reconnects  atomic.Int64
+	state       atomic.Value // ConnectionState
+	peers       []*Client    // Extra connections from Options.Connections
+	version     atomic.Pointer[ServerVersion]
+	limiter     *rateLimiter // Nil without Options.RateLimit
+	calls       callTracker  // Calls and jobs in flight, awaited by Shutdown
 	wg          sync.WaitGroup
 }
 
-// NewClient builds a new TrueNAS Client.
+// NewClient builds a new TrueNAS Client and detects the server's version.
 // Close() should be called to clean up resources when the client is no longer needed.
 func NewClient(endpoint string, opts Options) (*Client, error) {
+	return newClient(endpoint, opts, nil)
+}
+
+// newClient builds a client. Clients derived from a parent, such as extra pool connections,
+// share its HTTP client and detected server version instead of detecting it again.
+func newClient(endpoint string, opts Options, parent *Client) (*Client, error) {
 	c := &Client{
 		logger:      &defaultLogger{},
 		url:         endpoint,
 		opts:        opts,
 		pending:     xsync.NewMapOf[string, chan Message](),
+		methods:     xsync.NewMapOf[string, string](),
+		protocol:    opts.Protocol,
+		rpcSubs:     xsync.NewMapOf[string, string](),
 		errCh:       make(chan error, 1),
 		reconnectCh: make(chan struct{}, 1),
 		doneCh:      make(chan struct{}),
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // Match the websocket dialer
+			},
+		},
+	}
+	if parent != nil {
+		c.httpClient = parent.httpClient
+		c.version.Store(parent.ServerVersion())
 	}
 	if c.opts.DefaultWriteTimeout == 0 {
 		c.opts.DefaultWriteTimeout = 5 * time.Second
 	}
+	if c.opts.DefaultCallTimeout == 0 {
+		c.opts.DefaultCallTimeout = c.opts.DefaultWriteTimeout
+	}
+	if c.opts.JobPollInterval == 0 {
+		c.opts.JobPollInterval = 500 * time.Millisecond
+	}
+	if c.opts.JobPollMaxInterval == 0 {
+		c.opts.JobPollMaxInterval = 5 * time.Second
+	}
+	c.opts.JobPollMaxInterval = max(c.opts.JobPollMaxInterval, c.opts.JobPollInterval)
+	if c.opts.PingInterval == 0 {
+		c.opts.PingInterval = 30 * time.Second
+	}
+	if c.opts.PongTimeout == 0 {
+		c.opts.PongTimeout = 10 * time.Second
+	}
+	c.invoke = chainInterceptors(c.opts.Interceptors, c.invokeCall)
+	c.limiter = newRateLimiter(c.opts.RateLimit)
+	c.state.Store(StateConnecting)
+	if c.protocol == ProtocolAuto {
+		c.protocol = detectProtocol(endpoint)
+	}
 	if c.opts.DefaultLogger != nil {
 		c.logger = c.opts.DefaultLogger
 	}
@@ -101,6 +235,8 @@ func NewClient(endpoint string, opts Options) (*Client, error) {
 	c.SMB = NewSMBClient(c)
 	c.NFS = NewNFSClient(c)
 	c.SSH = NewSSHClient(c)
+	c.SNMP = NewSNMPClient(c)
+	c.DynDNS = NewDynDNSClient(c)
 	c.Smart = NewSmartClient(c)
 	c.VM = NewVMClient(c)
 	c.VMDevice = NewVMDeviceClient(c)
@@ -108,6 +244,8 @@ func NewClient(endpoint string, opts Options) (*Client, error) {
 	c.Group = NewGroupClient(c)
 	c.Alert = NewAlertClient(c)
 	c.Job = NewJobClient(c)
+	c.Jobs = NewJobsClient(c)
+	c.jobs = newJobWatcher(c)
 	c.AlertService = NewAlertServiceClient(c)
 	c.Boot = NewBootClient(c)
 	c.Certificate = NewCertificateClient(c)
@@ -117,8 +255,37 @@ func NewClient(endpoint string, opts Options) (*Client, error) {
 	c.Filesystem = NewFilesystemClient(c)
 	c.Sharing = NewSharingClient(c)
 	c.App = NewAppClient(c)
+	c.Snapshot = NewSnapshotClient(c)
+	c.CloudSync = NewCloudSyncClient(c)
+	c.SnapshotTask = NewSnapshotTaskClient(c)
+	c.Replication = NewReplicationClient(c)
+	c.Keychain = NewKeychainClient(c)
+	c.Chart = NewChartReleaseClient(c)
+	c.Catalog = NewCatalogClient(c)
+	c.Kubernetes = NewKubernetesClient(c)
+	c.Kerberos = NewKerberosClient(c)
+	c.Reporting = NewReportingClient(c)
+	c.SystemDataset = NewSystemDatasetClient(c)
+	c.Update = NewUpdateClient(c)
+	c.Audit = NewAuditClient(c)
+	c.Storage = NewStorageClient(c)
+	c.Privilege = NewPrivilegeClient(c)
+	c.TrueCommand = NewTrueCommandClient(c)
+	c.Docker = NewDockerClient(c)
+	c.Jail = NewJailClient(c)
+	c.Plugin = NewPluginClient(c)
 	c.Subscribe = NewClientSubscribe(c)
 
+	if c.opts.Transport == TransportREST {
+		// Each request carries the credentials, so only check that they are accepted.
+		if err := c.detectVersion(context.Background()); err != nil {
+			_ = c.Close()
+			return nil, fmt.Errorf("authentication: %w", err)
+		}
+		c.connected()
+		return c, nil
+	}
+
 	if err := c.connect(); err != nil {
 		return nil, fmt.Errorf("connect: %w", err)
 	}
@@ -130,21 +297,37 @@ func NewClient(endpoint string, opts Options) (*Client, error) {
 		_ = c.Close()
 		return nil, fmt.Errorf("authentication: %w", err)
 	}
+	if parent == nil {
+		if err := c.detectVersion(context.Background()); err != nil {
+			c.logConnection(slog.LevelDebug, "truenas version detection failed", "error", err)
+		}
+	}
+	if err := c.dialPeers(); err != nil {
+		_ = c.Close()
+		return nil, err
+	}
+	c.connected()
 
 	return c, nil
 }
 
+// connected records the initial connection and notifies OnConnect
+func (c *Client) connected() {
+	if c.state.CompareAndSwap(StateConnecting, StateConnected) && c.opts.OnConnect != nil {
+		c.opts.OnConnect()
+	}
+}
+
+// Close closes the client's connections at once, failing calls in flight with
+// ErrClientClosed. Use Shutdown to let them finish first.
 func (c *Client) Close() error {
 	if !c.closed.CompareAndSwap(false, true) {
 		return nil // Already closed
 	}
+	c.state.Store(StateClosed)
 
 	// Cancel all pending requests by closing their channels
-	c.pending.Range(func(id string, ch chan Message) bool {
-		close(ch)
-		c.pending.Delete(id)
-		return true
-	})
+	c.failPending()
 
 	c.mu.Lock()
 	if c.conn != nil {
@@ -162,103 +345,290 @@ func (c *Client) Close() error {
 	close(c.doneCh)
 	close(c.reconnectCh)
 	c.wg.Wait()
+
+	for _, peer := range c.peers {
+		_ = peer.Close()
+	}
 	return nil
 }
 
+// Stats describes the client's connection history and rate limiting
+type Stats struct {
+	Reconnects       int64 // Successful reconnects of all connections since the client was created
+	RateLimitWaiting int64 // Calls waiting for Options.RateLimit
+	RateLimited      int64 // Calls delayed by Options.RateLimit since the client was created
+}
+
+// Stats returns the client's connection statistics
+func (c *Client) Stats() Stats {
+	stats := Stats{Reconnects: c.reconnects.Load()}
+	for _, peer := range c.peers {
+		stats.Reconnects += peer.Stats().Reconnects
+	}
+	if c.limiter != nil {
+		stats.RateLimitWaiting = c.limiter.waiting.Load()
+		stats.RateLimited = c.limiter.limited.Load()
+	}
+	return stats
+}
+
+// ConnectionState represents the health of the client's connection
+type ConnectionState string
+
+const (
+	StateConnecting   ConnectionState = "connecting"
+	StateConnected    ConnectionState = "connected"
+	StateReconnecting ConnectionState = "reconnecting"
+	StateClosed       ConnectionState = "closed"
+)
+
+// State returns the current connection state. Calls made while reconnecting
+// fail with ErrNotConnected unless a RetryPolicy is set.
+func (c *Client) State() ConnectionState {
+	return c.state.Load().(ConnectionState)
+}
+
 // Call calls the requested method, passing an optional set of arguments.
 // If v is not nil, the result will be unmarshaled into it.
 // Prefer to use the type-safe API clients for normal operations.
 func (c *Client) Call(ctx context.Context, method string, params []any, v any) error {
-	msgID := fmt.Sprintf("%d", c.msgID.Add(1))
-	if _, ok := ctx.Deadline(); !ok {
-		// Context doesn't have a timeout, apply the default.
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, c.opts.DefaultWriteTimeout)
-		defer cancel()
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	result, err := c.call(ctx, method, params)
+	if err != nil {
+		return err
+	}
+	if v != nil {
+		return result.Unmarshal(v)
 	}
+	return nil
+}
 
-	msg := &Message{
-		ID:     msgID,
-		Msg:    "method",
-		Method: method,
-		Params: params,
+// CallRaw calls a method and returns its undecoded JSON result.
+// Prefer to use the type-safe API clients for normal operations.
+func (c *Client) CallRaw(ctx context.Context, method string, params []any) (json.RawMessage, error) {
+	ctx, cancel := c.callContext(ctx)
+	defer cancel()
+
+	result, err := c.call(ctx, method, params)
+	if err != nil {
+		return nil, err
 	}
+	return result.Result, nil
+}
+
+// CallStream calls a query method, such as "zfs.snapshot.query", a page of records at a time
+// and passes each record to fn in order, so that listing e.g. 100k snapshots holds at most
+// one page in memory. q may be nil to match all records; its PageSize sets the records
+// fetched per call. Sort by a unique field, such as OrderBy("id"), so that records do not
+// move between pages. If fn returns an error, the remaining records are skipped and
+// CallStream returns that error.
+func (c *Client) CallStream(ctx context.Context, method string, q *Query, fn func(json.RawMessage) error) error {
+	list := func(ctx context.Context, page *Query) ([]json.RawMessage, error) {
+		return query[json.RawMessage](ctx, c, method, page)
+	}
+	for record, err := range paginate(ctx, q, list) {
+		if err != nil {
+			return err
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// call sends a method call through the interceptors and returns the reply,
+// converting middleware errors into Go errors.
+func (c *Client) call(ctx context.Context, method string, params []any) (Message, error) {
+	ctx, done, ok := c.calls.track(ctx)
+	if !ok {
+		return Message{}, ErrClientClosed
+	}
+	defer done()
+	if err := c.checkVersion(method); err != nil {
+		return Message{}, err
+	}
+	if err := c.limiter.wait(ctx, method); err != nil {
+		return Message{}, err
+	}
+	result, err := c.invoke(ctx, method, params)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Msg: "result", Method: method, Result: result}, nil
+}
+
+// invokeCall performs a call, retrying it according to the retry policy.
+func (c *Client) invokeCall(ctx context.Context, method string, params []any) (json.RawMessage, error) {
+	start := time.Now()
+	var result json.RawMessage
+	err := c.retry(ctx, func() error {
+		if c.opts.Transport == TransportREST {
+			var err error
+			result, err = c.restCall(ctx, method, params)
+			return err
+		}
+
+		target := c.pick()
+		reply, err := target.send(ctx, &Message{
+			ID:     target.nextID(),
+			Msg:    "method",
+			Method: method,
+			Params: params,
+		})
+		if err != nil {
+			return err
+		}
+		if reply.Error != nil {
+			return apiError(reply.Error)
+		}
+		result = reply.Result
+		return nil
+	})
+	c.logCall(ctx, method, params, start, err)
+	return result, err
+}
+
+// nextID returns a new unique message ID.
+func (c *Client) nextID() string {
+	return fmt.Sprintf("%d", c.msgID.Add(1))
+}
+
+// send queues msg for writing and waits for the reply routed back by msg.ID.
+func (c *Client) send(ctx context.Context, msg *Message) (Message, error) {
 	resultCh := make(chan Message, 1)
 
-	c.pending.Store(msgID, resultCh)
+	c.pending.Store(msg.ID, resultCh)
+	if c.opts.Debug {
+		// Lets readLoop redact the reply by the method it answers
+		c.methods.Store(msg.ID, msg.Method)
+	}
 	defer func() {
-		ch, ok := c.pending.LoadAndDelete(msgID)
+		ch, ok := c.pending.LoadAndDelete(msg.ID)
 		if ok {
 			close(ch)
 		}
+		c.methods.Delete(msg.ID)
 	}()
 
+	if err := c.write(ctx, msg); err != nil {
+		return Message{}, err
+	}
+
+	select {
+	case err := <-c.errCh:
+		return Message{}, err
+	case result, ok := <-resultCh:
+		if !ok {
+			if !c.closed.Load() {
+				// Channel was closed by readLoop, the connection dropped mid-call
+				return Message{}, ErrConnectionLost
+			}
+			// Channel was closed, client is shutting down
+			return Message{}, ErrClientClosed
+		}
+		return result, nil
+	case <-ctx.Done():
+		return Message{}, ctx.Err()
+	}
+}
+
+// write queues msg for writing without waiting for a reply.
+func (c *Client) write(ctx context.Context, msg *Message) error {
+	if c.opts.Transport == TransportREST {
+		return fmt.Errorf("%s messages: %w", msg.Msg, ErrTransportUnsupported)
+	}
+
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
 	if c.writeChan == nil || c.closed.Load() {
-		return fmt.Errorf("not connected")
+		return ErrNotConnected
 	}
 
 	select {
 	case c.writeChan <- msg:
-		// Message queued successfully
+		return nil
 	case <-ctx.Done():
 		return ctx.Err()
 	}
+}
 
-	select {
-	case err := <-c.errCh:
-		return err
-	case result, ok := <-resultCh:
-		if !ok {
-			// Channel was closed, client is shutting down
-			return fmt.Errorf("client closed")
-		}
-		if result.Error != nil {
-			return result.Error
-		}
-		if v != nil {
-			return result.Unmarshal(v)
-		}
-		return nil
-	case <-ctx.Done():
-		return ctx.Err()
+// Token returns a token for embedding in download or upload URLs, such as the
+// auth_token query parameter. It returns Options.Token when set and otherwise
+// generates a new token with the default lifetime.
+func (c *Client) Token(ctx context.Context) (string, error) {
+	if c.opts.Token != "" {
+		return c.opts.Token, nil
 	}
+	token, err := c.Auth.GenerateToken(ctx, GenerateTokenRequest{})
+	if err != nil {
+		return "", fmt.Errorf("generate token: %w", err)
+	}
+	return token.Token, nil
 }
 
 // CallJob calls a job method and waits for completion.
 // If v is not nil, the result will be unmarshaled into it.
 // Prefer to use the type-safe API clients for normal operations.
 func (c *Client) CallJob(ctx context.Context, method string, params []any, v any) error {
+	return c.CallJobWithProgress(ctx, method, params, v, nil)
+}
+
+// CallJobWithProgress calls a job method and waits for completion, invoking fn
+// whenever the job's state or progress changes. fn may be nil.
+// If v is not nil, the result will be unmarshaled into it.
+func (c *Client) CallJobWithProgress(ctx context.Context, method string, params []any, v any, fn JobProgressFunc) error {
+	ctx, done, ok := c.calls.track(ctx)
+	if !ok {
+		return fmt.Errorf("call %s: %w", method, ErrClientClosed)
+	}
+	defer done()
+	ctx, cancel := c.jobContext(ctx)
+	defer cancel()
+
 	var jobID int
-	if err := c.Call(ctx, method, params, &jobID); err != nil {
+	if err := c.Call(context.WithValue(ctx, jobCallKey{}, true), method, params, &jobID); err != nil {
 		return fmt.Errorf("call %s: %w", method, err)
 	}
 
-	job, err := c.Job.Wait(ctx, jobID)
+	return c.waitJob(ctx, method, jobID, v, fn)
+}
+
+// waitJob waits for jobID to complete and unmarshals its result into v.
+func (c *Client) waitJob(ctx context.Context, method string, jobID int, v any, fn JobProgressFunc) error {
+	job, err := c.Job.WaitWithProgress(ctx, jobID, fn)
 	if err != nil {
 		return fmt.Errorf("wait for job %d (%s): %w", jobID, method, err)
 	}
-
-	if v != nil && job.Result != nil {
-		resultBytes, err := json.Marshal(job.Result)
-		if err != nil {
-			return fmt.Errorf("marshal job result: %w", err)
-		}
-		if err := json.Unmarshal(resultBytes, v); err != nil {
-			return fmt.Errorf("unmarshal job result: %w", err)
-		}
-	}
-
-	return nil
+	return job.decodeResult(v)
 }
 
 func (c *Client) reconnect() error {
 	if err := c.connect(); err != nil {
 		return err
 	}
-	return c.authenticate()
+	// The login reply is read by readLoop, so the loops must run before authenticating.
+	conn := c.startLoops()
+	if err := c.authenticate(); err != nil {
+		_ = conn.Close()
+		return err
+	}
+	return nil
+}
+
+// startLoops starts the read and write loops for the current connection.
+func (c *Client) startLoops() *websocket.Conn {
+	c.wg.Add(2)
+	c.mu.RLock()
+	conn := c.conn
+	writeChan := c.writeChan
+	c.mu.RUnlock()
+	go c.readLoop(conn)
+	go c.writeLoop(conn, writeChan)
+	return conn
 }
 
 func (c *Client) connect() error {
@@ -282,6 +652,21 @@ func (c *Client) connect() error {
 		return fmt.Errorf("websocket dial: %s: %w", u.String(), err)
 	}
 
+	// JSON-RPC sessions need no handshake; DDP negotiates a session first.
+	if c.protocol != ProtocolJSONRPC {
+		if err := c.handshake(conn); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+	c.conn = conn
+	c.writeChan = make(chan *Message, 256)
+	c.closed.Store(false)
+	return nil
+}
+
+// handshake negotiates a DDP session on a new connection.
+func (c *Client) handshake(conn *websocket.Conn) error {
 	msg := map[string]any{
 		"msg":     "connect",
 		"version": "1",
@@ -291,7 +676,6 @@ func (c *Client) connect() error {
 		c.logger.Printf("send: %s\n", tryMarshal(msg))
 	}
 	if err := conn.WriteJSON(msg); err != nil {
-		conn.Close()
 		return fmt.Errorf("send connect request: %w", err)
 	}
 
@@ -300,29 +684,23 @@ func (c *Client) connect() error {
 		Session string `json:"session"`
 	}
 	if err := conn.ReadJSON(&resp); err != nil {
-		conn.Close()
 		return fmt.Errorf("read connection response: %w", err)
 	}
 	if c.opts.Debug {
 		c.logger.Printf("recv: %s\n", tryMarshal(resp))
 	}
 	if !strings.EqualFold(resp.Msg, "connected") {
-		conn.Close()
 		return fmt.Errorf("connection failed: %s", resp.Msg)
 	}
 	if resp.Session == "" {
-		conn.Close()
 		return fmt.Errorf("connected but did not receive a session")
 	}
-	c.conn = conn
-	c.writeChan = make(chan *Message, 256)
-	c.closed.Store(false)
 	return nil
 }
 
 func (c *Client) authenticate() error {
 	// Skip authentication if no credentials provided
-	if c.opts.APIKey == "" && c.opts.Username == "" && c.opts.Password == "" {
+	if c.opts.APIKey == "" && c.opts.Token == "" && c.opts.Username == "" && c.opts.Password == "" {
 		return nil
 	}
 
@@ -332,6 +710,9 @@ func (c *Client) authenticate() error {
 	if c.opts.APIKey != "" {
 		method = "auth.login_with_api_key"
 		params = []any{c.opts.APIKey}
+	} else if c.opts.Token != "" {
+		method = "auth.login_with_token"
+		params = []any{c.opts.Token}
 	} else {
 		method = "auth.login"
 		params = []any{c.opts.Username, c.opts.Password}
@@ -358,13 +739,7 @@ func (c *Client) connectionManager() {
 		}
 	}()
 
-	c.wg.Add(2)
-	c.mu.RLock()
-	conn := c.conn
-	writeChan := c.writeChan
-	c.mu.RUnlock()
-	go c.readLoop(conn)
-	go c.writeLoop(conn, writeChan)
+	c.startLoops()
 
 	bo := backoff.NewExponentialBackOff()
 	bo.InitialInterval = 100 * time.Millisecond
@@ -387,6 +762,7 @@ func (c *Client) connectionManager() {
 				if c.opts.Debug {
 					c.logger.Printf("reconnection failed, retrying in %s: %v\n", delay.String(), err)
 				}
+				c.logConnection(slog.LevelWarn, "truenas reconnect failed", "retry_in", delay, "error", err)
 				select {
 				case <-time.After(delay):
 					if !c.closed.Load() {
@@ -402,17 +778,17 @@ func (c *Client) connectionManager() {
 			continue
 		}
 		bo.Reset()
+		c.reconnects.Add(1)
 		if c.opts.Debug {
 			c.logger.Println("reconnected successfully")
 		}
+		c.logConnection(slog.LevelInfo, "truenas reconnected")
 
-		c.wg.Add(2)
-		c.mu.RLock()
-		conn := c.conn
-		writeChan := c.writeChan
-		c.mu.RUnlock()
-		go c.readLoop(conn)
-		go c.writeLoop(conn, writeChan)
+		// Subscriptions are bound to the old session, so replay them.
+		c.Subscribe.resubscribe()
+		if c.state.CompareAndSwap(StateReconnecting, StateConnected) && c.opts.OnReconnect != nil {
+			c.opts.OnReconnect()
+		}
 	}
 }
 
@@ -428,25 +804,46 @@ func (c *Client) readLoop(conn *websocket.Conn) {
 		return
 	}
 
+	// Any message or pong proves the connection is alive; a half-open
+	// connection stays silent until the read deadline expires.
+	c.extendReadDeadline(conn)
+	conn.SetPongHandler(func(string) error {
+		c.extendReadDeadline(conn)
+		return nil
+	})
+
 	for !c.closed.Load() {
 		var msg Message
 
-		// _, buf, err := conn.ReadMessage()
-		// _ = json.Unmarshal(buf, &msg)
-
-		if err := conn.ReadJSON(&msg); err != nil {
+		if err := c.readMessage(conn, &msg); err != nil {
 			if c.closed.Load() {
 				return
 			}
 			// Check for connection errors that should trigger reconnection
+			var netErr net.Error
 			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) ||
 				websocket.IsUnexpectedCloseError(err) ||
+				(errors.As(err, &netErr) && netErr.Timeout()) ||
 				strings.Contains(err.Error(), "connection reset") ||
 				strings.Contains(err.Error(), "broken pipe") ||
 				strings.Contains(err.Error(), "use of closed network connection") {
 				if c.opts.Debug {
 					c.logger.Printf("connection lost: %v\n", err)
 				}
+				c.logConnection(slog.LevelWarn, "truenas connection lost", "error", err)
+				// Stop queueing on the dead connection; replies to in-flight
+				// calls will never arrive on a new session.
+				c.mu.Lock()
+				if c.conn == conn && c.writeChan != nil {
+					close(c.writeChan)
+					c.writeChan = nil
+				}
+				c.mu.Unlock()
+				_ = conn.Close()
+				c.failPending()
+				if c.state.CompareAndSwap(StateConnected, StateReconnecting) && c.opts.OnDisconnect != nil {
+					c.opts.OnDisconnect(err)
+				}
 				select {
 				case c.reconnectCh <- struct{}{}:
 					// Successfully signaled reconnection
@@ -466,21 +863,54 @@ func (c *Client) readLoop(conn *websocket.Conn) {
 			}
 			continue
 		}
+		c.extendReadDeadline(conn)
 		if c.opts.Debug {
-			c.logger.Printf("recv: %s\n", tryMarshal(msg))
+			method, _ := c.methods.Load(msg.ID)
+			c.logger.Printf("recv: %s\n", tryMarshal(redactMessage(&msg, method)))
 		}
 
-		if msg.ID != "" {
-			if ch, exists := c.pending.Load(msg.ID); exists {
-				ch <- msg
+		switch {
+		case msg.Collection != "":
+			// Collection updates carry the ID of the changed object, not of a request.
+			c.Subscribe.dispatch(msg)
+		case msg.Msg == "ready":
+			for _, id := range msg.Subs {
+				c.deliver(id, msg)
 			}
-		}
-		// Event `collection_update`
-		if msg.Collection != "" {
-			if ch, exists := c.pending.Load(msg.Collection); exists {
-				ch <- msg
+		case msg.Msg == "nosub":
+			if !c.deliver(msg.ID, msg) {
+				c.Subscribe.closed(msg.ID)
 			}
+		case msg.ID != "":
+			c.deliver(msg.ID, msg)
+		}
+	}
+}
+
+// failPending releases every in-flight call, after the connection is lost or the client closes.
+func (c *Client) failPending() {
+	c.pending.Range(func(id string, _ chan Message) bool {
+		if ch, ok := c.pending.LoadAndDelete(id); ok {
+			close(ch)
 		}
+		return true
+	})
+}
+
+// deliver routes msg to the caller waiting on id, reporting whether one was found.
+// Removing the entry keeps send and failPending from closing the channel during the send.
+func (c *Client) deliver(id string, msg Message) bool {
+	ch, exists := c.pending.LoadAndDelete(id)
+	if exists {
+		ch <- msg
+	}
+	return exists
+}
+
+// extendReadDeadline gives the connection until the next ping plus PongTimeout to be heard from
+func (c *Client) extendReadDeadline(conn *websocket.Conn) {
+	if c.opts.PingInterval > 0 {
+		_ = conn.SetReadDeadline(time.Now().Add(c.opts.PingInterval + c.opts.PongTimeout))
 	}
 }
 
@@ -496,8 +926,12 @@ func (c *Client) writeLoop(conn *websocket.Conn, messages <-chan *Message) {
 		return
 	}
 
-	ticker := time.NewTicker(30 * time.Second)
-	defer ticker.Stop()
+	var pings <-chan time.Time
+	if c.opts.PingInterval > 0 {
+		ticker := time.NewTicker(c.opts.PingInterval)
+		defer ticker.Stop()
+		pings = ticker.C
+	}
 
 	for {
 		select {
@@ -506,16 +940,16 @@ func (c *Client) writeLoop(conn *websocket.Conn, messages <-chan *Message) {
 				return
 			}
 			if c.opts.Debug {
-				c.logger.Printf("send: %s\n", tryMarshal(msg))
+				c.logger.Printf("send: %s\n", tryMarshal(redactMessage(msg, msg.Method)))
 			}
-			if err := conn.WriteJSON(msg); err != nil {
+			if err := c.writeMessage(conn, msg); err != nil {
 				if c.opts.Debug {
 					c.logger.Printf("writeLoop error: %v\n", err)
 				}
 				return
 			}
-		case <-ticker.C:
-			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(10*time.Second)); err != nil {
+		case <-pings:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(c.opts.PongTimeout)); err != nil {
 				if c.opts.Debug {
 					c.logger.Printf("ping error: %v\n", err)
 				}
@@ -530,6 +964,19 @@ func tryMarshal(v any) string {
 	return string(b)
 }
 
+// toMap converts a struct into the map form used by loosely typed request fields
+func toMap(v any) (map[string]any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := unmarshalJSON(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func Ptr[T any](v T) *T {
 	return &v
 }
@@ -549,22 +996,45 @@ type Message struct {
 	Params     any             `json:"params,omitempty"`
 	Result     json.RawMessage `json:"result,omitempty"`
 	Error      *ErrorMsg       `json:"error,omitempty"`
-	Collection string          `json:"collection,omitempty"` // core.subscribe
+	Name       string          `json:"name,omitempty"`       // sub
+	Subs       []string        `json:"subs,omitempty"`       // ready
+	Collection string          `json:"collection,omitempty"` // added, changed, removed
 	Fields     json.RawMessage `json:"fields,omitempty"`
 }
 
+// UnmarshalJSON accepts numeric IDs, which the middleware uses for objects in collection updates.
+func (m *Message) UnmarshalJSON(data []byte) error {
+	type message Message
+	aux := struct {
+		*message
+		ID json.RawMessage `json:"id,omitempty"`
+	}{message: (*message)(m)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	m.ID = ""
+	if len(aux.ID) > 0 && string(aux.ID) != "null" {
+		if err := json.Unmarshal(aux.ID, &m.ID); err != nil {
+			m.ID = string(aux.ID)
+		}
+	}
+	return nil
+}
+
 func (m *Message) Unmarshal(v any) error {
-	if err := json.Unmarshal(m.Result, v); err != nil {
+	if err := unmarshalJSON(m.Result, v); err != nil {
 		return fmt.Errorf("unmarshal result: %s: %w", string(m.Result), err)
 	}
 	return nil
 }
 
 type ErrorMsg struct {
-	Message string `json:"message,omitempty"`
-	Code    int    `json:"error,omitempty"`
-	Reason  string `json:"reason,omitempty"`
-	Type    string `json:"errorType,omitempty"`
+	Message string          `json:"message,omitempty"`
+	Code    int             `json:"error,omitempty"`
+	Reason  string          `json:"reason,omitempty"`
+	Type    string          `json:"errorType,omitempty"`
+	Errname string          `json:"errname,omitempty"`
+	Extra   json.RawMessage `json:"extra,omitempty"` // Validation failures as [attribute, message, errno] entries
 }
 
 func (e *ErrorMsg) Error() string {
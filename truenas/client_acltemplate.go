@@ -0,0 +1,105 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// ACLTemplateClient provides methods for managing reusable ACL templates
+type ACLTemplateClient struct {
+	client *Client
+}
+
+// NewACLTemplateClient creates a new ACL template client
+func NewACLTemplateClient(client *Client) *ACLTemplateClient {
+	return &ACLTemplateClient{client: client}
+}
+
+// ACLTemplate represents an ACL template
+type ACLTemplate struct {
+	ID      int        `json:"id"`
+	Builtin bool       `json:"builtin"`
+	Name    string     `json:"name"`
+	ACLType ACLType    `json:"acltype"`
+	Comment string     `json:"comment"`
+	ACL     []ACLEntry `json:"acl"`
+}
+
+// ACLTemplateRequest represents parameters for creating or updating an ACL template
+type ACLTemplateRequest struct {
+	Name    string     `json:"name"`
+	ACLType ACLType    `json:"acltype"`
+	Comment string     `json:"comment,omitempty"`
+	ACL     []ACLEntry `json:"acl"`
+}
+
+// ACLTemplateByPathOptions represents the format options for ByPath
+type ACLTemplateByPathOptions struct {
+	Canonicalize   bool `json:"canonicalize"`    // Sort entries into canonical order
+	EnsureBuiltins bool `json:"ensure_builtins"` // Add the builtin_users and builtin_administrators groups
+	ResolveNames   bool `json:"resolve_names"`   // Set Who on each entry to the user or group name
+}
+
+// List returns all ACL templates
+func (a *ACLTemplateClient) List(ctx context.Context) ([]ACLTemplate, error) {
+	var result []ACLTemplate
+	err := a.client.Call(ctx, "filesystem.acltemplate.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns ACL templates matching q
+func (a *ACLTemplateClient) ListWithQuery(ctx context.Context, q *Query) ([]ACLTemplate, error) {
+	return query[ACLTemplate](ctx, a.client, "filesystem.acltemplate.query", q)
+}
+
+// ListIter returns an iterator over the ACL templates matching q, fetched a page at a time
+func (a *ACLTemplateClient) ListIter(ctx context.Context, q *Query) iter.Seq2[ACLTemplate, error] {
+	return paginate(ctx, q, a.ListWithQuery)
+}
+
+// Get returns a specific ACL template by ID
+func (a *ACLTemplateClient) Get(ctx context.Context, id int) (*ACLTemplate, error) {
+	var result []ACLTemplate
+	err := a.client.Call(ctx, "filesystem.acltemplate.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("acl_template", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates a new ACL template
+func (a *ACLTemplateClient) Create(ctx context.Context, req *ACLTemplateRequest) (*ACLTemplate, error) {
+	var result ACLTemplate
+	err := a.client.Call(ctx, "filesystem.acltemplate.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates an existing ACL template
+func (a *ACLTemplateClient) Update(ctx context.Context, id int, req *ACLTemplateRequest) (*ACLTemplate, error) {
+	var result ACLTemplate
+	err := a.client.Call(ctx, "filesystem.acltemplate.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes an ACL template. Builtin templates cannot be deleted.
+func (a *ACLTemplateClient) Delete(ctx context.Context, id int) error {
+	return a.client.Call(ctx, "filesystem.acltemplate.delete", []any{id}, nil)
+}
+
+// ByPath returns the templates matching q that can be applied to path, i.e. those of the ACL
+// type of its filesystem, with their entries formatted according to opts
+func (a *ACLTemplateClient) ByPath(ctx context.Context, path string, q *Query, opts ACLTemplateByPathOptions) ([]ACLTemplate, error) {
+	filters, options := q.parts()
+	var result []ACLTemplate
+	err := a.client.Call(ctx, "filesystem.acltemplate.by_path", []any{map[string]any{
+		"path":           path,
+		"query-filters":  filters,
+		"query-options":  options,
+		"format-options": opts,
+	}}, &result)
+	return result, err
+}
@@ -0,0 +1,142 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewACLTemplateClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	require.NotNil(t, client.Filesystem.ACLTemplates)
+	assert.Equal(t, client, client.Filesystem.ACLTemplates.client)
+}
+
+func TestACLTemplateClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("filesystem.acltemplate.query", []ACLTemplate{
+		{ID: 1, Builtin: true, Name: "NFS4_RESTRICTED", ACLType: ACLTypeNFS4},
+		{ID: 2, Name: "projects", ACLType: ACLTypePOSIX1E, Comment: "Project shares"},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	templates, err := client.Filesystem.ACLTemplates.List(NewTestContext(t))
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.True(t, templates[0].Builtin)
+	assert.Equal(t, "projects", templates[1].Name)
+	assert.Equal(t, ACLTypePOSIX1E, templates[1].ACLType)
+}
+
+func TestACLTemplateClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("filesystem.acltemplate.query", []ACLTemplate{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	template, err := client.Filesystem.ACLTemplates.Get(NewTestContext(t), 42)
+	require.Error(t, err)
+	assert.Nil(t, template)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestACLTemplateClient_CreateUpdateDelete(t *testing.T) {
+	t.Parallel()
+	received := make(chan Message, 3)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "filesystem.acltemplate.create", "filesystem.acltemplate.update":
+			received <- msg
+			return Message{ID: msg.ID, Result: json.RawMessage(`{"id": 3, "name": "projects", "acltype": "NFS4", "acl": []}`)}, true
+		case "filesystem.acltemplate.delete":
+			received <- msg
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	req := &ACLTemplateRequest{
+		Name:    "projects",
+		ACLType: ACLTypeNFS4,
+		ACL:     []ACLEntry{NewAllowEntry(ACLOwner, FullControl, InheritAll)},
+	}
+	template, err := client.Filesystem.ACLTemplates.Create(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 3, template.ID)
+	params, err := json.Marshal((<-received).Params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"name": "projects", "acltype": "NFS4", "acl": [
+		{"tag": "owner@", "type": "ALLOW", "perms": {"BASIC": "FULL_CONTROL"}, "flags": {"BASIC": "INHERIT"}}
+	]}]`, string(params))
+
+	req.Comment = "Project shares"
+	_, err = client.Filesystem.ACLTemplates.Update(ctx, 3, req)
+	require.NoError(t, err)
+	msg := <-received
+	assert.Equal(t, "filesystem.acltemplate.update", msg.Method)
+	params, err = json.Marshal(msg.Params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[3, {"name": "projects", "acltype": "NFS4", "comment": "Project shares", "acl": [
+		{"tag": "owner@", "type": "ALLOW", "perms": {"BASIC": "FULL_CONTROL"}, "flags": {"BASIC": "INHERIT"}}
+	]}]`, string(params))
+
+	require.NoError(t, client.Filesystem.ACLTemplates.Delete(ctx, 3))
+	params, err = json.Marshal((<-received).Params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[3]`, string(params))
+}
+
+func TestACLTemplateClient_ByPath(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "filesystem.acltemplate.by_path" {
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`[{"id": 1, "builtin": true, "name": "NFS4_RESTRICTED", "acltype": "NFS4", "acl": [
+				{"tag": "owner@", "id": null, "type": "ALLOW", "perms": {"BASIC": "FULL_CONTROL"}, "flags": {"BASIC": "INHERIT"}, "who": "root"}
+			]}]`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	templates, err := client.Filesystem.ACLTemplates.ByPath(NewTestContext(t), "/mnt/tank/share",
+		NewQuery().Filter("builtin", "=", true), ACLTemplateByPathOptions{ResolveNames: true})
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	require.Len(t, templates[0].ACL, 1)
+	assert.Equal(t, "root", templates[0].ACL[0].Who)
+
+	params, err := json.Marshal(<-received)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{
+		"path": "/mnt/tank/share",
+		"query-filters": [["builtin", "=", true]],
+		"query-options": {},
+		"format-options": {"canonicalize": false, "ensure_builtins": false, "resolve_names": true}
+	}]`, string(params))
+}
@@ -2,8 +2,9 @@ package truenas
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"time"
+	"iter"
 )
 
 // AlertClient provides methods for alert management
@@ -18,20 +19,20 @@ func NewAlertClient(client *Client) *AlertClient {
 
 // Alert represents a system alert
 type Alert struct {
-	UUID           string    `json:"uuid"`
-	Source         string    `json:"source"`
-	Klass          string    `json:"klass"`
-	Args           any       `json:"args"`
-	Node           string    `json:"node"`
-	Key            string    `json:"key"`
-	DateTime       time.Time `json:"datetime"`
-	LastOccurrence time.Time `json:"last_occurrence"`
-	Dismissed      bool      `json:"dismissed"`
-	Mail           any       `json:"mail"`
-	Text           string    `json:"text"`
-	Level          string    `json:"level"`
-	OneShot        bool      `json:"one_shot"`
-	Formatted      string    `json:"formatted"`
+	UUID           string      `json:"uuid"`
+	Source         string      `json:"source"`
+	Klass          string      `json:"klass"`
+	Args           any         `json:"args"`
+	Node           string      `json:"node"`
+	Key            string      `json:"key"`
+	DateTime       TrueNASTime `json:"datetime"`
+	LastOccurrence TrueNASTime `json:"last_occurrence"`
+	Dismissed      bool        `json:"dismissed"`
+	Mail           any         `json:"mail"`
+	Text           string      `json:"text"`
+	Level          string      `json:"level"`
+	OneShot        bool        `json:"one_shot"`
+	Formatted      string      `json:"formatted"`
 }
 
 // AlertCategory represents an alert category/class
@@ -78,6 +79,63 @@ type AlertServiceUpdateRequest struct {
 	Enabled    bool           `json:"enabled,omitempty"`
 }
 
+// AlertServiceAttributes is implemented by the typed attributes of each alert service type
+type AlertServiceAttributes interface {
+	AlertServiceType() string
+}
+
+// MailAlertAttributes configures an email alert service
+type MailAlertAttributes struct {
+	Email string `json:"email"` // Empty sends to the root user's address
+}
+
+// AlertServiceType returns the alert service type name
+func (MailAlertAttributes) AlertServiceType() string { return "Mail" }
+
+// SlackAlertAttributes configures a Slack incoming webhook alert service
+type SlackAlertAttributes struct {
+	URL string `json:"url"`
+}
+
+// AlertServiceType returns the alert service type name
+func (SlackAlertAttributes) AlertServiceType() string { return "Slack" }
+
+// PagerDutyAlertAttributes configures a PagerDuty alert service
+type PagerDutyAlertAttributes struct {
+	ServiceKey string `json:"service_key"`
+	ClientName string `json:"client_name"`
+}
+
+// AlertServiceType returns the alert service type name
+func (PagerDutyAlertAttributes) AlertServiceType() string { return "PagerDuty" }
+
+// NewAlertServiceRequest builds an alertservice.create request from typed attributes
+func NewAlertServiceRequest(name string, level AlertLevel, attrs AlertServiceAttributes) (*AlertServiceCreateRequest, error) {
+	attributes, err := toMap(attrs)
+	if err != nil {
+		return nil, fmt.Errorf("convert %s attributes: %w", attrs.AlertServiceType(), err)
+	}
+	return &AlertServiceCreateRequest{
+		Name:       name,
+		Type:       attrs.AlertServiceType(),
+		Attributes: attributes,
+		Level:      string(level),
+		Enabled:    true,
+	}, nil
+}
+
+// DecodeAttributes decodes the service attributes into a typed struct such as SlackAlertAttributes
+func (s *AlertService) DecodeAttributes(v AlertServiceAttributes) error {
+	b, err := json.Marshal(s.Attributes)
+	if err != nil {
+		return fmt.Errorf("marshal attributes: %w", err)
+	}
+	if err := unmarshalJSON(b, v); err != nil {
+		return fmt.Errorf("unmarshal attributes: %w", err)
+	}
+	return nil
+}
+
 // AlertClassesUpdateRequest represents parameters for alertclasses.update
 type AlertClassesUpdateRequest struct {
 	Classes map[string]any `json:"classes"`
@@ -164,6 +222,16 @@ func (s *AlertServiceClient) List(ctx context.Context) ([]AlertService, error) {
 	return result, err
 }
 
+// ListWithQuery returns alert services matching q
+func (s *AlertServiceClient) ListWithQuery(ctx context.Context, q *Query) ([]AlertService, error) {
+	return query[AlertService](ctx, s.client, "alertservice.query", q)
+}
+
+// ListIter returns an iterator over the alert services matching q, fetched a page at a time
+func (s *AlertServiceClient) ListIter(ctx context.Context, q *Query) iter.Seq2[AlertService, error] {
+	return paginate(ctx, q, s.ListWithQuery)
+}
+
 // Get returns a specific alert service by ID
 func (s *AlertServiceClient) Get(ctx context.Context, id int) (*AlertService, error) {
 	var result []AlertService
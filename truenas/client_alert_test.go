@@ -1,6 +1,7 @@
 package truenas
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -23,7 +24,7 @@ func TestAlertClient_List(t *testing.T) {
 			Text:      "Warning text",
 			OneShot:   false,
 			Mail:      true,
-			DateTime:  time.Now(),
+			DateTime:  TrueNASTime{time.Now()},
 		},
 		{
 			UUID:      "alert-2",
@@ -34,7 +35,7 @@ func TestAlertClient_List(t *testing.T) {
 			Text:      "Critical disk error",
 			OneShot:   true,
 			Mail:      true,
-			DateTime:  time.Now(),
+			DateTime:  TrueNASTime{time.Now()},
 		},
 	}
 	server.SetResponse("alert.list", mockAlerts)
@@ -440,3 +441,63 @@ func TestAlertClient_ErrorHandling(t *testing.T) {
 	assert.Equal(t, 500, apiErr.Code)
 	assert.Equal(t, "Alert service unavailable", apiErr.Message)
 }
+
+func TestAlertClient_List_MiddlewareDates(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("alert.list", json.RawMessage(`[{
+		"uuid": "a1",
+		"klass": "VolumeStatus",
+		"level": "CRITICAL",
+		"datetime": {"$date": 1704067200000},
+		"last_occurrence": null,
+		"formatted": "Pool tank state is DEGRADED"
+	}]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	alerts, err := client.Alert.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, int64(1704067200), alerts[0].DateTime.Unix())
+	assert.True(t, alerts[0].LastOccurrence.IsZero())
+}
+
+func TestNewAlertServiceRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		attrs AlertServiceAttributes
+		want  string
+	}{
+		{"mail", MailAlertAttributes{Email: "ops@example.com"}, `{"name":"mail","type":"Mail","attributes":{"email":"ops@example.com"},"level":"WARNING","enabled":true}`},
+		{"slack", SlackAlertAttributes{URL: "https://hooks.slack.com/services/x"}, `{"name":"slack","type":"Slack","attributes":{"url":"https://hooks.slack.com/services/x"},"level":"WARNING","enabled":true}`},
+		{"pagerduty", PagerDutyAlertAttributes{ServiceKey: "key", ClientName: "truenas"}, `{"name":"pagerduty","type":"PagerDuty","attributes":{"service_key":"key","client_name":"truenas"},"level":"WARNING","enabled":true}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := NewAlertServiceRequest(tt.name, AlertLevelWarning, tt.attrs)
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, tryMarshal(req))
+		})
+	}
+}
+
+func TestAlertService_DecodeAttributes(t *testing.T) {
+	t.Parallel()
+	service := AlertService{
+		Type:       "PagerDuty",
+		Attributes: map[string]any{"service_key": "abc", "client_name": "nas01"},
+	}
+
+	var attrs PagerDutyAlertAttributes
+	require.NoError(t, service.DecodeAttributes(&attrs))
+	assert.Equal(t, "abc", attrs.ServiceKey)
+	assert.Equal(t, "nas01", attrs.ClientName)
+}
@@ -3,6 +3,7 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -16,24 +17,35 @@ func NewAPIKeyClient(client *Client) *APIKeyClient {
 	return &APIKeyClient{client: client}
 }
 
-// APIKey represents an API key
+// APIKey represents an API key. Key is only populated by Create and Reset;
+// the server never returns it from api_key.query.
 type APIKey struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Key       string    `json:"key"`
-	CreatedAt time.Time `json:"created_at"`
-	Username  string    `json:"username"`
+	ID        int                    `json:"id"`
+	Name      string                 `json:"name"`
+	Key       string                 `json:"key"`
+	CreatedAt time.Time              `json:"created_at"`
+	Username  string                 `json:"username"`
+	Allowlist []APIKeyAllowlistEntry `json:"allowlist,omitempty"`
+}
+
+// APIKeyAllowlistEntry scopes an API key to a method and resource.
+// Method is an HTTP verb such as "GET" or "*", Resource a path such as "/api/v2.0/pool" or "*".
+type APIKeyAllowlistEntry struct {
+	Method   string `json:"method"`
+	Resource string `json:"resource"`
 }
 
 // APIKeyCreateRequest represents parameters for api_key.create
 type APIKeyCreateRequest struct {
-	Name string `json:"name"`
+	Name      string                 `json:"name"`
+	Allowlist []APIKeyAllowlistEntry `json:"allowlist,omitempty"` // Empty grants full access
 }
 
 // APIKeyUpdateRequest represents parameters for api_key.update
 type APIKeyUpdateRequest struct {
-	Name  *string `json:"name,omitempty"`
-	Reset *bool   `json:"reset,omitempty"`
+	Name      *string                `json:"name,omitempty"`
+	Reset     *bool                  `json:"reset,omitempty"`
+	Allowlist []APIKeyAllowlistEntry `json:"allowlist,omitempty"`
 }
 
 // List returns all API keys
@@ -43,6 +55,16 @@ func (a *APIKeyClient) List(ctx context.Context) ([]APIKey, error) {
 	return result, err
 }
 
+// ListWithQuery returns API keys matching q
+func (a *APIKeyClient) ListWithQuery(ctx context.Context, q *Query) ([]APIKey, error) {
+	return query[APIKey](ctx, a.client, "api_key.query", q)
+}
+
+// ListIter returns an iterator over the API keys matching q, fetched a page at a time
+func (a *APIKeyClient) ListIter(ctx context.Context, q *Query) iter.Seq2[APIKey, error] {
+	return paginate(ctx, q, a.ListWithQuery)
+}
+
 // Get returns a specific API key by ID
 func (a *APIKeyClient) Get(ctx context.Context, id int) (*APIKey, error) {
 	var result []APIKey
@@ -56,11 +78,16 @@ func (a *APIKeyClient) Get(ctx context.Context, id int) (*APIKey, error) {
 	return &result[0], nil
 }
 
-// Create creates a new API key
+// Create creates a new API key with full access and returns it including the generated key
 func (a *APIKeyClient) Create(ctx context.Context, name string) (*APIKey, error) {
+	return a.CreateWithRequest(ctx, &APIKeyCreateRequest{Name: name})
+}
+
+// CreateWithRequest creates a new API key, optionally scoped by an allowlist, and returns it
+// including the generated key. The key cannot be retrieved again later.
+func (a *APIKeyClient) CreateWithRequest(ctx context.Context, req *APIKeyCreateRequest) (*APIKey, error) {
 	var result APIKey
-	req := APIKeyCreateRequest{Name: name}
-	err := a.client.Call(ctx, "api_key.create", []any{req}, &result)
+	err := a.client.Call(ctx, "api_key.create", []any{*req}, &result)
 	return &result, err
 }
 
@@ -807,3 +807,38 @@ func TestAPIKeyUpdateRequest_JSON(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIKeyClient_CreateWithRequest(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "api_key.create" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`{
+				"id": 4,
+				"name": "provisioner",
+				"key": "4-secret",
+				"created_at": "2023-01-01T12:00:00Z",
+				"allowlist": [{"method": "GET", "resource": "/api/v2.0/pool"}]
+			}`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	key, err := client.APIKey.CreateWithRequest(ctx, &APIKeyCreateRequest{
+		Name:      "provisioner",
+		Allowlist: []APIKeyAllowlistEntry{{Method: "GET", Resource: "/api/v2.0/pool"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "4-secret", key.Key)
+	require.Len(t, key.Allowlist, 1)
+	assert.Equal(t, "/api/v2.0/pool", key.Allowlist[0].Resource)
+
+	assert.JSONEq(t, `[{"name": "provisioner", "allowlist": [{"method": "GET", "resource": "/api/v2.0/pool"}]}]`, tryMarshal(<-received))
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
+	"log/slog"
+	"time"
 )
 
 // AppClient provides methods for application management
@@ -174,11 +177,17 @@ type AppQueryOptions struct {
 	ExtraOptions map[string]interface{} `json:"extra,omitempty"`
 }
 
-// AppCreateRequest represents parameters for app.create
+// AppCreateRequest represents parameters for app.create. Either CatalogApp names the catalog
+// item to install or CustomApp is set with a compose config.
 type AppCreateRequest struct {
-	ReleaseName  string                 `json:"release_name"`
-	ChartRelease string                 `json:"chart_release"`
-	Values       map[string]interface{} `json:"values,omitempty"`
+	AppName                   string         `json:"app_name"`
+	CatalogApp                string         `json:"catalog_app,omitempty"`
+	Train                     string         `json:"train,omitempty"`   // Defaults to "stable"
+	Version                   string         `json:"version,omitempty"` // Defaults to "latest"
+	Values                    map[string]any `json:"values,omitempty"`
+	CustomApp                 bool           `json:"custom_app,omitempty"`
+	CustomComposeConfig       map[string]any `json:"custom_compose_config,omitempty"`
+	CustomComposeConfigString string         `json:"custom_compose_config_string,omitempty"`
 }
 
 // AppUpdateRequest represents parameters for app.update
@@ -186,6 +195,21 @@ type AppUpdateRequest struct {
 	Values map[string]interface{} `json:"values,omitempty"`
 }
 
+// AppDeleteOptions represents options for app.delete
+type AppDeleteOptions struct {
+	RemoveImages         bool `json:"remove_images"`
+	RemoveIXVolumes      bool `json:"remove_ix_volumes"`
+	ForceRemoveIXVolumes bool `json:"force_remove_ix_volumes,omitempty"`
+	ForceRemoveCustomApp bool `json:"force_remove_custom_app,omitempty"`
+}
+
+// AppUpgradeOptions represents options for app.upgrade
+type AppUpgradeOptions struct {
+	AppVersion        string         `json:"app_version,omitempty"` // Defaults to "latest"
+	Values            map[string]any `json:"values,omitempty"`
+	SnapshotHostpaths bool           `json:"snapshot_hostpaths,omitempty"`
+}
+
 type AppVersionDetails struct {
 	Version      string `json:"version"`
 	HumanVersion string `json:"human_version"`
@@ -203,6 +227,16 @@ func (a *AppClient) List(ctx context.Context) ([]App, error) {
 	return result, err
 }
 
+// ListWithQuery returns applications matching q
+func (a *AppClient) ListWithQuery(ctx context.Context, q *Query) ([]App, error) {
+	return query[App](ctx, a.client, "app.query", q)
+}
+
+// ListIter returns an iterator over the applications matching q, fetched a page at a time
+func (a *AppClient) ListIter(ctx context.Context, q *Query) iter.Seq2[App, error] {
+	return paginate(ctx, q, a.ListWithQuery)
+}
+
 // ListWithOptions returns applications with custom query options
 func (a *AppClient) ListWithOptions(ctx context.Context, options *AppQueryOptions) ([]App, error) {
 	var result []App
@@ -298,11 +332,38 @@ func (a *AppClient) ListCrashed(ctx context.Context) ([]App, error) {
 	return a.QueryByState(ctx, AppStateCrashed)
 }
 
+// Create installs an application (asynchronous job)
+func (a *AppClient) Create(ctx context.Context, req *AppCreateRequest) (*App, error) {
+	var result App
+	err := a.client.CallJob(ctx, "app.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Delete removes an application (asynchronous job). Nil options keep images and ix-volumes.
+func (a *AppClient) Delete(ctx context.Context, name string, options *AppDeleteOptions) error {
+	params := []any{name}
+	if options != nil {
+		params = append(params, *options)
+	}
+	return a.client.CallJob(ctx, "app.delete", params, nil)
+}
+
+// Upgrade upgrades an application to a newer catalog version (asynchronous job)
+func (a *AppClient) Upgrade(ctx context.Context, name string, options *AppUpgradeOptions) (*App, error) {
+	var result App
+	params := []any{name}
+	if options != nil {
+		params = append(params, *options)
+	}
+	err := a.client.CallJob(ctx, "app.upgrade", params, &result)
+	return &result, err
+}
+
 // Stats retrieves statistics for all applications
 func (a *AppClient) SubscribeStats(ctx context.Context, fn func([]AppStats) error) error {
 	return a.client.Subscribe.Subscribe(ctx, "app.stats", func(m Message) error {
 		var result []AppStats
-		_ = json.Unmarshal(m.Fields, &result)
+		_ = unmarshalJSON(m.Fields, &result)
 		return fn(result)
 	})
 }
@@ -310,3 +371,95 @@ func (a *AppClient) SubscribeStats(ctx context.Context, fn func([]AppStats) erro
 func (a *AppClient) UnsubscribeStats(ctx context.Context) error {
 	return a.client.Subscribe.Unsubscribe(ctx, "app.stats")
 }
+
+// Defaults for AppClient.Logs
+const (
+	appLogTailLines   = 500         // Lines of a container's log sent before new ones
+	appLogIdleTimeout = time.Second // How long Logs waits for more lines when not following
+)
+
+// AppContainerID identifies a container of an app, as returned by app.container_ids
+type AppContainerID struct {
+	ID          string `json:"id"`
+	ServiceName string `json:"service_name"` // Compose service the container runs
+	Image       string `json:"image"`
+	State       string `json:"state"`
+}
+
+// AppLogLine is a line of an app container's log
+type AppLogLine struct {
+	Timestamp string `json:"timestamp"`
+	Data      string `json:"data"`
+}
+
+// appLogFollowArgs are the arguments of the app.container_log_follow event
+type appLogFollowArgs struct {
+	AppName     string `json:"app_name"`
+	ContainerID string `json:"container_id"`
+	TailLines   int    `json:"tail_lines"`
+}
+
+// PodChoices returns the running containers of an app by ID, to pass to Logs. Apps run as
+// Docker Compose projects since SCALE 24.10, so containers take the place of the pods of
+// chart releases.
+func (a *AppClient) PodChoices(ctx context.Context, name string) (map[string]AppContainerID, error) {
+	var result map[string]AppContainerID
+	err := a.client.Call(ctx, "app.container_ids", []any{name, map[string]any{"alive_only": true}}, &result)
+	return result, err
+}
+
+// Logs streams the log of an app's container, identified by an ID from PodChoices, starting
+// with its last 500 lines. With follow, lines are delivered on the returned channel as they
+// are written until ctx is cancelled or the subscription ends; without, the channel is closed
+// once no more lines arrive for a second. Lines that cannot be decoded, or that overflow a
+// buffer of the tail plus 100 lines while the channel is not read, are skipped and reported
+// to Options.Logger.
+func (a *AppClient) Logs(ctx context.Context, name, containerID string, follow bool) (<-chan AppLogLine, error) {
+	args, err := json.Marshal(appLogFollowArgs{AppName: name, ContainerID: containerID, TailLines: appLogTailLines})
+	if err != nil {
+		return nil, err
+	}
+	// Buffer the tail, which arrives in one burst, on top of what Watch buffers for new lines
+	sub, err := a.client.Subscribe.WatchBuffered(ctx, "app.container_log_follow:"+string(args), appLogTailLines+eventBufferSize)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make(chan AppLogLine)
+	go func() {
+		defer close(lines)
+		defer func() {
+			unsubCtx, cancel := context.WithTimeout(context.Background(), a.client.opts.DefaultWriteTimeout)
+			defer cancel()
+			_ = sub.Unsubscribe(unsubCtx)
+		}()
+
+		var idle <-chan time.Time
+		for {
+			if !follow {
+				idle = time.After(appLogIdleTimeout)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-idle:
+				return
+			case event, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				var line AppLogLine
+				if err := event.Unmarshal(&line); err != nil {
+					a.client.logConnection(slog.LevelWarn, "truenas app log line dropped", "event", sub.Name(), "error", err)
+					continue
+				}
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return lines, nil
+}
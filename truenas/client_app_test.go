@@ -2,10 +2,15 @@ package truenas
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"log/slog"
 	"os"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAppClient_Methods(t *testing.T) {
@@ -78,6 +83,9 @@ func TestAppClient_GetApp(t *testing.T) {
 
 	endpoint := "" // put your TrueNAS endpoint here for testing
 	apiKey := ""   // put your TrueNAS API key here for testing
+	if endpoint == "" {
+		t.Skip("Skipping live app test: no TrueNAS endpoint configured")
+	}
 
 	t.Logf("Using endpoint: %s", endpoint)
 	client, err := NewClient(endpoint, Options{
@@ -177,3 +185,201 @@ func ExampleAppClient_QueryByState() {
 
 // Query App details
 // {"jsonrpc":"2.0","id":"a11f55f4-7b9f-2e4e-9cb5-ec4423d13e3b","method":"app.query","params":[[["name","=","grafana"]],{"extra":{"include_app_schema":true,"retrieve_config":true,"host_ip":"nas.tooko.io"}}]}
+
+func TestAppClient_Create(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("app.create", App{ID: "plex", Name: "plex", State: AppStateDeploying})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	req := &AppCreateRequest{
+		AppName:    "plex",
+		CatalogApp: "plex",
+		Train:      "stable",
+		Values:     map[string]any{"TZ": "UTC"},
+	}
+	app, err := client.App.Create(NewTestContext(t), req)
+	require.NoError(t, err)
+	assert.Equal(t, AppStateDeploying, app.State)
+
+	params, err := json.Marshal(req)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"app_name": "plex", "catalog_app": "plex", "train": "stable", "values": {"TZ": "UTC"}}`, string(params))
+}
+
+func TestAppClient_Lifecycle(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	// Every job method resolves to the same completed job
+	app := App{ID: "plex", Name: "plex", Version: "1.1.0"}
+	for _, method := range []string{"app.upgrade", "app.delete"} {
+		server.SetJobResponse(method, app)
+	}
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	upgraded, err := client.App.Upgrade(ctx, "plex", &AppUpgradeOptions{AppVersion: "1.1.0", SnapshotHostpaths: true})
+	require.NoError(t, err)
+	assert.Equal(t, "1.1.0", upgraded.Version)
+	require.NoError(t, client.App.Delete(ctx, "plex", &AppDeleteOptions{RemoveImages: true}))
+}
+
+func TestAppClient_Delete_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("app.delete", "Docker service is not running")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	err := client.App.Delete(NewTestContext(t), "plex", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Docker service is not running")
+}
+
+func TestAppClient_PodChoices(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("app.container_ids", map[string]any{
+		"4f1c": map[string]any{"id": "4f1c", "service_name": "plex", "image": "plexinc/pms-docker:latest", "state": "running"},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	containers, err := client.App.PodChoices(NewTestContext(t), "plex")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]AppContainerID{
+		"4f1c": {ID: "4f1c", ServiceName: "plex", Image: "plexinc/pms-docker:latest", State: "running"},
+	}, containers)
+	server.AssertCalled(t, "app.container_ids", "plex", map[string]any{"alive_only": true})
+}
+
+func TestAppClient_Logs(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	lines, err := client.App.Logs(ctx, "plex", "4f1c", false)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, server.EmitEvent("app.container_log_follow", map[string]any{"timestamp": "2024-10-01T12:00:00Z", "data": "Starting Plex"}))
+	assert.Equal(t, 1, server.EmitEvent("app.container_log_follow", map[string]any{"timestamp": "2024-10-01T12:00:01Z", "data": "Ready"}))
+
+	var got []AppLogLine
+	for line := range lines {
+		got = append(got, line)
+	}
+	assert.Equal(t, []AppLogLine{
+		{Timestamp: "2024-10-01T12:00:00Z", Data: "Starting Plex"},
+		{Timestamp: "2024-10-01T12:00:01Z", Data: "Ready"},
+	}, got)
+}
+
+func TestAppClient_Logs_SlowReader(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	lines, err := client.App.Logs(ctx, "plex", "4f1c", false)
+	require.NoError(t, err)
+
+	// The tail arrives in a burst larger than Watch buffers before anything is read
+	const n = 300
+	for i := range n {
+		server.EmitEvent("app.container_log_follow", map[string]any{"data": fmt.Sprintf("line %d", i)})
+	}
+	var got []string
+	for line := range lines {
+		time.Sleep(time.Millisecond)
+		got = append(got, line.Data)
+	}
+	require.Len(t, got, n)
+	assert.Equal(t, "line 0", got[0])
+	assert.Equal(t, fmt.Sprintf("line %d", n-1), got[n-1])
+}
+
+func TestAppClient_Logs_UndecodableLine(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	var buf syncBuffer
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username: "root",
+		Password: "hunter2",
+		Logger:   slog.New(slog.NewJSONHandler(&buf, nil)),
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	lines, err := client.App.Logs(ctx, "plex", "4f1c", false)
+	require.NoError(t, err)
+
+	server.EmitEvent("app.container_log_follow", map[string]any{"data": 42})
+	server.EmitEvent("app.container_log_follow", map[string]any{"data": "Ready"})
+
+	var got []AppLogLine
+	for line := range lines {
+		got = append(got, line)
+	}
+	assert.Equal(t, []AppLogLine{{Data: "Ready"}}, got)
+	assert.Contains(t, buf.String(), `"msg":"truenas app log line dropped"`)
+}
+
+func TestAppClient_Logs_Follow(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	followCtx, cancel := context.WithCancel(ctx)
+	lines, err := client.App.Logs(followCtx, "plex", "4f1c", true)
+	require.NoError(t, err)
+
+	server.EmitEvent("app.container_log_follow", map[string]any{"data": "first"})
+	select {
+	case line := <-lines:
+		assert.Equal(t, "first", line.Data)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for log line")
+	}
+
+	// Following keeps the channel open past the idle timeout until ctx is cancelled
+	time.Sleep(appLogIdleTimeout + 200*time.Millisecond)
+	server.EmitEvent("app.container_log_follow", map[string]any{"data": "second"})
+	select {
+	case line := <-lines:
+		assert.Equal(t, "second", line.Data)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for log line")
+	}
+
+	cancel()
+	for range lines {
+	}
+}
@@ -0,0 +1,167 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+// AuditService represents a service that produces audit records
+type AuditService string
+
+const (
+	AuditServiceMiddleware AuditService = "MIDDLEWARE"
+	AuditServiceSMB        AuditService = "SMB"
+	AuditServiceSudo       AuditService = "SUDO"
+)
+
+// AuditExportFormat represents the file format of an audit export
+type AuditExportFormat string
+
+const (
+	AuditExportFormatCSV  AuditExportFormat = "CSV"
+	AuditExportFormatJSON AuditExportFormat = "JSON"
+	AuditExportFormatYAML AuditExportFormat = "YAML"
+)
+
+// AuditClient provides methods for querying and exporting audit logs
+type AuditClient struct {
+	client *Client
+}
+
+// NewAuditClient creates a new audit client
+func NewAuditClient(client *Client) *AuditClient {
+	return &AuditClient{client: client}
+}
+
+// AuditEntry represents an audit record
+type AuditEntry struct {
+	AuditID          string         `json:"audit_id"`
+	MessageTimestamp int64          `json:"message_timestamp"`
+	Timestamp        TrueNASTime    `json:"timestamp"`
+	Address          string         `json:"address"`
+	Username         string         `json:"username"`
+	Session          string         `json:"session"`
+	Service          AuditService   `json:"service"`
+	ServiceData      map[string]any `json:"service_data"`
+	Event            string         `json:"event"`
+	EventData        map[string]any `json:"event_data"`
+	Success          bool           `json:"success"`
+}
+
+// AuditFilter selects audit records. Zero-valued fields do not filter.
+type AuditFilter struct {
+	Services []AuditService // Defaults to the middleware and SMB on the server
+	Username string
+	Event    string // e.g. "AUTHENTICATION", "METHOD_CALL" or "CREATE"
+	Success  *bool
+	Start    time.Time
+	End      time.Time
+	Limit    int
+	Offset   int
+}
+
+// params builds the audit.query and audit.export request, newest records first
+func (f *AuditFilter) params() map[string]any {
+	q := NewQuery().OrderBy("-message_timestamp")
+	data := map[string]any{}
+	if f != nil {
+		if len(f.Services) > 0 {
+			data["services"] = f.Services
+		}
+		if f.Username != "" {
+			q.Filter("username", "=", f.Username)
+		}
+		if f.Event != "" {
+			q.Filter("event", "=", f.Event)
+		}
+		if f.Success != nil {
+			q.Filter("success", "=", *f.Success)
+		}
+		if !f.Start.IsZero() {
+			q.Filter("message_timestamp", ">=", f.Start.Unix())
+		}
+		if !f.End.IsZero() {
+			q.Filter("message_timestamp", "<=", f.End.Unix())
+		}
+		q.Limit(f.Limit).Offset(f.Offset)
+	}
+	params := q.Params()
+	data["query-filters"] = params[0]
+	data["query-options"] = params[1]
+	return data
+}
+
+// AuditConfig represents the audit dataset configuration
+type AuditConfig struct {
+	ID                   int                 `json:"id"`
+	Retention            int                 `json:"retention"`   // Days
+	Reservation          int                 `json:"reservation"` // GiB
+	Quota                int                 `json:"quota"`       // GiB
+	QuotaFillWarning     int                 `json:"quota_fill_warning"`
+	QuotaFillCritical    int                 `json:"quota_fill_critical"`
+	RemoteLoggingEnabled bool                `json:"remote_logging_enabled"`
+	Space                *AuditSpace         `json:"space,omitempty"`
+	EnabledServices      map[string][]string `json:"enabled_services,omitempty"`
+}
+
+// AuditSpace reports the space used by the audit dataset in bytes
+type AuditSpace struct {
+	Used              int64 `json:"used"`
+	UsedByDataset     int64 `json:"used_by_dataset"`
+	UsedByReservation int64 `json:"used_by_reservation"`
+	UsedBySnapshots   int64 `json:"used_by_snapshots"`
+	Available         int64 `json:"available"`
+}
+
+// AuditConfigUpdateRequest represents parameters for audit.update
+type AuditConfigUpdateRequest struct {
+	Retention         *int `json:"retention,omitempty"`
+	Reservation       *int `json:"reservation,omitempty"`
+	Quota             *int `json:"quota,omitempty"`
+	QuotaFillWarning  *int `json:"quota_fill_warning,omitempty"`
+	QuotaFillCritical *int `json:"quota_fill_critical,omitempty"`
+}
+
+// Query returns audit records matching filter, newest first
+func (a *AuditClient) Query(ctx context.Context, filter *AuditFilter) ([]AuditEntry, error) {
+	var result []AuditEntry
+	err := a.client.Call(ctx, "audit.query", []any{filter.params()}, &result)
+	return result, err
+}
+
+// GetConfig returns the audit configuration
+func (a *AuditClient) GetConfig(ctx context.Context) (*AuditConfig, error) {
+	var result AuditConfig
+	err := a.client.Call(ctx, "audit.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig updates the audit configuration
+func (a *AuditClient) UpdateConfig(ctx context.Context, req *AuditConfigUpdateRequest) (*AuditConfig, error) {
+	var result AuditConfig
+	err := a.client.Call(ctx, "audit.update", []any{*req}, &result)
+	return &result, err
+}
+
+// Export writes audit records matching filter to w in the given format. The report is
+// generated by an audit.export job and then downloaded with audit.download_report.
+func (a *AuditClient) Export(ctx context.Context, w io.Writer, filter *AuditFilter, format AuditExportFormat) error {
+	data := filter.params()
+	data["export_format"] = format
+
+	var report string
+	if err := a.client.CallJob(ctx, "audit.export", []any{data}, &report); err != nil {
+		return err
+	}
+
+	name := path.Base(report)
+	filename := name
+	if !strings.Contains(filename, ".") {
+		filename = fmt.Sprintf("%s.%s", name, strings.ToLower(string(format)))
+	}
+	return a.client.CallDownload(ctx, "audit.download_report", []any{map[string]any{"report_name": name}}, filename, w)
+}
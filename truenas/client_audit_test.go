@@ -0,0 +1,129 @@
+package truenas
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	auditClient := NewAuditClient(client)
+	require.NotNil(t, auditClient)
+	assert.Equal(t, client, auditClient.client)
+}
+
+func TestAuditClient_Query(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "audit.query" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`[{
+				"audit_id": "1b3e",
+				"message_timestamp": 1700000000,
+				"timestamp": {"$date": 1700000000000},
+				"address": "10.0.0.5",
+				"username": "admin",
+				"service": "SMB",
+				"event": "AUTHENTICATION",
+				"event_data": {"clientAccount": "admin"},
+				"success": false
+			}]`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	start := time.Unix(1699990000, 0)
+	entries, err := client.Audit.Query(ctx, &AuditFilter{
+		Services: []AuditService{AuditServiceSMB},
+		Username: "admin",
+		Success:  Ptr(false),
+		Start:    start,
+		Limit:    50,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, AuditServiceSMB, entries[0].Service)
+	assert.Equal(t, "AUTHENTICATION", entries[0].Event)
+	assert.Equal(t, int64(1700000000), entries[0].Timestamp.Unix())
+	assert.False(t, entries[0].Success)
+
+	assert.JSONEq(t, `[{
+		"services": ["SMB"],
+		"query-filters": [["username", "=", "admin"], ["success", "=", false], ["message_timestamp", ">=", 1699990000]],
+		"query-options": {"order_by": ["-message_timestamp"], "limit": 50}
+	}]`, tryMarshal(<-received))
+}
+
+func TestAuditClient_Config(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	config := AuditConfig{ID: 1, Retention: 7, Quota: 10, QuotaFillWarning: 75, QuotaFillCritical: 95, Space: &AuditSpace{Used: 1024}}
+	server.SetResponse("audit.config", config)
+	server.SetResponse("audit.update", config)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	got, err := client.Audit.GetConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 7, got.Retention)
+	require.NotNil(t, got.Space)
+	assert.Equal(t, int64(1024), got.Space.Used)
+
+	_, err = client.Audit.UpdateConfig(ctx, &AuditConfigUpdateRequest{Retention: Ptr(30)})
+	require.NoError(t, err)
+}
+
+func TestAuditClient_Export(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "audit.export":
+			return Message{ID: msg.ID, Result: json.RawMessage(`11`)}, true
+		case "core.download":
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`[101, "/_download/101?auth_token=test-token"]`)}, true
+		case "core.get_jobs":
+			if strings.Contains(tryMarshal(msg.Params), `11`) {
+				return Message{ID: msg.ID, Result: json.RawMessage(`[{"id": 11, "state": "SUCCESS", "result": "/audit/reports/root/a1b2.csv"}]`)}, true
+			}
+			return Message{ID: msg.ID, Result: json.RawMessage(`[{"id": 101, "state": "SUCCESS"}]`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+	server.SetDownload("audit.download_report", []byte("audit_id,username\n1b3e,admin\n"))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	var buf bytes.Buffer
+	require.NoError(t, client.Audit.Export(ctx, &buf, &AuditFilter{Username: "admin"}, AuditExportFormatCSV))
+	assert.Equal(t, "audit_id,username\n1b3e,admin\n", buf.String())
+
+	assert.JSONEq(t, `["audit.download_report", [{"report_name": "a1b2.csv"}], "a1b2.csv"]`, tryMarshal(<-received))
+}
@@ -2,6 +2,7 @@ package truenas
 
 import (
 	"context"
+	"encoding/json"
 )
 
 // AuthClient provides methods for authentication and user management
@@ -25,10 +26,14 @@ type LoginWithAPIKeyRequest struct {
 	APIKey string
 }
 
+// defaultTokenTTL is the lifetime in seconds the middleware gives tokens by default
+const defaultTokenTTL = 600
+
 // GenerateTokenRequest represents parameters for auth.generate_token
 type GenerateTokenRequest struct {
-	TTL        int `json:"ttl,omitempty"`
-	Attributes any `json:"attributes,omitempty"`
+	TTL         int  `json:"ttl,omitempty"` // Lifetime in seconds, defaults to 600
+	Attributes  any  `json:"attributes,omitempty"`
+	MatchOrigin bool `json:"match_origin,omitempty"` // Only accept the token from the origin that generated it
 }
 
 // TokenResponse represents the response from auth.generate_token
@@ -36,6 +41,15 @@ type TokenResponse struct {
 	Token string `json:"token"`
 }
 
+// UnmarshalJSON accepts the bare token string returned by auth.generate_token
+func (t *TokenResponse) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &t.Token); err == nil {
+		return nil
+	}
+	type tokenResponse TokenResponse
+	return json.Unmarshal(data, (*tokenResponse)(t))
+}
+
 // CheckPasswordRequest represents parameters for auth.check_password
 type CheckPasswordRequest struct {
 	Username string `json:"username"`
@@ -56,6 +70,13 @@ func (a *AuthClient) LoginWithAPIKey(ctx context.Context, apiKey string) (bool,
 	return result, err
 }
 
+// LoginWithToken authenticates with a token from auth.generate_token
+func (a *AuthClient) LoginWithToken(ctx context.Context, token string) (bool, error) {
+	var result bool
+	err := a.client.Call(ctx, "auth.login_with_token", []any{token}, &result)
+	return result, err
+}
+
 // Logout ends the current session
 func (a *AuthClient) Logout(ctx context.Context) error {
 	return a.client.Call(ctx, "auth.logout", []any{}, nil)
@@ -71,16 +92,17 @@ func (a *AuthClient) CheckPassword(ctx context.Context, username, password strin
 // GenerateToken creates a new authentication token
 func (a *AuthClient) GenerateToken(ctx context.Context, req GenerateTokenRequest) (*TokenResponse, error) {
 	var result TokenResponse
-	params := []any{}
-	if req.TTL > 0 || req.Attributes != nil {
-		tokenParams := map[string]any{}
-		if req.TTL > 0 {
-			tokenParams["ttl"] = req.TTL
-		}
-		if req.Attributes != nil {
-			tokenParams["attributes"] = req.Attributes
-		}
-		params = append(params, tokenParams)
+	ttl := req.TTL
+	if ttl <= 0 {
+		ttl = defaultTokenTTL
+	}
+	attributes := req.Attributes
+	if attributes == nil {
+		attributes = map[string]any{}
+	}
+	params := []any{ttl, attributes}
+	if req.MatchOrigin {
+		params = append(params, true)
 	}
 	err := a.client.Call(ctx, "auth.generate_token", params, &result)
 	return &result, err
@@ -1,6 +1,7 @@
 package truenas
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -234,3 +235,57 @@ func TestAuthClient_ErrorHandling(t *testing.T) {
 	assert.Equal(t, 401, apiErr.Code)
 	assert.Equal(t, "Authentication failed", apiErr.Message)
 }
+
+func TestAuthClient_GenerateTokenParams(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "auth.generate_token" {
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`"bare-token"`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	token, err := client.Auth.GenerateToken(ctx, GenerateTokenRequest{MatchOrigin: true})
+	require.NoError(t, err)
+	assert.Equal(t, "bare-token", token.Token)
+	assert.Equal(t, []any{float64(600), map[string]any{}, true}, <-received)
+}
+
+func TestClient_TokenAuth(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "auth.login_with_token":
+			received <- msg.Params
+		case "auth.generate_token":
+			return Message{ID: msg.ID, Result: json.RawMessage(`"generated-token"`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{Token: "short-lived"})
+	require.NoError(t, err)
+	defer client.Close()
+	assert.Equal(t, []any{"short-lived"}, <-received)
+
+	ctx := NewTestContext(t)
+	token, err := client.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "short-lived", token)
+
+	// Without a configured token a new one is generated
+	other := server.CreateTestClient(t)
+	defer other.Close()
+	token, err = other.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "generated-token", token)
+}
@@ -0,0 +1,148 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// CatalogClient provides methods for managing application catalogs
+type CatalogClient struct {
+	client *Client
+}
+
+// NewCatalogClient creates a new catalog client
+func NewCatalogClient(client *Client) *CatalogClient {
+	return &CatalogClient{client: client}
+}
+
+// Catalog represents an application catalog
+type Catalog struct {
+	ID              string         `json:"id"`
+	Label           string         `json:"label"`
+	Repository      string         `json:"repository"`
+	Branch          string         `json:"branch"`
+	Location        string         `json:"location"`
+	Builtin         bool           `json:"builtin"`
+	PreferredTrains []string       `json:"preferred_trains"`
+	Healthy         bool           `json:"healthy,omitempty"`
+	Error           bool           `json:"error,omitempty"`
+	Cached          bool           `json:"cached,omitempty"`
+	Trains          map[string]any `json:"trains,omitempty"`
+}
+
+// CatalogCreateRequest represents parameters for catalog.create
+type CatalogCreateRequest struct {
+	Label           string   `json:"label"`
+	Repository      string   `json:"repository"`
+	Branch          string   `json:"branch,omitempty"`
+	PreferredTrains []string `json:"preferred_trains,omitempty"`
+	Force           bool     `json:"force,omitempty"`
+}
+
+// CatalogUpdateRequest represents parameters for catalog.update
+type CatalogUpdateRequest struct {
+	PreferredTrains []string `json:"preferred_trains"`
+}
+
+// CatalogItemsOptions represents options for catalog.items
+type CatalogItemsOptions struct {
+	Cache             bool     `json:"cache"`
+	CacheOnly         bool     `json:"cache_only,omitempty"`
+	RetrieveAllTrains bool     `json:"retrieve_all_trains"`
+	Trains            []string `json:"trains,omitempty"`
+}
+
+// CatalogItem represents an application available in a catalog train
+type CatalogItem struct {
+	Name               string         `json:"name"`
+	Title              string         `json:"title"`
+	Description        string         `json:"description"`
+	Icon               string         `json:"icon_url"`
+	Healthy            bool           `json:"healthy"`
+	HealthyError       string         `json:"healthy_error,omitempty"`
+	LatestVersion      string         `json:"latest_version"`
+	LatestAppVersion   string         `json:"latest_app_version"`
+	LatestHumanVersion string         `json:"latest_human_version"`
+	Categories         []string       `json:"categories"`
+	Recommended        bool           `json:"recommended"`
+	Versions           map[string]any `json:"versions,omitempty"`
+}
+
+// List returns all catalogs
+func (c *CatalogClient) List(ctx context.Context) ([]Catalog, error) {
+	var result []Catalog
+	err := c.client.Call(ctx, "catalog.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns catalogs matching q
+func (c *CatalogClient) ListWithQuery(ctx context.Context, q *Query) ([]Catalog, error) {
+	return query[Catalog](ctx, c.client, "catalog.query", q)
+}
+
+// ListIter returns an iterator over the catalogs matching q, fetched a page at a time
+func (c *CatalogClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Catalog, error] {
+	return paginate(ctx, q, c.ListWithQuery)
+}
+
+// Get returns a specific catalog by label
+func (c *CatalogClient) Get(ctx context.Context, label string) (*Catalog, error) {
+	var result []Catalog
+	err := c.client.Call(ctx, "catalog.query", []any{[]any{[]any{"id", "=", label}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("catalog", fmt.Sprintf("label %s", label))
+	}
+	return &result[0], nil
+}
+
+// Create adds a new catalog (asynchronous job)
+func (c *CatalogClient) Create(ctx context.Context, req *CatalogCreateRequest) (*Catalog, error) {
+	var result Catalog
+	err := c.client.CallJob(ctx, "catalog.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates the preferred trains of a catalog
+func (c *CatalogClient) Update(ctx context.Context, label string, req *CatalogUpdateRequest) (*Catalog, error) {
+	var result Catalog
+	err := c.client.Call(ctx, "catalog.update", []any{label, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a catalog
+func (c *CatalogClient) Delete(ctx context.Context, label string) error {
+	return c.client.Call(ctx, "catalog.delete", []any{label}, nil)
+}
+
+// Sync refreshes a catalog from its repository (asynchronous job)
+func (c *CatalogClient) Sync(ctx context.Context, label string) error {
+	return c.client.CallJob(ctx, "catalog.sync", []any{label}, nil)
+}
+
+// SyncAll refreshes all catalogs (asynchronous job)
+func (c *CatalogClient) SyncAll(ctx context.Context) error {
+	return c.client.CallJob(ctx, "catalog.sync_all", []any{}, nil)
+}
+
+// Items returns the items of a catalog grouped by train name
+func (c *CatalogClient) Items(ctx context.Context, label string, options *CatalogItemsOptions) (map[string]map[string]CatalogItem, error) {
+	var result map[string]map[string]CatalogItem
+	params := []any{label}
+	if options != nil {
+		params = append(params, *options)
+	}
+	err := c.client.Call(ctx, "catalog.items", params, &result)
+	return result, err
+}
+
+// GetItemDetails returns the details of a catalog item, including its versions and schemas
+func (c *CatalogClient) GetItemDetails(ctx context.Context, item, catalog, train string) (map[string]any, error) {
+	var result map[string]any
+	options := map[string]any{"catalog": catalog, "train": train}
+	err := c.client.Call(ctx, "catalog.get_item_details", []any{item, options}, &result)
+	return result, err
+}
@@ -0,0 +1,130 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCatalogClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	catalogClient := NewCatalogClient(client)
+	require.NotNil(t, catalogClient)
+	assert.Equal(t, client, catalogClient.client)
+}
+
+func TestCatalogClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	mockCatalogs := []Catalog{
+		{
+			ID:              "TRUENAS",
+			Label:           "TRUENAS",
+			Repository:      "https://github.com/truenas/charts.git",
+			Branch:          "master",
+			Builtin:         true,
+			PreferredTrains: []string{"charts"},
+		},
+	}
+	server.SetResponse("catalog.query", mockCatalogs)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	catalogs, err := client.Catalog.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, catalogs, 1)
+	assert.Equal(t, "TRUENAS", catalogs[0].Label)
+	assert.True(t, catalogs[0].Builtin)
+}
+
+func TestCatalogClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("catalog.query", []Catalog{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	catalog, err := client.Catalog.Get(ctx, "MISSING")
+	assert.Nil(t, catalog)
+
+	var notFoundErr *NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "catalog", notFoundErr.ResourceType)
+}
+
+func TestCatalogClient_Create(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("catalog.create", Catalog{ID: "TRUECHARTS", Label: "TRUECHARTS"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	catalog, err := client.Catalog.Create(ctx, &CatalogCreateRequest{
+		Label:           "TRUECHARTS",
+		Repository:      "https://github.com/truecharts/catalog",
+		Branch:          "main",
+		PreferredTrains: []string{"stable"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "TRUECHARTS", catalog.Label)
+}
+
+func TestCatalogClient_Items(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("catalog.items", json.RawMessage(`{
+		"charts": {
+			"plex": {"name": "plex", "title": "Plex", "healthy": true, "latest_version": "1.0.10", "categories": ["media"]}
+		}
+	}`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	items, err := client.Catalog.Items(ctx, "TRUENAS", &CatalogItemsOptions{Cache: true})
+	require.NoError(t, err)
+	require.Contains(t, items, "charts")
+	plex := items["charts"]["plex"]
+	assert.Equal(t, "Plex", plex.Title)
+	assert.True(t, plex.Healthy)
+	assert.Equal(t, []string{"media"}, plex.Categories)
+}
+
+func TestCatalogClient_Sync(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("catalog.sync", nil)
+	server.SetJobResponse("catalog.sync_all", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.Catalog.Sync(ctx, "TRUENAS"))
+	require.NoError(t, client.Catalog.SyncAll(ctx))
+}
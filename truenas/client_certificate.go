@@ -3,6 +3,7 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"time"
 )
 
@@ -203,6 +204,16 @@ func (c *CertificateClient) List(ctx context.Context) ([]Certificate, error) {
 	return result, err
 }
 
+// ListWithQuery returns certificates matching q
+func (c *CertificateClient) ListWithQuery(ctx context.Context, q *Query) ([]Certificate, error) {
+	return query[Certificate](ctx, c.client, "certificate.query", q)
+}
+
+// ListIter returns an iterator over the certificates matching q, fetched a page at a time
+func (c *CertificateClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Certificate, error] {
+	return paginate(ctx, q, c.ListWithQuery)
+}
+
 // Get returns a specific certificate by ID
 func (c *CertificateClient) Get(ctx context.Context, id int) (*Certificate, error) {
 	var result []Certificate
@@ -0,0 +1,184 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// ChartReleaseStatus represents the state of a chart release
+type ChartReleaseStatus string
+
+const (
+	ChartReleaseStatusActive    ChartReleaseStatus = "ACTIVE"
+	ChartReleaseStatusDeploying ChartReleaseStatus = "DEPLOYING"
+	ChartReleaseStatusStopped   ChartReleaseStatus = "STOPPED"
+)
+
+// ChartReleaseClient provides methods for managing TrueNAS SCALE chart releases (Kubernetes apps)
+type ChartReleaseClient struct {
+	client *Client
+}
+
+// NewChartReleaseClient creates a new chart release client
+func NewChartReleaseClient(client *Client) *ChartReleaseClient {
+	return &ChartReleaseClient{client: client}
+}
+
+// ChartRelease represents an installed chart release
+type ChartRelease struct {
+	ID                             string                `json:"id"`
+	Name                           string                `json:"name"`
+	Catalog                        string                `json:"catalog"`
+	CatalogTrain                   string                `json:"catalog_train"`
+	Path                           string                `json:"path"`
+	Dataset                        string                `json:"dataset"`
+	Status                         ChartReleaseStatus    `json:"status"`
+	Version                        string                `json:"version"`
+	HumanVersion                   string                `json:"human_version"`
+	HumanLatestVersion             string                `json:"human_latest_version"`
+	UpdateAvailable                bool                  `json:"update_available"`
+	ContainerImagesUpdateAvailable bool                  `json:"container_images_update_available"`
+	ChartMetadata                  ChartMetadata         `json:"chart_metadata"`
+	Config                         map[string]any        `json:"config,omitempty"`
+	UsedPorts                      []ChartReleasePort    `json:"used_ports"`
+	Portals                        map[string][]string   `json:"portals,omitempty"`
+	PodStatus                      *ChartReleasePodState `json:"pod_status,omitempty"`
+}
+
+// ChartMetadata represents the Helm chart metadata of a release
+type ChartMetadata struct {
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	AppVersion  string   `json:"appVersion"`
+	Description string   `json:"description"`
+	Home        string   `json:"home,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+	Keywords    []string `json:"keywords,omitempty"`
+	Sources     []string `json:"sources,omitempty"`
+}
+
+// ChartReleasePort represents a port exposed by a chart release
+type ChartReleasePort struct {
+	Port     int    `json:"port"`
+	Protocol string `json:"protocol"`
+}
+
+// ChartReleasePodState summarises the pods of a chart release
+type ChartReleasePodState struct {
+	Desired   int `json:"desired"`
+	Available int `json:"available"`
+}
+
+// ChartReleaseCreateRequest represents parameters for chart.release.create
+type ChartReleaseCreateRequest struct {
+	ReleaseName string         `json:"release_name"`
+	Catalog     string         `json:"catalog"`
+	Item        string         `json:"item"`
+	Train       string         `json:"train"`
+	Version     string         `json:"version,omitempty"` // Defaults to "latest"
+	Values      map[string]any `json:"values,omitempty"`
+}
+
+// ChartReleaseUpdateRequest represents parameters for chart.release.update
+type ChartReleaseUpdateRequest struct {
+	Values map[string]any `json:"values"`
+}
+
+// ChartReleaseUpgradeOptions represents options for chart.release.upgrade
+type ChartReleaseUpgradeOptions struct {
+	ItemVersion string         `json:"item_version,omitempty"` // Defaults to "latest"
+	Values      map[string]any `json:"values,omitempty"`
+}
+
+// ChartReleaseRollbackOptions represents options for chart.release.rollback
+type ChartReleaseRollbackOptions struct {
+	ItemVersion       string `json:"item_version"`
+	ForceRollback     bool   `json:"force_rollback,omitempty"`
+	RecreateResources bool   `json:"recreate_resources,omitempty"`
+	RollbackSnapshot  *bool  `json:"rollback_snapshot,omitempty"`
+}
+
+// List returns all chart releases
+func (c *ChartReleaseClient) List(ctx context.Context) ([]ChartRelease, error) {
+	var result []ChartRelease
+	err := c.client.Call(ctx, "chart.release.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns chart releases matching q
+func (c *ChartReleaseClient) ListWithQuery(ctx context.Context, q *Query) ([]ChartRelease, error) {
+	return query[ChartRelease](ctx, c.client, "chart.release.query", q)
+}
+
+// ListIter returns an iterator over the chart releases matching q, fetched a page at a time
+func (c *ChartReleaseClient) ListIter(ctx context.Context, q *Query) iter.Seq2[ChartRelease, error] {
+	return paginate(ctx, q, c.ListWithQuery)
+}
+
+// Get returns a specific chart release by name
+func (c *ChartReleaseClient) Get(ctx context.Context, name string) (*ChartRelease, error) {
+	var result []ChartRelease
+	err := c.client.Call(ctx, "chart.release.query", []any{[]any{[]any{"id", "=", name}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("chart_release", fmt.Sprintf("name %s", name))
+	}
+	return &result[0], nil
+}
+
+// Create installs a new chart release (asynchronous job)
+func (c *ChartReleaseClient) Create(ctx context.Context, req *ChartReleaseCreateRequest) (*ChartRelease, error) {
+	var result ChartRelease
+	err := c.client.CallJob(ctx, "chart.release.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates the values of a chart release (asynchronous job)
+func (c *ChartReleaseClient) Update(ctx context.Context, name string, req *ChartReleaseUpdateRequest) (*ChartRelease, error) {
+	var result ChartRelease
+	err := c.client.CallJob(ctx, "chart.release.update", []any{name, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a chart release (asynchronous job)
+func (c *ChartReleaseClient) Delete(ctx context.Context, name string, deleteUnusedImages bool) error {
+	return c.client.CallJob(ctx, "chart.release.delete", []any{name, map[string]any{"delete_unused_images": deleteUnusedImages}}, nil)
+}
+
+// Upgrade upgrades a chart release to a newer catalog item version (asynchronous job)
+func (c *ChartReleaseClient) Upgrade(ctx context.Context, name string, options *ChartReleaseUpgradeOptions) (*ChartRelease, error) {
+	var result ChartRelease
+	params := []any{name}
+	if options != nil {
+		params = append(params, *options)
+	}
+	err := c.client.CallJob(ctx, "chart.release.upgrade", params, &result)
+	return &result, err
+}
+
+// Rollback rolls a chart release back to a previous version (asynchronous job)
+func (c *ChartReleaseClient) Rollback(ctx context.Context, name string, options *ChartReleaseRollbackOptions) (*ChartRelease, error) {
+	var result ChartRelease
+	params := []any{name}
+	if options != nil {
+		params = append(params, *options)
+	}
+	err := c.client.CallJob(ctx, "chart.release.rollback", params, &result)
+	return &result, err
+}
+
+// Scale sets the number of replicas of a chart release's workloads (asynchronous job).
+// A replica count of 0 stops the release.
+func (c *ChartReleaseClient) Scale(ctx context.Context, name string, replicaCount int) error {
+	return c.client.CallJob(ctx, "chart.release.scale", []any{name, map[string]any{"replica_count": replicaCount}}, nil)
+}
+
+// GetVersions returns the versions a chart release can be upgraded or rolled back to
+func (c *ChartReleaseClient) GetVersions(ctx context.Context, name string) (map[string]any, error) {
+	var result map[string]any
+	err := c.client.Call(ctx, "chart.release.get_versions", []any{name}, &result)
+	return result, err
+}
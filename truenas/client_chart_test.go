@@ -0,0 +1,141 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewChartReleaseClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	chartClient := NewChartReleaseClient(client)
+	require.NotNil(t, chartClient)
+	assert.Equal(t, client, chartClient.client)
+}
+
+func TestChartReleaseClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("chart.release.query", json.RawMessage(`[{
+		"id": "plex",
+		"name": "plex",
+		"catalog": "TRUENAS",
+		"catalog_train": "charts",
+		"status": "ACTIVE",
+		"human_version": "1.32.5_1.0.10",
+		"update_available": true,
+		"chart_metadata": {"name": "plex", "version": "1.0.10", "appVersion": "1.32.5"},
+		"used_ports": [{"port": 32400, "protocol": "TCP"}],
+		"portals": {"web_portal": ["http://10.0.0.2:32400/web"]}
+	}]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	releases, err := client.Chart.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, releases, 1)
+
+	release := releases[0]
+	assert.Equal(t, "plex", release.Name)
+	assert.Equal(t, ChartReleaseStatusActive, release.Status)
+	assert.True(t, release.UpdateAvailable)
+	assert.Equal(t, "1.32.5", release.ChartMetadata.AppVersion)
+	require.Len(t, release.UsedPorts, 1)
+	assert.Equal(t, 32400, release.UsedPorts[0].Port)
+	assert.Equal(t, []string{"http://10.0.0.2:32400/web"}, release.Portals["web_portal"])
+}
+
+func TestChartReleaseClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("chart.release.query", []ChartRelease{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	release, err := client.Chart.Get(ctx, "missing")
+	assert.Nil(t, release)
+
+	var notFoundErr *NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "chart_release", notFoundErr.ResourceType)
+}
+
+func TestChartReleaseClient_Create(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("chart.release.create", ChartRelease{ID: "plex", Name: "plex", Status: ChartReleaseStatusDeploying})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	release, err := client.Chart.Create(ctx, &ChartReleaseCreateRequest{
+		ReleaseName: "plex",
+		Catalog:     "TRUENAS",
+		Item:        "plex",
+		Train:       "charts",
+		Values:      map[string]any{"timezone": "UTC"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "plex", release.Name)
+	assert.Equal(t, ChartReleaseStatusDeploying, release.Status)
+}
+
+func TestChartReleaseClient_Create_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("chart.release.create", "Kubernetes service is not running")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Chart.Create(ctx, &ChartReleaseCreateRequest{ReleaseName: "plex", Catalog: "TRUENAS", Item: "plex", Train: "charts"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Kubernetes service is not running")
+}
+
+func TestChartReleaseClient_Lifecycle(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	// Every job method resolves to the same completed job
+	release := ChartRelease{ID: "plex", Name: "plex"}
+	for _, method := range []string{"chart.release.update", "chart.release.upgrade", "chart.release.rollback", "chart.release.scale", "chart.release.delete"} {
+		server.SetJobResponse(method, release)
+	}
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Chart.Update(ctx, "plex", &ChartReleaseUpdateRequest{Values: map[string]any{"timezone": "UTC"}})
+	require.NoError(t, err)
+	_, err = client.Chart.Upgrade(ctx, "plex", nil)
+	require.NoError(t, err)
+	_, err = client.Chart.Rollback(ctx, "plex", &ChartReleaseRollbackOptions{ItemVersion: "1.0.9"})
+	require.NoError(t, err)
+	require.NoError(t, client.Chart.Scale(ctx, "plex", 0))
+	require.NoError(t, client.Chart.Delete(ctx, "plex", true))
+}
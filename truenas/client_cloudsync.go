@@ -0,0 +1,322 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// CloudProvider represents a cloud storage provider
+type CloudProvider string
+
+const (
+	CloudProviderS3    CloudProvider = "S3"
+	CloudProviderB2    CloudProvider = "B2"
+	CloudProviderGCS   CloudProvider = "GOOGLE_CLOUD_STORAGE"
+	CloudProviderAzure CloudProvider = "AZUREBLOB"
+)
+
+// CloudSyncDirection represents the direction of a cloud sync task
+type CloudSyncDirection string
+
+const (
+	CloudSyncDirectionPush CloudSyncDirection = "PUSH"
+	CloudSyncDirectionPull CloudSyncDirection = "PULL"
+)
+
+// CloudSyncTransferMode represents how files are transferred
+type CloudSyncTransferMode string
+
+const (
+	CloudSyncTransferModeSync CloudSyncTransferMode = "SYNC"
+	CloudSyncTransferModeCopy CloudSyncTransferMode = "COPY"
+	CloudSyncTransferModeMove CloudSyncTransferMode = "MOVE"
+)
+
+// CloudSyncClient provides methods for cloud sync task management
+type CloudSyncClient struct {
+	client      *Client
+	Credentials *CloudCredentialClient
+}
+
+// NewCloudSyncClient creates a new cloud sync client
+func NewCloudSyncClient(client *Client) *CloudSyncClient {
+	return &CloudSyncClient{
+		client:      client,
+		Credentials: NewCloudCredentialClient(client),
+	}
+}
+
+// CloudSyncTask represents a cloud sync task
+type CloudSyncTask struct {
+	ID                 int                       `json:"id"`
+	Description        string                    `json:"description"`
+	Path               string                    `json:"path"`
+	Credentials        CloudCredential           `json:"credentials"`
+	Attributes         map[string]any            `json:"attributes"`
+	Schedule           Schedule                  `json:"schedule"`
+	Direction          CloudSyncDirection        `json:"direction"`
+	TransferMode       CloudSyncTransferMode     `json:"transfer_mode"`
+	Enabled            bool                      `json:"enabled"`
+	Snapshot           bool                      `json:"snapshot"`
+	Encryption         bool                      `json:"encryption"`
+	FilenameEncryption bool                      `json:"filename_encryption"`
+	Transfers          *int                      `json:"transfers"`
+	BWLimit            []CloudSyncBandwidthLimit `json:"bwlimit"`
+	Include            []string                  `json:"include"`
+	Exclude            []string                  `json:"exclude"`
+	PreScript          string                    `json:"pre_script"`
+	PostScript         string                    `json:"post_script"`
+	Args               string                    `json:"args"`
+	FollowSymlinks     bool                      `json:"follow_symlinks"`
+	CreateEmptySrcDirs bool                      `json:"create_empty_src_dirs"`
+	Locked             bool                      `json:"locked"`
+	Job                *Job                      `json:"job"`
+	EncryptionPassword string                    `json:"encryption_password,omitempty"`
+	EncryptionSalt     string                    `json:"encryption_salt,omitempty"`
+}
+
+// CloudSyncBandwidthLimit limits transfer speed from a time of day onwards.
+// A nil Bandwidth removes the limit.
+type CloudSyncBandwidthLimit struct {
+	Time      string `json:"time"`      // "HH:MM"
+	Bandwidth *int   `json:"bandwidth"` // Bytes per second
+}
+
+// CloudSyncTaskRequest represents parameters for cloudsync.create and cloudsync.update
+type CloudSyncTaskRequest struct {
+	Description        string                    `json:"description,omitempty"`
+	Path               string                    `json:"path"`
+	Credentials        int                       `json:"credentials"`
+	Attributes         any                       `json:"attributes"`
+	Schedule           *Schedule                 `json:"schedule,omitempty"`
+	Direction          CloudSyncDirection        `json:"direction"`
+	TransferMode       CloudSyncTransferMode     `json:"transfer_mode"`
+	Enabled            *bool                     `json:"enabled,omitempty"`
+	Snapshot           *bool                     `json:"snapshot,omitempty"`
+	Encryption         *bool                     `json:"encryption,omitempty"`
+	FilenameEncryption *bool                     `json:"filename_encryption,omitempty"`
+	EncryptionPassword string                    `json:"encryption_password,omitempty"`
+	EncryptionSalt     string                    `json:"encryption_salt,omitempty"`
+	Transfers          *int                      `json:"transfers,omitempty"`
+	BWLimit            []CloudSyncBandwidthLimit `json:"bwlimit,omitempty"`
+	Include            []string                  `json:"include,omitempty"`
+	Exclude            []string                  `json:"exclude,omitempty"`
+	PreScript          string                    `json:"pre_script,omitempty"`
+	PostScript         string                    `json:"post_script,omitempty"`
+	Args               string                    `json:"args,omitempty"`
+	FollowSymlinks     *bool                     `json:"follow_symlinks,omitempty"`
+	CreateEmptySrcDirs *bool                     `json:"create_empty_src_dirs,omitempty"`
+}
+
+// S3TaskAttributes represents task attributes for S3-compatible providers
+type S3TaskAttributes struct {
+	Bucket       string `json:"bucket"`
+	Folder       string `json:"folder"`
+	FastList     bool   `json:"fast_list,omitempty"`
+	Encryption   string `json:"encryption,omitempty"` // "AES256" for server-side encryption
+	StorageClass string `json:"storage_class,omitempty"`
+	ChunkSize    int    `json:"chunk_size,omitempty"` // MiB
+}
+
+// B2TaskAttributes represents task attributes for Backblaze B2
+type B2TaskAttributes struct {
+	Bucket    string `json:"bucket"`
+	Folder    string `json:"folder"`
+	FastList  bool   `json:"fast_list,omitempty"`
+	ChunkSize int    `json:"chunk_size,omitempty"` // MiB
+}
+
+// GCSTaskAttributes represents task attributes for Google Cloud Storage
+type GCSTaskAttributes struct {
+	Bucket           string `json:"bucket"`
+	Folder           string `json:"folder"`
+	FastList         bool   `json:"fast_list,omitempty"`
+	BucketPolicyOnly bool   `json:"bucket_policy_only,omitempty"`
+}
+
+// AzureTaskAttributes represents task attributes for Azure Blob Storage
+type AzureTaskAttributes struct {
+	Container string `json:"container"`
+	Folder    string `json:"folder"`
+	FastList  bool   `json:"fast_list,omitempty"`
+}
+
+// CloudSyncOptions represents options for cloudsync.sync
+type CloudSyncOptions struct {
+	DryRun bool `json:"dry_run"`
+}
+
+// List returns all cloud sync tasks
+func (c *CloudSyncClient) List(ctx context.Context) ([]CloudSyncTask, error) {
+	var result []CloudSyncTask
+	err := c.client.Call(ctx, "cloudsync.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns cloud sync tasks matching q
+func (c *CloudSyncClient) ListWithQuery(ctx context.Context, q *Query) ([]CloudSyncTask, error) {
+	return query[CloudSyncTask](ctx, c.client, "cloudsync.query", q)
+}
+
+// ListIter returns an iterator over the cloud sync tasks matching q, fetched a page at a time
+func (c *CloudSyncClient) ListIter(ctx context.Context, q *Query) iter.Seq2[CloudSyncTask, error] {
+	return paginate(ctx, q, c.ListWithQuery)
+}
+
+// Get returns a specific cloud sync task by ID
+func (c *CloudSyncClient) Get(ctx context.Context, id int) (*CloudSyncTask, error) {
+	var result []CloudSyncTask
+	err := c.client.Call(ctx, "cloudsync.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("cloudsync_task", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates a new cloud sync task
+func (c *CloudSyncClient) Create(ctx context.Context, req *CloudSyncTaskRequest) (*CloudSyncTask, error) {
+	var result CloudSyncTask
+	err := c.client.Call(ctx, "cloudsync.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates an existing cloud sync task
+func (c *CloudSyncClient) Update(ctx context.Context, id int, req *CloudSyncTaskRequest) (*CloudSyncTask, error) {
+	var result CloudSyncTask
+	err := c.client.Call(ctx, "cloudsync.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a cloud sync task
+func (c *CloudSyncClient) Delete(ctx context.Context, id int) error {
+	return c.client.Call(ctx, "cloudsync.delete", []any{id}, nil)
+}
+
+// Sync runs a cloud sync task and waits for it to complete (asynchronous job)
+func (c *CloudSyncClient) Sync(ctx context.Context, id int, options *CloudSyncOptions) error {
+	params := []any{id}
+	if options != nil {
+		params = append(params, *options)
+	}
+	return c.client.CallJob(ctx, "cloudsync.sync", params, nil)
+}
+
+// Abort aborts a running cloud sync task
+func (c *CloudSyncClient) Abort(ctx context.Context, id int) error {
+	return c.client.Call(ctx, "cloudsync.abort", []any{id}, nil)
+}
+
+// Cloud Credentials
+
+// CloudCredentialClient provides methods for cloud credential management
+type CloudCredentialClient struct {
+	client *Client
+}
+
+// NewCloudCredentialClient creates a new cloud credential client
+func NewCloudCredentialClient(client *Client) *CloudCredentialClient {
+	return &CloudCredentialClient{client: client}
+}
+
+// CloudCredential represents stored credentials for a cloud provider
+type CloudCredential struct {
+	ID         int            `json:"id"`
+	Name       string         `json:"name"`
+	Provider   CloudProvider  `json:"provider"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// CloudCredentialRequest represents parameters for cloudsync.credentials.create and update.
+// Attributes is one of the provider-specific credential attribute types.
+type CloudCredentialRequest struct {
+	Name       string        `json:"name"`
+	Provider   CloudProvider `json:"provider"`
+	Attributes any           `json:"attributes"`
+}
+
+// S3CredentialAttributes represents credentials for S3-compatible providers
+type S3CredentialAttributes struct {
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	Endpoint        string `json:"endpoint,omitempty"`
+	Region          string `json:"region,omitempty"`
+	SkipRegion      bool   `json:"skip_region,omitempty"`
+	SignaturesV2    bool   `json:"signatures_v2,omitempty"`
+	MaxUploadParts  int    `json:"max_upload_parts,omitempty"`
+}
+
+// B2CredentialAttributes represents credentials for Backblaze B2
+type B2CredentialAttributes struct {
+	Account string `json:"account"`
+	Key     string `json:"key"`
+}
+
+// GCSCredentialAttributes represents credentials for Google Cloud Storage
+type GCSCredentialAttributes struct {
+	ServiceAccountCredentials string `json:"service_account_credentials"` // JSON key file contents
+}
+
+// AzureCredentialAttributes represents credentials for Azure Blob Storage
+type AzureCredentialAttributes struct {
+	Account  string `json:"account"`
+	Key      string `json:"key"`
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// CloudCredentialVerifyResult represents the result of cloudsync.credentials.verify
+type CloudCredentialVerifyResult struct {
+	Valid   bool   `json:"valid"`
+	Error   string `json:"error,omitempty"`
+	Excerpt string `json:"excerpt,omitempty"`
+}
+
+// List returns all cloud credentials
+func (c *CloudCredentialClient) List(ctx context.Context) ([]CloudCredential, error) {
+	var result []CloudCredential
+	err := c.client.Call(ctx, "cloudsync.credentials.query", []any{}, &result)
+	return result, err
+}
+
+// Get returns specific cloud credentials by ID
+func (c *CloudCredentialClient) Get(ctx context.Context, id int) (*CloudCredential, error) {
+	var result []CloudCredential
+	err := c.client.Call(ctx, "cloudsync.credentials.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("cloud_credential", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates new cloud credentials
+func (c *CloudCredentialClient) Create(ctx context.Context, req *CloudCredentialRequest) (*CloudCredential, error) {
+	var result CloudCredential
+	err := c.client.Call(ctx, "cloudsync.credentials.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates existing cloud credentials
+func (c *CloudCredentialClient) Update(ctx context.Context, id int, req *CloudCredentialRequest) (*CloudCredential, error) {
+	var result CloudCredential
+	err := c.client.Call(ctx, "cloudsync.credentials.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes cloud credentials
+func (c *CloudCredentialClient) Delete(ctx context.Context, id int) error {
+	return c.client.Call(ctx, "cloudsync.credentials.delete", []any{id}, nil)
+}
+
+// Verify checks that credentials are accepted by the provider without saving them
+func (c *CloudCredentialClient) Verify(ctx context.Context, provider CloudProvider, attributes any) (*CloudCredentialVerifyResult, error) {
+	var result CloudCredentialVerifyResult
+	params := map[string]any{"provider": provider, "attributes": attributes}
+	err := c.client.Call(ctx, "cloudsync.credentials.verify", []any{params}, &result)
+	return &result, err
+}
@@ -0,0 +1,198 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCloudSyncClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	cloudSyncClient := NewCloudSyncClient(client)
+	require.NotNil(t, cloudSyncClient)
+	assert.Equal(t, client, cloudSyncClient.client)
+	require.NotNil(t, cloudSyncClient.Credentials)
+}
+
+func TestCloudSyncClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("cloudsync.query", json.RawMessage(`[{
+		"id": 1,
+		"description": "Offsite backup",
+		"path": "/mnt/tank/data",
+		"credentials": {"id": 2, "name": "b2", "provider": "B2", "attributes": {"account": "acct"}},
+		"attributes": {"bucket": "backups", "folder": "/nas"},
+		"schedule": {"minute": "0", "hour": "3", "dom": "*", "month": "*", "dow": "*"},
+		"direction": "PUSH",
+		"transfer_mode": "SYNC",
+		"enabled": true,
+		"bwlimit": [{"time": "08:00", "bandwidth": 1048576}, {"time": "18:00", "bandwidth": null}],
+		"job": {"id": 77, "state": "SUCCESS"}
+	}]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	tasks, err := client.CloudSync.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	task := tasks[0]
+	assert.Equal(t, "Offsite backup", task.Description)
+	assert.Equal(t, CloudProviderB2, task.Credentials.Provider)
+	assert.Equal(t, "backups", task.Attributes["bucket"])
+	assert.Equal(t, CloudSyncDirectionPush, task.Direction)
+	assert.Equal(t, CloudSyncTransferModeSync, task.TransferMode)
+	assert.Equal(t, "3", task.Schedule.Hour)
+	require.Len(t, task.BWLimit, 2)
+	assert.Equal(t, 1048576, value(task.BWLimit[0].Bandwidth))
+	assert.Nil(t, task.BWLimit[1].Bandwidth)
+	require.NotNil(t, task.Job)
+	assert.True(t, task.Job.IsSuccessful())
+}
+
+func TestCloudSyncClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("cloudsync.query", []CloudSyncTask{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	task, err := client.CloudSync.Get(ctx, 42)
+	assert.Nil(t, task)
+
+	var notFoundErr *NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "cloudsync_task", notFoundErr.ResourceType)
+}
+
+func TestCloudSyncClient_Create(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "cloudsync.create" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`{"id": 5, "path": "/mnt/tank/data", "direction": "PUSH", "transfer_mode": "COPY"}`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	task, err := client.CloudSync.Create(ctx, &CloudSyncTaskRequest{
+		Path:         "/mnt/tank/data",
+		Credentials:  2,
+		Attributes:   S3TaskAttributes{Bucket: "backups", Folder: "/nas", Encryption: "AES256"},
+		Schedule:     Ptr(NewDailySchedule("3", "0")),
+		Direction:    CloudSyncDirectionPush,
+		TransferMode: CloudSyncTransferModeCopy,
+		BWLimit:      []CloudSyncBandwidthLimit{{Time: "08:00", Bandwidth: Ptr(1048576)}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 5, task.ID)
+
+	assert.JSONEq(t, `[{
+		"path": "/mnt/tank/data",
+		"credentials": 2,
+		"attributes": {"bucket": "backups", "folder": "/nas", "encryption": "AES256"},
+		"schedule": {"minute": "0", "hour": "3", "dom": "*", "month": "*", "dow": "*"},
+		"direction": "PUSH",
+		"transfer_mode": "COPY",
+		"bwlimit": [{"time": "08:00", "bandwidth": 1048576}]
+	}]`, tryMarshal(<-received))
+}
+
+func TestCloudSyncClient_SyncAndAbort(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("cloudsync.sync", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.CloudSync.Sync(ctx, 1, &CloudSyncOptions{DryRun: true}))
+	require.NoError(t, client.CloudSync.Abort(ctx, 1))
+}
+
+func TestCloudSyncClient_Sync_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("cloudsync.sync", "bucket does not exist")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	err := client.CloudSync.Sync(ctx, 1, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "bucket does not exist")
+}
+
+func TestCloudCredentialClient_CRUD(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	credential := CloudCredential{
+		ID:         3,
+		Name:       "s3",
+		Provider:   CloudProviderS3,
+		Attributes: map[string]any{"access_key_id": "AKIA"},
+	}
+	server.SetResponse("cloudsync.credentials.query", []CloudCredential{credential})
+	server.SetResponse("cloudsync.credentials.create", credential)
+	server.SetResponse("cloudsync.credentials.update", credential)
+	server.SetResponse("cloudsync.credentials.verify", CloudCredentialVerifyResult{Valid: true})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	attrs := S3CredentialAttributes{AccessKeyID: "AKIA", SecretAccessKey: "secret", Region: "us-east-1"}
+
+	verify, err := client.CloudSync.Credentials.Verify(ctx, CloudProviderS3, attrs)
+	require.NoError(t, err)
+	assert.True(t, verify.Valid)
+
+	created, err := client.CloudSync.Credentials.Create(ctx, &CloudCredentialRequest{Name: "s3", Provider: CloudProviderS3, Attributes: attrs})
+	require.NoError(t, err)
+	assert.Equal(t, 3, created.ID)
+
+	list, err := client.CloudSync.Credentials.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "AKIA", list[0].Attributes["access_key_id"])
+
+	got, err := client.CloudSync.Credentials.Get(ctx, 3)
+	require.NoError(t, err)
+	assert.Equal(t, CloudProviderS3, got.Provider)
+
+	_, err = client.CloudSync.Credentials.Update(ctx, 3, &CloudCredentialRequest{Name: "s3", Provider: CloudProviderS3, Attributes: attrs})
+	require.NoError(t, err)
+	require.NoError(t, client.CloudSync.Credentials.Delete(ctx, 3))
+}
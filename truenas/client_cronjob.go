@@ -3,6 +3,8 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"iter"
+	"strings"
 )
 
 // CronjobClient provides methods for cronjob management
@@ -65,6 +67,16 @@ func (c *CronjobClient) List(ctx context.Context) ([]Cronjob, error) {
 	return result, err
 }
 
+// ListWithQuery returns cronjobs matching q
+func (c *CronjobClient) ListWithQuery(ctx context.Context, q *Query) ([]Cronjob, error) {
+	return query[Cronjob](ctx, c.client, "cronjob.query", q)
+}
+
+// ListIter returns an iterator over the cronjobs matching q, fetched a page at a time
+func (c *CronjobClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Cronjob, error] {
+	return paginate(ctx, q, c.ListWithQuery)
+}
+
 // Get returns a specific cronjob by ID
 func (c *CronjobClient) Get(ctx context.Context, id int) (*Cronjob, error) {
 	var result []Cronjob
@@ -102,6 +114,41 @@ func (c *CronjobClient) Run(ctx context.Context, id int, skipDisabled bool) erro
 	return c.client.CallJob(ctx, "cronjob.run", []any{id, skipDisabled}, nil)
 }
 
+// Validate checks a cronjob before it is created: the command and user are required and
+// every field of the schedule must be a valid cron expression
+func (c *CronjobClient) Validate(req *CronjobCreateRequest) error {
+	var fields []FieldError
+	invalid := func(field, message string) {
+		fields = append(fields, FieldError{Attribute: "cron_job_create." + field, Message: message, Errno: errnoEINVAL})
+	}
+
+	if strings.TrimSpace(req.Command) == "" {
+		invalid("command", "This field is required")
+	}
+	if req.User == "" {
+		invalid("user", "This field is required")
+	}
+	for _, field := range []struct {
+		expr string
+		spec cronField
+	}{
+		{req.Schedule.Minute, cronMinute},
+		{req.Schedule.Hour, cronHour},
+		{req.Schedule.DOM, cronDOM},
+		{req.Schedule.Month, cronMonth},
+		{req.Schedule.DOW, cronDOW},
+	} {
+		if _, err := field.spec.parse(field.expr); err != nil {
+			invalid("schedule."+field.spec.name, err.Error())
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return newValidationError(fields)
+}
+
 // Helper methods for creating common schedules
 
 // NewDailySchedule creates a schedule that runs daily at the specified hour and minute
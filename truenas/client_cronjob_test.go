@@ -784,3 +784,32 @@ func TestCronjobRequestStructures(t *testing.T) {
 		assert.Equal(t, "newuser", *req.User)
 	})
 }
+
+func TestCronjobClient_Validate(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	assert.NoError(t, client.Cronjob.Validate(&CronjobCreateRequest{
+		Command:  "/usr/local/bin/backup.sh",
+		User:     "root",
+		Schedule: NewDailySchedule("2", "30"),
+	}))
+
+	err := client.Cronjob.Validate(&CronjobCreateRequest{
+		Command:  " ",
+		Schedule: NewCustomSchedule("*/0", "24", "*", "*", "fri-mon"),
+	})
+	var validationErr *ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, map[string][]string{
+		"command":         {"This field is required"},
+		"user":            {"This field is required"},
+		"schedule.minute": {`invalid step "0"`},
+		"schedule.hour":   {`"24" is not a value between 0 and 23`},
+		"schedule.dow":    {`invalid range "fri-mon"`},
+	}, validationErr.Fields())
+}
@@ -3,6 +3,9 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"io"
+	"iter"
+	"strconv"
 )
 
 // DatasetType represents the type of a ZFS dataset
@@ -64,6 +67,34 @@ const (
 	DatasetVolBlockSize128K DatasetVolBlockSize = "128K"
 )
 
+// DatasetPropertyName is the name of a ZFS property accepted by pool.dataset.update
+type DatasetPropertyName string
+
+const (
+	DatasetPropertyAclMode               DatasetPropertyName = "aclmode"
+	DatasetPropertyAclType               DatasetPropertyName = "acltype"
+	DatasetPropertyAtime                 DatasetPropertyName = "atime"
+	DatasetPropertyChecksum              DatasetPropertyName = "checksum"
+	DatasetPropertyCompression           DatasetPropertyName = "compression"
+	DatasetPropertyCopies                DatasetPropertyName = "copies"
+	DatasetPropertyDeduplication         DatasetPropertyName = "deduplication"
+	DatasetPropertyExec                  DatasetPropertyName = "exec"
+	DatasetPropertyQuota                 DatasetPropertyName = "quota"
+	DatasetPropertyReadonly              DatasetPropertyName = "readonly"
+	DatasetPropertyRecordsize            DatasetPropertyName = "recordsize"
+	DatasetPropertyRefquota              DatasetPropertyName = "refquota"
+	DatasetPropertyRefreservation        DatasetPropertyName = "refreservation"
+	DatasetPropertyReservation           DatasetPropertyName = "reservation"
+	DatasetPropertySnapdev               DatasetPropertyName = "snapdev"
+	DatasetPropertySnapdir               DatasetPropertyName = "snapdir"
+	DatasetPropertySpecialSmallBlockSize DatasetPropertyName = "special_small_block_size"
+	DatasetPropertySync                  DatasetPropertyName = "sync"
+	DatasetPropertyXattr                 DatasetPropertyName = "xattr"
+)
+
+// Inherit is the property value that makes a dataset inherit the property from its parent
+const Inherit = "INHERIT"
+
 // DatasetClient provides methods for dataset management
 type DatasetClient struct {
 	client *Client
@@ -135,6 +166,16 @@ type DatasetProperty struct {
 	SourceInfo any    `json:"source_info"`
 }
 
+// Int64 returns the property's raw value as an integer, such as a size in bytes, or 0 if the
+// property is unset or not numeric
+func (p *DatasetProperty) Int64() int64 {
+	if p == nil {
+		return 0
+	}
+	n, _ := strconv.ParseInt(p.RawValue, 10, 64)
+	return n
+}
+
 // DatasetCreateRequest represents parameters for pool.dataset.create
 type DatasetCreateRequest struct {
 	Name              string            `json:"name"`
@@ -231,6 +272,45 @@ type DatasetUnlockEntry struct {
 	KeyFile    string `json:"key_file,omitempty"`
 }
 
+// DatasetUnlockResult represents the outcome of pool.dataset.unlock
+type DatasetUnlockResult struct {
+	Unlocked []string                        `json:"unlocked"`
+	Failed   map[string]DatasetUnlockFailure `json:"failed"`
+}
+
+// DatasetUnlockFailure describes why a dataset could not be unlocked
+type DatasetUnlockFailure struct {
+	Error   string   `json:"error"`
+	Skipped []string `json:"skipped"` // Child datasets not attempted because this one failed
+}
+
+// DatasetChangeKeyOptions represents options for pool.dataset.change_key
+type DatasetChangeKeyOptions struct {
+	GenerateKey bool   `json:"generate_key,omitempty"`
+	KeyFile     bool   `json:"key_file,omitempty"` // Set by ChangeKeyWithKeyFile
+	PBKDF2Iters int    `json:"pbkdf2iters,omitempty"`
+	Passphrase  string `json:"passphrase,omitempty"`
+	Key         string `json:"key,omitempty"` // Hex-encoded raw key
+}
+
+// DatasetEncryptionSummaryOptions represents options for pool.dataset.encryption_summary
+type DatasetEncryptionSummaryOptions struct {
+	KeyFile  bool                 `json:"key_file,omitempty"` // Set by EncryptionSummaryWithKeyFile
+	Force    bool                 `json:"force,omitempty"`
+	Datasets []DatasetUnlockEntry `json:"datasets,omitempty"`
+}
+
+// DatasetEncryptionSummary reports the key state of an encrypted dataset
+type DatasetEncryptionSummary struct {
+	Name                 string  `json:"name"`
+	KeyFormat            string  `json:"key_format"`
+	KeyPresentInDatabase bool    `json:"key_present_in_database"`
+	ValidKey             bool    `json:"valid_key"`
+	Locked               bool    `json:"locked"`
+	UnlockError          *string `json:"unlock_error"`
+	UnlockSuccessful     bool    `json:"unlock_successful"`
+}
+
 // DatasetSnapshotRequest represents parameters for pool.dataset.snapshot
 type DatasetSnapshotRequest struct {
 	Dataset    string         `json:"dataset"`
@@ -248,6 +328,16 @@ func (d *DatasetClient) List(ctx context.Context) ([]Dataset, error) {
 	return result, err
 }
 
+// ListWithQuery returns datasets matching q
+func (d *DatasetClient) ListWithQuery(ctx context.Context, q *Query) ([]Dataset, error) {
+	return query[Dataset](ctx, d.client, "pool.dataset.query", q)
+}
+
+// ListIter returns an iterator over the datasets matching q, fetched a page at a time
+func (d *DatasetClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Dataset, error] {
+	return paginate(ctx, q, d.ListWithQuery)
+}
+
 // Get returns a specific dataset by ID
 func (d *DatasetClient) Get(ctx context.Context, id string) (*Dataset, error) {
 	var result []Dataset
@@ -274,6 +364,19 @@ func (d *DatasetClient) GetByName(ctx context.Context, name string) (*Dataset, e
 	return &result[0], nil
 }
 
+// Tree returns a dataset with its descendants nested in Children, fetched in a single query
+func (d *DatasetClient) Tree(ctx context.Context, root string) (*Dataset, error) {
+	q := NewQuery().Filter("id", "=", root).Extra("flat", false).Extra("retrieve_children", true)
+	result, err := d.ListWithQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("dataset", fmt.Sprintf("ID %s", root))
+	}
+	return &result[0], nil
+}
+
 // Create creates a new dataset
 func (d *DatasetClient) Create(ctx context.Context, req *DatasetCreateRequest) (*Dataset, error) {
 	var result Dataset
@@ -288,6 +391,21 @@ func (d *DatasetClient) Update(ctx context.Context, id string, req DatasetUpdate
 	return &result, err
 }
 
+// UpdateProperties sets ZFS properties of a dataset, e.g.
+// {DatasetPropertyCompression: "ZSTD", DatasetPropertyAtime: Inherit}. Values are sent as given, in
+// the form pool.dataset.update accepts for each property.
+func (d *DatasetClient) UpdateProperties(ctx context.Context, id string, props map[DatasetPropertyName]any) (*Dataset, error) {
+	var result Dataset
+	err := d.client.Call(ctx, "pool.dataset.update", []any{id, props}, &result)
+	return &result, err
+}
+
+// InheritProperty makes a dataset inherit a property from its parent. Properties without an
+// inherited value, such as quotas, are rejected by the server.
+func (d *DatasetClient) InheritProperty(ctx context.Context, id string, name DatasetPropertyName) (*Dataset, error) {
+	return d.UpdateProperties(ctx, id, map[DatasetPropertyName]any{name: Inherit})
+}
+
 // Delete deletes a dataset
 func (d *DatasetClient) Delete(ctx context.Context, id string, req DatasetDeleteRequest) error {
 	return d.client.Call(ctx, "pool.dataset.delete", []any{id, req}, nil)
@@ -307,6 +425,56 @@ func (d *DatasetClient) Unlock(ctx context.Context, id string, req DatasetUnlock
 	return d.client.CallJob(ctx, "pool.dataset.unlock", []any{id, req}, nil)
 }
 
+// UnlockWithKeyFile unlocks encrypted datasets using an uploaded JSON key file that maps
+// dataset names to their hex-encoded keys, as produced by pool.export_keys
+func (d *DatasetClient) UnlockWithKeyFile(ctx context.Context, id string, req DatasetUnlockRequest, keyFile io.Reader) (*DatasetUnlockResult, error) {
+	req.KeyFile = Ptr(true)
+	var result DatasetUnlockResult
+	err := d.client.CallUpload(ctx, "pool.dataset.unlock", []any{id, req}, keyFile, &result, nil)
+	return &result, err
+}
+
+// ExportKey returns the hex-encoded key of a dataset encrypted with a key (not a passphrase)
+func (d *DatasetClient) ExportKey(ctx context.Context, id string) (string, error) {
+	var result string
+	err := d.client.CallJob(ctx, "pool.dataset.export_key", []any{id}, &result)
+	return result, err
+}
+
+// ChangeKey changes the encryption key or passphrase of an encryption root
+func (d *DatasetClient) ChangeKey(ctx context.Context, id string, options *DatasetChangeKeyOptions) error {
+	params := []any{id}
+	if options != nil {
+		params = append(params, *options)
+	}
+	return d.client.CallJob(ctx, "pool.dataset.change_key", params, nil)
+}
+
+// ChangeKeyWithKeyFile changes the encryption key of an encryption root to the uploaded key
+func (d *DatasetClient) ChangeKeyWithKeyFile(ctx context.Context, id string, options DatasetChangeKeyOptions, keyFile io.Reader) error {
+	options.KeyFile = true
+	return d.client.CallUpload(ctx, "pool.dataset.change_key", []any{id, options}, keyFile, nil, nil)
+}
+
+// EncryptionSummary reports, for id and its children, whether the stored or supplied keys can unlock them
+func (d *DatasetClient) EncryptionSummary(ctx context.Context, id string, options *DatasetEncryptionSummaryOptions) ([]DatasetEncryptionSummary, error) {
+	var result []DatasetEncryptionSummary
+	params := []any{id}
+	if options != nil {
+		params = append(params, *options)
+	}
+	err := d.client.CallJob(ctx, "pool.dataset.encryption_summary", params, &result)
+	return result, err
+}
+
+// EncryptionSummaryWithKeyFile is EncryptionSummary with keys supplied as an uploaded JSON key file
+func (d *DatasetClient) EncryptionSummaryWithKeyFile(ctx context.Context, id string, options DatasetEncryptionSummaryOptions, keyFile io.Reader) ([]DatasetEncryptionSummary, error) {
+	options.KeyFile = true
+	var result []DatasetEncryptionSummary
+	err := d.client.CallUpload(ctx, "pool.dataset.encryption_summary", []any{id, options}, keyFile, &result, nil)
+	return result, err
+}
+
 // Mount mounts a dataset
 func (d *DatasetClient) Mount(ctx context.Context, id string) error {
 	return d.client.Call(ctx, "pool.dataset.mount", []any{id}, nil)
@@ -1,6 +1,8 @@
 package truenas
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -160,6 +162,90 @@ func TestDatasetClient_Update(t *testing.T) {
 	assert.Equal(t, "tank/test", dataset.Name)
 }
 
+func TestDatasetClient_Tree(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "pool.dataset.query" {
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`[{"id": "tank/apps", "name": "tank/apps", "children": [
+				{"id": "tank/apps/db", "name": "tank/apps/db", "children": [
+					{"id": "tank/apps/db/logs", "name": "tank/apps/db/logs", "children": []}
+				]},
+				{"id": "tank/apps/web", "name": "tank/apps/web", "children": []}
+			]}]`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	tree, err := client.Dataset.Tree(NewTestContext(t), "tank/apps")
+	require.NoError(t, err)
+	assert.Equal(t, "tank/apps", tree.Name)
+	require.Len(t, tree.Children, 2)
+	assert.Equal(t, "tank/apps/db", tree.Children[0].Name)
+	require.Len(t, tree.Children[0].Children, 1)
+	assert.Equal(t, "tank/apps/db/logs", tree.Children[0].Children[0].Name)
+
+	params, err := json.Marshal(<-received)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[[["id", "=", "tank/apps"]], {"extra": {"flat": false, "retrieve_children": true}}]`, string(params))
+}
+
+func TestDatasetClient_Tree_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("pool.dataset.query", []Dataset{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	tree, err := client.Dataset.Tree(NewTestContext(t), "tank/missing")
+	require.Error(t, err)
+	assert.Nil(t, tree)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestDatasetClient_UpdateProperties(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 2)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "pool.dataset.update" {
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`{"id": "tank/test", "name": "tank/test"}`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	dataset, err := client.Dataset.UpdateProperties(ctx, "tank/test", map[DatasetPropertyName]any{
+		DatasetPropertyCompression:           "ZSTD",
+		DatasetPropertyRecordsize:            "1M",
+		DatasetPropertySpecialSmallBlockSize: 65536,
+		DatasetPropertyAtime:                 Inherit,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tank/test", dataset.Name)
+	params, err := json.Marshal(<-received)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["tank/test", {"compression": "ZSTD", "recordsize": "1M", "special_small_block_size": 65536, "atime": "INHERIT"}]`, string(params))
+
+	_, err = client.Dataset.InheritProperty(ctx, "tank/test", DatasetPropertyCompression)
+	require.NoError(t, err)
+	params, err = json.Marshal(<-received)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["tank/test", {"compression": "INHERIT"}]`, string(params))
+}
+
 func TestDatasetClient_Delete(t *testing.T) {
 	t.Parallel()
 	server := NewTestServer(t)
@@ -225,6 +311,109 @@ func TestDatasetClient_Unlock(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDatasetClient_UnlockWithKeyFile(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("pool.dataset.unlock", map[string]any{
+		"unlocked": []string{"tank/encrypted"},
+		"failed":   map[string]any{"tank/encrypted/child": map[string]any{"error": "Invalid Key", "skipped": []string{"tank/encrypted/child/grandchild"}}},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	keyFile := `{"tank/encrypted": "3d5b6c"}`
+	result, err := client.Dataset.UnlockWithKeyFile(ctx, "tank/encrypted", DatasetUnlockRequest{Recursive: Ptr(true)}, strings.NewReader(keyFile))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tank/encrypted"}, result.Unlocked)
+	require.Contains(t, result.Failed, "tank/encrypted/child")
+	assert.Equal(t, "Invalid Key", result.Failed["tank/encrypted/child"].Error)
+
+	uploads := server.Uploads()
+	require.Len(t, uploads, 1)
+	assert.Equal(t, "pool.dataset.unlock", uploads[0].Method)
+	assert.Equal(t, keyFile, string(uploads[0].Data))
+	assert.JSONEq(t, `["tank/encrypted", {"datasets": null, "key_file": true, "recursive": true}]`, tryMarshal(uploads[0].Params))
+}
+
+func TestDatasetClient_ExportKey(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("pool.dataset.export_key", "3d5b6c")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	key, err := client.Dataset.ExportKey(ctx, "tank/encrypted")
+	require.NoError(t, err)
+	assert.Equal(t, "3d5b6c", key)
+}
+
+func TestDatasetClient_ChangeKey(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("pool.dataset.change_key", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.Dataset.ChangeKey(ctx, "tank/encrypted", &DatasetChangeKeyOptions{GenerateKey: true}))
+	require.NoError(t, client.Dataset.ChangeKeyWithKeyFile(ctx, "tank/encrypted", DatasetChangeKeyOptions{}, strings.NewReader("3d5b6c")))
+
+	uploads := server.Uploads()
+	require.Len(t, uploads, 1)
+	assert.JSONEq(t, `["tank/encrypted", {"key_file": true}]`, tryMarshal(uploads[0].Params))
+}
+
+func TestDatasetClient_EncryptionSummary(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("pool.dataset.encryption_summary", []DatasetEncryptionSummary{
+		{Name: "tank/encrypted", KeyFormat: "HEX", KeyPresentInDatabase: true, ValidKey: true, Locked: true, UnlockSuccessful: true},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	summary, err := client.Dataset.EncryptionSummary(ctx, "tank/encrypted", nil)
+	require.NoError(t, err)
+	require.Len(t, summary, 1)
+	assert.True(t, summary[0].ValidKey)
+	assert.Nil(t, summary[0].UnlockError)
+
+	summary, err = client.Dataset.EncryptionSummaryWithKeyFile(ctx, "tank/encrypted", DatasetEncryptionSummaryOptions{}, strings.NewReader(`{}`))
+	require.NoError(t, err)
+	require.Len(t, summary, 1)
+}
+
+func TestDatasetClient_EncryptionSummary_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("pool.dataset.encryption_summary", "tank/plain is not encrypted")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Dataset.EncryptionSummary(ctx, "tank/plain", nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not encrypted")
+}
+
 func TestDatasetClient_Mount(t *testing.T) {
 	t.Parallel()
 	server := NewTestServer(t)
@@ -3,8 +3,13 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"iter"
+	"time"
 )
 
+// unusedDiskPollInterval is how often WaitForUnused checks for unused disks
+const unusedDiskPollInterval = time.Second
+
 // DiskClient provides methods for disk management
 type DiskClient struct {
 	client *Client
@@ -221,6 +226,16 @@ func (d *DiskClient) List(ctx context.Context) ([]Disk, error) {
 	return result, err
 }
 
+// ListWithQuery returns disks matching q
+func (d *DiskClient) ListWithQuery(ctx context.Context, q *Query) ([]Disk, error) {
+	return query[Disk](ctx, d.client, "disk.query", q)
+}
+
+// ListIter returns an iterator over the disks matching q, fetched a page at a time
+func (d *DiskClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Disk, error] {
+	return paginate(ctx, q, d.ListWithQuery)
+}
+
 // ListWithOptions returns disks with additional options
 func (d *DiskClient) ListWithOptions(ctx context.Context, opts *DiskQueryOptions) ([]Disk, error) {
 	var result []Disk
@@ -267,12 +282,58 @@ func (d *DiskClient) Decrypt(ctx context.Context, req *DecryptRequest) error {
 // Utility operations
 
 // GetUnused returns all unused disks
+//
+// Deprecated: Use ListUnused, which leaves out devices that cannot hold a pool.
 func (d *DiskClient) GetUnused(ctx context.Context, joinPartitions bool) ([]UnusedDisk, error) {
 	var result []UnusedDisk
 	err := d.client.Call(ctx, "disk.get_unused", []any{joinPartitions}, &result)
 	return result, err
 }
 
+// ListUnused returns the disks that are not part of a pool and can be used to create or extend
+// one. Floppy drives, which disk.get_unused reports on some virtual machines, are left out.
+// If join is set, partitions of each disk are reported with it.
+func (d *DiskClient) ListUnused(ctx context.Context, join bool) ([]UnusedDisk, error) {
+	var result []UnusedDisk
+	if err := d.client.Call(ctx, "disk.get_unused", []any{join}, &result); err != nil {
+		return nil, err
+	}
+	usable := result[:0]
+	for _, disk := range result {
+		if disk.Driver != "floppy" {
+			usable = append(usable, disk)
+		}
+	}
+	return usable, nil
+}
+
+// WaitForUnused waits until at least n unused disks are available, such as after attaching
+// disks to a virtual machine, and returns them all. It gives up after timeout, or when ctx
+// ends if timeout is zero.
+func (d *DiskClient) WaitForUnused(ctx context.Context, n int, timeout time.Duration) ([]UnusedDisk, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	ticker := time.NewTicker(unusedDiskPollInterval)
+	defer ticker.Stop()
+	for {
+		disks, err := d.ListUnused(ctx, false)
+		if err != nil {
+			return nil, fmt.Errorf("wait for %d unused disks: %w", n, err)
+		}
+		if len(disks) >= n {
+			return disks, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for %d unused disks: found %d: %w", n, len(disks), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
 // LabelToDev converts disk label to device name
 func (d *DiskClient) LabelToDev(ctx context.Context, label string) (string, error) {
 	var result string
@@ -1,7 +1,9 @@
 package truenas
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -456,3 +458,56 @@ func TestDiskClient_ErrorHandling(t *testing.T) {
 	assert.Equal(t, 500, apiErr.Code)
 	assert.Equal(t, "Disk service unavailable", apiErr.Message)
 }
+
+func TestDiskClient_ListUnused(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("disk.get_unused", []UnusedDisk{
+		{Name: "fd0", Devname: "fd0", Driver: "floppy"},
+		{Name: "sdb", Devname: "sdb", Size: 1 << 40, Driver: "sd"},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	disks, err := client.Disk.ListUnused(NewTestContext(t), true)
+	require.NoError(t, err)
+	require.Len(t, disks, 1)
+	assert.Equal(t, "sdb", disks[0].Name)
+	server.AssertCalled(t, "disk.get_unused", true)
+}
+
+func TestDiskClient_WaitForUnused(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.QueueResponses("disk.get_unused", []UnusedDisk{{Name: "sdb"}})
+	server.SetResponse("disk.get_unused", []UnusedDisk{{Name: "sdb"}, {Name: "sdc"}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	disks, err := client.Disk.WaitForUnused(NewTestContext(t), 2, time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, disks, 2)
+	assert.Len(t, server.Calls("disk.get_unused"), 2)
+}
+
+func TestDiskClient_WaitForUnused_Timeout(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("disk.get_unused", []UnusedDisk{{Name: "sdb"}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Disk.WaitForUnused(NewTestContext(t), 3, 100*time.Millisecond)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "found 1")
+}
@@ -0,0 +1,83 @@
+package truenas
+
+import (
+	"context"
+)
+
+// DockerState represents the state of the Docker service backing apps
+type DockerState string
+
+const (
+	DockerStatePending      DockerState = "PENDING"
+	DockerStateInitializing DockerState = "INITIALIZING"
+	DockerStateRunning      DockerState = "RUNNING"
+	DockerStateStopping     DockerState = "STOPPING"
+	DockerStateStopped      DockerState = "STOPPED"
+	DockerStateUnconfigured DockerState = "UNCONFIGURED"
+	DockerStateFailed       DockerState = "FAILED"
+)
+
+// DockerClient provides methods for configuring the Docker apps backend of TrueNAS SCALE 24.10
+// and later. Apps themselves are managed through AppClient.
+type DockerClient struct {
+	client *Client
+}
+
+// NewDockerClient creates a new Docker client
+func NewDockerClient(client *Client) *DockerClient {
+	return &DockerClient{client: client}
+}
+
+// DockerAddressPool is a network range Docker allocates container networks from
+type DockerAddressPool struct {
+	Base string `json:"base"` // CIDR, e.g. "172.17.0.0/12"
+	Size int    `json:"size"` // Prefix length of each allocated network
+}
+
+// DockerConfig represents the Docker configuration
+type DockerConfig struct {
+	ID                 int                 `json:"id"`
+	Pool               *string             `json:"pool"` // Nil while apps are unconfigured
+	Dataset            *string             `json:"dataset"`
+	EnableImageUpdates bool                `json:"enable_image_updates"`
+	NVIDIA             bool                `json:"nvidia"`
+	AddressPools       []DockerAddressPool `json:"address_pools"`
+	CIDRv6             string              `json:"cidr_v6,omitempty"`
+}
+
+// DockerUpdateRequest represents parameters for docker.update
+type DockerUpdateRequest struct {
+	Pool               *string             `json:"pool,omitempty"` // Pool for the ix-apps dataset; an empty string unsets it
+	EnableImageUpdates *bool               `json:"enable_image_updates,omitempty"`
+	NVIDIA             *bool               `json:"nvidia,omitempty"`
+	AddressPools       []DockerAddressPool `json:"address_pools,omitempty"`
+	CIDRv6             *string             `json:"cidr_v6,omitempty"`
+}
+
+// DockerStatus represents the status of the Docker service
+type DockerStatus struct {
+	Status      DockerState `json:"status"`
+	Description string      `json:"description"`
+}
+
+// GetConfig returns the Docker configuration
+func (d *DockerClient) GetConfig(ctx context.Context) (*DockerConfig, error) {
+	var result DockerConfig
+	err := d.client.Call(ctx, "docker.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig updates the Docker configuration (asynchronous job). Setting the pool
+// initializes the apps dataset on it and starts Docker.
+func (d *DockerClient) UpdateConfig(ctx context.Context, req *DockerUpdateRequest) (*DockerConfig, error) {
+	var result DockerConfig
+	err := d.client.CallJob(ctx, "docker.update", []any{*req}, &result)
+	return &result, err
+}
+
+// Status returns the status of the Docker service
+func (d *DockerClient) Status(ctx context.Context) (*DockerStatus, error) {
+	var result DockerStatus
+	err := d.client.Call(ctx, "docker.status", []any{}, &result)
+	return &result, err
+}
@@ -0,0 +1,95 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDockerClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	require.NotNil(t, client.Docker)
+	assert.Equal(t, client, client.Docker.client)
+}
+
+func TestDockerClient_GetConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("docker.config", json.RawMessage(`{
+		"id": 1,
+		"pool": "tank",
+		"dataset": "tank/ix-apps",
+		"enable_image_updates": true,
+		"nvidia": false,
+		"address_pools": [{"base": "172.17.0.0/12", "size": 24}],
+		"cidr_v6": "fdd0::/64"
+	}`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	config, err := client.Docker.GetConfig(NewTestContext(t))
+	require.NoError(t, err)
+	require.NotNil(t, config.Pool)
+	assert.Equal(t, "tank", *config.Pool)
+	assert.Equal(t, []DockerAddressPool{{Base: "172.17.0.0/12", Size: 24}}, config.AddressPools)
+}
+
+func TestDockerClient_UpdateConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("docker.update", DockerConfig{ID: 1, Pool: Ptr("tank"), Dataset: Ptr("tank/ix-apps")})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	config, err := client.Docker.UpdateConfig(NewTestContext(t), &DockerUpdateRequest{Pool: Ptr("tank")})
+	require.NoError(t, err)
+	assert.Equal(t, "tank/ix-apps", *config.Dataset)
+
+	params, err := json.Marshal(DockerUpdateRequest{Pool: Ptr("tank"), EnableImageUpdates: Ptr(false)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"pool": "tank", "enable_image_updates": false}`, string(params))
+}
+
+func TestDockerClient_UpdateConfig_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("docker.update", "Pool tank does not exist")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Docker.UpdateConfig(NewTestContext(t), &DockerUpdateRequest{Pool: Ptr("tank")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Pool tank does not exist")
+}
+
+func TestDockerClient_Status(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("docker.status", DockerStatus{Status: DockerStateUnconfigured})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	status, err := client.Docker.Status(NewTestContext(t))
+	require.NoError(t, err)
+	assert.Equal(t, DockerStateUnconfigured, status.Status)
+}
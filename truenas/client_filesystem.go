@@ -2,17 +2,26 @@ package truenas
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
 	"time"
 )
 
 // FilesystemClient provides methods for filesystem management
 type FilesystemClient struct {
-	client *Client
+	client       *Client
+	ACLTemplates *ACLTemplateClient
 }
 
 // NewFilesystemClient creates a new filesystem client
 func NewFilesystemClient(client *Client) *FilesystemClient {
-	return &FilesystemClient{client: client}
+	return &FilesystemClient{
+		client:       client,
+		ACLTemplates: NewACLTemplateClient(client),
+	}
 }
 
 // FilesystemStat represents filesystem stat information
@@ -52,6 +61,22 @@ type FilesystemStatfs struct {
 	Flags      []string `json:"flags"`
 }
 
+// DirEntry types reported in DirEntry.Type
+const (
+	DirEntryTypeFile      = "FILE"
+	DirEntryTypeDirectory = "DIRECTORY"
+	DirEntryTypeSymlink   = "SYMLINK"
+	DirEntryTypeOther     = "OTHER"
+)
+
+// ListDirOptions filters and pages the entries returned by ListDirWithOptions
+type ListDirOptions struct {
+	Type   string // Only return entries of this type, such as DirEntryTypeFile or DirEntryTypeDirectory
+	Name   string // Only return entries whose name matches this path.Match pattern
+	Limit  int    // Maximum number of entries to return, or 0 for all
+	Offset int    // Number of matching entries to skip
+}
+
 // DirEntry represents a directory entry
 type DirEntry struct {
 	Name     string    `json:"name"`
@@ -78,12 +103,13 @@ type ACL struct {
 
 // ACLEntry represents a single ACL entry
 type ACLEntry struct {
-	Tag   string `json:"tag"`
-	ID    *int   `json:"id,omitempty"`
-	Type  string `json:"type"`
-	Perms any    `json:"perms"` // Can be simplified string or detailed object
-	Flags any    `json:"flags,omitempty"`
-	Who   string `json:"who,omitempty"`
+	Tag     string   `json:"tag"`
+	ID      *int     `json:"id,omitempty"`
+	Type    string   `json:"type"`
+	Perms   ACLPerms `json:"perms"`
+	Flags   ACLFlags `json:"flags,omitzero"`
+	Who     string   `json:"who,omitempty"`
+	Default bool     `json:"default,omitempty"` // POSIX1E default (inheritable) entry
 }
 
 // NFS41Flags represents NFSv4.1 ACL flags
@@ -117,6 +143,22 @@ type SetACLRequest struct {
 	Options    SetACLOptions `json:"options"`
 }
 
+// ACLDiff describes how the ACL of a path differs from a SetACLRequest, as returned by
+// FilesystemClient.EnsureACL
+type ACLDiff struct {
+	Added   []ACLEntry // Requested entries the path does not have
+	Removed []ACLEntry // Entries of the path that were not requested
+	UID     *int       // Requested owner, if it differs
+	GID     *int       // Requested group, if it differs
+	ACLType ACLType    // Requested ACL type, if it differs
+	Strip   bool       // Whether the request strips an ACL that is not trivial
+}
+
+// Empty reports whether the path already has the requested ACL
+func (d *ACLDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && d.UID == nil && d.GID == nil && d.ACLType == "" && !d.Strip
+}
+
 // SetACLOptions represents options for setacl operation
 type SetACLOptions struct {
 	StripACL     bool `json:"stripacl"`
@@ -205,6 +247,87 @@ func (f *FilesystemClient) ListDir(ctx context.Context, path string) ([]DirEntry
 	return result, err
 }
 
+// ListDirWithOptions returns the directory entries matching opts, filtered, sorted by name and
+// paged on the server so that very large directories can be listed a page at a time
+func (f *FilesystemClient) ListDirWithOptions(ctx context.Context, dir string, opts ListDirOptions) ([]DirEntry, error) {
+	q := NewQuery().OrderBy("name").Limit(opts.Limit).Offset(opts.Offset)
+	if opts.Type != "" {
+		q.Filter("type", "=", opts.Type)
+	}
+	if opts.Name != "" {
+		pattern, err := globRegexp(opts.Name)
+		if err != nil {
+			return nil, err
+		}
+		q.Filter("name", "~", pattern)
+	}
+	var result []DirEntry
+	err := f.client.Call(ctx, "filesystem.listdir", append([]any{dir}, q.Params()...), &result)
+	return result, err
+}
+
+// globRegexp converts a path.Match pattern into an anchored regular expression for the "~" filter
+func globRegexp(pattern string) (string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return "", fmt.Errorf("name pattern %q: %w", pattern, err)
+	}
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		case '\\':
+			i++
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case '[':
+			b.WriteString("[")
+			if i+1 < len(pattern) && pattern[i+1] == '^' {
+				b.WriteString("^")
+				i++
+			}
+			for i++; pattern[i] != ']'; i++ {
+				switch c := pattern[i]; c {
+				case '\\':
+					i++
+					if c = pattern[i]; c == '-' || c == '^' {
+						b.WriteString(`\` + string(c))
+					} else {
+						b.WriteString(regexp.QuoteMeta(string(c)))
+					}
+				case '[', '&', '~', '|':
+					// Escaped so that Python doesn't read them as nested sets or set operations
+					b.WriteString(`\` + string(c))
+				default:
+					b.WriteByte(c)
+				}
+			}
+			b.WriteString("]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String(), nil
+}
+
+// Mkdir creates a directory with the given octal mode, such as "755", and returns its entry. An
+// empty mode uses the server's default of 755.
+func (f *FilesystemClient) Mkdir(ctx context.Context, path, mode string) (*DirEntry, error) {
+	options := map[string]any{}
+	if mode != "" {
+		options["mode"] = mode
+	}
+	var result DirEntry
+	err := f.client.Call(ctx, "filesystem.mkdir", []any{map[string]any{"path": path, "options": options}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 // ACL operations
 
 // GetACL returns the ACL for a path
@@ -224,6 +347,41 @@ func (f *FilesystemClient) SetACL(ctx context.Context, req *SetACLRequest) error
 	}, nil)
 }
 
+// EnsureACL applies the ACL described by req only if the path does not already have it, and
+// returns how the path's ACL differed. Entries are compared with DiffACLEntries, so an ACL
+// the middleware has reordered or rewritten in another form still matches. Only req.Path
+// itself is compared: with req.Options.Recursive set, the ACL is applied recursively if the
+// path's own ACL differs.
+func (f *FilesystemClient) EnsureACL(ctx context.Context, req *SetACLRequest) (*ACLDiff, error) {
+	current, err := f.GetACL(ctx, req.Path, false)
+	if err != nil {
+		return nil, fmt.Errorf("ensure ACL of %s: %w", req.Path, err)
+	}
+
+	var diff ACLDiff
+	if req.Options.StripACL {
+		diff.Strip = !current.Trivial
+	} else {
+		diff.Added, diff.Removed = DiffACLEntries(current.ACL, req.DACL)
+		if req.ACLType != "" && string(req.ACLType) != current.ACLType {
+			diff.ACLType = req.ACLType
+		}
+	}
+	if req.UID != nil && *req.UID != current.UID {
+		diff.UID = req.UID
+	}
+	if req.GID != nil && *req.GID != current.GID {
+		diff.GID = req.GID
+	}
+	if diff.Empty() {
+		return &diff, nil
+	}
+	if err := f.SetACL(ctx, req); err != nil {
+		return nil, fmt.Errorf("ensure ACL of %s: %w", req.Path, err)
+	}
+	return &diff, nil
+}
+
 // IsACLTrivial checks if the ACL can be expressed as a simple file mode
 func (f *FilesystemClient) IsACLTrivial(ctx context.Context, path string) (bool, error) {
 	var result bool
@@ -267,16 +425,28 @@ func (f *FilesystemClient) ChangeOwner(ctx context.Context, req *ChownRequest) e
 // File operations
 
 // GetFile downloads a file (asynchronous job with download support)
+//
+// Deprecated: Use Download, which returns the file's contents.
 func (f *FilesystemClient) GetFile(ctx context.Context, path string) error {
 	return f.client.CallJob(ctx, "filesystem.get", []any{path}, nil)
 }
 
-// PutFile uploads a file (asynchronous job with upload support)
-func (f *FilesystemClient) PutFile(ctx context.Context, path string, options *PutFileOptions) error {
+// Download streams the contents of a file on the server into w
+func (f *FilesystemClient) Download(ctx context.Context, filePath string, w io.Writer) error {
+	return f.client.CallDownload(ctx, "filesystem.get", []any{filePath}, path.Base(filePath), w)
+}
+
+// PutFile uploads the contents of r to path on the server
+func (f *FilesystemClient) PutFile(ctx context.Context, path string, r io.Reader, options *PutFileOptions) error {
+	return f.PutFileWithProgress(ctx, path, r, options, nil)
+}
+
+// PutFileWithProgress uploads the contents of r to path, reporting the bytes sent to fn
+func (f *FilesystemClient) PutFileWithProgress(ctx context.Context, path string, r io.Reader, options *PutFileOptions, fn UploadProgressFunc) error {
 	if options == nil {
 		options = &PutFileOptions{}
 	}
-	return f.client.CallJob(ctx, "filesystem.put", []any{path, *options}, nil)
+	return f.client.CallUpload(ctx, "filesystem.put", []any{path, *options}, r, nil, fn)
 }
 
 // Helper methods for common operations
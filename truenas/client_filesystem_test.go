@@ -1,6 +1,12 @@
 package truenas
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -160,6 +166,33 @@ func TestFilesystemClient_Statfs(t *testing.T) {
 	assert.Equal(t, []string{"rw", "relatime"}, statfs.Flags)
 }
 
+func TestFilesystemClient_Statfs_LargeValues(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	// 2^53+1 is the smallest integer a float64 cannot represent
+	server.SetResponse("filesystem.statfs", json.RawMessage(`{
+		"free_bytes": 9007199254740993,
+		"avail_bytes": 9007199254740995,
+		"total_bytes": 18014398509481985,
+		"total_files": 9007199254740997,
+		"free_files": 9007199254740999
+	}`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	statfs, err := client.Filesystem.Statfs(ctx, "/mnt/tank")
+	require.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), statfs.FreeBytes)
+	assert.Equal(t, int64(9007199254740995), statfs.AvailBytes)
+	assert.Equal(t, int64(18014398509481985), statfs.TotalBytes)
+	assert.Equal(t, int64(9007199254740997), statfs.TotalFiles)
+	assert.Equal(t, int64(9007199254740999), statfs.FreeFiles)
+}
+
 func TestFilesystemClient_Statfs_Error(t *testing.T) {
 	t.Parallel()
 	server := NewTestServer(t)
@@ -297,6 +330,114 @@ func TestFilesystemClient_ListDir_Error(t *testing.T) {
 	assert.Equal(t, "Permission denied", apiErr.Message)
 }
 
+func TestFilesystemClient_ListDirWithOptions(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "filesystem.listdir" {
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`[{"name": "b.log", "path": "/mnt/tank/logs/b.log", "type": "FILE"}]`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	entries, err := client.Filesystem.ListDirWithOptions(ctx, "/mnt/tank/logs", ListDirOptions{
+		Type:   DirEntryTypeFile,
+		Name:   "*.log",
+		Limit:  100,
+		Offset: 200,
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "b.log", entries[0].Name)
+
+	params, err := json.Marshal(<-received)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["/mnt/tank/logs", [["type", "=", "FILE"], ["name", "~", "^.*\\.log$"]], {"order_by": ["name"], "limit": 100, "offset": 200}]`, string(params))
+
+	_, err = client.Filesystem.ListDirWithOptions(ctx, "/mnt/tank/logs", ListDirOptions{Name: "[a-"})
+	require.Error(t, err)
+}
+
+func TestFilesystemClient_Mkdir(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "filesystem.mkdir" {
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`{"name": "share", "path": "/mnt/tank/share", "realpath": "/mnt/tank/share", "type": "DIRECTORY", "mode": 16872}`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	entry, err := client.Filesystem.Mkdir(ctx, "/mnt/tank/share", "750")
+	require.NoError(t, err)
+	assert.Equal(t, "share", entry.Name)
+	assert.Equal(t, DirEntryTypeDirectory, entry.Type)
+
+	params, err := json.Marshal(<-received)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"path": "/mnt/tank/share", "options": {"mode": "750"}}]`, string(params))
+}
+
+func TestFilesystemClient_Mkdir_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetError("filesystem.mkdir", 17, "Path already exists")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	entry, err := client.Filesystem.Mkdir(NewTestContext(t), "/mnt/tank/share", "")
+	require.Error(t, err)
+	assert.Nil(t, entry)
+}
+
+func TestGlobRegexp(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pattern string
+		want    string
+		match   []string
+		noMatch []string
+	}{
+		{"*", `^.*$`, []string{"", "a.txt"}, nil},
+		{"*.txt", `^.*\.txt$`, []string{"a.txt", ".txt"}, []string{"a.txt.bak", "atxt"}},
+		{"file?.log", `^file.\.log$`, []string{"file1.log"}, []string{"file.log", "file12.log"}},
+		{"[a-c]*", `^[a-c].*$`, []string{"apple", "cat"}, []string{"dog"}},
+		{"[^a-c]*", `^[^a-c].*$`, []string{"dog"}, []string{"apple"}},
+		{`a\*b`, `^a\*b$`, []string{"a*b"}, []string{"aab"}},
+		{`[\]x]`, `^[\]x]$`, []string{"]", "x"}, []string{"y"}},
+		{"(1)+$", `^\(1\)\+\$$`, []string{"(1)+$"}, []string{"1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			got, err := globRegexp(tt.pattern)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			re := regexp.MustCompile(got)
+			for _, name := range tt.match {
+				assert.True(t, re.MatchString(name), name)
+			}
+			for _, name := range tt.noMatch {
+				assert.False(t, re.MatchString(name), name)
+			}
+		})
+	}
+}
+
 func TestFilesystemClient_GetACL(t *testing.T) {
 	t.Parallel()
 	server := NewTestServer(t)
@@ -310,17 +451,17 @@ func TestFilesystemClient_GetACL(t *testing.T) {
 			{
 				Tag:   "owner@",
 				Type:  "ALLOW",
-				Perms: "rwxpDdaARWcCos",
+				Perms: FullControl,
 			},
 			{
 				Tag:   "group@",
 				Type:  "ALLOW",
-				Perms: "rxaRc",
+				Perms: ReadAccess,
 			},
 			{
 				Tag:   "everyone@",
 				Type:  "ALLOW",
-				Perms: "rxaRc",
+				Perms: ReadAccess,
 			},
 		},
 		Trivial: false,
@@ -343,7 +484,7 @@ func TestFilesystemClient_GetACL(t *testing.T) {
 	assert.Len(t, acl.ACL, 3)
 	assert.Equal(t, "owner@", acl.ACL[0].Tag)
 	assert.Equal(t, "ALLOW", acl.ACL[0].Type)
-	assert.Equal(t, "rwxpDdaARWcCos", acl.ACL[0].Perms)
+	assert.Equal(t, FullControl, acl.ACL[0].Perms)
 }
 
 func TestFilesystemClient_GetACL_Simplified(t *testing.T) {
@@ -359,17 +500,17 @@ func TestFilesystemClient_GetACL_Simplified(t *testing.T) {
 			{
 				Tag:   "USER_OBJ",
 				Type:  "ALLOW",
-				Perms: "rwx",
+				Perms: ACLPerms{POSIX: &POSIXPerms{Read: true, Write: true, Execute: true}},
 			},
 			{
 				Tag:   "GROUP_OBJ",
 				Type:  "ALLOW",
-				Perms: "r-x",
+				Perms: ACLPerms{POSIX: &POSIXPerms{Read: true, Execute: true}},
 			},
 			{
 				Tag:   "OTHER",
 				Type:  "ALLOW",
-				Perms: "r-x",
+				Perms: ACLPerms{POSIX: &POSIXPerms{Read: true, Execute: true}},
 			},
 		},
 		Trivial: true,
@@ -430,17 +571,17 @@ func TestFilesystemClient_SetACL(t *testing.T) {
 			{
 				Tag:   "owner@",
 				Type:  "ALLOW",
-				Perms: "full_set",
+				Perms: FullControl,
 			},
 			{
 				Tag:   "group@",
 				Type:  "ALLOW",
-				Perms: "read_set",
+				Perms: ReadAccess,
 			},
 			{
 				Tag:   "everyone@",
 				Type:  "ALLOW",
-				Perms: "read_set",
+				Perms: ReadAccess,
 			},
 		},
 		NFS41Flags: &NFS41Flags{
@@ -477,7 +618,7 @@ func TestFilesystemClient_SetACL_Recursive(t *testing.T) {
 			{
 				Tag:   "owner@",
 				Type:  "ALLOW",
-				Perms: "full_set",
+				Perms: FullControl,
 			},
 		},
 		ACLType: ACLTypeNFS4,
@@ -586,17 +727,17 @@ func TestFilesystemClient_GetDefaultACL(t *testing.T) {
 			{
 				Tag:   "owner@",
 				Type:  "ALLOW",
-				Perms: "full_set",
+				Perms: FullControl,
 			},
 			{
 				Tag:   "group@",
 				Type:  "ALLOW",
-				Perms: "modify_set",
+				Perms: ModifyAccess,
 			},
 			{
 				Tag:   "everyone@",
 				Type:  "ALLOW",
-				Perms: "read_set",
+				Perms: ReadAccess,
 			},
 		},
 		Trivial: false,
@@ -613,7 +754,7 @@ func TestFilesystemClient_GetDefaultACL(t *testing.T) {
 	assert.Equal(t, "NFS4", acl.ACLType)
 	assert.Len(t, acl.ACL, 3)
 	assert.Equal(t, "owner@", acl.ACL[0].Tag)
-	assert.Equal(t, "full_set", acl.ACL[0].Perms)
+	assert.Equal(t, FullControl, acl.ACL[0].Perms)
 }
 
 func TestFilesystemClient_GetDefaultACL_AllTypes(t *testing.T) {
@@ -970,8 +1111,15 @@ func TestFilesystemClient_PutFile(t *testing.T) {
 	}
 
 	ctx := NewTestContext(t)
-	err := client.Filesystem.PutFile(ctx, "/mnt/tank/newfile.txt", options)
-	assert.NoError(t, err)
+	err := client.Filesystem.PutFile(ctx, "/mnt/tank/newfile.txt", strings.NewReader("hello world"), options)
+	require.NoError(t, err)
+
+	uploads := server.Uploads()
+	require.Len(t, uploads, 1)
+	assert.Equal(t, "filesystem.put", uploads[0].Method)
+	assert.Equal(t, []any{"/mnt/tank/newfile.txt", map[string]any{"append": false, "mode": float64(0644)}}, uploads[0].Params)
+	assert.Equal(t, "hello world", string(uploads[0].Data))
+	assert.True(t, strings.HasPrefix(uploads[0].Authorization, "Basic "))
 }
 
 func TestFilesystemClient_PutFile_Append(t *testing.T) {
@@ -990,8 +1138,12 @@ func TestFilesystemClient_PutFile_Append(t *testing.T) {
 	}
 
 	ctx := NewTestContext(t)
-	err := client.Filesystem.PutFile(ctx, "/mnt/tank/appendfile.txt", options)
-	assert.NoError(t, err)
+	err := client.Filesystem.PutFile(ctx, "/mnt/tank/appendfile.txt", strings.NewReader("more"), options)
+	require.NoError(t, err)
+
+	uploads := server.Uploads()
+	require.Len(t, uploads, 1)
+	assert.Equal(t, map[string]any{"append": true}, uploads[0].Params[1])
 }
 
 func TestFilesystemClient_PutFile_NilOptions(t *testing.T) {
@@ -1005,7 +1157,7 @@ func TestFilesystemClient_PutFile_NilOptions(t *testing.T) {
 	defer client.Close()
 
 	ctx := NewTestContext(t)
-	err := client.Filesystem.PutFile(ctx, "/mnt/tank/defaultfile.txt", nil)
+	err := client.Filesystem.PutFile(ctx, "/mnt/tank/defaultfile.txt", strings.NewReader(""), nil)
 	assert.NoError(t, err)
 }
 
@@ -1020,11 +1172,66 @@ func TestFilesystemClient_PutFile_Error(t *testing.T) {
 	defer client.Close()
 
 	ctx := NewTestContext(t)
-	err := client.Filesystem.PutFile(ctx, "/mnt/tank/restricted/file.txt", nil)
+	err := client.Filesystem.PutFile(ctx, "/mnt/tank/restricted/file.txt", strings.NewReader("data"), nil)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "Permission denied")
 }
 
+func TestFilesystemClient_PutFile_Rejected(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	err := client.Filesystem.PutFile(ctx, "/mnt/tank/file.txt", strings.NewReader("data"), nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "422")
+}
+
+func TestFilesystemClient_PutFileWithProgress(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("filesystem.put", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	content := strings.Repeat("x", 256*1024)
+	var sent atomic.Int64
+	ctx := NewTestContext(t)
+	err := client.Filesystem.PutFileWithProgress(ctx, "/mnt/tank/big.bin", strings.NewReader(content), nil, func(n int64) {
+		sent.Store(n)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(content)), sent.Load())
+
+	uploads := server.Uploads()
+	require.Len(t, uploads, 1)
+	assert.Len(t, uploads[0].Data, len(content))
+}
+
+func TestFilesystemClient_PutFile_Cancelled(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("filesystem.put", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := context.WithCancel(NewTestContext(t))
+	cancel()
+	err := client.Filesystem.PutFile(ctx, "/mnt/tank/file.txt", strings.NewReader("data"), nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, server.Uploads())
+}
+
 func TestFilesystemClient_CreateDefaultACL(t *testing.T) {
 	t.Parallel()
 	server := NewTestServer(t)
@@ -1036,7 +1243,7 @@ func TestFilesystemClient_CreateDefaultACL(t *testing.T) {
 			{
 				Tag:   "owner@",
 				Type:  "ALLOW",
-				Perms: "full_set",
+				Perms: FullControl,
 			},
 		},
 	}
@@ -1064,7 +1271,7 @@ func TestFilesystemClient_CreateShareACL(t *testing.T) {
 			{
 				Tag:   "owner@",
 				Type:  "ALLOW",
-				Perms: "full_set",
+				Perms: FullControl,
 			},
 		},
 	}
@@ -1216,8 +1423,8 @@ func TestFilesystemClient_ComplexACLEntry(t *testing.T) {
 				Tag:   "USER",
 				ID:    &id,
 				Type:  "ALLOW",
-				Perms: map[string]any{"BASIC": "FULL_CONTROL"},
-				Flags: map[string]any{"INHERIT": "INHERIT_ONLY"},
+				Perms: FullControl,
+				Flags: ACLFlags{Advanced: &NFS4Flags{FileInherit: true, InheritOnly: true}},
 				Who:   "testuser",
 			},
 		},
@@ -1238,8 +1445,8 @@ func TestFilesystemClient_ComplexACLEntry(t *testing.T) {
 	assert.Equal(t, &id, entry.ID)
 	assert.Equal(t, "ALLOW", entry.Type)
 	assert.Equal(t, "testuser", entry.Who)
-	assert.NotNil(t, entry.Perms)
-	assert.NotNil(t, entry.Flags)
+	assert.Equal(t, FullControl, entry.Perms)
+	assert.Equal(t, ACLFlags{Advanced: &NFS4Flags{FileInherit: true, InheritOnly: true}}, entry.Flags)
 }
 
 func TestFilesystemClient_NFS41Flags(t *testing.T) {
@@ -1258,7 +1465,7 @@ func TestFilesystemClient_NFS41Flags(t *testing.T) {
 			{
 				Tag:   "owner@",
 				Type:  "ALLOW",
-				Perms: "full_set",
+				Perms: FullControl,
 			},
 		},
 		NFS41Flags: &NFS41Flags{
@@ -1342,3 +1549,90 @@ func TestFilesystemClient_LargeFileStatistics(t *testing.T) {
 	assert.Equal(t, int64(10995116277760), statfs.TotalBytes)
 	assert.Equal(t, int64(10000000), statfs.TotalFiles)
 }
+
+func TestFilesystemClient_EnsureACL(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("filesystem.getacl", ACL{
+		ACLType: "NFS4",
+		UID:     0,
+		GID:     1000,
+		Path:    "/mnt/tank/share",
+		ACL: []ACLEntry{
+			{Tag: ACLTagOwner, ID: Ptr(-1), Type: ACLEntryAllow, Perms: ACLPerms{Advanced: Ptr(nfs4BasicPerms[ACLBasicFullControl])}},
+			{Tag: ACLTagGroup, ID: Ptr(1000), Who: "staff", Type: ACLEntryAllow, Perms: ModifyAccess},
+		},
+	})
+	server.SetJobResponse("filesystem.setacl", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	req := &SetACLRequest{
+		Path:    "/mnt/tank/share",
+		GID:     Ptr(1000),
+		ACLType: ACLTypeNFS4,
+		DACL: []ACLEntry{
+			NewAllowEntry(ACLGroup("staff"), ModifyAccess, ACLFlags{}),
+			NewAllowEntry(ACLOwner, FullControl, ACLFlags{}),
+		},
+		Options: SetACLOptions{Recursive: true},
+	}
+	diff, err := client.Filesystem.EnsureACL(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, diff.Empty())
+	server.AssertCalled(t, "filesystem.getacl", "/mnt/tank/share", false)
+	server.AssertNotCalled(t, "filesystem.setacl")
+
+	req.UID = Ptr(1001)
+	req.DACL = append(req.DACL, NewAllowEntry(ACLEveryone, ReadAccess, ACLFlags{}))
+	diff, err = client.Filesystem.EnsureACL(ctx, req)
+	require.NoError(t, err)
+	assert.False(t, diff.Empty())
+	assert.Equal(t, Ptr(1001), diff.UID)
+	assert.Nil(t, diff.GID)
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, ACLTagEveryone, diff.Added[0].Tag)
+	assert.Empty(t, diff.Removed)
+	assert.Len(t, server.Calls("filesystem.setacl"), 1)
+}
+
+func TestFilesystemClient_EnsureACL_Strip(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("filesystem.getacl", ACL{ACLType: "NFS4", Trivial: true})
+	server.SetJobResponse("filesystem.setacl", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	diff, err := client.Filesystem.EnsureACL(NewTestContext(t), &SetACLRequest{
+		Path:    "/mnt/tank/share",
+		Options: SetACLOptions{StripACL: true},
+	})
+	require.NoError(t, err)
+	assert.True(t, diff.Empty())
+	server.AssertNotCalled(t, "filesystem.setacl")
+}
+
+func TestFilesystemClient_Download(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetDownload("filesystem.get", []byte("line 1\nline 2\n"))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	var buf bytes.Buffer
+	require.NoError(t, client.Filesystem.Download(ctx, "/mnt/tank/notes.txt", &buf))
+	assert.Equal(t, "line 1\nline 2\n", buf.String())
+	server.AssertCalled(t, "core.download", "filesystem.get", []any{"/mnt/tank/notes.txt"}, "notes.txt")
+}
@@ -3,6 +3,7 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // GroupClient provides methods for group management
@@ -71,6 +72,16 @@ func (g *GroupClient) List(ctx context.Context) ([]Group, error) {
 	return result, err
 }
 
+// ListWithQuery returns groups matching q
+func (g *GroupClient) ListWithQuery(ctx context.Context, q *Query) ([]Group, error) {
+	return query[Group](ctx, g.client, "group.query", q)
+}
+
+// ListIter returns an iterator over the groups matching q, fetched a page at a time
+func (g *GroupClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Group, error] {
+	return paginate(ctx, q, g.ListWithQuery)
+}
+
 // ListWithDSCache returns all groups including directory service groups
 func (g *GroupClient) ListWithDSCache(ctx context.Context) ([]Group, error) {
 	var result []Group
@@ -2,6 +2,7 @@ package truenas
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -688,7 +689,7 @@ func TestGroupClient_GetGroupObj(t *testing.T) {
 			},
 			mockResponse: map[string]any{
 				"gr_name":   "testgroup",
-				"gr_gid":    float64(1000),
+				"gr_gid":    json.Number("1000"),
 				"gr_passwd": "x",
 				"gr_mem":    []any{"user1", "user2"},
 			},
@@ -701,7 +702,7 @@ func TestGroupClient_GetGroupObj(t *testing.T) {
 			},
 			mockResponse: map[string]any{
 				"gr_name":   "testgroup",
-				"gr_gid":    float64(1000),
+				"gr_gid":    json.Number("1000"),
 				"gr_passwd": "x",
 				"gr_mem":    []any{"user1", "user2"},
 			},
@@ -715,7 +716,7 @@ func TestGroupClient_GetGroupObj(t *testing.T) {
 			},
 			mockResponse: map[string]any{
 				"gr_name":   "testgroup",
-				"gr_gid":    float64(1000),
+				"gr_gid":    json.Number("1000"),
 				"gr_passwd": "x",
 				"gr_mem":    []any{"user1", "user2"},
 			},
@@ -1,17 +1,21 @@
 package truenas
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -40,6 +44,22 @@ func WithCustomHandler(handler func(Message) (Message, bool)) TestServerOption {
 	}
 }
 
+// WithDroppedCalls makes the server drop the connection instead of replying to the first n calls of method
+func WithDroppedCalls(method string, n int) TestServerOption {
+	return func(ts *TestServer) {
+		ts.drops[method] = n
+	}
+}
+
+// WithRecording makes the server forward calls other than logins to upstream, typically a client
+// connected to a real TrueNAS system, and record each reply as a fixture in dir. Replay the
+// fixtures with LoadFixtures.
+func WithRecording(upstream *Client, dir string) TestServerOption {
+	return func(ts *TestServer) {
+		ts.recorder = &recorder{upstream: upstream, dir: dir}
+	}
+}
+
 // WithDebug enables debug logging for the server
 func WithDebug(debug bool) TestServerOption {
 	return func(ts *TestServer) {
@@ -56,9 +76,28 @@ type TestServer struct {
 
 	// Connection tracking
 	connections      map[*websocket.Conn]bool
+	subscribers      map[*subscriber]bool // Every open connection, for EmitEvent
 	connMutex        sync.Mutex
 	trackConnections bool
 
+	// Files received on the /_upload endpoint and served on /_download
+	uploads   []Upload
+	downloads map[int][]byte
+	uploadMu  sync.Mutex
+
+	// Calls answered by dropping the connection, keyed by method
+	drops   map[string]int
+	dropsMu sync.Mutex
+
+	// Queued and computed responses, and the calls received, keyed by method
+	queues  map[string][]any
+	funcs   map[string]func(params []any) any
+	calls   []MethodCall
+	callsMu sync.Mutex
+
+	// Calls forwarded to a real system and recorded as fixtures
+	recorder *recorder
+
 	// Behavior configuration
 	customHandler func(Message) (Message, bool)
 	authSuccess   bool
@@ -69,7 +108,12 @@ type TestServer struct {
 func NewTestServer(t *testing.T, opts ...TestServerOption) *TestServer {
 	ts := &TestServer{
 		responses:   make(map[string]any),
+		downloads:   make(map[int][]byte),
 		errors:      make(map[string]*ErrorMsg),
+		drops:       make(map[string]int),
+		subscribers: make(map[*subscriber]bool),
+		queues:      make(map[string][]any),
+		funcs:       make(map[string]func(params []any) any),
 		nextJobID:   100,  // Start at 100 to avoid conflicts
 		authSuccess: true, // Default to successful auth
 	}
@@ -80,6 +124,15 @@ func NewTestServer(t *testing.T, opts ...TestServerOption) *TestServer {
 	}
 
 	ts.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_upload" {
+			ts.handleUpload(w, r)
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/_download/") {
+			ts.handleDownload(w, r)
+			return
+		}
+
 		conn, err := upgrader.Upgrade(w, r, nil)
 		require.NoError(t, err)
 
@@ -115,6 +168,16 @@ func NewTestServer(t *testing.T, opts ...TestServerOption) *TestServer {
 			return
 		}
 
+		sc := &subscriber{conn: conn, subs: make(map[string]string)}
+		ts.connMutex.Lock()
+		ts.subscribers[sc] = true
+		ts.connMutex.Unlock()
+		defer func() {
+			ts.connMutex.Lock()
+			delete(ts.subscribers, sc)
+			ts.connMutex.Unlock()
+		}()
+
 		for {
 			var msg Message
 			err := conn.ReadJSON(&msg)
@@ -122,23 +185,46 @@ func NewTestServer(t *testing.T, opts ...TestServerOption) *TestServer {
 				break
 			}
 
+			// Track subscriptions for EmitEvent before acknowledging them
+			switch msg.Msg {
+			case "sub":
+				sc.subscribe(msg.ID, msg.Name)
+			case "unsub":
+				sc.subscribe(msg.ID, "")
+			}
+
+			if ts.dropCall(msg.Method) {
+				return
+			}
+			params, _ := msg.Params.([]any)
+			queued, hasQueued := ts.recordCall(msg.Method, params)
+
 			// Use custom handler if provided
 			if ts.customHandler != nil {
 				response, shouldSend := ts.customHandler(msg)
 				if shouldSend {
-					_ = conn.WriteJSON(response)
+					_ = sc.writeJSON(response)
 				}
 				continue
 			}
 
+			if ts.recorder != nil && !strings.HasPrefix(msg.Method, "auth.") {
+				_ = sc.writeJSON(ts.recorder.forward(t, msg))
+				continue
+			}
+
 			response := Message{
 				ID: msg.ID,
 			}
 
-			// Check for error responses first
-			if errResp, hasError := ts.errors[msg.Method]; hasError {
+			// Queued and computed responses come first, then error responses
+			if hasQueued {
+				response.Result, response.Error = mockResult(queued)
+			} else if fn, hasFunc := ts.responseFunc(msg.Method); hasFunc {
+				response.Result, response.Error = mockResult(fn(params))
+			} else if errResp, hasError := ts.errors[msg.Method]; hasError {
 				response.Error = errResp
-			} else if msg.Method == "auth.login" || msg.Method == "auth.login_with_api_key" {
+			} else if msg.Method == "auth.login" || msg.Method == "auth.login_with_api_key" || msg.Method == "auth.login_with_token" {
 				if ts.authSuccess {
 					response.Result = json.RawMessage(`true`)
 				} else {
@@ -166,13 +252,86 @@ func NewTestServer(t *testing.T, opts ...TestServerOption) *TestServer {
 				}
 			}
 
-			_ = conn.WriteJSON(response)
+			_ = sc.writeJSON(response)
 		}
 	}))
 
 	return ts
 }
 
+// dropCall reports whether a call to method should be answered by dropping the connection
+func (ts *TestServer) dropCall(method string) bool {
+	ts.dropsMu.Lock()
+	defer ts.dropsMu.Unlock()
+	if ts.drops[method] == 0 {
+		return false
+	}
+	ts.drops[method]--
+	return true
+}
+
+// subscriber is a client connection and the events it subscribed to
+type subscriber struct {
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	subs    map[string]string // Subscribed names keyed by subscription ID
+}
+
+func (sc *subscriber) writeJSON(v any) error {
+	sc.writeMu.Lock()
+	defer sc.writeMu.Unlock()
+	return sc.conn.WriteJSON(v)
+}
+
+// subscribe records subscription id for name, or removes it when name is empty
+func (sc *subscriber) subscribe(id, name string) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if name == "" {
+		delete(sc.subs, id)
+		return
+	}
+	sc.subs[id] = name
+}
+
+// subscribed reports whether the connection subscribed to collection, with or without arguments
+func (sc *subscriber) subscribed(collection string) bool {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	for _, name := range sc.subs {
+		if base, _, _ := strings.Cut(name, ":"); base == collection {
+			return true
+		}
+	}
+	return false
+}
+
+// EmitEvent pushes a "changed" collection update with payload as its fields to the clients
+// subscribed to name, returning the number of connections it was sent to
+func (ts *TestServer) EmitEvent(name string, payload any) int {
+	return ts.EmitEventType(EventTypeChanged, name, nil, payload)
+}
+
+// EmitEventType pushes an added, changed or removed collection update for the object with the
+// given ID, which is omitted when nil, to the clients subscribed to name
+func (ts *TestServer) EmitEventType(typ EventType, name string, id any, payload any) int {
+	msg := map[string]any{"msg": typ, "collection": name, "fields": payload}
+	if id != nil {
+		msg["id"] = id
+	}
+
+	ts.connMutex.Lock()
+	defer ts.connMutex.Unlock()
+	sent := 0
+	for sc := range ts.subscribers {
+		if sc.subscribed(name) && sc.writeJSON(msg) == nil {
+			sent++
+		}
+	}
+	return sent
+}
+
 // Shutdown gracefully shuts down the test server and immediately closes all tracked connections
 func (ts *TestServer) Shutdown() {
 	ts.Close()
@@ -190,6 +349,101 @@ func (ts *TestServer) SetResponse(method string, response any) {
 	ts.responses[method] = response
 }
 
+// QueueResponses queues responses for method, one per call in order, ahead of any other response
+// configured for it. Once they are used up, calls get the method's SetResponseFunc, SetError or
+// SetResponse response. An *ErrorMsg is sent as an error.
+func (ts *TestServer) QueueResponses(method string, responses ...any) {
+	ts.callsMu.Lock()
+	defer ts.callsMu.Unlock()
+	ts.queues[method] = append(ts.queues[method], responses...)
+}
+
+// SetResponseFunc makes calls to method reply with the result of fn, which receives the call's
+// params, in place of SetError and SetResponse responses. An *ErrorMsg result is sent as an error.
+func (ts *TestServer) SetResponseFunc(method string, fn func(params []any) any) {
+	ts.callsMu.Lock()
+	defer ts.callsMu.Unlock()
+	ts.funcs[method] = fn
+}
+
+// recordCall records a call and pops its next queued response, if any
+func (ts *TestServer) recordCall(method string, params []any) (any, bool) {
+	ts.callsMu.Lock()
+	defer ts.callsMu.Unlock()
+	ts.calls = append(ts.calls, MethodCall{Method: method, Params: params})
+	queue := ts.queues[method]
+	if len(queue) == 0 {
+		return nil, false
+	}
+	ts.queues[method] = queue[1:]
+	return queue[0], true
+}
+
+func (ts *TestServer) responseFunc(method string) (func(params []any) any, bool) {
+	ts.callsMu.Lock()
+	defer ts.callsMu.Unlock()
+	fn, ok := ts.funcs[method]
+	return fn, ok
+}
+
+// mockResult converts a queued or computed response into a result or error
+func mockResult(response any) (json.RawMessage, *ErrorMsg) {
+	if errMsg, ok := response.(*ErrorMsg); ok {
+		return nil, errMsg
+	}
+	result, _ := json.Marshal(response)
+	return result, nil
+}
+
+// Calls returns the calls received for method in order, or all calls if method is empty
+func (ts *TestServer) Calls(method string) []MethodCall {
+	ts.callsMu.Lock()
+	defer ts.callsMu.Unlock()
+	var calls []MethodCall
+	for _, call := range ts.calls {
+		if method == "" || call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// CallCount returns the number of calls received for method
+func (ts *TestServer) CallCount(method string) int {
+	return len(ts.Calls(method))
+}
+
+// AssertCalled asserts that method was called, with params equal to the given ones
+// when any are given. Params are compared by their JSON encoding.
+func (ts *TestServer) AssertCalled(t *testing.T, method string, params ...any) bool {
+	t.Helper()
+	calls := ts.Calls(method)
+	if len(params) == 0 {
+		return assert.NotEmpty(t, calls, "%s was not called", method)
+	}
+	// Round trip through any so that struct params encode with sorted keys, like the received ones
+	encoded, err := json.Marshal(params)
+	require.NoError(t, err)
+	var decoded any
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	want, _ := json.Marshal(decoded)
+	received := make([]string, 0, len(calls))
+	for _, call := range calls {
+		got, _ := json.Marshal(call.Params)
+		if string(got) == string(want) {
+			return true
+		}
+		received = append(received, string(got))
+	}
+	return assert.Fail(t, fmt.Sprintf("%s was not called with %s", method, want), "received params: %v", received)
+}
+
+// AssertNotCalled asserts that method was not called
+func (ts *TestServer) AssertNotCalled(t *testing.T, method string) bool {
+	t.Helper()
+	return assert.Empty(t, ts.Calls(method), "%s was called", method)
+}
+
 // SetError sets a mock error response for a specific method
 func (ts *TestServer) SetError(method string, code int, message string) {
 	ts.errors[method] = &ErrorMsg{
@@ -326,45 +580,120 @@ var (
 		OneShot:        false,
 		Mail:           false,
 		Text:           "Test alert",
-		DateTime:       time.Now(),
-		LastOccurrence: time.Now(),
+		DateTime:       TrueNASTime{time.Now()},
+		LastOccurrence: TrueNASTime{time.Now()},
 	}
 )
 
 // SetJobResponse sets up a mock job response that returns a job ID and
-// configures core.get_jobs to return a completed job with the specified result
-func (ts *TestServer) SetJobResponse(method string, result any) {
+// configures core.get_jobs to return a completed job with the specified result.
+// Options make core.get_jobs report intermediate states before the job completes.
+func (ts *TestServer) SetJobResponse(method string, result any, opts ...JobOption) {
+	ts.setJob(Job{Method: method, State: "SUCCESS", Result: result}, opts)
+}
+
+// SetJobError sets up a mock job response that returns a job ID and
+// configures core.get_jobs to return a failed job with the specified error.
+// Options make core.get_jobs report intermediate states before the job fails.
+func (ts *TestServer) SetJobError(method string, errorMsg string, opts ...JobOption) {
+	ts.setJob(Job{Method: method, State: "FAILED", Error: &errorMsg}, opts)
+}
+
+func (ts *TestServer) setJob(final Job, opts []JobOption) {
 	ts.nextJobID++
-	jobID := ts.nextJobID
+	final.ID = ts.nextJobID
 
 	// Set the method to return the job ID
-	ts.SetResponse(method, jobID)
+	ts.SetResponse(final.Method, final.ID)
 
-	// Set core.get_jobs to return completed job
-	mockJob := Job{
-		ID:     jobID,
-		Method: method,
-		State:  "SUCCESS",
-		Result: result,
+	sim := &jobSimulation{final: final, reported: -1}
+	for _, opt := range opts {
+		opt(sim)
+	}
+	if len(sim.steps) == 0 && sim.delay == 0 {
+		ts.callsMu.Lock()
+		delete(ts.funcs, "core.get_jobs")
+		ts.callsMu.Unlock()
+		ts.SetResponse("core.get_jobs", []Job{final})
+		return
 	}
-	ts.SetResponse("core.get_jobs", []Job{mockJob})
+	ts.SetResponseFunc("core.get_jobs", sim.poll)
 }
 
-// SetJobError sets up a mock job response that returns a job ID and
-// configures core.get_jobs to return a failed job with the specified error
-func (ts *TestServer) SetJobError(method string, errorMsg string) {
-	ts.nextJobID++
-	jobID := ts.nextJobID
+// JobStep is an intermediate state of a mocked job
+type JobStep struct {
+	State    string        // Defaults to RUNNING
+	Progress *JobProgress  // Optional
+	Delay    time.Duration // Minimum time after the previous state was reported
+}
 
-	ts.SetResponse(method, jobID)
+// JobOption configures the states a mocked job goes through
+type JobOption func(*jobSimulation)
 
-	mockJob := Job{
-		ID:     jobID,
-		Method: method,
-		State:  "FAILED",
-		Error:  &errorMsg,
+// WithJobSteps makes the job go through steps before completing
+func WithJobSteps(steps ...JobStep) JobOption {
+	return func(sim *jobSimulation) {
+		sim.steps = append(sim.steps, steps...)
+	}
+}
+
+// WithJobProgress makes the job report RUNNING with each of the percentages before completing
+func WithJobProgress(percents ...float64) JobOption {
+	return func(sim *jobSimulation) {
+		for _, percent := range percents {
+			sim.steps = append(sim.steps, JobStep{Progress: &JobProgress{Percent: percent}})
+		}
+	}
+}
+
+// WithJobDelay makes the job complete at least d after its last intermediate state was reported
+func WithJobDelay(d time.Duration) JobOption {
+	return func(sim *jobSimulation) {
+		sim.delay = d
+	}
+}
+
+// jobSimulation answers core.get_jobs polls for a mocked job. Each poll advances the job by
+// at most one state, once that state's delay has passed, so every state is reported. The job
+// is WAITING until its first state is due.
+type jobSimulation struct {
+	final    Job
+	steps    []JobStep
+	delay    time.Duration
+	mu       sync.Mutex
+	reported int // Index of the last reported state, with the final state after the steps
+	at       time.Time
+}
+
+func (sim *jobSimulation) poll([]any) any {
+	sim.mu.Lock()
+	defer sim.mu.Unlock()
+	now := time.Now()
+	if sim.at.IsZero() {
+		sim.at = now
+	}
+
+	if next := sim.reported + 1; next <= len(sim.steps) {
+		delay := sim.delay
+		if next < len(sim.steps) {
+			delay = sim.steps[next].Delay
+		}
+		if now.Sub(sim.at) >= delay {
+			sim.reported = next
+			sim.at = now
+		}
+	}
+
+	job := sim.final
+	switch {
+	case sim.reported < 0:
+		job.State, job.Result, job.Error = "WAITING", nil, nil
+	case sim.reported < len(sim.steps):
+		step := sim.steps[sim.reported]
+		job.State, job.Result, job.Error = cmp.Or(step.State, "RUNNING"), nil, nil
+		job.Progress = step.Progress
 	}
-	ts.SetResponse("core.get_jobs", []Job{mockJob})
+	return []Job{job}
 }
 
 // upgrader is the WebSocket upgrader used by test servers
@@ -373,3 +702,105 @@ var upgrader = websocket.Upgrader{
 		return true
 	},
 }
+
+// Upload records a file received on the test server's /_upload endpoint
+type Upload struct {
+	Method        string
+	Params        []any
+	Data          []byte
+	Authorization string
+}
+
+// handleUpload accepts multipart uploads and starts the job configured for the method
+// with SetJobResponse or SetJobError.
+func (ts *TestServer) handleUpload(w http.ResponseWriter, r *http.Request) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var upload Upload
+	upload.Authorization = r.Header.Get("Authorization")
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		content, err := io.ReadAll(part)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		switch part.FormName() {
+		case "data":
+			var call struct {
+				Method string `json:"method"`
+				Params []any  `json:"params"`
+			}
+			if err := json.Unmarshal(content, &call); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			upload.Method = call.Method
+			upload.Params = call.Params
+		case "file":
+			upload.Data = content
+		}
+	}
+
+	jobID, ok := ts.responses[upload.Method]
+	if !ok {
+		http.Error(w, "no job configured for "+upload.Method, http.StatusUnprocessableEntity)
+		return
+	}
+
+	ts.uploadMu.Lock()
+	ts.uploads = append(ts.uploads, upload)
+	ts.uploadMu.Unlock()
+
+	_ = json.NewEncoder(w).Encode(map[string]any{"job_id": jobID})
+}
+
+// SetDownload configures core.download to start a successful job for method
+// whose output, data, is served on the /_download endpoint
+func (ts *TestServer) SetDownload(method string, data []byte) {
+	ts.nextJobID++
+	jobID := ts.nextJobID
+
+	ts.uploadMu.Lock()
+	ts.downloads[jobID] = data
+	ts.uploadMu.Unlock()
+
+	ts.SetResponse("core.download", []any{jobID, fmt.Sprintf("/_download/%d?auth_token=test-token", jobID)})
+	ts.SetResponse("core.get_jobs", []Job{{ID: jobID, Method: method, State: "SUCCESS"}})
+}
+
+// handleDownload serves the data configured with SetDownload
+func (ts *TestServer) handleDownload(w http.ResponseWriter, r *http.Request) {
+	jobID, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/_download/"))
+	if err != nil || r.URL.Query().Get("auth_token") == "" {
+		http.Error(w, "invalid download", http.StatusBadRequest)
+		return
+	}
+
+	ts.uploadMu.Lock()
+	data, ok := ts.downloads[jobID]
+	ts.uploadMu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	_, _ = w.Write(data)
+}
+
+// Uploads returns the files received on the /_upload endpoint
+func (ts *TestServer) Uploads() []Upload {
+	ts.uploadMu.Lock()
+	defer ts.uploadMu.Unlock()
+	return append([]Upload(nil), ts.uploads...)
+}
@@ -74,8 +74,7 @@ func testConnectionAndAuthentication(t *testing.T, client *Client) {
 	defer cancel()
 
 	// Test that we can make a basic API call
-	var info SystemInfo
-	err := client.Call(ctx, "system.info", nil, &info)
+	info, err := client.System.Info(ctx)
 	require.NoError(t, err)
 
 	// Verify the result contains expected fields
@@ -88,8 +87,7 @@ func testSystemOperations(t *testing.T, client *Client) {
 	defer cancel()
 
 	t.Run("SystemInfo", func(t *testing.T) {
-		var info SystemInfo
-		err := client.Call(ctx, "system.info", nil, &info)
+		info, err := client.System.Info(ctx)
 		require.NoError(t, err)
 
 		// Check for required fields
@@ -100,28 +98,39 @@ func testSystemOperations(t *testing.T, client *Client) {
 	})
 
 	t.Run("SystemVersion", func(t *testing.T) {
-		var version string
-		err := client.Call(ctx, "system.version", nil, &version)
+		version, err := client.System.Version(ctx)
 		require.NoError(t, err)
-		assert.NotEmpty(t, version)
+		assert.NotEmpty(t, version.Raw)
+		assert.Equal(t, ProductSCALE, version.Product)
 	})
 
 	t.Run("SystemHostname", func(t *testing.T) {
-		var hostname string
-		err := client.Call(ctx, "system.hostname", nil, &hostname)
+		hostname, err := client.System.Hostname(ctx)
 		require.NoError(t, err)
 		assert.NotEmpty(t, hostname)
 	})
 
 	t.Run("SystemUptime", func(t *testing.T) {
-		var info SystemInfo
-		err := client.Call(ctx, "system.info", nil, &info)
+		info, err := client.System.Info(ctx)
 		require.NoError(t, err)
 
 		// Uptime should be a positive number
 		assert.Greater(t, info.UptimeSeconds, 0.0)
 		assert.NotEmpty(t, info.Uptime)
 	})
+
+	t.Run("SystemProduct", func(t *testing.T) {
+		product, err := client.System.Product(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, []ProductType{ProductSCALE, ProductSCALEEnterprise}, product)
+
+		_, err = client.System.IsStable(ctx)
+		require.NoError(t, err)
+		_, err = client.System.FeatureEnabled(ctx, SystemFeatureVM)
+		require.NoError(t, err)
+		_, err = client.System.License(ctx)
+		require.NoError(t, err)
+	})
 }
 
 func testUserManagement(t *testing.T, client *Client) {
@@ -445,80 +454,18 @@ func testRAIDZ1PoolWithDatasetAndNFS(t *testing.T, client *Client, helper *testv
 	}
 	assert.True(t, foundPool, "should find created RAIDZ1 pool in list")
 
-	// Create a dataset on the pool
+	// Create a dataset on the pool and export it over NFS
 	datasetName := poolName + "/testdata"
-	datasetReq := &DatasetCreateRequest{
-		Name: datasetName,
-		Type: DatasetTypeFilesystem,
-	}
-
-	dataset, err := client.Dataset.Create(ctx, datasetReq)
-	require.NoError(t, err, "should create dataset")
-	require.NotNil(t, dataset, "dataset should not be nil")
-	assert.Equal(t, datasetName, dataset.Name, "dataset name should match")
-	t.Logf("Created dataset: %s", dataset.Name)
-
-	// Verify the dataset exists
-	datasets, err := client.Dataset.List(ctx)
-	require.NoError(t, err, "should list datasets")
-
-	var foundDataset bool
-	for _, d := range datasets {
-		if d.Name == datasetName {
-			foundDataset = true
-			assert.Equal(t, DatasetTypeFilesystem, d.Type, "dataset should be a filesystem")
-			break
-		}
-	}
-	assert.True(t, foundDataset, "should find created dataset in list")
-
-	// Enable NFS service
-	nfsService, err := client.Service.GetByName(ctx, "nfs")
-	require.NoError(t, err, "should get NFS service")
-	require.NotNil(t, nfsService, "NFS service should exist")
-
-	if !nfsService.Enable {
-		_, err = client.Service.Update(ctx, nfsService.ID, ServiceUpdateRequest{Enable: true})
-		require.NoError(t, err, "should enable NFS service")
-		t.Log("Enabled NFS service")
-	}
-
-	// Start NFS service if not running
-	isRunning, err := client.Service.Started(ctx, "nfs")
-	require.NoError(t, err, "should check if NFS service is running")
-	if !isRunning {
-		err = client.Service.Start(ctx, "nfs")
-		require.NoError(t, err, "should start NFS service")
-		t.Log("Started NFS service")
-	}
-
-	// Create an NFS share for the dataset
-	nfsShareReq := &NFSShareRequest{
-		Path:     "/mnt/" + datasetName,
+	provisioned, err := client.Sharing.NFS.Provision(ctx, NFSProvisionRequest{
+		Dataset:  datasetName,
 		Comment:  "Test NFS share for integration test",
-		Enabled:  true,
-		RO:       false,
 		Security: []string{"SYS"},
-	}
-
-	nfsShare, err := client.Sharing.NFS.Create(ctx, nfsShareReq)
-	require.NoError(t, err, "should create NFS share")
-	require.NotNil(t, nfsShare, "NFS share should not be nil")
-	require.NotEmpty(t, nfsShare.Path, "NFS share should have a path")
-	t.Logf("Created NFS share (ID: %d) for path: %s", nfsShare.ID, nfsShare.Path)
-
-	// Verify the NFS share exists
-	shares, err := client.Sharing.NFS.List(ctx)
-	require.NoError(t, err, "should list NFS shares")
-
-	var foundShare bool
-	for _, share := range shares {
-		if share.ID == nfsShare.ID {
-			foundShare = true
-			break
-		}
-	}
-	assert.True(t, foundShare, "should find created NFS share in list")
+	})
+	require.NoError(t, err, "should provision NFS export")
+	assert.Equal(t, datasetName, provisioned.Dataset.Name, "dataset name should match")
+	assert.Equal(t, "/mnt/"+datasetName, provisioned.Share.Path, "NFS share should export the dataset")
+	assert.Equal(t, ServiceStateRunning, provisioned.Service.State, "NFS service should be running")
+	t.Logf("Created NFS share (ID: %d) for path: %s", provisioned.Share.ID, provisioned.Share.Path)
 
 	// Trigger a scrub on the pool using the new RunScrubAsync method
 	jobID, err := client.Pool.RunScrubAsync(ctx, poolName, "START")
@@ -537,14 +484,14 @@ func testRAIDZ1PoolWithDatasetAndNFS(t *testing.T, client *Client, helper *testv
 	}
 
 	// Clean up: delete NFS share
-	err = client.Sharing.NFS.Delete(ctx, nfsShare.ID)
+	err = client.Sharing.NFS.Delete(ctx, provisioned.Share.ID)
 	require.NoError(t, err, "should delete NFS share")
-	t.Logf("Deleted NFS share (ID: %d)", nfsShare.ID)
+	t.Logf("Deleted NFS share (ID: %d)", provisioned.Share.ID)
 
 	// Clean up: delete dataset
-	err = client.Dataset.Delete(ctx, dataset.ID, DatasetDeleteRequest{Recursive: Ptr(true), Force: Ptr(true)})
+	err = client.Dataset.Delete(ctx, provisioned.Dataset.ID, DatasetDeleteRequest{Recursive: Ptr(true), Force: Ptr(true)})
 	require.NoError(t, err, "should delete dataset")
-	t.Logf("Deleted dataset: %s", dataset.Name)
+	t.Logf("Deleted dataset: %s", provisioned.Dataset.Name)
 
 	// Clean up: delete pool
 	err = client.Pool.Delete(ctx, pool.ID, false)
@@ -552,45 +499,18 @@ func testRAIDZ1PoolWithDatasetAndNFS(t *testing.T, client *Client, helper *testv
 	t.Logf("Successfully deleted RAIDZ1 pool: %s", poolName)
 }
 
-// waitForUnusedDisks polls for unused disks until the expected count is available
+// waitForUnusedDisks waits for expectedCount unused disks and returns the last ones found
 func waitForUnusedDisks(ctx context.Context, t *testing.T, client *Client, expectedCount int) ([]UnusedDisk, error) {
-	timeout := time.Now().Add(2 * time.Minute)
 	t.Logf("Waiting for %d unused disks to be recognized by TrueNAS...", expectedCount)
-
-	for time.Now().Before(timeout) {
-		select {
-		case <-ctx.Done():
-			return nil, fmt.Errorf("context canceled while waiting for unused disks")
-		case <-time.After(5 * time.Second):
-			disks, err := client.Disk.GetUnused(ctx, false)
-			if err != nil {
-				t.Logf("Error querying unused disks: %v", err)
-				continue
-			}
-
-			var usableDisks []UnusedDisk
-			for _, d := range disks {
-				if d.Driver != "floppy" {
-					usableDisks = append(usableDisks, d)
-				}
-			}
-			disks = usableDisks
-
-			if len(disks) >= expectedCount {
-				t.Logf("Found %d unused disks (needed %d)", len(disks), expectedCount)
-				if len(disks) > expectedCount {
-					disks = disks[len(disks)-expectedCount:]
-				}
-				// TrueNAS seems to error out if you try to use the disk too soon.
-				time.Sleep(30 * time.Second)
-				return disks, nil
-			}
-
-			t.Logf("Found %d unused disks, waiting for %d...", len(disks), expectedCount)
-		}
+	disks, err := client.Disk.WaitForUnused(ctx, expectedCount, 2*time.Minute)
+	if err != nil {
+		return nil, err
 	}
-
-	return nil, fmt.Errorf("timeout waiting for %d unused disks", expectedCount)
+	t.Logf("Found %d unused disks (needed %d)", len(disks), expectedCount)
+	disks = disks[len(disks)-expectedCount:]
+	// TrueNAS seems to error out if you try to use the disk too soon.
+	time.Sleep(30 * time.Second)
+	return disks, nil
 }
 
 // waitForJobCompletion polls until a job completes (success or failure)
@@ -0,0 +1,214 @@
+package truenas
+
+import (
+	"cmp"
+	"context"
+	"iter"
+)
+
+// Jail states reported in Jail.State and Plugin.State
+const (
+	JailStateUp   = "up"
+	JailStateDown = "down"
+)
+
+// JailClient provides methods for iocage jail management on TrueNAS CORE
+type JailClient struct {
+	client *Client
+}
+
+// NewJailClient creates a new jail client
+func NewJailClient(client *Client) *JailClient {
+	return &JailClient{client: client}
+}
+
+// Jail represents an iocage jail
+type Jail struct {
+	ID           string `json:"id"` // Jail name
+	HostHostname string `json:"host_hostname"`
+	HostHostUUID string `json:"host_hostuuid"`
+	Release      string `json:"release"`
+	State        string `json:"state"` // JailStateUp or JailStateDown
+	Type         string `json:"type"`  // jail, basejail or pluginv2
+	IP4Addr      string `json:"ip4_addr"`
+	IP6Addr      string `json:"ip6_addr"`
+	Notes        string `json:"notes"`
+}
+
+// JailCreateRequest represents parameters for jail.create
+type JailCreateRequest struct {
+	UUID     string   `json:"uuid"`              // Jail name
+	Release  string   `json:"release,omitempty"` // Such as "13.2-RELEASE"
+	Template string   `json:"template,omitempty"`
+	Pkglist  []string `json:"pkglist,omitempty"` // Packages to install
+	Basejail bool     `json:"basejail,omitempty"`
+	Empty    bool     `json:"empty,omitempty"`
+	Short    bool     `json:"short,omitempty"`
+	Props    []string `json:"props,omitempty"` // iocage properties as "key=value", such as "vnet=1"
+	HTTPS    *bool    `json:"https,omitempty"` // Fetch the release over HTTPS
+}
+
+// JailExecOptions represents options for jail.exec
+type JailExecOptions struct {
+	HostUser string // User on the host that runs the command, root if empty
+	JailUser string // User in the jail that runs the command, overriding HostUser
+}
+
+// List returns all jails, including plugin jails
+func (j *JailClient) List(ctx context.Context) ([]Jail, error) {
+	var result []Jail
+	err := j.client.Call(ctx, "jail.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns jails matching q
+func (j *JailClient) ListWithQuery(ctx context.Context, q *Query) ([]Jail, error) {
+	return query[Jail](ctx, j.client, "jail.query", q)
+}
+
+// ListIter returns an iterator over the jails matching q, fetched a page at a time
+func (j *JailClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Jail, error] {
+	return paginate(ctx, q, j.ListWithQuery)
+}
+
+// Get returns a specific jail by name
+func (j *JailClient) Get(ctx context.Context, name string) (*Jail, error) {
+	var result []Jail
+	err := j.client.Call(ctx, "jail.query", []any{[]any{[]any{"id", "=", name}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("jail", name)
+	}
+	return &result[0], nil
+}
+
+// Create creates a jail, fetching its release if needed, and returns it (asynchronous job)
+func (j *JailClient) Create(ctx context.Context, req *JailCreateRequest) (*Jail, error) {
+	if err := j.client.CallJob(ctx, "jail.create", []any{*req}, nil); err != nil {
+		return nil, err
+	}
+	return j.Get(ctx, req.UUID)
+}
+
+// Start starts a jail and waits for it to be up (asynchronous job)
+func (j *JailClient) Start(ctx context.Context, name string) error {
+	return j.client.CallJob(ctx, "jail.start", []any{name}, nil)
+}
+
+// Stop stops a jail and waits for it to be down (asynchronous job). With force, the
+// jail's processes are killed rather than shut down.
+func (j *JailClient) Stop(ctx context.Context, name string, force bool) error {
+	return j.client.CallJob(ctx, "jail.stop", []any{name, force}, nil)
+}
+
+// Delete deletes a jail and its datasets. With force, a running jail is stopped first.
+func (j *JailClient) Delete(ctx context.Context, name string, force bool) error {
+	return j.client.Call(ctx, "jail.delete", []any{name, map[string]any{"force": force}}, nil)
+}
+
+// Exec runs a command in a running jail and returns its output (asynchronous job). opts may be nil.
+func (j *JailClient) Exec(ctx context.Context, name string, command []string, opts *JailExecOptions) (string, error) {
+	params := []any{name, command}
+	if opts != nil {
+		params = append(params, cmp.Or(opts.HostUser, "root"))
+		if opts.JailUser != "" {
+			params = append(params, opts.JailUser)
+		}
+	}
+	var result string
+	err := j.client.CallJob(ctx, "jail.exec", params, &result)
+	return result, err
+}
+
+// PluginClient provides methods for plugin management on TrueNAS CORE. Plugins are jails
+// created from a plugin repository, and are also listed by JailClient.
+type PluginClient struct {
+	client *Client
+}
+
+// NewPluginClient creates a new plugin client
+func NewPluginClient(client *Client) *PluginClient {
+	return &PluginClient{client: client}
+}
+
+// Plugin represents an installed plugin
+type Plugin struct {
+	ID               string   `json:"id"`     // Name of the plugin's jail
+	Plugin           string   `json:"plugin"` // Name of the plugin, such as "plexmediaserver"
+	State            string   `json:"state"`  // JailStateUp or JailStateDown
+	Release          string   `json:"release"`
+	Version          string   `json:"version"`
+	Revision         string   `json:"revision"`
+	IP4              string   `json:"ip4"`
+	IP6              string   `json:"ip6"`
+	AdminPortals     []string `json:"admin_portals"`
+	DocURL           string   `json:"doc_url"`
+	PluginRepository string   `json:"plugin_repository"`
+}
+
+// PluginCreateRequest represents parameters for plugin.create
+type PluginCreateRequest struct {
+	PluginName       string   `json:"plugin_name"` // Such as "plexmediaserver"
+	JailName         string   `json:"jail_name"`
+	Props            []string `json:"props,omitempty"` // iocage properties as "key=value", such as "nat=1"
+	Branch           string   `json:"branch,omitempty"`
+	PluginRepository string   `json:"plugin_repository,omitempty"`
+}
+
+// PluginUpdateRequest represents parameters for plugin.update
+type PluginUpdateRequest struct {
+	Props []string `json:"props,omitempty"` // iocage properties as "key=value"
+}
+
+// List returns all installed plugins
+func (p *PluginClient) List(ctx context.Context) ([]Plugin, error) {
+	var result []Plugin
+	err := p.client.Call(ctx, "plugin.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns installed plugins matching q
+func (p *PluginClient) ListWithQuery(ctx context.Context, q *Query) ([]Plugin, error) {
+	return query[Plugin](ctx, p.client, "plugin.query", q)
+}
+
+// ListIter returns an iterator over the installed plugins matching q, fetched a page at a time
+func (p *PluginClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Plugin, error] {
+	return paginate(ctx, q, p.ListWithQuery)
+}
+
+// Get returns a specific plugin by the name of its jail
+func (p *PluginClient) Get(ctx context.Context, name string) (*Plugin, error) {
+	var result []Plugin
+	err := p.client.Call(ctx, "plugin.query", []any{[]any{[]any{"id", "=", name}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("plugin", name)
+	}
+	return &result[0], nil
+}
+
+// Create installs a plugin in a new jail and returns it (asynchronous job)
+func (p *PluginClient) Create(ctx context.Context, req *PluginCreateRequest) (*Plugin, error) {
+	if err := p.client.CallJob(ctx, "plugin.create", []any{*req}, nil); err != nil {
+		return nil, err
+	}
+	return p.Get(ctx, req.JailName)
+}
+
+// Update updates the properties of a plugin's jail
+func (p *PluginClient) Update(ctx context.Context, name string, req *PluginUpdateRequest) (*Plugin, error) {
+	if err := p.client.Call(ctx, "plugin.update", []any{name, *req}, nil); err != nil {
+		return nil, err
+	}
+	return p.Get(ctx, name)
+}
+
+// Delete deletes a plugin and its jail
+func (p *PluginClient) Delete(ctx context.Context, name string) error {
+	return p.client.Call(ctx, "plugin.delete", []any{name}, nil)
+}
@@ -0,0 +1,131 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJailClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-13.0-U6.1")
+	server.SetResponse("jail.query", []map[string]any{
+		{"id": "web", "host_hostuuid": "web", "release": "13.2-RELEASE-p4", "state": "up", "type": "jail", "ip4_addr": "vnet0|10.0.0.20/24"},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	jails, err := client.Jail.List(NewTestContext(t))
+	require.NoError(t, err)
+	require.Len(t, jails, 1)
+	assert.Equal(t, "web", jails[0].ID)
+	assert.Equal(t, JailStateUp, jails[0].State)
+	assert.Equal(t, "vnet0|10.0.0.20/24", jails[0].IP4Addr)
+}
+
+func TestJailClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("jail.query", []any{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Jail.Get(NewTestContext(t), "missing")
+	assert.True(t, IsNotFound(err))
+	server.AssertCalled(t, "jail.query", []any{[]any{"id", "=", "missing"}})
+}
+
+func TestJailClient_Lifecycle(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-13.0-U6.1")
+	server.SetJobResponse("jail.create", true)
+	server.SetJobResponse("jail.start", true)
+	server.SetJobResponse("jail.stop", true)
+	server.SetJobResponse("jail.exec", "FreeBSD\n")
+	server.SetResponse("jail.delete", true)
+	server.SetResponse("jail.query", []map[string]any{{"id": "web", "release": "13.2-RELEASE", "state": "down"}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	jail, err := client.Jail.Create(ctx, &JailCreateRequest{UUID: "web", Release: "13.2-RELEASE", Props: []string{"vnet=1", "boot=1"}})
+	require.NoError(t, err)
+	assert.Equal(t, "web", jail.ID)
+	server.AssertCalled(t, "jail.create", map[string]any{"uuid": "web", "release": "13.2-RELEASE", "props": []string{"vnet=1", "boot=1"}})
+
+	require.NoError(t, client.Jail.Start(ctx, "web"))
+	server.AssertCalled(t, "jail.start", "web")
+
+	out, err := client.Jail.Exec(ctx, "web", []string{"uname", "-s"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "FreeBSD\n", out)
+	server.AssertCalled(t, "jail.exec", "web", []string{"uname", "-s"})
+
+	_, err = client.Jail.Exec(ctx, "web", []string{"whoami"}, &JailExecOptions{JailUser: "www"})
+	require.NoError(t, err)
+	server.AssertCalled(t, "jail.exec", "web", []string{"whoami"}, "root", "www")
+
+	require.NoError(t, client.Jail.Stop(ctx, "web", true))
+	server.AssertCalled(t, "jail.stop", "web", true)
+
+	require.NoError(t, client.Jail.Delete(ctx, "web", false))
+	server.AssertCalled(t, "jail.delete", "web", map[string]any{"force": false})
+}
+
+func TestPluginClient_Lifecycle(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-13.0-U6.1")
+	server.SetJobResponse("plugin.create", true)
+	server.SetResponse("plugin.update", true)
+	server.SetResponse("plugin.delete", true)
+	server.SetResponse("plugin.query", []map[string]any{{
+		"id": "plex", "plugin": "plexmediaserver", "state": "up", "version": "1.40.2",
+		"admin_portals": []string{"http://10.0.0.21:32400/web"},
+	}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	plugin, err := client.Plugin.Create(ctx, &PluginCreateRequest{PluginName: "plexmediaserver", JailName: "plex", Props: []string{"nat=1"}})
+	require.NoError(t, err)
+	assert.Equal(t, "plexmediaserver", plugin.Plugin)
+	assert.Equal(t, []string{"http://10.0.0.21:32400/web"}, plugin.AdminPortals)
+	server.AssertCalled(t, "plugin.create", map[string]any{"plugin_name": "plexmediaserver", "jail_name": "plex", "props": []string{"nat=1"}})
+
+	_, err = client.Plugin.Update(ctx, "plex", &PluginUpdateRequest{Props: []string{"boot=1"}})
+	require.NoError(t, err)
+	server.AssertCalled(t, "plugin.update", "plex", map[string]any{"props": []string{"boot=1"}})
+
+	require.NoError(t, client.Plugin.Delete(ctx, "plex"))
+	server.AssertCalled(t, "plugin.delete", "plex")
+}
+
+func TestJailClient_SCALE(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.10.1")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Jail.List(ctx)
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+	_, err = client.Plugin.List(ctx)
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+	server.AssertNotCalled(t, "jail.query")
+	server.AssertNotCalled(t, "plugin.query")
+}
@@ -2,7 +2,13 @@ package truenas
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"iter"
+	"log/slog"
+	"slices"
+	"sync"
 	"time"
 )
 
@@ -58,6 +64,16 @@ func (j *JobClient) List(ctx context.Context) ([]Job, error) {
 	return result, err
 }
 
+// ListWithQuery returns jobs matching q
+func (j *JobClient) ListWithQuery(ctx context.Context, q *Query) ([]Job, error) {
+	return query[Job](ctx, j.client, "core.get_jobs", q)
+}
+
+// ListIter returns an iterator over the jobs matching q, fetched a page at a time
+func (j *JobClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Job, error] {
+	return paginate(ctx, q, j.ListWithQuery)
+}
+
 // Get returns a specific job by ID
 func (j *JobClient) Get(ctx context.Context, id int) (*Job, error) {
 	var result []Job
@@ -77,6 +93,68 @@ func (j *Job) IsCompleted() bool {
 	return state == JobStateSuccess || state == JobStateFailed || state == JobStateAborted
 }
 
+// progressChanged reports whether the job's state or progress differs from prev
+func (j *Job) progressChanged(prev *Job) bool {
+	if prev == nil || prev.State != j.State {
+		return true
+	}
+	if (prev.Progress == nil) != (j.Progress == nil) {
+		return true
+	}
+	if j.Progress == nil {
+		return false
+	}
+	return prev.Progress.Percent != j.Progress.Percent || prev.Progress.Description != j.Progress.Description
+}
+
+// failure returns the JobError a failed job ended with, or nil
+func (j *Job) failure() error {
+	if !j.IsFailed() {
+		return nil
+	}
+	err := &JobError{JobID: j.ID, Method: j.Method, State: j.State}
+	if j.Error != nil {
+		err.Reason = *j.Error
+	}
+	if j.Exception != nil {
+		err.Exception = *j.Exception
+	}
+	err.Validation = j.validationError()
+	return err
+}
+
+// validationError decodes the ValidationError described by the job's exc_info, if any
+func (j *Job) validationError() *ValidationError {
+	var excInfo struct {
+		Type  string          `json:"type"`
+		Extra json.RawMessage `json:"extra"`
+	}
+	data, err := json.Marshal(j.ExcInfo)
+	if err != nil || json.Unmarshal(data, &excInfo) != nil || excInfo.Type != "VALIDATION" {
+		return nil
+	}
+	errMsg := &ErrorMsg{Code: errnoEINVAL, Errname: "EINVAL", Type: excInfo.Type, Extra: excInfo.Extra}
+	if j.Error != nil {
+		errMsg.Message = *j.Error
+	}
+	return &ValidationError{ErrorMsg: errMsg, Errors: errMsg.fieldErrors()}
+}
+
+// decodeResult unmarshals the job's result into v
+func (j *Job) decodeResult(v any) error {
+	if v == nil || j.Result == nil {
+		return nil
+	}
+	resultBytes, err := json.Marshal(j.Result)
+	if err != nil {
+		return fmt.Errorf("marshal job result: %w", err)
+	}
+	if err := unmarshalJSON(resultBytes, v); err != nil {
+		return fmt.Errorf("unmarshal job result: %w", err)
+	}
+	return nil
+}
+
 // IsRunning checks if a job is currently running
 func (j *Job) IsRunning() bool {
 	return JobState(j.State) == JobStateRunning
@@ -93,33 +171,151 @@ func (j *Job) IsFailed() bool {
 	return state == JobStateFailed || state == JobStateAborted
 }
 
+// JobProgressFunc receives job updates while waiting for a job to complete.
+// It is called whenever the job's state, percent or description changes.
+type JobProgressFunc func(job *Job)
+
 // Wait waits for a job to complete and returns the final job result
 func (j *JobClient) Wait(ctx context.Context, jobID int) (*Job, error) {
-	ticker := time.NewTicker(500 * time.Millisecond)
-	defer ticker.Stop()
+	return j.WaitWithProgress(ctx, jobID, nil)
+}
+
+// WaitWithProgress waits for a job to complete, reporting state and progress changes to fn
+func (j *JobClient) WaitWithProgress(ctx context.Context, jobID int, fn JobProgressFunc) (*Job, error) {
+	ctx, cancel := j.client.jobContext(ctx)
+	defer cancel()
 
+	updates, stop := j.client.jobs.watch(jobID)
+	defer stop()
+
+	// Poll right away, in case the job finished before the watch started
+	interval := j.client.opts.JobPollInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	var last *Job
+	eventsSeen := false
 	for {
+		var job *Job
 		select {
 		case <-ctx.Done():
 			return nil, ctx.Err()
-		case <-ticker.C:
-			job, err := j.Get(ctx, jobID)
+		case update := <-updates:
+			job = &update
+			eventsSeen = true
+		case <-timer.C:
+			var err error
+			job, err = j.Get(ctx, jobID)
 			if err != nil {
 				return nil, fmt.Errorf("get job %d: %w", jobID, err)
 			}
+			// Once events arrive for the job, polling only backs them up
+			if eventsSeen {
+				timer.Reset(j.client.opts.JobPollMaxInterval)
+			} else {
+				timer.Reset(interval)
+				interval = min(time.Duration(float64(interval)*jobPollMultiplier), j.client.opts.JobPollMaxInterval)
+			}
+		}
+
+		if fn != nil && job.progressChanged(last) {
+			fn(job)
+		}
+		last = job
+
+		if job.IsCompleted() {
+			return job, job.failure()
+		}
+	}
+}
+
+// jobPollMultiplier grows the interval between job polls
+const jobPollMultiplier = 1.5
+
+// jobWatcher shares a single core.get_jobs subscription between everything waiting for jobs,
+// forwarding each update to the waiters for that job. The subscription is started by the first
+// waiter; without it, waiters fall back to polling.
+type jobWatcher struct {
+	client      *Client
+	mu          sync.Mutex
+	started     bool // A subscription is being set up or running
+	unavailable bool // The server rejected the subscription
+	waiters     map[int][]chan Job
+}
+
+func newJobWatcher(client *Client) *jobWatcher {
+	return &jobWatcher{client: client, waiters: map[int][]chan Job{}}
+}
+
+// watch registers for updates to jobID until stop is called. Only the latest update is kept
+// for a waiter that has not received the previous one yet.
+func (w *jobWatcher) watch(jobID int) (updates <-chan Job, stop func()) {
+	ch := make(chan Job, 1)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.waiters[jobID] = append(w.waiters[jobID], ch)
+	if !w.started && !w.unavailable && !w.client.opts.DisableJobEvents && w.client.opts.Transport != TransportREST {
+		w.started = true
+		go w.run()
+	}
+	return ch, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.waiters[jobID] = slices.DeleteFunc(w.waiters[jobID], func(c chan Job) bool { return c == ch })
+		if len(w.waiters[jobID]) == 0 {
+			delete(w.waiters, jobID)
+		}
+	}
+}
+
+// run subscribes to core.get_jobs and dispatches updates until the subscription ends or the client closes
+func (w *jobWatcher) run() {
+	ctx, cancel := context.WithTimeout(context.Background(), w.client.opts.DefaultCallTimeout)
+	sub, err := w.client.Subscribe.Watch(ctx, "core.get_jobs")
+	cancel()
+
+	defer func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.started = false
+	}()
+	if err != nil {
+		var errMsg *ErrorMsg
+		if errors.As(err, &errMsg) {
+			w.mu.Lock()
+			w.unavailable = true
+			w.mu.Unlock()
+		}
+		w.client.logConnection(slog.LevelDebug, "truenas job events unavailable", "error", err)
+		return
+	}
 
-			if job.IsCompleted() {
-				if job.IsFailed() {
-					if job.Error != nil {
-						return job, fmt.Errorf("job %d failed: %s", jobID, *job.Error)
-					}
-					if job.Exception != nil {
-						return job, fmt.Errorf("job %d failed with exception: %s", jobID, *job.Exception)
-					}
-					return job, fmt.Errorf("job %d failed", jobID)
-				}
-				return job, nil
+	for {
+		select {
+		case event, ok := <-sub.Events():
+			if !ok {
+				return
 			}
+			w.dispatch(event)
+		case <-w.client.doneCh:
+			return
+		}
+	}
+}
+
+func (w *jobWatcher) dispatch(event Event) {
+	var job Job
+	if event.Unmarshal(&job) != nil || job.State == "" {
+		return
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, ch := range w.waiters[job.ID] {
+		// Replace an update the waiter has not received yet
+		select {
+		case <-ch:
+		default:
 		}
+		ch <- job
 	}
 }
@@ -1,7 +1,14 @@
 package truenas
 
 import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -117,3 +124,127 @@ func TestJobClient_Get_NotFound(t *testing.T) {
 	assert.ErrorAs(t, err, &notFoundErr)
 	assert.Equal(t, "job", notFoundErr.ResourceType)
 }
+
+func TestClient_CallJobWithProgress(t *testing.T) {
+	t.Parallel()
+	states := []Job{
+		{ID: 5, State: "RUNNING", Progress: &JobProgress{Percent: 10, Description: "Creating pool"}},
+		{ID: 5, State: "RUNNING", Progress: &JobProgress{Percent: 10, Description: "Creating pool"}},
+		{ID: 5, State: "RUNNING", Progress: &JobProgress{Percent: 60, Description: "Formatting disks"}},
+		{ID: 5, State: "SUCCESS", Progress: &JobProgress{Percent: 100, Description: "Done"}, Result: map[string]any{"id": 1}},
+	}
+	var polls atomic.Int32
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID}
+		switch msg.Method {
+		case "pool.create":
+			response.Result = json.RawMessage(`5`)
+		case "core.get_jobs":
+			i := min(int(polls.Add(1))-1, len(states)-1)
+			result, _ := json.Marshal([]Job{states[i]})
+			response.Result = result
+		default:
+			response.Result = json.RawMessage(`true`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	var updates []Job
+	var pool Pool
+	err := client.CallJobWithProgress(NewTestContext(t), "pool.create", []any{}, &pool, func(job *Job) {
+		updates = append(updates, *job)
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, pool.ID)
+
+	// Unchanged polls are not reported
+	require.Len(t, updates, 3)
+	assert.Equal(t, 10.0, updates[0].Progress.Percent)
+	assert.Equal(t, "Formatting disks", updates[1].Progress.Description)
+	assert.Equal(t, "SUCCESS", updates[2].State)
+}
+
+// newJobEventServer starts a server whose core.get_jobs reports job 5 as running until the
+// given number of polls, and that pushes its completion to core.get_jobs subscribers.
+func newJobEventServer(t *testing.T, runningPolls int32, polls, subs *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var connectMsg map[string]any
+		_ = conn.ReadJSON(&connectMsg)
+		_ = conn.WriteJSON(map[string]any{"msg": "connected", "session": "test-session"})
+
+		for {
+			var msg Message
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			switch {
+			case msg.Msg == "sub":
+				subs.Add(1)
+				_ = conn.WriteJSON(map[string]any{"msg": "ready", "subs": []string{msg.ID}})
+				_ = conn.WriteJSON(map[string]any{"msg": "changed", "collection": "core.get_jobs", "id": 5,
+					"fields": Job{ID: 5, State: "SUCCESS", Result: map[string]any{"id": 1}}})
+			case msg.Method == "pool.create":
+				_ = conn.WriteJSON(Message{ID: msg.ID, Result: json.RawMessage(`5`)})
+			case msg.Method == "core.get_jobs":
+				state := "RUNNING"
+				if polls.Add(1) > runningPolls {
+					state = "SUCCESS"
+				}
+				result, _ := json.Marshal([]Job{{ID: 5, State: state, Result: map[string]any{"id": 1}}})
+				_ = conn.WriteJSON(Message{ID: msg.ID, Result: result})
+			default:
+				_ = conn.WriteJSON(Message{ID: msg.ID, Result: json.RawMessage(`true`)})
+			}
+		}
+	}))
+}
+
+func TestClient_CallJob_Events(t *testing.T) {
+	t.Parallel()
+	var polls, subs atomic.Int32
+	server := newJobEventServer(t, math.MaxInt32, &polls, &subs)
+	defer server.Close()
+
+	client, err := NewClient(strings.Replace(server.URL, "http://", "ws://", 1)+"/websocket", Options{})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// Polling alone would never see the job finish
+	var pool Pool
+	require.NoError(t, client.CallJob(NewTestContext(t), "pool.create", []any{}, &pool))
+	assert.Equal(t, 1, pool.ID)
+	assert.LessOrEqual(t, polls.Load(), int32(1))
+	assert.Equal(t, int32(1), subs.Load())
+}
+
+func TestClient_CallJob_DisableJobEvents(t *testing.T) {
+	t.Parallel()
+	var polls, subs atomic.Int32
+	server := newJobEventServer(t, 3, &polls, &subs)
+	defer server.Close()
+
+	client, err := NewClient(strings.Replace(server.URL, "http://", "ws://", 1)+"/websocket", Options{
+		JobPollInterval:    10 * time.Millisecond,
+		JobPollMaxInterval: 20 * time.Millisecond,
+		DisableJobEvents:   true,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	var pool Pool
+	require.NoError(t, client.CallJob(NewTestContext(t), "pool.create", []any{}, &pool))
+	assert.Equal(t, 1, pool.ID)
+	assert.Equal(t, int32(4), polls.Load())
+	assert.Zero(t, subs.Load())
+}
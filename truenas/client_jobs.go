@@ -0,0 +1,142 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrJobNotCompleted is returned when reading the result of a job that is still running
+var ErrJobNotCompleted = errors.New("job has not completed")
+
+// JobsClient starts jobs and tracks them through handles. Handles can be recovered from a
+// job ID, so jobs outlive the process that started them.
+type JobsClient struct {
+	client *Client
+}
+
+// NewJobsClient creates a new jobs client
+func NewJobsClient(client *Client) *JobsClient {
+	return &JobsClient{client: client}
+}
+
+// JobHandle tracks a running job. It is safe for concurrent use.
+type JobHandle struct {
+	client *Client
+	id     int
+	mu     sync.Mutex
+	job    Job // Latest known state of the job
+}
+
+// Submit calls a job method and returns a handle to the started job without waiting for it
+func (j *JobsClient) Submit(ctx context.Context, method string, params []any) (*JobHandle, error) {
+	var jobID int
+	if err := j.client.Call(context.WithValue(ctx, jobCallKey{}, true), method, params, &jobID); err != nil {
+		return nil, fmt.Errorf("call %s: %w", method, err)
+	}
+	return j.handle(Job{ID: jobID, Method: method, Arguments: params, State: string(JobStateWaiting)}), nil
+}
+
+// Get returns a handle to an existing job by ID
+func (j *JobsClient) Get(ctx context.Context, id int) (*JobHandle, error) {
+	job, err := j.client.Job.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return j.handle(*job), nil
+}
+
+// List returns handles to the jobs matching q, or all jobs if q is nil
+func (j *JobsClient) List(ctx context.Context, q *Query) ([]*JobHandle, error) {
+	jobs, err := j.client.Job.ListWithQuery(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	handles := make([]*JobHandle, len(jobs))
+	for i, job := range jobs {
+		handles[i] = j.handle(job)
+	}
+	return handles, nil
+}
+
+func (j *JobsClient) handle(job Job) *JobHandle {
+	return &JobHandle{client: j.client, id: job.ID, job: job}
+}
+
+// ID returns the job ID
+func (h *JobHandle) ID() int {
+	return h.id
+}
+
+// Job returns a copy of the latest known state of the job
+func (h *JobHandle) Job() *Job {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	job := h.job
+	return &job
+}
+
+// Progress returns the latest known progress of the job, or nil if none was reported
+func (h *JobHandle) Progress() *JobProgress {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.job.Progress == nil {
+		return nil
+	}
+	progress := *h.job.Progress
+	return &progress
+}
+
+// Refresh fetches the current state of the job
+func (h *JobHandle) Refresh(ctx context.Context) error {
+	job, err := h.client.Job.Get(ctx, h.id)
+	if err != nil {
+		return err
+	}
+	h.update(job)
+	return nil
+}
+
+// Wait waits for the job to complete, returning an error if it failed
+func (h *JobHandle) Wait(ctx context.Context) error {
+	return h.WaitWithProgress(ctx, nil)
+}
+
+// WaitWithProgress waits for the job to complete, reporting state and progress changes to fn
+func (h *JobHandle) WaitWithProgress(ctx context.Context, fn JobProgressFunc) error {
+	job, err := h.client.Job.WaitWithProgress(ctx, h.id, func(job *Job) {
+		h.update(job)
+		if fn != nil {
+			fn(job)
+		}
+	})
+	if job != nil {
+		h.update(job)
+	}
+	return err
+}
+
+// Abort asks the middleware to abort the job
+func (h *JobHandle) Abort(ctx context.Context) error {
+	return h.client.Call(ctx, "core.job_abort", []any{h.id}, nil)
+}
+
+// Result unmarshals the result of the completed job into v. It returns ErrJobNotCompleted
+// if the job is still running, and the job's error if it failed.
+func (h *JobHandle) Result(v any) error {
+	job := h.Job()
+	if !job.IsCompleted() {
+		return fmt.Errorf("job %d: %w", h.id, ErrJobNotCompleted)
+	}
+	if err := job.failure(); err != nil {
+		return err
+	}
+	return job.decodeResult(v)
+}
+
+func (h *JobHandle) update(job *Job) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.job = *job
+}
@@ -0,0 +1,96 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJobsClient_Submit(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetJobResponse("pool.create", map[string]any{"id": 1, "name": "tank"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	job, err := client.Jobs.Submit(ctx, "pool.create", []any{map[string]any{"name": "tank"}})
+	require.NoError(t, err)
+	assert.Equal(t, 101, job.ID())
+	assert.Equal(t, "pool.create", job.Job().Method)
+	assert.ErrorIs(t, job.Result(nil), ErrJobNotCompleted)
+
+	require.NoError(t, job.Wait(ctx))
+	var pool Pool
+	require.NoError(t, job.Result(&pool))
+	assert.Equal(t, "tank", pool.Name)
+	assert.True(t, job.Job().IsSuccessful())
+}
+
+func TestJobsClient_Get(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetJobError("pool.scrub.run", "pool is busy")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	// A job started elsewhere can be picked up by its ID
+	ctx := NewTestContext(t)
+	job, err := client.Jobs.Get(ctx, 101)
+	require.NoError(t, err)
+	assert.True(t, job.Job().IsFailed())
+	assert.EqualError(t, job.Result(nil), "job 101 failed: pool is busy")
+	assert.EqualError(t, job.Wait(ctx), "job 101 failed: pool is busy")
+}
+
+func TestJobsClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("core.get_jobs", []Job{
+		{ID: 1, Method: "pool.scrub.run", State: "RUNNING", Progress: &JobProgress{Percent: 40}},
+		{ID: 2, Method: "pool.create", State: "SUCCESS"},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	jobs, err := client.Jobs.List(NewTestContext(t), NewQuery().Filter("method", "^", "pool."))
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, 1, jobs[0].ID())
+	require.NotNil(t, jobs[0].Progress())
+	assert.Equal(t, 40.0, jobs[0].Progress().Percent)
+	assert.Nil(t, jobs[1].Progress())
+}
+
+func TestJobHandle_Abort(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "pool.scrub.run":
+			return Message{ID: msg.ID, Result: json.RawMessage(`7`)}, true
+		case "core.job_abort":
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`null`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	job, err := client.Jobs.Submit(ctx, "pool.scrub.run", []any{"tank"})
+	require.NoError(t, err)
+	require.NoError(t, job.Abort(ctx))
+	assert.Equal(t, []any{float64(7)}, <-received)
+}
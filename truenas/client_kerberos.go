@@ -0,0 +1,218 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// KerberosClient provides methods for Kerberos configuration, realms and keytabs
+type KerberosClient struct {
+	client  *Client
+	Realms  *KerberosRealmClient
+	Keytabs *KerberosKeytabClient
+}
+
+// NewKerberosClient creates a new Kerberos client
+func NewKerberosClient(client *Client) *KerberosClient {
+	return &KerberosClient{
+		client:  client,
+		Realms:  NewKerberosRealmClient(client),
+		Keytabs: NewKerberosKeytabClient(client),
+	}
+}
+
+// KerberosConfig represents the global Kerberos configuration
+type KerberosConfig struct {
+	ID             int    `json:"id"`
+	AppDefaultsAux string `json:"appdefaults_aux"` // Auxiliary parameters for the [appdefaults] section of krb5.conf
+	LibDefaultsAux string `json:"libdefaults_aux"` // Auxiliary parameters for the [libdefaults] section of krb5.conf
+}
+
+// KerberosConfigUpdateRequest represents parameters for kerberos.update
+type KerberosConfigUpdateRequest struct {
+	AppDefaultsAux *string `json:"appdefaults_aux,omitempty"`
+	LibDefaultsAux *string `json:"libdefaults_aux,omitempty"`
+}
+
+// GetConfig returns the global Kerberos configuration
+func (k *KerberosClient) GetConfig(ctx context.Context) (*KerberosConfig, error) {
+	var result KerberosConfig
+	err := k.client.Call(ctx, "kerberos.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig updates the global Kerberos configuration
+func (k *KerberosClient) UpdateConfig(ctx context.Context, req *KerberosConfigUpdateRequest) (*KerberosConfig, error) {
+	var result KerberosConfig
+	err := k.client.Call(ctx, "kerberos.update", []any{*req}, &result)
+	return &result, err
+}
+
+// Kerberos Realms
+
+// KerberosRealmClient provides methods for Kerberos realm management
+type KerberosRealmClient struct {
+	client *Client
+}
+
+// NewKerberosRealmClient creates a new Kerberos realm client
+func NewKerberosRealmClient(client *Client) *KerberosRealmClient {
+	return &KerberosRealmClient{client: client}
+}
+
+// KerberosRealm represents a Kerberos realm
+type KerberosRealm struct {
+	ID            int      `json:"id"`
+	Realm         string   `json:"realm"`
+	KDC           []string `json:"kdc"`
+	AdminServer   []string `json:"admin_server"`
+	KPasswdServer []string `json:"kpasswd_server"`
+}
+
+// KerberosRealmRequest represents parameters for kerberos.realm.create and update
+type KerberosRealmRequest struct {
+	Realm         string   `json:"realm"`
+	KDC           []string `json:"kdc,omitempty"`
+	AdminServer   []string `json:"admin_server,omitempty"`
+	KPasswdServer []string `json:"kpasswd_server,omitempty"`
+}
+
+// List returns all Kerberos realms
+func (r *KerberosRealmClient) List(ctx context.Context) ([]KerberosRealm, error) {
+	var result []KerberosRealm
+	err := r.client.Call(ctx, "kerberos.realm.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns Kerberos realms matching q
+func (r *KerberosRealmClient) ListWithQuery(ctx context.Context, q *Query) ([]KerberosRealm, error) {
+	return query[KerberosRealm](ctx, r.client, "kerberos.realm.query", q)
+}
+
+// ListIter returns an iterator over the Kerberos realms matching q, fetched a page at a time
+func (r *KerberosRealmClient) ListIter(ctx context.Context, q *Query) iter.Seq2[KerberosRealm, error] {
+	return paginate(ctx, q, r.ListWithQuery)
+}
+
+// Get returns a specific Kerberos realm by ID
+func (r *KerberosRealmClient) Get(ctx context.Context, id int) (*KerberosRealm, error) {
+	var result []KerberosRealm
+	err := r.client.Call(ctx, "kerberos.realm.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("kerberos_realm", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates a new Kerberos realm
+func (r *KerberosRealmClient) Create(ctx context.Context, req *KerberosRealmRequest) (*KerberosRealm, error) {
+	var result KerberosRealm
+	err := r.client.Call(ctx, "kerberos.realm.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates an existing Kerberos realm
+func (r *KerberosRealmClient) Update(ctx context.Context, id int, req *KerberosRealmRequest) (*KerberosRealm, error) {
+	var result KerberosRealm
+	err := r.client.Call(ctx, "kerberos.realm.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a Kerberos realm
+func (r *KerberosRealmClient) Delete(ctx context.Context, id int) error {
+	return r.client.Call(ctx, "kerberos.realm.delete", []any{id}, nil)
+}
+
+// Kerberos Keytabs
+
+// KerberosKeytabClient provides methods for Kerberos keytab management
+type KerberosKeytabClient struct {
+	client *Client
+}
+
+// NewKerberosKeytabClient creates a new Kerberos keytab client
+func NewKerberosKeytabClient(client *Client) *KerberosKeytabClient {
+	return &KerberosKeytabClient{client: client}
+}
+
+// KerberosKeytab represents a stored Kerberos keytab
+type KerberosKeytab struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	File string `json:"file"` // Base64-encoded keytab contents
+}
+
+// KerberosKeytabRequest represents parameters for kerberos.keytab.create and update
+type KerberosKeytabRequest struct {
+	Name string `json:"name"`
+	File string `json:"file"` // Base64-encoded keytab contents
+}
+
+// KerberosKeytabEntry represents a principal entry in the system keytab
+type KerberosKeytabEntry struct {
+	Slot      int    `json:"slot"`
+	KVNO      int    `json:"kvno"`
+	Principal string `json:"principal"`
+	EncType   string `json:"etype"`
+	Date      int64  `json:"date"`
+}
+
+// List returns all Kerberos keytabs
+func (k *KerberosKeytabClient) List(ctx context.Context) ([]KerberosKeytab, error) {
+	var result []KerberosKeytab
+	err := k.client.Call(ctx, "kerberos.keytab.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns Kerberos keytabs matching q
+func (k *KerberosKeytabClient) ListWithQuery(ctx context.Context, q *Query) ([]KerberosKeytab, error) {
+	return query[KerberosKeytab](ctx, k.client, "kerberos.keytab.query", q)
+}
+
+// ListIter returns an iterator over the Kerberos keytabs matching q, fetched a page at a time
+func (k *KerberosKeytabClient) ListIter(ctx context.Context, q *Query) iter.Seq2[KerberosKeytab, error] {
+	return paginate(ctx, q, k.ListWithQuery)
+}
+
+// Get returns a specific Kerberos keytab by ID
+func (k *KerberosKeytabClient) Get(ctx context.Context, id int) (*KerberosKeytab, error) {
+	var result []KerberosKeytab
+	err := k.client.Call(ctx, "kerberos.keytab.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("kerberos_keytab", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create uploads a new Kerberos keytab
+func (k *KerberosKeytabClient) Create(ctx context.Context, req *KerberosKeytabRequest) (*KerberosKeytab, error) {
+	var result KerberosKeytab
+	err := k.client.Call(ctx, "kerberos.keytab.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update replaces an existing Kerberos keytab
+func (k *KerberosKeytabClient) Update(ctx context.Context, id int, req *KerberosKeytabRequest) (*KerberosKeytab, error) {
+	var result KerberosKeytab
+	err := k.client.Call(ctx, "kerberos.keytab.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a Kerberos keytab
+func (k *KerberosKeytabClient) Delete(ctx context.Context, id int) error {
+	return k.client.Call(ctx, "kerberos.keytab.delete", []any{id}, nil)
+}
+
+// SystemKeytabList returns the principal entries of the system keytab
+func (k *KerberosKeytabClient) SystemKeytabList(ctx context.Context) ([]KerberosKeytabEntry, error) {
+	var result []KerberosKeytabEntry
+	err := k.client.Call(ctx, "kerberos.keytab.system_keytab_list", []any{}, &result)
+	return result, err
+}
@@ -0,0 +1,141 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKerberosClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	kerberosClient := NewKerberosClient(client)
+	require.NotNil(t, kerberosClient)
+	assert.Equal(t, client, kerberosClient.client)
+	require.NotNil(t, kerberosClient.Realms)
+	require.NotNil(t, kerberosClient.Keytabs)
+}
+
+func TestKerberosClient_Config(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	config := KerberosConfig{ID: 1, LibDefaultsAux: "rdns = false"}
+	server.SetResponse("kerberos.config", config)
+	server.SetResponse("kerberos.update", config)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	got, err := client.Kerberos.GetConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "rdns = false", got.LibDefaultsAux)
+
+	updated, err := client.Kerberos.UpdateConfig(ctx, &KerberosConfigUpdateRequest{LibDefaultsAux: Ptr("rdns = false")})
+	require.NoError(t, err)
+	assert.Equal(t, 1, updated.ID)
+}
+
+func TestKerberosRealmClient_CRUD(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	realm := KerberosRealm{
+		ID:          1,
+		Realm:       "EXAMPLE.COM",
+		KDC:         []string{"kdc1.example.com"},
+		AdminServer: []string{"kadmin.example.com"},
+	}
+	server.SetResponse("kerberos.realm.query", []KerberosRealm{realm})
+	server.SetResponse("kerberos.realm.create", realm)
+	server.SetResponse("kerberos.realm.update", realm)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	created, err := client.Kerberos.Realms.Create(ctx, &KerberosRealmRequest{Realm: "EXAMPLE.COM", KDC: []string{"kdc1.example.com"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, created.ID)
+
+	got, err := client.Kerberos.Realms.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "EXAMPLE.COM", got.Realm)
+	assert.Equal(t, []string{"kadmin.example.com"}, got.AdminServer)
+
+	_, err = client.Kerberos.Realms.Update(ctx, 1, &KerberosRealmRequest{Realm: "EXAMPLE.COM"})
+	require.NoError(t, err)
+	require.NoError(t, client.Kerberos.Realms.Delete(ctx, 1))
+}
+
+func TestKerberosRealmClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("kerberos.realm.query", []KerberosRealm{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	realm, err := client.Kerberos.Realms.Get(ctx, 9)
+	assert.Nil(t, realm)
+
+	var notFoundErr *NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "kerberos_realm", notFoundErr.ResourceType)
+}
+
+func TestKerberosKeytabClient_Create(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "kerberos.keytab.create" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`{"id": 2, "name": "nfs", "file": "BQIAAAA="}`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	keytab, err := client.Kerberos.Keytabs.Create(ctx, &KerberosKeytabRequest{Name: "nfs", File: "BQIAAAA="})
+	require.NoError(t, err)
+	assert.Equal(t, 2, keytab.ID)
+	assert.JSONEq(t, `[{"name": "nfs", "file": "BQIAAAA="}]`, tryMarshal(<-received))
+}
+
+func TestKerberosKeytabClient_SystemKeytabList(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("kerberos.keytab.system_keytab_list", json.RawMessage(`[
+		{"slot": 1, "kvno": 2, "principal": "nfs/nas.example.com@EXAMPLE.COM", "etype": "aes256-cts-hmac-sha1-96", "date": 1700000000}
+	]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	entries, err := client.Kerberos.Keytabs.SystemKeytabList(ctx)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "nfs/nas.example.com@EXAMPLE.COM", entries[0].Principal)
+	assert.Equal(t, 2, entries[0].KVNO)
+}
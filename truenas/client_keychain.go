@@ -0,0 +1,214 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// SSHCredentialID is the ID of an SSH_CREDENTIALS keychain credential, the connection
+// to a remote system used by replication tasks
+type SSHCredentialID int
+
+// KeychainCredentialType represents the type of a keychain credential
+type KeychainCredentialType string
+
+const (
+	KeychainCredentialTypeSSHKeyPair     KeychainCredentialType = "SSH_KEY_PAIR"
+	KeychainCredentialTypeSSHCredentials KeychainCredentialType = "SSH_CREDENTIALS"
+)
+
+// KeychainClient provides methods for SSH key pairs and connections, which replication and
+// rsync tasks use to reach remote systems
+type KeychainClient struct {
+	client *Client
+}
+
+// NewKeychainClient creates a new keychain client
+func NewKeychainClient(client *Client) *KeychainClient {
+	return &KeychainClient{client: client}
+}
+
+// KeychainCredential represents an SSH key pair or an SSH connection to a remote system
+type KeychainCredential struct {
+	ID         int                    `json:"id"`
+	Name       string                 `json:"name"`
+	Type       KeychainCredentialType `json:"type"`
+	Attributes map[string]any         `json:"attributes"`
+}
+
+// PrivateKeyID returns the ID of the key pair an SSH connection authenticates with, or 0 if
+// the credential is not a connection
+func (k *KeychainCredential) PrivateKeyID() int {
+	n, _ := k.Attributes["private_key"].(json.Number)
+	id, _ := n.Int64()
+	return int(id)
+}
+
+// KeychainCredentialRequest represents parameters for keychaincredential.create and
+// keychaincredential.update. Type cannot be updated.
+type KeychainCredentialRequest struct {
+	Name       string                 `json:"name"`
+	Type       KeychainCredentialType `json:"type,omitempty"`
+	Attributes any                    `json:"attributes"` // SSHKeyPairAttributes or SSHCredentialsAttributes
+}
+
+// SSHKeyPairAttributes holds the keys of an SSH_KEY_PAIR credential. Either may be empty
+// when creating one, to have it derived from the other.
+type SSHKeyPairAttributes struct {
+	PrivateKey string `json:"private_key,omitempty"`
+	PublicKey  string `json:"public_key,omitempty"`
+}
+
+// SSHCredentialsAttributes holds the connection details of an SSH_CREDENTIALS credential
+type SSHCredentialsAttributes struct {
+	Host           string `json:"host"`
+	Port           int    `json:"port,omitempty"`
+	Username       string `json:"username,omitempty"`
+	PrivateKey     int    `json:"private_key"` // ID of an SSH_KEY_PAIR credential
+	RemoteHostKey  string `json:"remote_host_key"`
+	ConnectTimeout int    `json:"connect_timeout,omitempty"` // Seconds
+}
+
+// KeychainCredentialDeleteOptions represents options for keychaincredential.delete
+type KeychainCredentialDeleteOptions struct {
+	Cascade bool `json:"cascade"` // Also delete the connections and tasks using the credential
+}
+
+// SSHKeyPair is a key pair returned by keychaincredential.generate_ssh_key_pair
+type SSHKeyPair struct {
+	PrivateKey string `json:"private_key"`
+	PublicKey  string `json:"public_key"`
+}
+
+// SSHHostKeyScanRequest represents parameters for keychaincredential.remote_ssh_host_key_scan
+type SSHHostKeyScanRequest struct {
+	Host           string `json:"host"`
+	Port           int    `json:"port,omitempty"`
+	ConnectTimeout int    `json:"connect_timeout,omitempty"` // Seconds
+}
+
+// SSHConnectionSetupType represents how keychaincredential.setup_ssh_connection reaches the
+// remote system
+type SSHConnectionSetupType string
+
+const (
+	// SSHConnectionSetupSemiAutomatic logs in to a remote TrueNAS system to install the public key
+	SSHConnectionSetupSemiAutomatic SSHConnectionSetupType = "SEMI-AUTOMATIC"
+	// SSHConnectionSetupManual uses connection details and a host key given up front
+	SSHConnectionSetupManual SSHConnectionSetupType = "MANUAL"
+)
+
+// SSHConnectionSetup represents parameters for keychaincredential.setup_ssh_connection
+type SSHConnectionSetup struct {
+	PrivateKey         SSHConnectionPrivateKey `json:"private_key"`
+	ConnectionName     string                  `json:"connection_name"`
+	SetupType          SSHConnectionSetupType  `json:"setup_type"`
+	SemiAutomaticSetup *SSHSemiAutomaticSetup  `json:"semi_automatic_setup,omitempty"`
+	ManualSetup        *SSHManualSetup         `json:"manual_setup,omitempty"`
+}
+
+// SSHConnectionPrivateKey selects the key pair of a new SSH connection: a generated one
+// named Name, or the existing key pair ExistingKeyID
+type SSHConnectionPrivateKey struct {
+	GenerateKey   bool   `json:"generate_key"`
+	ExistingKeyID int    `json:"existing_key_id,omitempty"`
+	Name          string `json:"name,omitempty"`
+}
+
+// SSHSemiAutomaticSetup holds how to log in to a remote TrueNAS system to install a public key
+type SSHSemiAutomaticSetup struct {
+	URL           string `json:"url"`
+	VerifySSL     bool   `json:"verify_ssl"`
+	Token         string `json:"token,omitempty"`
+	AdminUsername string `json:"admin_username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	OTPToken      string `json:"otp_token,omitempty"`
+	Username      string `json:"username,omitempty"` // User the connection logs in as, "root" if empty
+	Sudo          bool   `json:"sudo"`
+}
+
+// SSHManualSetup holds the connection details of a remote system
+type SSHManualSetup struct {
+	Host           string `json:"host"`
+	Port           int    `json:"port,omitempty"`
+	Username       string `json:"username,omitempty"`
+	RemoteHostKey  string `json:"remote_host_key"`
+	ConnectTimeout int    `json:"connect_timeout,omitempty"` // Seconds
+}
+
+// List returns all keychain credentials
+func (k *KeychainClient) List(ctx context.Context) ([]KeychainCredential, error) {
+	var result []KeychainCredential
+	err := k.client.Call(ctx, "keychaincredential.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns keychain credentials matching q
+func (k *KeychainClient) ListWithQuery(ctx context.Context, q *Query) ([]KeychainCredential, error) {
+	return query[KeychainCredential](ctx, k.client, "keychaincredential.query", q)
+}
+
+// ListIter returns an iterator over the keychain credentials matching q, fetched a page at a time
+func (k *KeychainClient) ListIter(ctx context.Context, q *Query) iter.Seq2[KeychainCredential, error] {
+	return paginate(ctx, q, k.ListWithQuery)
+}
+
+// Get returns a specific keychain credential by ID
+func (k *KeychainClient) Get(ctx context.Context, id int) (*KeychainCredential, error) {
+	var result []KeychainCredential
+	err := k.client.Call(ctx, "keychaincredential.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("keychain_credential", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates a new keychain credential
+func (k *KeychainClient) Create(ctx context.Context, req *KeychainCredentialRequest) (*KeychainCredential, error) {
+	var result KeychainCredential
+	err := k.client.Call(ctx, "keychaincredential.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates an existing keychain credential
+func (k *KeychainClient) Update(ctx context.Context, id int, req *KeychainCredentialRequest) (*KeychainCredential, error) {
+	var result KeychainCredential
+	err := k.client.Call(ctx, "keychaincredential.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a keychain credential
+func (k *KeychainClient) Delete(ctx context.Context, id int, options *KeychainCredentialDeleteOptions) error {
+	params := []any{id}
+	if options != nil {
+		params = append(params, *options)
+	}
+	return k.client.Call(ctx, "keychaincredential.delete", params, nil)
+}
+
+// GenerateSSHKeyPair generates a key pair without storing it
+func (k *KeychainClient) GenerateSSHKeyPair(ctx context.Context) (*SSHKeyPair, error) {
+	var result SSHKeyPair
+	err := k.client.Call(ctx, "keychaincredential.generate_ssh_key_pair", []any{}, &result)
+	return &result, err
+}
+
+// RemoteSSHHostKeyScan returns the host keys of a remote system, in known_hosts format
+func (k *KeychainClient) RemoteSSHHostKeyScan(ctx context.Context, req SSHHostKeyScanRequest) (string, error) {
+	var result string
+	err := k.client.Call(ctx, "keychaincredential.remote_ssh_host_key_scan", []any{req}, &result)
+	return result, err
+}
+
+// SetupSSHConnection creates an SSH connection to a remote system, along with a key pair for
+// it if req asks for one, and returns the connection
+func (k *KeychainClient) SetupSSHConnection(ctx context.Context, req *SSHConnectionSetup) (*KeychainCredential, error) {
+	var result KeychainCredential
+	err := k.client.Call(ctx, "keychaincredential.setup_ssh_connection", []any{*req}, &result)
+	return &result, err
+}
@@ -0,0 +1,147 @@
+package truenas
+
+import (
+	"context"
+)
+
+// KubernetesStatus represents the state of the Kubernetes service
+type KubernetesStatus string
+
+const (
+	KubernetesStatusPending      KubernetesStatus = "PENDING"
+	KubernetesStatusRunning      KubernetesStatus = "RUNNING"
+	KubernetesStatusInitializing KubernetesStatus = "INITIALIZING"
+	KubernetesStatusStopping     KubernetesStatus = "STOPPING"
+	KubernetesStatusStopped      KubernetesStatus = "STOPPED"
+	KubernetesStatusFailed       KubernetesStatus = "FAILED"
+)
+
+// KubernetesClient provides methods for managing the TrueNAS SCALE Kubernetes service
+type KubernetesClient struct {
+	client *Client
+}
+
+// NewKubernetesClient creates a new Kubernetes client
+func NewKubernetesClient(client *Client) *KubernetesClient {
+	return &KubernetesClient{client: client}
+}
+
+// KubernetesConfig represents the Kubernetes service configuration
+type KubernetesConfig struct {
+	ID               int     `json:"id"`
+	Pool             *string `json:"pool"`
+	Dataset          *string `json:"dataset"`
+	ClusterCIDR      string  `json:"cluster_cidr"`
+	ServiceCIDR      string  `json:"service_cidr"`
+	ClusterDNSIP     string  `json:"cluster_dns_ip"`
+	RouteV4Interface *string `json:"route_v4_interface"`
+	RouteV4Gateway   *string `json:"route_v4_gateway"`
+	RouteV6Interface *string `json:"route_v6_interface"`
+	RouteV6Gateway   *string `json:"route_v6_gateway"`
+	NodeIP           string  `json:"node_ip"`
+	ConfigureGPUs    bool    `json:"configure_gpus"`
+	ServiceLB        bool    `json:"servicelb"`
+	ValidateHostPath bool    `json:"validate_host_path"`
+	PassthroughMode  bool    `json:"passthrough_mode"`
+	MetricsServer    bool    `json:"metrics_server"`
+}
+
+// KubernetesUpdateRequest represents parameters for kubernetes.update
+type KubernetesUpdateRequest struct {
+	Pool             *string `json:"pool,omitempty"` // Setting the pool initialises the apps subsystem on it
+	ClusterCIDR      *string `json:"cluster_cidr,omitempty"`
+	ServiceCIDR      *string `json:"service_cidr,omitempty"`
+	ClusterDNSIP     *string `json:"cluster_dns_ip,omitempty"`
+	RouteV4Interface *string `json:"route_v4_interface,omitempty"`
+	RouteV4Gateway   *string `json:"route_v4_gateway,omitempty"`
+	RouteV6Interface *string `json:"route_v6_interface,omitempty"`
+	RouteV6Gateway   *string `json:"route_v6_gateway,omitempty"`
+	NodeIP           *string `json:"node_ip,omitempty"`
+	ConfigureGPUs    *bool   `json:"configure_gpus,omitempty"`
+	ServiceLB        *bool   `json:"servicelb,omitempty"`
+	ValidateHostPath *bool   `json:"validate_host_path,omitempty"`
+	PassthroughMode  *bool   `json:"passthrough_mode,omitempty"`
+	MetricsServer    *bool   `json:"metrics_server,omitempty"`
+	// MigrateApplications moves existing applications when the pool changes
+	MigrateApplications *bool `json:"migrate_applications,omitempty"`
+}
+
+// KubernetesStatusResult represents the result of kubernetes.status
+type KubernetesStatusResult struct {
+	Status      KubernetesStatus `json:"status"`
+	Description string           `json:"description"`
+}
+
+// KubernetesRestoreOptions represents options for kubernetes.restore_backup
+type KubernetesRestoreOptions struct {
+	WaitForCSI bool `json:"wait_for_csi"`
+}
+
+// GetConfig returns the Kubernetes service configuration
+func (k *KubernetesClient) GetConfig(ctx context.Context) (*KubernetesConfig, error) {
+	var result KubernetesConfig
+	err := k.client.Call(ctx, "kubernetes.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig updates the Kubernetes service configuration (asynchronous job)
+func (k *KubernetesClient) UpdateConfig(ctx context.Context, req *KubernetesUpdateRequest) (*KubernetesConfig, error) {
+	var result KubernetesConfig
+	err := k.client.CallJob(ctx, "kubernetes.update", []any{*req}, &result)
+	return &result, err
+}
+
+// Status returns the current status of the Kubernetes service
+func (k *KubernetesClient) Status(ctx context.Context) (*KubernetesStatusResult, error) {
+	var result KubernetesStatusResult
+	err := k.client.Call(ctx, "kubernetes.status", []any{}, &result)
+	return &result, err
+}
+
+// NodeIP returns the IP address of the Kubernetes node
+func (k *KubernetesClient) NodeIP(ctx context.Context) (string, error) {
+	var result string
+	err := k.client.Call(ctx, "kubernetes.node_ip", []any{}, &result)
+	return result, err
+}
+
+// BindIPChoices returns the IP addresses the Kubernetes node can bind to
+func (k *KubernetesClient) BindIPChoices(ctx context.Context) (map[string]string, error) {
+	var result map[string]string
+	err := k.client.Call(ctx, "kubernetes.bindip_choices", []any{}, &result)
+	return result, err
+}
+
+// Backups
+
+// BackupChartReleases backs up all chart releases (asynchronous job). An empty name generates one.
+func (k *KubernetesClient) BackupChartReleases(ctx context.Context, name string) (string, error) {
+	var result string
+	params := []any{}
+	if name != "" {
+		params = append(params, name)
+	}
+	err := k.client.CallJob(ctx, "kubernetes.backup_chart_releases", params, &result)
+	return result, err
+}
+
+// ListBackups returns the chart release backups keyed by backup name
+func (k *KubernetesClient) ListBackups(ctx context.Context) (map[string]any, error) {
+	var result map[string]any
+	err := k.client.Call(ctx, "kubernetes.list_backups", []any{}, &result)
+	return result, err
+}
+
+// RestoreBackup restores chart releases from a backup (asynchronous job)
+func (k *KubernetesClient) RestoreBackup(ctx context.Context, name string, options *KubernetesRestoreOptions) error {
+	params := []any{name}
+	if options != nil {
+		params = append(params, *options)
+	}
+	return k.client.CallJob(ctx, "kubernetes.restore_backup", params, nil)
+}
+
+// DeleteBackup deletes a chart release backup
+func (k *KubernetesClient) DeleteBackup(ctx context.Context, name string) error {
+	return k.client.Call(ctx, "kubernetes.delete_backup", []any{name}, nil)
+}
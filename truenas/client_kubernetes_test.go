@@ -0,0 +1,123 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKubernetesClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	k8sClient := NewKubernetesClient(client)
+	require.NotNil(t, k8sClient)
+	assert.Equal(t, client, k8sClient.client)
+}
+
+func TestKubernetesClient_GetConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	mockConfig := KubernetesConfig{
+		ID:           1,
+		Pool:         Ptr("tank"),
+		Dataset:      Ptr("tank/ix-applications"),
+		ClusterCIDR:  "172.16.0.0/16",
+		ServiceCIDR:  "172.17.0.0/16",
+		ClusterDNSIP: "172.17.0.10",
+		NodeIP:       "0.0.0.0",
+		ServiceLB:    true,
+	}
+	server.SetResponse("kubernetes.config", mockConfig)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	config, err := client.Kubernetes.GetConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "tank", value(config.Pool))
+	assert.Equal(t, "172.16.0.0/16", config.ClusterCIDR)
+	assert.True(t, config.ServiceLB)
+	assert.Nil(t, config.RouteV4Gateway)
+}
+
+func TestKubernetesClient_UpdateConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("kubernetes.update", KubernetesConfig{ID: 1, Pool: Ptr("tank")})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	config, err := client.Kubernetes.UpdateConfig(ctx, &KubernetesUpdateRequest{Pool: Ptr("tank")})
+	require.NoError(t, err)
+	assert.Equal(t, "tank", value(config.Pool))
+}
+
+func TestKubernetesClient_Status(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("kubernetes.status", KubernetesStatusResult{Status: KubernetesStatusRunning, Description: "Applications are fully operational"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	status, err := client.Kubernetes.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, KubernetesStatusRunning, status.Status)
+}
+
+func TestKubernetesClient_Backups(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("kubernetes.backup_chart_releases", "ix-applications-backup-nightly")
+	server.SetResponse("kubernetes.list_backups", map[string]any{
+		"ix-applications-backup-nightly": map[string]any{"name": "ix-applications-backup-nightly"},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	name, err := client.Kubernetes.BackupChartReleases(ctx, "nightly")
+	require.NoError(t, err)
+	assert.Equal(t, "ix-applications-backup-nightly", name)
+
+	backups, err := client.Kubernetes.ListBackups(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, backups, name)
+
+	require.NoError(t, client.Kubernetes.DeleteBackup(ctx, name))
+}
+
+func TestKubernetesClient_RestoreBackup_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("kubernetes.restore_backup", "Backup does not exist")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	err := client.Kubernetes.RestoreBackup(ctx, "missing", &KubernetesRestoreOptions{WaitForCSI: true})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Backup does not exist")
+}
@@ -3,6 +3,8 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"slices"
 )
 
 // InterfaceType represents network interface types
@@ -79,6 +81,40 @@ type NetworkInterfaceAlias struct {
 	Broadcast string `json:"broadcast,omitempty"`
 }
 
+// Alias address families
+const (
+	AliasTypeINET  = "INET"
+	AliasTypeINET6 = "INET6"
+)
+
+// NewInetAlias creates an alias from an address in CIDR notation, such as "192.168.1.10/24"
+// or "fd00::10/64"
+func NewInetAlias(cidr string) (NetworkInterfaceAlias, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return NetworkInterfaceAlias{}, fmt.Errorf("alias %q: %w", cidr, err)
+	}
+	alias := NetworkInterfaceAlias{Type: AliasTypeINET, Address: prefix.Addr().String(), Netmask: prefix.Bits()}
+	if prefix.Addr().Is6() {
+		alias.Type = AliasTypeINET6
+	}
+	return alias, nil
+}
+
+// validate reports a problem with the alias, or an empty string if it is valid
+func (a NetworkInterfaceAlias) validate() string {
+	addr, err := netip.ParseAddr(a.Address)
+	switch {
+	case err != nil:
+		return fmt.Sprintf("%q is not a valid IP address", a.Address)
+	case addr.Is4() && a.Type != AliasTypeINET, addr.Is6() && a.Type != AliasTypeINET6:
+		return fmt.Sprintf("Type %q does not match address %s", a.Type, a.Address)
+	case a.Netmask < 0 || a.Netmask > addr.BitLen():
+		return fmt.Sprintf("Netmask %d is out of range for %s", a.Netmask, a.Address)
+	}
+	return ""
+}
+
 // NetworkInterfaceState represents the current state of an interface
 type NetworkInterfaceState struct {
 	Name               string           `json:"name"`
@@ -227,6 +263,11 @@ func (n *NetworkClient) ListInterfaces(ctx context.Context) ([]NetworkInterface,
 	return result, err
 }
 
+// ListInterfacesWithQuery returns network interfaces matching q
+func (n *NetworkClient) ListInterfacesWithQuery(ctx context.Context, q *Query) ([]NetworkInterface, error) {
+	return query[NetworkInterface](ctx, n.client, "interface.query", q)
+}
+
 // GetInterface returns a specific interface by ID
 func (n *NetworkClient) GetInterface(ctx context.Context, id int) (*NetworkInterface, error) {
 	var result []NetworkInterface
@@ -253,13 +294,88 @@ func (n *NetworkClient) GetInterfaceByName(ctx context.Context, name string) (*N
 	return &result[0], nil
 }
 
-// CreateInterface creates a new network interface
+// CreateInterface creates a new network interface. The request is checked with ValidateInterface first.
 func (n *NetworkClient) CreateInterface(ctx context.Context, req *NetworkInterfaceCreateRequest) (*NetworkInterface, error) {
+	if err := n.ValidateInterface(req); err != nil {
+		return nil, err
+	}
 	var result NetworkInterface
 	err := n.client.Call(ctx, "interface.create", []any{*req}, &result)
 	return &result, err
 }
 
+// ValidateInterface checks the aliases of an interface and that its bridge members, link
+// aggregation ports or VLAN settings match its type, returning a ValidationError that
+// describes every problem found
+func (n *NetworkClient) ValidateInterface(req *NetworkInterfaceCreateRequest) error {
+	var fields []FieldError
+	invalid := func(field, message string) {
+		fields = append(fields, FieldError{Attribute: "interface_create." + field, Message: message, Errno: errnoEINVAL})
+	}
+	members := func(field string, names []string) {
+		for i, name := range names {
+			switch {
+			case name == req.Name:
+				invalid(fmt.Sprintf("%s.%d", field, i), "An interface cannot be a member of itself")
+			case slices.Index(names, name) < i:
+				invalid(fmt.Sprintf("%s.%d", field, i), fmt.Sprintf("%s is listed more than once", name))
+			}
+		}
+	}
+
+	if req.Name == "" {
+		invalid("name", "This field is required")
+	}
+	for i, alias := range req.Aliases {
+		if message := alias.validate(); message != "" {
+			invalid(fmt.Sprintf("aliases.%d", i), message)
+		}
+	}
+
+	if req.Type != InterfaceTypeBridge && len(req.BridgeMembers) > 0 {
+		invalid("bridge_members", "Only bridge interfaces have bridge members")
+	}
+	if req.Type != InterfaceTypeLinkAgg && (req.LagProtocol != nil || len(req.LagPorts) > 0) {
+		invalid("lag_ports", "Only link aggregation interfaces have a protocol and ports")
+	}
+	if req.Type != InterfaceTypeVLAN && (req.VlanParent != nil || req.VlanTag != nil || req.VlanPcp != nil) {
+		invalid("vlan_parent_interface", "Only VLAN interfaces have a parent interface, tag and priority")
+	}
+
+	switch req.Type {
+	case InterfaceTypeBridge:
+		members("bridge_members", req.BridgeMembers)
+	case InterfaceTypeLinkAgg:
+		if req.LagProtocol == nil {
+			invalid("lag_protocol", "This field is required for link aggregation interfaces")
+		}
+		if len(req.LagPorts) == 0 {
+			invalid("lag_ports", "At least one port is required for link aggregation interfaces")
+		}
+		members("lag_ports", req.LagPorts)
+	case InterfaceTypeVLAN:
+		switch {
+		case req.VlanParent == nil || *req.VlanParent == "":
+			invalid("vlan_parent_interface", "This field is required for VLAN interfaces")
+		case *req.VlanParent == req.Name:
+			invalid("vlan_parent_interface", "A VLAN cannot be its own parent interface")
+		}
+		if req.VlanTag == nil {
+			invalid("vlan_tag", "This field is required for VLAN interfaces")
+		} else if *req.VlanTag < 1 || *req.VlanTag > 4094 {
+			invalid("vlan_tag", "VLAN tag must be between 1 and 4094")
+		}
+		if req.VlanPcp != nil && (*req.VlanPcp < 0 || *req.VlanPcp > 7) {
+			invalid("vlan_pcp", "Priority code point must be between 0 and 7")
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return newValidationError(fields)
+}
+
 // UpdateInterface updates an existing interface
 func (n *NetworkClient) UpdateInterface(ctx context.Context, id int, req *NetworkInterfaceUpdateRequest) (*NetworkInterface, error) {
 	var result NetworkInterface
@@ -297,6 +413,11 @@ func (n *NetworkClient) ListStaticRoutes(ctx context.Context) ([]StaticRoute, er
 	return result, err
 }
 
+// ListStaticRoutesWithQuery returns static routes matching q
+func (n *NetworkClient) ListStaticRoutesWithQuery(ctx context.Context, q *Query) ([]StaticRoute, error) {
+	return query[StaticRoute](ctx, n.client, "staticroute.query", q)
+}
+
 // GetStaticRoute returns a specific static route by ID
 func (n *NetworkClient) GetStaticRoute(ctx context.Context, id int) (*StaticRoute, error) {
 	var result []StaticRoute
@@ -798,9 +798,10 @@ func TestNetworkClient_CreateInterface_Error(t *testing.T) {
 	defer client.Close()
 
 	req := &NetworkInterfaceCreateRequest{
-		Name: "invalid",
-		Type: InterfaceTypeVLAN,
-		// Missing required VLAN parameters
+		Name:       "vlan200",
+		Type:       InterfaceTypeVLAN,
+		VlanParent: Ptr("eth9"), // Passes client-side validation but is rejected by the server
+		VlanTag:    Ptr(200),
 	}
 
 	ctx := NewTestContext(t)
@@ -1031,3 +1032,102 @@ func TestNetworkClient_UpdateConfiguration_ServiceAnnouncement(t *testing.T) {
 	assert.False(t, config.ServiceAnnouncement.MDNS)
 	assert.True(t, config.ServiceAnnouncement.WSD)
 }
+
+func TestNewInetAlias(t *testing.T) {
+	t.Parallel()
+	alias, err := NewInetAlias("192.168.1.10/24")
+	require.NoError(t, err)
+	assert.Equal(t, NetworkInterfaceAlias{Type: AliasTypeINET, Address: "192.168.1.10", Netmask: 24}, alias)
+
+	alias, err = NewInetAlias("fd00::10/64")
+	require.NoError(t, err)
+	assert.Equal(t, NetworkInterfaceAlias{Type: AliasTypeINET6, Address: "fd00::10", Netmask: 64}, alias)
+
+	_, err = NewInetAlias("192.168.1.10")
+	assert.Error(t, err)
+	_, err = NewInetAlias("192.168.1.10/33")
+	assert.Error(t, err)
+}
+
+func TestNetworkClient_ValidateInterface(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	alias, err := NewInetAlias("10.0.0.1/24")
+	require.NoError(t, err)
+	assert.NoError(t, client.Network.ValidateInterface(&NetworkInterfaceCreateRequest{
+		Name:       "vlan10",
+		Type:       InterfaceTypeVLAN,
+		VlanParent: Ptr("eth0"),
+		VlanTag:    Ptr(10),
+		Aliases:    []NetworkInterfaceAlias{alias},
+	}))
+
+	tests := []struct {
+		name string
+		req  *NetworkInterfaceCreateRequest
+		want map[string][]string
+	}{
+		{
+			name: "vlan_missing_fields",
+			req:  &NetworkInterfaceCreateRequest{Name: "vlan10", Type: InterfaceTypeVLAN, VlanPcp: Ptr(9)},
+			want: map[string][]string{
+				"vlan_parent_interface": {"This field is required for VLAN interfaces"},
+				"vlan_tag":              {"This field is required for VLAN interfaces"},
+				"vlan_pcp":              {"Priority code point must be between 0 and 7"},
+			},
+		},
+		{
+			name: "vlan_with_lag_ports",
+			req:  &NetworkInterfaceCreateRequest{Name: "vlan10", Type: InterfaceTypeVLAN, VlanParent: Ptr("eth0"), VlanTag: Ptr(5000), LagPorts: []string{"eth1"}},
+			want: map[string][]string{
+				"lag_ports": {"Only link aggregation interfaces have a protocol and ports"},
+				"vlan_tag":  {"VLAN tag must be between 1 and 4094"},
+			},
+		},
+		{
+			name: "lag_duplicate_ports",
+			req:  &NetworkInterfaceCreateRequest{Name: "bond0", Type: InterfaceTypeLinkAgg, LagPorts: []string{"eth1", "eth1"}},
+			want: map[string][]string{
+				"lag_protocol": {"This field is required for link aggregation interfaces"},
+				"lag_ports.1":  {"eth1 is listed more than once"},
+			},
+		},
+		{
+			name: "bridge_member_of_itself",
+			req:  &NetworkInterfaceCreateRequest{Name: "br0", Type: InterfaceTypeBridge, BridgeMembers: []string{"eth1", "br0"}, VlanTag: Ptr(10)},
+			want: map[string][]string{
+				"bridge_members.1":      {"An interface cannot be a member of itself"},
+				"vlan_parent_interface": {"Only VLAN interfaces have a parent interface, tag and priority"},
+			},
+		},
+		{
+			name: "bad_alias",
+			req: &NetworkInterfaceCreateRequest{Type: InterfaceTypeBridge, Aliases: []NetworkInterfaceAlias{
+				{Type: AliasTypeINET6, Address: "10.0.0.1", Netmask: 24},
+				{Type: AliasTypeINET, Address: "10.0.0.2", Netmask: 40},
+			}},
+			want: map[string][]string{
+				"name":      {"This field is required"},
+				"aliases.0": {`Type "INET6" does not match address 10.0.0.1`},
+				"aliases.1": {"Netmask 40 is out of range for 10.0.0.2"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.Network.ValidateInterface(tt.req)
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.want, validationErr.Fields())
+		})
+	}
+
+	// Invalid requests are not sent
+	_, err = client.Network.CreateInterface(NewTestContext(t), &NetworkInterfaceCreateRequest{Name: "br0", Type: InterfaceTypeBridge, LagPorts: []string{"eth1"}})
+	assert.True(t, IsValidation(err))
+}
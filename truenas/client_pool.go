@@ -3,6 +3,7 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // PoolStatus represents pool status values
@@ -68,6 +69,13 @@ type Pool struct {
 	StatusDetail string        `json:"status_detail"`
 	Autotrim     *PoolProperty `json:"autotrim"`
 	IsDecrypted  bool          `json:"is_decrypted"`
+
+	// Capacity in bytes, unset while the pool is offline
+	Size          int64  `json:"size"`
+	Allocated     int64  `json:"allocated"`
+	Free          int64  `json:"free"`
+	Freeing       int64  `json:"freeing"`
+	Fragmentation string `json:"fragmentation"`
 }
 
 // PoolScan represents pool scan information
@@ -98,9 +106,12 @@ type PoolTopologyCreate struct {
 	Data    []VDevCreate `json:"data"`
 	Cache   []VDevCreate `json:"cache,omitempty"`
 	Log     []VDevCreate `json:"log,omitempty"`
-	Spare   []VDevCreate `json:"spare,omitempty"`
+	Spares  []string     `json:"spares,omitempty"` // Hot spare disks
 	Special []VDevCreate `json:"special,omitempty"`
 	Dedup   []VDevCreate `json:"dedup,omitempty"`
+
+	// Deprecated: pool.create does not accept spares as vdevs. Use Spares.
+	Spare []VDevCreate `json:"spare,omitempty"`
 }
 
 // VDev represents a virtual device (for reading topology)
@@ -230,6 +241,39 @@ type PoolScrubTaskRequest struct {
 	Enabled     bool         `json:"enabled"`
 }
 
+// PoolAttachRequest represents parameters for pool.attach
+type PoolAttachRequest struct {
+	TargetVDev            string `json:"target_vdev"` // GUID of the vdev or disk to mirror
+	NewDisk               string `json:"new_disk"`
+	AllowDuplicateSerials bool   `json:"allow_duplicate_serials,omitempty"`
+}
+
+// PoolReplaceRequest represents parameters for pool.replace
+type PoolReplaceRequest struct {
+	Label            string `json:"label"` // GUID of the disk being replaced
+	Disk             string `json:"disk"`
+	Force            bool   `json:"force,omitempty"`
+	PreserveSettings *bool  `json:"preserve_settings,omitempty"`
+}
+
+// PoolResilverConfig represents the resilver priority window. During the window
+// resilvers run at higher priority; outside it they are throttled.
+type PoolResilverConfig struct {
+	ID      int    `json:"id"`
+	Begin   string `json:"begin"` // "HH:MM"
+	End     string `json:"end"`   // "HH:MM"
+	Enabled bool   `json:"enabled"`
+	Weekday []int  `json:"weekday"` // 1 (Monday) through 7 (Sunday)
+}
+
+// PoolResilverUpdateRequest represents parameters for pool.resilver.update
+type PoolResilverUpdateRequest struct {
+	Begin   *string `json:"begin,omitempty"`
+	End     *string `json:"end,omitempty"`
+	Enabled *bool   `json:"enabled,omitempty"`
+	Weekday []int   `json:"weekday,omitempty"`
+}
+
 // List returns all storage pools
 func (p *PoolClient) List(ctx context.Context) ([]Pool, error) {
 	var result []Pool
@@ -237,6 +281,16 @@ func (p *PoolClient) List(ctx context.Context) ([]Pool, error) {
 	return result, err
 }
 
+// ListWithQuery returns storage pools matching q
+func (p *PoolClient) ListWithQuery(ctx context.Context, q *Query) ([]Pool, error) {
+	return query[Pool](ctx, p.client, "pool.query", q)
+}
+
+// ListIter returns an iterator over the storage pools matching q, fetched a page at a time
+func (p *PoolClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Pool, error] {
+	return paginate(ctx, q, p.ListWithQuery)
+}
+
 // Get returns a specific pool by ID
 func (p *PoolClient) Get(ctx context.Context, id int) (*Pool, error) {
 	var result []Pool
@@ -331,6 +385,11 @@ func (p *PoolClient) ListScrubTasks(ctx context.Context) ([]PoolScrubTask, error
 	return result, err
 }
 
+// ListScrubTasksWithQuery returns scheduled scrub tasks matching q
+func (p *PoolClient) ListScrubTasksWithQuery(ctx context.Context, q *Query) ([]PoolScrubTask, error) {
+	return query[PoolScrubTask](ctx, p.client, "pool.scrub.query", q)
+}
+
 // GetScrubTask returns a specific scrub task by ID
 func (p *PoolClient) GetScrubTask(ctx context.Context, id int) (*PoolScrubTask, error) {
 	var result []PoolScrubTask
@@ -381,3 +440,51 @@ func (p *PoolClient) RunScrubAsync(ctx context.Context, poolName, action string)
 	err := p.client.Call(ctx, "pool.scrub.scrub", []any{poolName, action}, &result)
 	return result, err
 }
+
+// Resilver Methods
+
+// GetResilverConfig returns the resilver priority configuration
+func (p *PoolClient) GetResilverConfig(ctx context.Context) (*PoolResilverConfig, error) {
+	var result PoolResilverConfig
+	err := p.client.Call(ctx, "pool.resilver.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateResilverConfig updates the resilver priority configuration
+func (p *PoolClient) UpdateResilverConfig(ctx context.Context, req *PoolResilverUpdateRequest) (*PoolResilverConfig, error) {
+	var result PoolResilverConfig
+	err := p.client.Call(ctx, "pool.resilver.update", []any{*req}, &result)
+	return &result, err
+}
+
+// Disk Operations
+
+// Attach attaches a disk to an existing vdev or single-disk stripe, creating or extending a mirror (asynchronous job)
+func (p *PoolClient) Attach(ctx context.Context, id int, req PoolAttachRequest) error {
+	return p.client.CallJob(ctx, "pool.attach", []any{id, req}, nil)
+}
+
+// Detach detaches a disk, identified by its vdev GUID, from a mirror
+func (p *PoolClient) Detach(ctx context.Context, id int, label string, wipe bool) error {
+	return p.client.Call(ctx, "pool.detach", []any{id, map[string]any{"label": label, "wipe": wipe}}, nil)
+}
+
+// Replace replaces a disk, identified by its vdev GUID, with a new disk and starts a resilver (asynchronous job)
+func (p *PoolClient) Replace(ctx context.Context, id int, req PoolReplaceRequest) error {
+	return p.client.CallJob(ctx, "pool.replace", []any{id, req}, nil)
+}
+
+// Offline takes a disk, identified by its vdev GUID, offline
+func (p *PoolClient) Offline(ctx context.Context, id int, label string) error {
+	return p.client.Call(ctx, "pool.offline", []any{id, map[string]any{"label": label}}, nil)
+}
+
+// Online brings a disk, identified by its vdev GUID, back online
+func (p *PoolClient) Online(ctx context.Context, id int, label string) error {
+	return p.client.Call(ctx, "pool.online", []any{id, map[string]any{"label": label}}, nil)
+}
+
+// Expand grows the pool to use all available space on its disks (asynchronous job)
+func (p *PoolClient) Expand(ctx context.Context, id int) error {
+	return p.client.CallJob(ctx, "pool.expand", []any{id}, nil)
+}
@@ -1,6 +1,7 @@
 package truenas
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -117,6 +118,34 @@ func TestPoolClient_Create(t *testing.T) {
 	assert.Equal(t, 1, pool.ID)
 }
 
+func TestPoolClient_LargeCapacity(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	// 2^53+1 is the smallest integer a float64 cannot represent. Job results are decoded
+	// generically before being decoded into their type, so cover both paths.
+	pool := json.RawMessage(`{"id": 1, "name": "tank", "size": 9007199254740993, "allocated": 9007199254740991, "free": 2}`)
+	server.SetResponse("pool.query", []json.RawMessage{pool})
+	server.SetJobResponse("pool.create", pool)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	pools, err := client.Pool.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	created, err := client.Pool.Create(ctx, PoolCreateRequest{Name: "tank"})
+	require.NoError(t, err)
+
+	for _, p := range []Pool{pools[0], *created} {
+		assert.Equal(t, int64(9007199254740993), p.Size)
+		assert.Equal(t, int64(9007199254740991), p.Allocated)
+		assert.Equal(t, int64(2), p.Free)
+	}
+}
+
 func TestPoolClient_Update(t *testing.T) {
 	t.Parallel()
 	server := NewTestServer(t)
@@ -695,3 +724,134 @@ func TestPoolClient_DeleteScrubTask_MultipleCalls(t *testing.T) {
 	assert.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, 404, apiErr.Code)
 }
+
+func TestPoolClient_GetResilverConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("pool.resilver.config", PoolResilverConfig{
+		ID:      1,
+		Begin:   "18:00",
+		End:     "09:00",
+		Enabled: true,
+		Weekday: []int{1, 2, 3, 4, 5, 6, 7},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	config, err := client.Pool.GetResilverConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "18:00", config.Begin)
+	assert.Equal(t, "09:00", config.End)
+	assert.True(t, config.Enabled)
+	assert.Len(t, config.Weekday, 7)
+}
+
+func TestPoolClient_UpdateResilverConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("pool.resilver.update", PoolResilverConfig{
+		ID:      1,
+		Begin:   "22:00",
+		End:     "06:00",
+		Enabled: true,
+		Weekday: []int{6, 7},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	config, err := client.Pool.UpdateResilverConfig(ctx, &PoolResilverUpdateRequest{
+		Begin:   Ptr("22:00"),
+		End:     Ptr("06:00"),
+		Weekday: []int{6, 7},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "22:00", config.Begin)
+	assert.Equal(t, []int{6, 7}, config.Weekday)
+}
+
+func TestPoolClient_UpdateResilverConfig_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetError("pool.resilver.update", 22, "Invalid time format")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Pool.UpdateResilverConfig(ctx, &PoolResilverUpdateRequest{Begin: Ptr("25:00")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid time format")
+}
+
+func TestPoolClient_DiskOperations(t *testing.T) {
+	t.Parallel()
+	received := make(chan Message, 8)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "pool.attach", "pool.replace", "pool.expand":
+			received <- msg
+			return Message{ID: msg.ID, Result: json.RawMessage(`7`)}, true
+		case "pool.detach", "pool.offline", "pool.online":
+			received <- msg
+			return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+		case "core.get_jobs":
+			return Message{ID: msg.ID, Result: json.RawMessage(`[{"id": 7, "state": "SUCCESS", "result": true}]`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.Pool.Attach(ctx, 1, PoolAttachRequest{TargetVDev: "1234", NewDisk: "sdc"}))
+	require.NoError(t, client.Pool.Detach(ctx, 1, "5678", true))
+	require.NoError(t, client.Pool.Replace(ctx, 1, PoolReplaceRequest{Label: "5678", Disk: "sdd"}))
+	require.NoError(t, client.Pool.Offline(ctx, 1, "5678"))
+	require.NoError(t, client.Pool.Online(ctx, 1, "5678"))
+	require.NoError(t, client.Pool.Expand(ctx, 1))
+
+	expected := []struct {
+		method string
+		params string
+	}{
+		{"pool.attach", `[1, {"target_vdev": "1234", "new_disk": "sdc"}]`},
+		{"pool.detach", `[1, {"label": "5678", "wipe": true}]`},
+		{"pool.replace", `[1, {"label": "5678", "disk": "sdd"}]`},
+		{"pool.offline", `[1, {"label": "5678"}]`},
+		{"pool.online", `[1, {"label": "5678"}]`},
+		{"pool.expand", `[1]`},
+	}
+	for _, want := range expected {
+		msg := <-received
+		assert.Equal(t, want.method, msg.Method)
+		assert.JSONEq(t, want.params, tryMarshal(msg.Params))
+	}
+}
+
+func TestPoolClient_Replace_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("pool.replace", "Disk sdd is already in use")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	err := client.Pool.Replace(ctx, 1, PoolReplaceRequest{Label: "5678", Disk: "sdd"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "already in use")
+}
@@ -0,0 +1,135 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// Built-in roles that can be granted by a privilege
+const (
+	RoleFullAdmin     = "FULL_ADMIN"
+	RoleReadonlyAdmin = "READONLY_ADMIN"
+	RoleSharingAdmin  = "SHARING_ADMIN"
+)
+
+// PrivilegeClient provides methods for role-based access privileges
+type PrivilegeClient struct {
+	client *Client
+}
+
+// NewPrivilegeClient creates a new privilege client
+func NewPrivilegeClient(client *Client) *PrivilegeClient {
+	return &PrivilegeClient{client: client}
+}
+
+// Privilege grants roles to local and directory service groups
+type Privilege struct {
+	ID          int              `json:"id"`
+	BuiltinName *string          `json:"builtin_name"` // Set for privileges created by the system
+	Name        string           `json:"name"`
+	LocalGroups []PrivilegeGroup `json:"local_groups"`
+	DSGroups    []PrivilegeGroup `json:"ds_groups"`
+	Roles       []string         `json:"roles"`
+	WebShell    bool             `json:"web_shell"`
+}
+
+// PrivilegeGroup is a group a privilege applies to
+type PrivilegeGroup struct {
+	ID   int    `json:"id,omitempty"`
+	GID  int    `json:"gid"`
+	SID  string `json:"sid,omitempty"`
+	Name string `json:"name"`
+}
+
+// PrivilegeCreateRequest represents parameters for privilege.create
+type PrivilegeCreateRequest struct {
+	Name        string   `json:"name"`
+	LocalGroups []int    `json:"local_groups"` // GIDs
+	DSGroups    []any    `json:"ds_groups"`    // GIDs or SIDs
+	Roles       []string `json:"roles"`
+	WebShell    bool     `json:"web_shell"`
+}
+
+// PrivilegeUpdateRequest represents parameters for privilege.update
+type PrivilegeUpdateRequest struct {
+	Name        *string  `json:"name,omitempty"`
+	LocalGroups []int    `json:"local_groups,omitempty"`
+	DSGroups    []any    `json:"ds_groups,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+	WebShell    *bool    `json:"web_shell,omitempty"`
+}
+
+// Role is a role that can be granted by a privilege
+type Role struct {
+	Name     string   `json:"name"`
+	Title    string   `json:"title"`
+	Includes []string `json:"includes"` // Roles granted along with this one
+	Builtin  bool     `json:"builtin"`
+}
+
+// List returns all privileges
+func (p *PrivilegeClient) List(ctx context.Context) ([]Privilege, error) {
+	var result []Privilege
+	err := p.client.Call(ctx, "privilege.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns privileges matching q
+func (p *PrivilegeClient) ListWithQuery(ctx context.Context, q *Query) ([]Privilege, error) {
+	return query[Privilege](ctx, p.client, "privilege.query", q)
+}
+
+// ListIter returns an iterator over the privileges matching q, fetched a page at a time
+func (p *PrivilegeClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Privilege, error] {
+	return paginate(ctx, q, p.ListWithQuery)
+}
+
+// Get returns a specific privilege by ID
+func (p *PrivilegeClient) Get(ctx context.Context, id int) (*Privilege, error) {
+	var result []Privilege
+	err := p.client.Call(ctx, "privilege.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("privilege", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates a new privilege
+func (p *PrivilegeClient) Create(ctx context.Context, req *PrivilegeCreateRequest) (*Privilege, error) {
+	r := *req
+	if r.LocalGroups == nil {
+		r.LocalGroups = []int{}
+	}
+	if r.DSGroups == nil {
+		r.DSGroups = []any{}
+	}
+	if r.Roles == nil {
+		r.Roles = []string{}
+	}
+	var result Privilege
+	err := p.client.Call(ctx, "privilege.create", []any{r}, &result)
+	return &result, err
+}
+
+// Update updates an existing privilege
+func (p *PrivilegeClient) Update(ctx context.Context, id int, req *PrivilegeUpdateRequest) (*Privilege, error) {
+	var result Privilege
+	err := p.client.Call(ctx, "privilege.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a privilege. Built-in privileges cannot be deleted.
+func (p *PrivilegeClient) Delete(ctx context.Context, id int) error {
+	return p.client.Call(ctx, "privilege.delete", []any{id}, nil)
+}
+
+// Roles returns the roles that can be granted
+func (p *PrivilegeClient) Roles(ctx context.Context) ([]Role, error) {
+	var result []Role
+	err := p.client.Call(ctx, "privilege.roles", []any{}, &result)
+	return result, err
+}
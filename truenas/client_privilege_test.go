@@ -0,0 +1,130 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPrivilegeClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	require.NotNil(t, client.Privilege)
+	assert.Equal(t, client, client.Privilege.client)
+}
+
+func TestPrivilegeClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "privilege.query" {
+			return Message{ID: msg.ID, Result: json.RawMessage(`[
+				{"id": 1, "builtin_name": "LOCAL_ADMINISTRATOR", "name": "Local Administrator",
+				 "local_groups": [{"id": 41, "gid": 544, "name": "builtin_administrators"}], "ds_groups": [],
+				 "roles": ["FULL_ADMIN"], "web_shell": true},
+				{"id": 2, "builtin_name": null, "name": "Auditors",
+				 "local_groups": [], "ds_groups": [{"gid": 1500001, "sid": "S-1-5-21-1-2-3-1105", "name": "CORP\\auditors"}],
+				 "roles": ["READONLY_ADMIN"], "web_shell": false}
+			]`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	privileges, err := client.Privilege.List(NewTestContext(t))
+	require.NoError(t, err)
+	require.Len(t, privileges, 2)
+	require.NotNil(t, privileges[0].BuiltinName)
+	assert.Equal(t, "LOCAL_ADMINISTRATOR", *privileges[0].BuiltinName)
+	assert.Equal(t, 544, privileges[0].LocalGroups[0].GID)
+	assert.Nil(t, privileges[1].BuiltinName)
+	assert.Equal(t, "S-1-5-21-1-2-3-1105", privileges[1].DSGroups[0].SID)
+	assert.Equal(t, []string{RoleReadonlyAdmin}, privileges[1].Roles)
+}
+
+func TestPrivilegeClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("privilege.query", []Privilege{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	privilege, err := client.Privilege.Get(NewTestContext(t), 99)
+	require.Error(t, err)
+	assert.Nil(t, privilege)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestPrivilegeClient_CreateUpdateDelete(t *testing.T) {
+	t.Parallel()
+	received := make(chan Message, 3)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "privilege.create", "privilege.update":
+			received <- msg
+			return Message{ID: msg.ID, Result: json.RawMessage(`{"id": 3, "name": "Read-only admins", "roles": ["READONLY_ADMIN"]}`)}, true
+		case "privilege.delete":
+			received <- msg
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	privilege, err := client.Privilege.Create(ctx, &PrivilegeCreateRequest{
+		Name:        "Read-only admins",
+		LocalGroups: []int{3000},
+		Roles:       []string{RoleReadonlyAdmin},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, privilege.ID)
+	params, err := json.Marshal((<-received).Params)
+	require.NoError(t, err)
+	// Unset group lists are sent empty, as the middleware requires them
+	assert.JSONEq(t, `[{"name": "Read-only admins", "local_groups": [3000], "ds_groups": [], "roles": ["READONLY_ADMIN"], "web_shell": false}]`, string(params))
+
+	_, err = client.Privilege.Update(ctx, 3, &PrivilegeUpdateRequest{Roles: []string{RoleSharingAdmin}, WebShell: Ptr(true)})
+	require.NoError(t, err)
+	params, err = json.Marshal((<-received).Params)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[3, {"roles": ["SHARING_ADMIN"], "web_shell": true}]`, string(params))
+
+	require.NoError(t, client.Privilege.Delete(ctx, 3))
+	msg := <-received
+	assert.Equal(t, "privilege.delete", msg.Method)
+}
+
+func TestPrivilegeClient_Roles(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("privilege.roles", []Role{
+		{Name: RoleFullAdmin, Title: "Full Admin", Builtin: true},
+		{Name: RoleReadonlyAdmin, Title: "Readonly Admin", Includes: []string{"ALERT_LIST_READ"}, Builtin: true},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	roles, err := client.Privilege.Roles(NewTestContext(t))
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.Equal(t, RoleReadonlyAdmin, roles[1].Name)
+	assert.Equal(t, []string{"ALERT_LIST_READ"}, roles[1].Includes)
+}
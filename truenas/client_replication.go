@@ -0,0 +1,236 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ReplicationDirection represents whether a replication task sends or receives snapshots
+type ReplicationDirection string
+
+const (
+	ReplicationDirectionPush ReplicationDirection = "PUSH"
+	ReplicationDirectionPull ReplicationDirection = "PULL"
+)
+
+// ReplicationTransport represents how a replication task reaches the other system
+type ReplicationTransport string
+
+const (
+	ReplicationTransportSSH       ReplicationTransport = "SSH"
+	ReplicationTransportSSHNetcat ReplicationTransport = "SSH+NETCAT"
+	ReplicationTransportLocal     ReplicationTransport = "LOCAL"
+)
+
+// ReplicationRetentionPolicy represents how long replicated snapshots are kept on the target
+type ReplicationRetentionPolicy string
+
+const (
+	ReplicationRetentionSource ReplicationRetentionPolicy = "SOURCE" // As long as on the source
+	ReplicationRetentionCustom ReplicationRetentionPolicy = "CUSTOM" // LifetimeValue LifetimeUnits
+	ReplicationRetentionNone   ReplicationRetentionPolicy = "NONE"   // Forever
+)
+
+// ReplicationClient provides methods for replication task management
+type ReplicationClient struct {
+	client *Client
+}
+
+// NewReplicationClient creates a new replication client
+func NewReplicationClient(client *Client) *ReplicationClient {
+	return &ReplicationClient{client: client}
+}
+
+// ReplicationTask represents a replication task
+type ReplicationTask struct {
+	ID                      int                        `json:"id"`
+	Name                    string                     `json:"name"`
+	Direction               ReplicationDirection       `json:"direction"`
+	Transport               ReplicationTransport       `json:"transport"`
+	SSHCredentials          *KeychainCredential        `json:"ssh_credentials"`
+	SourceDatasets          []string                   `json:"source_datasets"`
+	TargetDataset           string                     `json:"target_dataset"`
+	Recursive               bool                       `json:"recursive"`
+	Exclude                 []string                   `json:"exclude"`
+	PeriodicSnapshotTasks   []SnapshotTask             `json:"periodic_snapshot_tasks"`
+	NamingSchema            []string                   `json:"naming_schema"`
+	AlsoIncludeNamingSchema []string                   `json:"also_include_naming_schema"`
+	Auto                    bool                       `json:"auto"`
+	Schedule                *Schedule                  `json:"schedule"`
+	RetentionPolicy         ReplicationRetentionPolicy `json:"retention_policy"`
+	LifetimeValue           *int                       `json:"lifetime_value"`
+	LifetimeUnit            *SnapshotLifetimeUnit      `json:"lifetime_unit"`
+	ReadOnly                string                     `json:"readonly"` // SET, REQUIRE or IGNORE
+	Enabled                 bool                       `json:"enabled"`
+	State                   *TaskState                 `json:"state"`
+	Job                     *Job                       `json:"job"`
+}
+
+// ReplicationTaskRequest represents parameters for replication.create and replication.update
+type ReplicationTaskRequest struct {
+	Name                    string                     `json:"name"`
+	Direction               ReplicationDirection       `json:"direction"`
+	Transport               ReplicationTransport       `json:"transport"`
+	SSHCredentials          *SSHCredentialID           `json:"ssh_credentials,omitempty"`
+	SourceDatasets          []string                   `json:"source_datasets"`
+	TargetDataset           string                     `json:"target_dataset"`
+	Recursive               bool                       `json:"recursive"`
+	Exclude                 []string                   `json:"exclude,omitempty"`
+	PeriodicSnapshotTasks   []int                      `json:"periodic_snapshot_tasks,omitempty"`
+	NamingSchema            []string                   `json:"naming_schema,omitempty"`
+	AlsoIncludeNamingSchema []string                   `json:"also_include_naming_schema,omitempty"`
+	Auto                    bool                       `json:"auto"`
+	Schedule                *Schedule                  `json:"schedule,omitempty"` // Runs after each periodic snapshot if nil
+	RetentionPolicy         ReplicationRetentionPolicy `json:"retention_policy"`
+	LifetimeValue           *int                       `json:"lifetime_value,omitempty"`
+	LifetimeUnit            *SnapshotLifetimeUnit      `json:"lifetime_unit,omitempty"`
+	ReadOnly                string                     `json:"readonly,omitempty"`
+	Enabled                 *bool                      `json:"enabled,omitempty"`
+}
+
+// List returns all replication tasks
+func (r *ReplicationClient) List(ctx context.Context) ([]ReplicationTask, error) {
+	var result []ReplicationTask
+	err := r.client.Call(ctx, "replication.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns replication tasks matching q
+func (r *ReplicationClient) ListWithQuery(ctx context.Context, q *Query) ([]ReplicationTask, error) {
+	return query[ReplicationTask](ctx, r.client, "replication.query", q)
+}
+
+// ListIter returns an iterator over the replication tasks matching q, fetched a page at a time
+func (r *ReplicationClient) ListIter(ctx context.Context, q *Query) iter.Seq2[ReplicationTask, error] {
+	return paginate(ctx, q, r.ListWithQuery)
+}
+
+// Get returns a specific replication task by ID
+func (r *ReplicationClient) Get(ctx context.Context, id int) (*ReplicationTask, error) {
+	var result []ReplicationTask
+	err := r.client.Call(ctx, "replication.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("replication_task", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates a new replication task
+func (r *ReplicationClient) Create(ctx context.Context, req *ReplicationTaskRequest) (*ReplicationTask, error) {
+	var result ReplicationTask
+	err := r.client.Call(ctx, "replication.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates an existing replication task
+func (r *ReplicationClient) Update(ctx context.Context, id int, req *ReplicationTaskRequest) (*ReplicationTask, error) {
+	var result ReplicationTask
+	err := r.client.Call(ctx, "replication.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a replication task
+func (r *ReplicationClient) Delete(ctx context.Context, id int) error {
+	return r.client.Call(ctx, "replication.delete", []any{id}, nil)
+}
+
+// Run runs a replication task and waits for it to complete (asynchronous job)
+func (r *ReplicationClient) Run(ctx context.Context, id int) error {
+	return r.client.CallJob(ctx, "replication.run", []any{id}, nil)
+}
+
+// ReplicationSetup holds what ReplicationClient.QuickSetup created
+type ReplicationSetup struct {
+	Connection   *KeychainCredential // Only set by QuickSetupWithConnection
+	SnapshotTask *SnapshotTask
+	Replication  *ReplicationTask
+}
+
+// QuickSetup replicates sourceDataset to targetDataset as the UI's replication wizard does:
+// it creates a recursive periodic snapshot task on schedule, keeping snapshots for two weeks,
+// and a replication task that pushes each snapshot over the SSH connection targetSystem and
+// keeps it on the target as long as on the source. A zero targetSystem replicates to a
+// dataset on the same system.
+//
+// If creating the replication task fails, the snapshot task is deleted again.
+func (r *ReplicationClient) QuickSetup(ctx context.Context, sourceDataset string, targetSystem SSHCredentialID, targetDataset string, schedule Schedule) (*ReplicationSetup, error) {
+	if err := schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("set up replication: %w", err)
+	}
+	return r.quickSetup(ctx, sourceDataset, targetSystem, targetDataset, schedule)
+}
+
+// QuickSetupWithConnection sets up replication like QuickSetup, first creating an SSH
+// connection to the target system and, if conn asks for one, a key pair for it. If a later
+// step fails, the connection and generated key pair are deleted again.
+func (r *ReplicationClient) QuickSetupWithConnection(ctx context.Context, sourceDataset string, conn SSHConnectionSetup, targetDataset string, schedule Schedule) (*ReplicationSetup, error) {
+	if err := schedule.Validate(); err != nil {
+		return nil, fmt.Errorf("set up replication: %w", err)
+	}
+
+	credential, err := r.client.Keychain.SetupSSHConnection(ctx, &conn)
+	if err != nil {
+		return nil, fmt.Errorf("set up replication: set up SSH connection %s: %w", conn.ConnectionName, err)
+	}
+	setup, err := r.quickSetup(ctx, sourceDataset, SSHCredentialID(credential.ID), targetDataset, schedule)
+	if err != nil {
+		ctx := context.WithoutCancel(ctx)
+		if delErr := r.client.Keychain.Delete(ctx, credential.ID, nil); delErr != nil {
+			err = errors.Join(err, fmt.Errorf("delete SSH connection %s: %w", credential.Name, delErr))
+		}
+		if keyID := credential.PrivateKeyID(); conn.PrivateKey.GenerateKey && keyID != 0 {
+			if delErr := r.client.Keychain.Delete(ctx, keyID, nil); delErr != nil {
+				err = errors.Join(err, fmt.Errorf("delete SSH key pair %d: %w", keyID, delErr))
+			}
+		}
+		return nil, err
+	}
+	setup.Connection = credential
+	return setup, nil
+}
+
+func (r *ReplicationClient) quickSetup(ctx context.Context, sourceDataset string, targetSystem SSHCredentialID, targetDataset string, schedule Schedule) (*ReplicationSetup, error) {
+	task, err := r.client.SnapshotTask.Create(ctx, &SnapshotTaskRequest{
+		Dataset:       sourceDataset,
+		Recursive:     true,
+		LifetimeValue: 2,
+		LifetimeUnit:  SnapshotLifetimeWeek,
+		NamingSchema:  DefaultSnapshotNamingSchema,
+		Schedule:      schedule,
+		Enabled:       Ptr(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set up replication: create snapshot task for %s: %w", sourceDataset, err)
+	}
+
+	req := &ReplicationTaskRequest{
+		Name:                  sourceDataset + " - " + targetDataset,
+		Direction:             ReplicationDirectionPush,
+		Transport:             ReplicationTransportLocal,
+		SourceDatasets:        []string{sourceDataset},
+		TargetDataset:         targetDataset,
+		Recursive:             true,
+		PeriodicSnapshotTasks: []int{task.ID},
+		Auto:                  true,
+		RetentionPolicy:       ReplicationRetentionSource,
+		Enabled:               Ptr(true),
+	}
+	if targetSystem != 0 {
+		req.Transport = ReplicationTransportSSH
+		req.SSHCredentials = &targetSystem
+	}
+	replication, err := r.Create(ctx, req)
+	if err != nil {
+		err = fmt.Errorf("set up replication: create replication task %s: %w", req.Name, err)
+		if delErr := r.client.SnapshotTask.Delete(context.WithoutCancel(ctx), task.ID); delErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("delete snapshot task %d: %w", task.ID, delErr))
+		}
+		return nil, err
+	}
+	return &ReplicationSetup{SnapshotTask: task, Replication: replication}, nil
+}
@@ -0,0 +1,250 @@
+package truenas
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testHourly = Schedule{Minute: "0", Hour: "*", DOM: "*", Month: "*", DOW: "*"}
+
+func TestReplicationClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("replication.query", json.RawMessage(`[{
+		"id": 1,
+		"name": "tank/data - backup/data",
+		"direction": "PUSH",
+		"transport": "SSH",
+		"ssh_credentials": {"id": 4, "name": "backup-nas", "type": "SSH_CREDENTIALS", "attributes": {"host": "10.0.0.2", "private_key": 3}},
+		"source_datasets": ["tank/data"],
+		"target_dataset": "backup/data",
+		"recursive": true,
+		"periodic_snapshot_tasks": [{"id": 2, "dataset": "tank/data", "naming_schema": "auto-%Y-%m-%d_%H-%M"}],
+		"auto": true,
+		"schedule": null,
+		"retention_policy": "SOURCE",
+		"lifetime_value": null,
+		"lifetime_unit": null,
+		"enabled": true,
+		"state": {"state": "ERROR", "error": "No incremental base"},
+		"job": null
+	}]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	tasks, err := client.Replication.List(NewTestContext(t))
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	task := tasks[0]
+	assert.Equal(t, ReplicationTransportSSH, task.Transport)
+	require.NotNil(t, task.SSHCredentials)
+	assert.Equal(t, 3, task.SSHCredentials.PrivateKeyID())
+	require.Len(t, task.PeriodicSnapshotTasks, 1)
+	assert.Equal(t, 2, task.PeriodicSnapshotTasks[0].ID)
+	assert.Nil(t, task.Schedule)
+	assert.Equal(t, ReplicationRetentionSource, task.RetentionPolicy)
+	assert.Equal(t, "No incremental base", task.State.Error)
+}
+
+func TestReplicationClient_Run(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("replication.run", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	require.NoError(t, client.Replication.Run(NewTestContext(t), 1))
+	server.AssertCalled(t, "replication.run", 1)
+}
+
+func setQuickSetupResponses(server *TestServer) {
+	server.SetResponse("keychaincredential.setup_ssh_connection", KeychainCredential{
+		ID:         4,
+		Name:       "backup-nas",
+		Type:       KeychainCredentialTypeSSHCredentials,
+		Attributes: map[string]any{"private_key": 3},
+	})
+	server.SetResponse("keychaincredential.delete", nil)
+	server.SetResponse("pool.snapshottask.create", SnapshotTask{ID: 2, Dataset: "tank/data"})
+	server.SetResponse("pool.snapshottask.delete", true)
+	server.SetResponse("replication.create", ReplicationTask{ID: 1, Name: "tank/data - backup/data"})
+}
+
+func TestReplicationClient_QuickSetup(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setQuickSetupResponses(server)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	setup, err := client.Replication.QuickSetup(NewTestContext(t), "tank/data", 4, "backup/data", testHourly)
+	require.NoError(t, err)
+	assert.Nil(t, setup.Connection)
+	assert.Equal(t, 2, setup.SnapshotTask.ID)
+	assert.Equal(t, 1, setup.Replication.ID)
+
+	server.AssertCalled(t, "pool.snapshottask.create", SnapshotTaskRequest{
+		Dataset:       "tank/data",
+		Recursive:     true,
+		LifetimeValue: 2,
+		LifetimeUnit:  SnapshotLifetimeWeek,
+		NamingSchema:  DefaultSnapshotNamingSchema,
+		Schedule:      testHourly,
+		Enabled:       Ptr(true),
+	})
+	server.AssertCalled(t, "replication.create", ReplicationTaskRequest{
+		Name:                  "tank/data - backup/data",
+		Direction:             ReplicationDirectionPush,
+		Transport:             ReplicationTransportSSH,
+		SSHCredentials:        Ptr(SSHCredentialID(4)),
+		SourceDatasets:        []string{"tank/data"},
+		TargetDataset:         "backup/data",
+		Recursive:             true,
+		PeriodicSnapshotTasks: []int{2},
+		Auto:                  true,
+		RetentionPolicy:       ReplicationRetentionSource,
+		Enabled:               Ptr(true),
+	})
+	server.AssertNotCalled(t, "keychaincredential.setup_ssh_connection")
+}
+
+func TestReplicationClient_QuickSetup_Local(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setQuickSetupResponses(server)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Replication.QuickSetup(NewTestContext(t), "tank/data", 0, "backup/data", testHourly)
+	require.NoError(t, err)
+
+	calls := server.Calls("replication.create")
+	require.Len(t, calls, 1)
+	req, ok := calls[0].Params[0].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "LOCAL", req["transport"])
+	assert.NotContains(t, req, "ssh_credentials")
+}
+
+func TestReplicationClient_QuickSetup_InvalidSchedule(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Replication.QuickSetup(NewTestContext(t), "tank/data", 4, "backup/data", Schedule{Minute: "61", Hour: "*", DOM: "*", Month: "*", DOW: "*"})
+	require.Error(t, err)
+	server.AssertNotCalled(t, "pool.snapshottask.create")
+}
+
+func TestReplicationClient_QuickSetupWithConnection(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setQuickSetupResponses(server)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	conn := SSHConnectionSetup{
+		PrivateKey:     SSHConnectionPrivateKey{GenerateKey: true, Name: "backup-nas-key"},
+		ConnectionName: "backup-nas",
+		SetupType:      SSHConnectionSetupSemiAutomatic,
+		SemiAutomaticSetup: &SSHSemiAutomaticSetup{
+			URL:           "https://10.0.0.2",
+			AdminUsername: "admin",
+			Password:      "secret",
+		},
+	}
+	setup, err := client.Replication.QuickSetupWithConnection(NewTestContext(t), "tank/data", conn, "backup/data", testHourly)
+	require.NoError(t, err)
+	require.NotNil(t, setup.Connection)
+	assert.Equal(t, 4, setup.Connection.ID)
+
+	server.AssertCalled(t, "keychaincredential.setup_ssh_connection", conn)
+	calls := server.Calls("replication.create")
+	require.Len(t, calls, 1)
+	assert.Equal(t, float64(4), calls[0].Params[0].(map[string]any)["ssh_credentials"])
+	server.AssertNotCalled(t, "keychaincredential.delete")
+}
+
+func TestReplicationClient_QuickSetupWithConnection_CleansUp(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setQuickSetupResponses(server)
+	server.SetError("replication.create", 22, "Target dataset does not exist")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	conn := SSHConnectionSetup{
+		PrivateKey:     SSHConnectionPrivateKey{GenerateKey: true, Name: "backup-nas-key"},
+		ConnectionName: "backup-nas",
+		SetupType:      SSHConnectionSetupManual,
+		ManualSetup:    &SSHManualSetup{Host: "10.0.0.2", RemoteHostKey: "ssh-ed25519 AAAA"},
+	}
+	setup, err := client.Replication.QuickSetupWithConnection(NewTestContext(t), "tank/data", conn, "backup/data", testHourly)
+	require.Error(t, err)
+	assert.Nil(t, setup)
+	assert.ErrorContains(t, err, "create replication task tank/data - backup/data")
+
+	var apiErr *ErrorMsg
+	assert.ErrorAs(t, err, &apiErr)
+	server.AssertCalled(t, "pool.snapshottask.delete", 2)
+	calls := server.Calls("keychaincredential.delete")
+	require.Len(t, calls, 2)
+	assert.Equal(t, []any{float64(4)}, calls[0].Params)
+	assert.Equal(t, []any{float64(3)}, calls[1].Params)
+}
+
+func TestReplicationClient_QuickSetupWithConnection_CleanupFails(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setQuickSetupResponses(server)
+	server.SetError("replication.create", 22, "Target dataset does not exist")
+	server.SetResponseFunc("keychaincredential.delete", func(params []any) any {
+		if fmt.Sprint(params[0]) == "4" {
+			return &ErrorMsg{Code: 16, Message: "Credential is in use"}
+		}
+		return true
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	conn := SSHConnectionSetup{
+		PrivateKey:     SSHConnectionPrivateKey{GenerateKey: true, Name: "backup-nas-key"},
+		ConnectionName: "backup-nas",
+		SetupType:      SSHConnectionSetupManual,
+		ManualSetup:    &SSHManualSetup{Host: "10.0.0.2", RemoteHostKey: "ssh-ed25519 AAAA"},
+	}
+	_, err := client.Replication.QuickSetupWithConnection(NewTestContext(t), "tank/data", conn, "backup/data", testHourly)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "create replication task tank/data - backup/data")
+	assert.ErrorContains(t, err, "delete SSH connection")
+	assert.NotContains(t, err.Error(), "delete SSH key pair")
+
+	// The generated key pair is deleted even though deleting the connection failed
+	calls := server.Calls("keychaincredential.delete")
+	require.Len(t, calls, 2)
+	assert.Equal(t, []any{float64(3)}, calls[1].Params)
+}
@@ -0,0 +1,261 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+)
+
+// ReportingGraphName identifies a reporting graph
+type ReportingGraphName string
+
+const (
+	ReportingGraphCPU       ReportingGraphName = "cpu"
+	ReportingGraphCPUTemp   ReportingGraphName = "cputemp"
+	ReportingGraphDisk      ReportingGraphName = "disk"
+	ReportingGraphDiskTemp  ReportingGraphName = "disktemp"
+	ReportingGraphInterface ReportingGraphName = "interface"
+	ReportingGraphLoad      ReportingGraphName = "load"
+	ReportingGraphMemory    ReportingGraphName = "memory"
+	ReportingGraphSwap      ReportingGraphName = "swap"
+	ReportingGraphUptime    ReportingGraphName = "uptime"
+	ReportingGraphProcesses ReportingGraphName = "processes"
+	ReportingGraphARCSize   ReportingGraphName = "arcsize"
+	ReportingGraphARCRate   ReportingGraphName = "arcrate"
+	ReportingGraphARCResult ReportingGraphName = "arcresult"
+	ReportingGraphNFSStat   ReportingGraphName = "nfsstat"
+	ReportingGraphUPS       ReportingGraphName = "ups"
+)
+
+// ReportingUnit represents a relative time range for reporting data
+type ReportingUnit string
+
+const (
+	ReportingUnitHour  ReportingUnit = "HOUR"
+	ReportingUnitDay   ReportingUnit = "DAY"
+	ReportingUnitWeek  ReportingUnit = "WEEK"
+	ReportingUnitMonth ReportingUnit = "MONTH"
+	ReportingUnitYear  ReportingUnit = "YEAR"
+)
+
+// ReportingClient provides methods for reporting configuration and metrics retrieval
+type ReportingClient struct {
+	client *Client
+}
+
+// NewReportingClient creates a new reporting client
+func NewReportingClient(client *Client) *ReportingClient {
+	return &ReportingClient{client: client}
+}
+
+// ReportingConfig represents the reporting configuration
+type ReportingConfig struct {
+	ID                        int    `json:"id"`
+	CPUInPercentage           bool   `json:"cpu_in_percentage"`
+	Graphite                  string `json:"graphite"`
+	GraphiteSeparateInstances bool   `json:"graphite_separateinstances"`
+	GraphAge                  int    `json:"graph_age"`    // Months of data to keep
+	GraphPoints               int    `json:"graph_points"` // Points per graph
+}
+
+// ReportingConfigUpdateRequest represents parameters for reporting.update
+type ReportingConfigUpdateRequest struct {
+	CPUInPercentage           *bool   `json:"cpu_in_percentage,omitempty"`
+	Graphite                  *string `json:"graphite,omitempty"`
+	GraphiteSeparateInstances *bool   `json:"graphite_separateinstances,omitempty"`
+	GraphAge                  *int    `json:"graph_age,omitempty"`
+	GraphPoints               *int    `json:"graph_points,omitempty"`
+	ConfirmRRDDestroy         *bool   `json:"confirm_rrd_destroy,omitempty"` // Required when changing GraphAge or GraphPoints
+}
+
+// ReportingGraph describes an available reporting graph
+type ReportingGraph struct {
+	Name          ReportingGraphName `json:"name"`
+	Title         string             `json:"title"`
+	VerticalLabel string             `json:"vertical_label"`
+	Identifiers   []string           `json:"identifiers"` // Instances such as disk or interface names; nil for single-instance graphs
+}
+
+// ReportingGraphRequest selects a graph, and optionally one of its identifiers, for reporting.get_data
+type ReportingGraphRequest struct {
+	Name       ReportingGraphName `json:"name"`
+	Identifier string             `json:"identifier,omitempty"`
+}
+
+// ReportingQuery represents the time range of a reporting.get_data request.
+// Either Unit (with optional Page) or Start/End as Unix timestamps is used.
+type ReportingQuery struct {
+	Unit      ReportingUnit `json:"unit,omitempty"`
+	Page      int           `json:"page,omitempty"`
+	Start     int64         `json:"start,omitempty"`
+	End       int64         `json:"end,omitempty"`
+	Aggregate *bool         `json:"aggregate,omitempty"`
+}
+
+// ReportingData represents the series returned for one graph
+type ReportingData struct {
+	Name         ReportingGraphName     `json:"name"`
+	Identifier   *string                `json:"identifier"`
+	Data         [][]*float64           `json:"data"` // One row per step; gaps are null
+	Start        int64                  `json:"start"`
+	End          int64                  `json:"end"`
+	Step         int                    `json:"step"`
+	Legend       []string               `json:"legend"`
+	Aggregations *ReportingAggregations `json:"aggregations,omitempty"`
+}
+
+// ReportingAggregations holds per-legend aggregate values of a series
+type ReportingAggregations struct {
+	Min  []*float64 `json:"min"`
+	Max  []*float64 `json:"max"`
+	Mean []*float64 `json:"mean"`
+}
+
+// GetConfig returns the reporting configuration
+func (r *ReportingClient) GetConfig(ctx context.Context) (*ReportingConfig, error) {
+	var result ReportingConfig
+	err := r.client.Call(ctx, "reporting.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig updates the reporting configuration
+func (r *ReportingClient) UpdateConfig(ctx context.Context, req *ReportingConfigUpdateRequest) (*ReportingConfig, error) {
+	var result ReportingConfig
+	err := r.client.Call(ctx, "reporting.update", []any{*req}, &result)
+	return &result, err
+}
+
+// Graphs returns the available reporting graphs
+func (r *ReportingClient) Graphs(ctx context.Context) ([]ReportingGraph, error) {
+	var result []ReportingGraph
+	err := r.client.Call(ctx, "reporting.graphs", []any{}, &result)
+	return result, err
+}
+
+// GetData returns the data series for the requested graphs over the given time range
+func (r *ReportingClient) GetData(ctx context.Context, graphs []ReportingGraphRequest, q *ReportingQuery) ([]ReportingData, error) {
+	var result []ReportingData
+	params := []any{graphs}
+	if q != nil {
+		params = append(params, *q)
+	}
+	err := r.client.Call(ctx, "reporting.get_data", params, &result)
+	return result, err
+}
+
+// RealtimeSample represents one sample pushed by the reporting.realtime event
+type RealtimeSample struct {
+	CPU           RealtimeCPUStats             `json:"cpu"`
+	VirtualMemory RealtimeMemory               `json:"virtual_memory"`
+	Interfaces    map[string]RealtimeInterface `json:"interfaces"`
+	Disks         RealtimeDisks                `json:"disks"`
+	ZFS           RealtimeZFS                  `json:"zfs"`
+}
+
+// RealtimeCPUStats holds per-core CPU usage keyed by core number, plus "average"
+type RealtimeCPUStats map[string]RealtimeCPU
+
+// UnmarshalJSON skips non-object entries such as temperature readings that share the cpu key
+func (c *RealtimeCPUStats) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	stats := make(RealtimeCPUStats, len(raw))
+	for name, v := range raw {
+		var cpu RealtimeCPU
+		if json.Unmarshal(v, &cpu) == nil {
+			stats[name] = cpu
+		}
+	}
+	*c = stats
+	return nil
+}
+
+// RealtimeCPU represents CPU time percentages of one core or the average of all cores
+type RealtimeCPU struct {
+	User    float64 `json:"user"`
+	Nice    float64 `json:"nice"`
+	System  float64 `json:"system"`
+	Idle    float64 `json:"idle"`
+	IOWait  float64 `json:"iowait"`
+	IRQ     float64 `json:"irq"`
+	SoftIRQ float64 `json:"softirq"`
+	Steal   float64 `json:"steal"`
+	Usage   float64 `json:"usage"`
+}
+
+// RealtimeMemory represents system memory usage in bytes
+type RealtimeMemory struct {
+	Total     int64   `json:"total"`
+	Available int64   `json:"available"`
+	Used      int64   `json:"used"`
+	Free      int64   `json:"free"`
+	Percent   float64 `json:"percent"`
+}
+
+// RealtimeInterface represents the throughput of a network interface
+type RealtimeInterface struct {
+	LinkState         string  `json:"link_state"`
+	Speed             *int    `json:"speed"`
+	ReceivedBytesRate float64 `json:"received_bytes_rate"`
+	SentBytesRate     float64 `json:"sent_bytes_rate"`
+}
+
+// RealtimeDisks represents aggregated disk I/O
+type RealtimeDisks struct {
+	ReadOps    float64 `json:"read_ops"`
+	ReadBytes  float64 `json:"read_bytes"`
+	WriteOps   float64 `json:"write_ops"`
+	WriteBytes float64 `json:"write_bytes"`
+	Busy       float64 `json:"busy"`
+}
+
+// RealtimeZFS represents ARC statistics
+type RealtimeZFS struct {
+	ARCMaxSize    int64   `json:"arc_max_size"`
+	ARCSize       int64   `json:"arc_size"`
+	CacheHitRatio float64 `json:"cache_hit_ratio"`
+}
+
+// StreamRealtime subscribes to reporting.realtime and delivers decoded samples on the returned
+// channel until ctx is cancelled or the subscription ends, after which the channel is closed.
+// Samples that cannot be decoded are skipped.
+func (r *ReportingClient) StreamRealtime(ctx context.Context) (<-chan RealtimeSample, error) {
+	sub, err := r.client.Subscribe.Watch(ctx, "reporting.realtime")
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make(chan RealtimeSample)
+	go func() {
+		defer close(samples)
+		defer func() {
+			unsubCtx, cancel := context.WithTimeout(context.Background(), r.client.opts.DefaultWriteTimeout)
+			defer cancel()
+			_ = sub.Unsubscribe(unsubCtx)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				var sample RealtimeSample
+				if err := event.Unmarshal(&sample); err != nil {
+					r.client.logConnection(slog.LevelWarn, "truenas realtime sample dropped", "event", sub.Name(), "error", err)
+					continue
+				}
+				select {
+				case samples <- sample:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return samples, nil
+}
@@ -0,0 +1,169 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReportingClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	reportingClient := NewReportingClient(client)
+	require.NotNil(t, reportingClient)
+	assert.Equal(t, client, reportingClient.client)
+}
+
+func TestReportingClient_Config(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	config := ReportingConfig{ID: 1, CPUInPercentage: true, Graphite: "graphite.example.com", GraphAge: 12, GraphPoints: 1200}
+	server.SetResponse("reporting.config", config)
+	server.SetResponse("reporting.update", config)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	got, err := client.Reporting.GetConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "graphite.example.com", got.Graphite)
+	assert.Equal(t, 12, got.GraphAge)
+
+	updated, err := client.Reporting.UpdateConfig(ctx, &ReportingConfigUpdateRequest{Graphite: Ptr("graphite.example.com")})
+	require.NoError(t, err)
+	assert.True(t, updated.CPUInPercentage)
+}
+
+func TestReportingClient_Graphs(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("reporting.graphs", json.RawMessage(`[
+		{"name": "cpu", "title": "CPU Usage", "vertical_label": "%CPU", "identifiers": null},
+		{"name": "disk", "title": "Disk I/O ({identifier})", "vertical_label": "Kibibytes/s", "identifiers": ["sda", "sdb"]}
+	]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	graphs, err := client.Reporting.Graphs(ctx)
+	require.NoError(t, err)
+	require.Len(t, graphs, 2)
+	assert.Equal(t, ReportingGraphCPU, graphs[0].Name)
+	assert.Nil(t, graphs[0].Identifiers)
+	assert.Equal(t, []string{"sda", "sdb"}, graphs[1].Identifiers)
+}
+
+func TestReportingClient_GetData(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "reporting.get_data" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`[{
+				"name": "interface",
+				"identifier": "eth0",
+				"data": [[1024.5, 2048.0], [null, 512.25]],
+				"start": 1700000000,
+				"end": 1700003600,
+				"step": 10,
+				"legend": ["rx", "tx"],
+				"aggregations": {"min": [1024.5, 512.25], "max": [1024.5, 2048.0], "mean": [1024.5, null]}
+			}]`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	data, err := client.Reporting.GetData(ctx,
+		[]ReportingGraphRequest{{Name: ReportingGraphInterface, Identifier: "eth0"}},
+		&ReportingQuery{Unit: ReportingUnitHour},
+	)
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+
+	series := data[0]
+	assert.Equal(t, "eth0", value(series.Identifier))
+	assert.Equal(t, []string{"rx", "tx"}, series.Legend)
+	require.Len(t, series.Data, 2)
+	assert.Nil(t, series.Data[1][0])
+	assert.Equal(t, 512.25, value(series.Data[1][1]))
+	require.NotNil(t, series.Aggregations)
+	assert.Nil(t, series.Aggregations.Mean[1])
+
+	assert.JSONEq(t, `[[{"name": "interface", "identifier": "eth0"}], {"unit": "HOUR"}]`, tryMarshal(<-received))
+}
+
+func TestReportingClient_StreamRealtime(t *testing.T) {
+	t.Parallel()
+	events := []map[string]any{
+		{"msg": "added", "collection": "reporting.realtime", "fields": map[string]any{
+			"cpu": map[string]any{
+				"0":                   map[string]any{"user": 10.5, "system": 2.5, "idle": 87.0, "usage": 13.0},
+				"average":             map[string]any{"user": 8.0, "idle": 90.0, "usage": 10.0},
+				"temperature_celsius": []any{41.0},
+			},
+			"virtual_memory": map[string]any{"total": 17179869184, "available": 8589934592, "percent": 50.0},
+			"interfaces": map[string]any{
+				"eth0": map[string]any{"link_state": "LINK_STATE_UP", "speed": 1000, "received_bytes_rate": 2048.0, "sent_bytes_rate": 1024.0},
+			},
+			"disks": map[string]any{"read_ops": 12.0, "write_bytes": 4096.0, "busy": 1.5},
+			"zfs":   map[string]any{"arc_size": 4294967296, "cache_hit_ratio": 0.97},
+		}},
+	}
+	unsubbed := make(chan string, 1)
+	server := newEventServer(t, events, unsubbed)
+	defer server.Close()
+
+	client, err := NewClient(strings.Replace(server.URL, "http://", "ws://", 1)+"/websocket", Options{})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	streamCtx, cancel := context.WithCancel(ctx)
+	samples, err := client.Reporting.StreamRealtime(streamCtx)
+	require.NoError(t, err)
+
+	select {
+	case sample := <-samples:
+		require.Contains(t, sample.CPU, "0")
+		assert.Equal(t, 13.0, sample.CPU["0"].Usage)
+		assert.Equal(t, 10.0, sample.CPU["average"].Usage)
+		assert.NotContains(t, sample.CPU, "temperature_celsius")
+		assert.Equal(t, int64(17179869184), sample.VirtualMemory.Total)
+		assert.Equal(t, 2048.0, sample.Interfaces["eth0"].ReceivedBytesRate)
+		assert.Equal(t, 1000, value(sample.Interfaces["eth0"].Speed))
+		assert.Equal(t, 4096.0, sample.Disks.WriteBytes)
+		assert.Equal(t, 0.97, sample.ZFS.CacheHitRatio)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for sample")
+	}
+
+	cancel()
+	select {
+	case <-unsubbed:
+	case <-ctx.Done():
+		t.Fatal("server did not receive unsub")
+	}
+	_, open := <-samples
+	assert.False(t, open)
+}
@@ -1,8 +1,20 @@
 package truenas
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"iter"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Service states reported in Service.State
+const (
+	ServiceStateRunning = "RUNNING"
+	ServiceStateStopped = "STOPPED"
 )
 
 // ServiceClient provides methods for service management
@@ -36,6 +48,16 @@ func (s *ServiceClient) List(ctx context.Context) ([]Service, error) {
 	return result, err
 }
 
+// ListWithQuery returns services matching q
+func (s *ServiceClient) ListWithQuery(ctx context.Context, q *Query) ([]Service, error) {
+	return query[Service](ctx, s.client, "service.query", q)
+}
+
+// ListIter returns an iterator over the services matching q, fetched a page at a time
+func (s *ServiceClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Service, error] {
+	return paginate(ctx, q, s.ListWithQuery)
+}
+
 // Get returns a specific service by ID
 func (s *ServiceClient) Get(ctx context.Context, id int) (*Service, error) {
 	var result []Service
@@ -117,6 +139,181 @@ func (s *ServiceClient) Started(ctx context.Context, serviceName string) (bool,
 	return result, err
 }
 
+// SetEnabled sets whether a service starts at boot
+func (s *ServiceClient) SetEnabled(ctx context.Context, serviceName string, enable bool) (*Service, error) {
+	service, err := s.GetByName(ctx, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	return s.Update(ctx, service.ID, ServiceUpdateRequest{Enable: enable})
+}
+
+// WaitForState polls a service until it reaches state, such as ServiceStateRunning
+// or ServiceStateStopped, or ctx is done
+func (s *ServiceClient) WaitForState(ctx context.Context, serviceName string, state string) (*Service, error) {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		service, err := s.GetByName(ctx, serviceName)
+		if err != nil {
+			return nil, err
+		}
+		if service.State == state {
+			return service, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return service, fmt.Errorf("wait for %s to be %s: %w", serviceName, state, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// serviceRestartAfter lists, for services restarted together by RestartMany, the services
+// whose restart they must follow: SMB and NFS read the Kerberos and ID mapping configuration
+// a directory service join or Kerberos change writes
+var serviceRestartAfter = map[string][]string{
+	"cifs":  {"kerberos", "idmap"},
+	"idmap": {"kerberos"},
+	"nfs":   {"kerberos"},
+}
+
+// Defaults for RestartManyOptions
+const (
+	defaultRestartConcurrency = 2
+	defaultRestartTimeout     = 30 * time.Second
+)
+
+// RestartManyOptions represents options for ServiceClient.RestartMany
+type RestartManyOptions struct {
+	Concurrency int           // Services restarted at once, 2 if 0
+	Timeout     time.Duration // How long each service may take to be RUNNING again, 30s if 0
+}
+
+// ServiceRestartResult is the outcome of restarting one service with RestartMany
+type ServiceRestartResult struct {
+	Service string
+	State   string // State after the restart, empty for services service.query does not list
+	Err     error
+}
+
+// ServiceRestartResults holds the outcomes of RestartMany in the order the services were restarted
+type ServiceRestartResults []ServiceRestartResult
+
+// Err joins the errors of all services that failed to restart, or returns nil if none did
+func (r ServiceRestartResults) Err() error {
+	var errs []error
+	for _, result := range r {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Service, result.Err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// RestartMany restarts services, such as "cifs" after joining Active Directory or "nfs"
+// after a Kerberos change, and waits for each to be RUNNING again. Services that depend
+// on others in names are restarted after them, and are skipped if those fail; the rest
+// are restarted up to opts.Concurrency at a time. opts may be nil.
+//
+// Every service gets a result; the returned error joins the errors of those that failed.
+func (s *ServiceClient) RestartMany(ctx context.Context, names []string, opts *RestartManyOptions) (ServiceRestartResults, error) {
+	opts = cmp.Or(opts, &RestartManyOptions{})
+	concurrency := cmp.Or(opts.Concurrency, defaultRestartConcurrency)
+	timeout := cmp.Or(opts.Timeout, defaultRestartTimeout)
+
+	var results ServiceRestartResults
+	failed := make(map[string]bool)
+	for _, stage := range restartStages(names) {
+		stageResults := make(ServiceRestartResults, len(stage))
+		sem := make(chan struct{}, concurrency)
+		var wg sync.WaitGroup
+		for i, name := range stage {
+			stageResults[i].Service = name
+			if dep := slices.IndexFunc(serviceRestartAfter[name], func(dep string) bool { return failed[dep] }); dep >= 0 {
+				stageResults[i].Err = fmt.Errorf("skipped because %s failed to restart", serviceRestartAfter[name][dep])
+				continue
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				stageResults[i] = s.restartAndWait(ctx, name, timeout)
+			}()
+		}
+		wg.Wait()
+		for _, result := range stageResults {
+			if result.Err != nil {
+				failed[result.Service] = true
+			}
+		}
+		results = append(results, stageResults...)
+	}
+	return results, results.Err()
+}
+
+// restartAndWait restarts a service and waits up to timeout for it to be RUNNING
+func (s *ServiceClient) restartAndWait(ctx context.Context, name string, timeout time.Duration) ServiceRestartResult {
+	result := ServiceRestartResult{Service: name}
+	if result.Err = s.Restart(ctx, name); result.Err != nil {
+		return result
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	service, err := s.WaitForState(ctx, name, ServiceStateRunning)
+	switch {
+	case IsNotFound(err):
+		// Internal services such as kerberos are not listed and cannot be checked
+	case err != nil:
+		result.Err = err
+		if service != nil {
+			result.State = service.State
+		}
+	default:
+		result.State = service.State
+	}
+	return result
+}
+
+// restartStages groups names, without duplicates, into stages that each follow the services
+// they depend on according to serviceRestartAfter. Names keep their order within a stage.
+func restartStages(names []string) [][]string {
+	restarting := make(map[string]bool, len(names))
+	for _, name := range names {
+		restarting[name] = true
+	}
+	var depth func(name string, seen map[string]bool) int
+	depth = func(name string, seen map[string]bool) int {
+		d := 0
+		seen[name] = true
+		for _, dep := range serviceRestartAfter[name] {
+			if restarting[dep] && !seen[dep] {
+				d = max(d, depth(dep, seen)+1)
+			}
+		}
+		delete(seen, name)
+		return d
+	}
+
+	var stages [][]string
+	done := make(map[string]bool)
+	for _, name := range names {
+		if done[name] {
+			continue
+		}
+		done[name] = true
+		d := depth(name, map[string]bool{})
+		for len(stages) <= d {
+			stages = append(stages, nil)
+		}
+		stages[d] = append(stages[d], name)
+	}
+	return stages
+}
+
 // SMB Service Methods
 
 // SMBClient provides methods for SMB service management
@@ -132,6 +329,7 @@ func NewSMBClient(client *Client) *SMBClient {
 // SMBConfig represents SMB service configuration
 type SMBConfig struct {
 	NetBIOSName        string   `json:"netbiosname"`
+	NetBIOSAlias       []string `json:"netbiosalias,omitempty"`
 	Workgroup          string   `json:"workgroup"`
 	Description        string   `json:"description"`
 	UnixCharset        string   `json:"unixcharset"`
@@ -161,20 +359,34 @@ type SMBConfig struct {
 	AuditIgnoreList    []string `json:"audit_ignore_list"`
 }
 
-// GetSMBConfig returns SMB service configuration
+// GetConfig returns SMB service configuration
 func (s *SMBClient) GetConfig(ctx context.Context) (*SMBConfig, error) {
 	var result SMBConfig
 	err := s.client.Call(ctx, "smb.config", []any{}, &result)
 	return &result, err
 }
 
-// UpdateSMBConfig updates SMB service configuration
+// UpdateConfig updates SMB service configuration
 func (s *SMBClient) UpdateConfig(ctx context.Context, config *SMBConfig) (*SMBConfig, error) {
 	var result SMBConfig
 	err := s.client.Call(ctx, "smb.update", []any{*config}, &result)
 	return &result, err
 }
 
+// BindIPChoices returns the IP addresses the SMB service can bind to
+func (s *SMBClient) BindIPChoices(ctx context.Context) (map[string]string, error) {
+	var result map[string]string
+	err := s.client.Call(ctx, "smb.bindip_choices", []any{}, &result)
+	return result, err
+}
+
+// UnixCharsetChoices returns the character sets available for SMBConfig.UnixCharset
+func (s *SMBClient) UnixCharsetChoices(ctx context.Context) (map[string]string, error) {
+	var result map[string]string
+	err := s.client.Call(ctx, "smb.unixcharset_choices", []any{}, &result)
+	return result, err
+}
+
 // NFS Service Methods
 
 // NFSClient provides methods for NFS service management
@@ -187,36 +399,60 @@ func NewNFSClient(client *Client) *NFSClient {
 	return &NFSClient{client: client}
 }
 
+// NFSProtocol represents an NFS protocol version the service can serve
+type NFSProtocol string
+
+const (
+	NFSProtocolV3 NFSProtocol = "NFSV3"
+	NFSProtocolV4 NFSProtocol = "NFSV4"
+)
+
 // NFSConfig represents NFS service configuration
 type NFSConfig struct {
-	V4             bool     `json:"v4"`
-	V4V3Owner      bool     `json:"v4_v3owner"`
-	V4KrbEnabled   bool     `json:"v4_krb"`
-	V4Domain       string   `json:"v4_domain"`
-	BindIP         []string `json:"bindip"`
-	MountdPort     int      `json:"mountd_port"`
-	RpcstatdPort   int      `json:"rpcstatd_port"`
-	RpclockdPort   int      `json:"rpclockd_port"`
-	Servers        int      `json:"servers"`
-	UDPEnabled     bool     `json:"udp"`
-	RPCGSSEnabled  bool     `json:"rpcgssd_enable"`
-	UserdMaxGroups int      `json:"userd_manage_groups"`
-}
-
-// GetNFSConfig returns NFS service configuration
+	V4             bool          `json:"v4"`
+	Protocols      []NFSProtocol `json:"protocols,omitempty"` // Replaces V4 on TrueNAS 23.10 and later
+	V4V3Owner      bool          `json:"v4_v3owner"`
+	V4KrbEnabled   bool          `json:"v4_krb"` // Require Kerberos authentication for NFSv4
+	V4Domain       string        `json:"v4_domain"`
+	BindIP         []string      `json:"bindip"`
+	MountdPort     int           `json:"mountd_port"`
+	RpcstatdPort   int           `json:"rpcstatd_port"`
+	RpclockdPort   int           `json:"rpclockd_port"`
+	Servers        int           `json:"servers"`
+	UDPEnabled     bool          `json:"udp"`
+	RPCGSSEnabled  bool          `json:"rpcgssd_enable"`
+	UserdMaxGroups int           `json:"userd_manage_groups"`
+}
+
+// HasProtocol reports whether the service is configured to serve protocol
+func (c *NFSConfig) HasProtocol(protocol NFSProtocol) bool {
+	if len(c.Protocols) == 0 {
+		return protocol == NFSProtocolV3 || (protocol == NFSProtocolV4 && c.V4)
+	}
+	return slices.Contains(c.Protocols, protocol)
+}
+
+// GetConfig returns NFS service configuration
 func (n *NFSClient) GetConfig(ctx context.Context) (*NFSConfig, error) {
 	var result NFSConfig
 	err := n.client.Call(ctx, "nfs.config", []any{}, &result)
 	return &result, err
 }
 
-// UpdateNFSConfig updates NFS service configuration
+// UpdateConfig updates NFS service configuration
 func (n *NFSClient) UpdateConfig(ctx context.Context, config *NFSConfig) (*NFSConfig, error) {
 	var result NFSConfig
 	err := n.client.Call(ctx, "nfs.update", []any{*config}, &result)
 	return &result, err
 }
 
+// BindIPChoices returns the IP addresses the NFS service can bind to
+func (n *NFSClient) BindIPChoices(ctx context.Context) (map[string]string, error) {
+	var result map[string]string
+	err := n.client.Call(ctx, "nfs.bindip_choices", []any{}, &result)
+	return result, err
+}
+
 // SSH Service Methods
 
 // SSHClient provides methods for SSH service management
@@ -257,3 +493,139 @@ func (s *SSHClient) UpdateConfig(ctx context.Context, config *SSHConfig) (*SSHCo
 	err := s.client.Call(ctx, "ssh.update", []any{*config}, &result)
 	return &result, err
 }
+
+// SNMP Service Methods
+
+// SNMPAuthType represents the SNMPv3 authentication protocol
+type SNMPAuthType string
+
+const (
+	SNMPAuthTypeNone SNMPAuthType = ""
+	SNMPAuthTypeMD5  SNMPAuthType = "MD5"
+	SNMPAuthTypeSHA  SNMPAuthType = "SHA"
+)
+
+// SNMPPrivProto represents the SNMPv3 privacy protocol
+type SNMPPrivProto string
+
+const (
+	SNMPPrivProtoNone SNMPPrivProto = ""
+	SNMPPrivProtoAES  SNMPPrivProto = "AES"
+	SNMPPrivProtoDES  SNMPPrivProto = "DES"
+)
+
+// SNMPClient provides methods for SNMP service management
+type SNMPClient struct {
+	client *Client
+}
+
+// NewSNMPClient creates a new SNMP client
+func NewSNMPClient(client *Client) *SNMPClient {
+	return &SNMPClient{client: client}
+}
+
+// SNMPConfig represents SNMP service configuration
+type SNMPConfig struct {
+	Location         string        `json:"location"`
+	Contact          string        `json:"contact"`
+	Traps            bool          `json:"traps"`
+	Community        string        `json:"community"`
+	V3               bool          `json:"v3"`
+	V3Username       string        `json:"v3_username"`
+	V3AuthType       SNMPAuthType  `json:"v3_authtype"`
+	V3Password       string        `json:"v3_password"`
+	V3PrivProto      SNMPPrivProto `json:"v3_privproto"`
+	V3PrivPassphrase string        `json:"v3_privpassphrase"`
+	Options          string        `json:"options"` // Auxiliary snmpd.conf parameters
+	ZILStat          bool          `json:"zilstat"`
+	LogLevel         int           `json:"loglevel"`
+}
+
+// GetConfig returns SNMP service configuration
+func (s *SNMPClient) GetConfig(ctx context.Context) (*SNMPConfig, error) {
+	var result SNMPConfig
+	err := s.client.Call(ctx, "snmp.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig updates SNMP service configuration
+func (s *SNMPClient) UpdateConfig(ctx context.Context, config *SNMPConfig) (*SNMPConfig, error) {
+	var result SNMPConfig
+	err := s.client.Call(ctx, "snmp.update", []any{*config}, &result)
+	return &result, err
+}
+
+// Dynamic DNS Service Methods
+
+// DynDNSProvider identifies a dynamic DNS provider as inadyn names it. ProviderChoices
+// returns all providers the server supports.
+type DynDNSProvider string
+
+const (
+	DynDNSProviderDynDNS     DynDNSProvider = "default@dyndns.org"
+	DynDNSProviderFreeDNS    DynDNSProvider = "default@freedns.afraid.org"
+	DynDNSProviderNoIP       DynDNSProvider = "default@no-ip.com"
+	DynDNSProviderDNSOMatic  DynDNSProvider = "default@dnsomatic.com"
+	DynDNSProviderHE         DynDNSProvider = "ipv6tb@he.net"
+	DynDNSProviderChangeIP   DynDNSProvider = "default@changeip.com"
+	DynDNSProviderOVH        DynDNSProvider = "default@ovh.com"
+	DynDNSProviderDynv6      DynDNSProvider = "default@dynv6.com"
+	DynDNSProviderDuckDNS    DynDNSProvider = "default@duckdns.org"
+	DynDNSProviderCloudflare DynDNSProvider = "default@cloudflare.com"
+	DynDNSProviderCustom     DynDNSProvider = "custom" // CustomDDNSServer and CustomDDNSPath
+)
+
+// DynDNSClient provides methods for dynamic DNS service management
+type DynDNSClient struct {
+	client *Client
+}
+
+// NewDynDNSClient creates a new dynamic DNS client
+func NewDynDNSClient(client *Client) *DynDNSClient {
+	return &DynDNSClient{client: client}
+}
+
+// DynDNSConfig represents dynamic DNS service configuration
+type DynDNSConfig struct {
+	Provider         DynDNSProvider `json:"provider"`
+	CheckIPSSL       bool           `json:"checkip_ssl"`
+	CheckIPServer    string         `json:"checkip_server"` // Server that reports the public IP address, such as "checkip.dyndns.org"
+	CheckIPPath      string         `json:"checkip_path"`
+	SSL              bool           `json:"ssl"`
+	CustomDDNSServer string         `json:"custom_ddns_server"`
+	CustomDDNSPath   string         `json:"custom_ddns_path"` // Such as "/update?hostname=%h&myip=%i"
+	Domain           []string       `json:"domain"`           // Hostnames to update
+	Username         string         `json:"username"`
+	Password         string         `json:"password"`
+	Period           int            `json:"period"` // Seconds between checks of the IP address
+}
+
+// GetConfig returns dynamic DNS service configuration
+func (d *DynDNSClient) GetConfig(ctx context.Context) (*DynDNSConfig, error) {
+	var result DynDNSConfig
+	err := d.client.Call(ctx, "dyndns.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig updates dynamic DNS service configuration. The service must be started
+// separately, such as with Service.Start(ctx, "dynamicdns").
+func (d *DynDNSClient) UpdateConfig(ctx context.Context, config *DynDNSConfig) (*DynDNSConfig, error) {
+	var result DynDNSConfig
+	err := d.client.Call(ctx, "dyndns.update", []any{*config}, &result)
+	return &result, err
+}
+
+// ProviderChoices returns the providers the server supports with their display names
+func (d *DynDNSClient) ProviderChoices(ctx context.Context) (map[DynDNSProvider]string, error) {
+	var result map[DynDNSProvider]string
+	err := d.client.Call(ctx, "dyndns.provider_choices", []any{}, &result)
+	return result, err
+}
+
+// CheckIPChoices returns the servers that can report the public IP address, keyed as
+// "server:path" for DynDNSConfig.CheckIPServer and CheckIPPath
+func (d *DynDNSClient) CheckIPChoices(ctx context.Context) (map[string]string, error) {
+	var result map[string]string
+	err := d.client.Call(ctx, "dyndns.checkip_choices", []any{}, &result)
+	return result, err
+}
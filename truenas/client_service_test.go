@@ -1,7 +1,13 @@
 package truenas
 
 import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -262,6 +268,27 @@ func TestSMBClient_UpdateConfig(t *testing.T) {
 	assert.False(t, updated.UseSendfile)
 }
 
+func TestSMBClient_Choices(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("smb.bindip_choices", map[string]string{"192.168.1.10": "192.168.1.10"})
+	server.SetResponse("smb.unixcharset_choices", map[string]string{"UTF-8": "UTF-8", "ISO-8859-1": "ISO-8859-1"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	bindIPs, err := client.SMB.BindIPChoices(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"192.168.1.10": "192.168.1.10"}, bindIPs)
+
+	charsets, err := client.SMB.UnixCharsetChoices(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, charsets, "UTF-8")
+	assert.Len(t, charsets, 2)
+}
+
 // NFSClient Tests
 func TestNFSClient_GetConfig(t *testing.T) {
 	t.Parallel()
@@ -322,6 +349,31 @@ func TestNFSClient_UpdateConfig(t *testing.T) {
 	assert.True(t, updated.UDPEnabled)
 }
 
+func TestNFSConfig_HasProtocol(t *testing.T) {
+	t.Parallel()
+	legacy := &NFSConfig{V4: true}
+	assert.True(t, legacy.HasProtocol(NFSProtocolV3))
+	assert.True(t, legacy.HasProtocol(NFSProtocolV4))
+
+	v4Only := &NFSConfig{Protocols: []NFSProtocol{NFSProtocolV4}}
+	assert.False(t, v4Only.HasProtocol(NFSProtocolV3))
+	assert.True(t, v4Only.HasProtocol(NFSProtocolV4))
+}
+
+func TestNFSClient_BindIPChoices(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("nfs.bindip_choices", map[string]string{"10.0.0.5": "10.0.0.5"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	choices, err := client.NFS.BindIPChoices(NewTestContext(t))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"10.0.0.5": "10.0.0.5"}, choices)
+}
+
 // SSHClient Tests
 func TestSSHClient_GetConfig(t *testing.T) {
 	t.Parallel()
@@ -401,3 +453,305 @@ func TestServiceClient_ErrorHandling(t *testing.T) {
 	assert.Equal(t, 404, apiErr.Code)
 	assert.Equal(t, "Service not found", apiErr.Message)
 }
+
+// SNMPClient Tests
+func TestSNMPClient_GetConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	mockConfig := &SNMPConfig{
+		Location:    "rack 4",
+		Contact:     "ops@example.com",
+		Community:   "public",
+		V3:          true,
+		V3Username:  "monitor",
+		V3AuthType:  SNMPAuthTypeSHA,
+		V3PrivProto: SNMPPrivProtoAES,
+		LogLevel:    3,
+	}
+	server.SetResponse("snmp.config", mockConfig)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	config, err := client.SNMP.GetConfig(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.True(t, config.V3)
+	assert.Equal(t, "monitor", config.V3Username)
+	assert.Equal(t, SNMPAuthTypeSHA, config.V3AuthType)
+	assert.Equal(t, SNMPPrivProtoAES, config.V3PrivProto)
+}
+
+func TestSNMPClient_UpdateConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	mockConfig := &SNMPConfig{
+		V3:               true,
+		V3Username:       "monitor",
+		V3AuthType:       SNMPAuthTypeSHA,
+		V3Password:       "authpassword",
+		V3PrivProto:      SNMPPrivProtoAES,
+		V3PrivPassphrase: "privpassphrase",
+	}
+	server.SetResponse("snmp.update", mockConfig)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	updated, err := client.SNMP.UpdateConfig(ctx, mockConfig)
+	require.NoError(t, err)
+	require.NotNil(t, updated)
+	assert.Equal(t, SNMPPrivProtoAES, updated.V3PrivProto)
+	assert.Equal(t, "privpassphrase", updated.V3PrivPassphrase)
+}
+
+// DynDNSClient Tests
+func TestDynDNSClient_GetConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("dyndns.config", map[string]any{
+		"id":             1,
+		"provider":       "default@cloudflare.com",
+		"checkip_ssl":    true,
+		"checkip_server": "checkip.dyndns.org",
+		"checkip_path":   "/",
+		"ssl":            true,
+		"domain":         []string{"nas.example.com"},
+		"username":       "token",
+		"password":       "secret",
+		"period":         300,
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	config, err := client.DynDNS.GetConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, DynDNSProviderCloudflare, config.Provider)
+	assert.Equal(t, []string{"nas.example.com"}, config.Domain)
+	assert.Equal(t, "checkip.dyndns.org", config.CheckIPServer)
+	assert.Equal(t, 300, config.Period)
+}
+
+func TestDynDNSClient_UpdateConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	var received []any
+	server.SetResponseFunc("dyndns.update", func(params []any) any {
+		received = params
+		return params[0]
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	updated, err := client.DynDNS.UpdateConfig(ctx, &DynDNSConfig{
+		Provider:         DynDNSProviderCustom,
+		SSL:              true,
+		CustomDDNSServer: "ddns.example.com",
+		CustomDDNSPath:   "/update?hostname=%h&myip=%i",
+		Domain:           []string{"nas.example.com"},
+		Username:         "nas",
+		Password:         "secret",
+		Period:           600,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, DynDNSProviderCustom, updated.Provider)
+	assert.Equal(t, "ddns.example.com", updated.CustomDDNSServer)
+
+	require.Len(t, received, 1)
+	params := received[0].(map[string]any)
+	assert.Equal(t, "custom", params["provider"])
+	assert.Equal(t, "/update?hostname=%h&myip=%i", params["custom_ddns_path"])
+	assert.Equal(t, []any{"nas.example.com"}, params["domain"])
+}
+
+func TestDynDNSClient_Choices(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("dyndns.provider_choices", map[string]string{
+		"default@duckdns.org": "duckdns.org",
+		"custom":              "Custom Provider",
+	})
+	server.SetResponse("dyndns.checkip_choices", map[string]string{
+		"checkip.dyndns.org:/": "checkip.dyndns.org",
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	providers, err := client.DynDNS.ProviderChoices(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "duckdns.org", providers[DynDNSProviderDuckDNS])
+	assert.Contains(t, providers, DynDNSProviderCustom)
+
+	checkIP, err := client.DynDNS.CheckIPChoices(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"checkip.dyndns.org:/": "checkip.dyndns.org"}, checkIP)
+}
+
+func TestServiceClient_WaitForState(t *testing.T) {
+	t.Parallel()
+	var polls atomic.Int32
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method != "service.query" {
+			return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+		}
+		state := ServiceStateStopped
+		if polls.Add(1) >= 3 {
+			state = ServiceStateRunning
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`[{"id": 4, "service": "cifs", "enable": true, "state": "` + state + `"}]`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.Service.Start(ctx, "cifs"))
+	service, err := client.Service.WaitForState(ctx, "cifs", ServiceStateRunning)
+	require.NoError(t, err)
+	assert.Equal(t, ServiceStateRunning, service.State)
+	assert.EqualValues(t, 3, polls.Load())
+}
+
+func TestServiceClient_WaitForState_Timeout(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("service.query", []Service{{ID: 4, Service: "cifs", State: ServiceStateStopped}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(NewTestContext(t), 700*time.Millisecond)
+	defer cancel()
+	_, err := client.Service.WaitForState(ctx, "cifs", ServiceStateRunning)
+	assert.Error(t, err)
+}
+
+func TestServiceClient_SetEnabled(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("service.query", []Service{{ID: 4, Service: "cifs", Enable: true, State: ServiceStateStopped}})
+	server.SetResponse("service.update", 4)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	service, err := client.Service.SetEnabled(ctx, "cifs", true)
+	require.NoError(t, err)
+	assert.Equal(t, 4, service.ID)
+	assert.True(t, service.Enable)
+}
+
+// serviceResponses makes service.restart record the restarted services, failing for those in
+// fail, and service.query report every service but kerberos and idmap in state
+func serviceResponses(server *TestServer, state string, fail ...string) func() []string {
+	var mu sync.Mutex
+	var restarted []string
+	server.SetResponseFunc("service.restart", func(params []any) any {
+		name := params[0].(string)
+		mu.Lock()
+		restarted = append(restarted, name)
+		mu.Unlock()
+		if slices.Contains(fail, name) {
+			return &ErrorMsg{Code: 22, Message: name + " failed to start"}
+		}
+		return true
+	})
+	server.SetResponseFunc("service.query", func(params []any) any {
+		name := params[0].([]any)[0].([]any)[2].(string)
+		if name == "kerberos" || name == "idmap" {
+			return []any{}
+		}
+		return []Service{{ID: 1, Service: name, State: state}}
+	})
+	return func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(restarted)
+	}
+}
+
+func TestServiceClient_RestartMany(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	restarted := serviceResponses(server, ServiceStateRunning)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	results, err := client.Service.RestartMany(NewTestContext(t), []string{"nfs", "cifs", "kerberos", "ssh", "idmap", "ssh"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ServiceRestartResults{
+		{Service: "kerberos"},
+		{Service: "ssh", State: ServiceStateRunning},
+		{Service: "nfs", State: ServiceStateRunning},
+		{Service: "idmap"},
+		{Service: "cifs", State: ServiceStateRunning},
+	}, results)
+
+	order := restarted()
+	require.Len(t, order, 5)
+	assert.ElementsMatch(t, []string{"kerberos", "ssh"}, order[:2])
+	assert.ElementsMatch(t, []string{"nfs", "idmap"}, order[2:4])
+	assert.Equal(t, "cifs", order[4])
+}
+
+func TestServiceClient_RestartMany_DependencyFails(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	restarted := serviceResponses(server, ServiceStateRunning, "kerberos")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	results, err := client.Service.RestartMany(NewTestContext(t), []string{"cifs", "nfs", "kerberos", "ssh"}, &RestartManyOptions{Concurrency: 1})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "kerberos failed to start")
+	assert.ErrorContains(t, err, "nfs: skipped because kerberos failed to restart")
+	require.Len(t, results, 4)
+	assert.Equal(t, []string{"kerberos", "ssh", "cifs", "nfs"}, []string{results[0].Service, results[1].Service, results[2].Service, results[3].Service})
+	assert.NoError(t, results[1].Err)
+	assert.Error(t, results[2].Err)
+	assert.Error(t, results[3].Err)
+	assert.ElementsMatch(t, []string{"kerberos", "ssh"}, restarted())
+}
+
+func TestServiceClient_RestartMany_NotRunning(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	serviceResponses(server, ServiceStateStopped)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	results, err := client.Service.RestartMany(NewTestContext(t), []string{"ssh"}, &RestartManyOptions{Timeout: 100 * time.Millisecond})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Len(t, results, 1)
+	assert.Equal(t, ServiceStateStopped, results[0].State)
+}
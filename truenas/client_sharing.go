@@ -3,6 +3,11 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"iter"
+	"net"
+	"net/netip"
+	"path"
+	"strings"
 )
 
 // SharingClient provides methods for managing file shares across all protocols
@@ -25,9 +30,119 @@ func NewSharingClient(client *Client) *SharingClient {
 	}
 }
 
+// ShareProtocol is the protocol of a Share
+type ShareProtocol string
+
+const (
+	ShareProtocolSMB    ShareProtocol = "SMB"
+	ShareProtocolNFS    ShareProtocol = "NFS"
+	ShareProtocolISCSI  ShareProtocol = "ISCSI"
+	ShareProtocolWebDAV ShareProtocol = "WEBDAV"
+	ShareProtocolAFP    ShareProtocol = "AFP"
+)
+
+// Share is a share of any protocol, as listed by SharingClient.ListAll
+type Share struct {
+	Type    ShareProtocol
+	ID      int    // ID of the share, or of the extent for iSCSI
+	Name    string // Empty for NFS exports, which are identified by path
+	Path    string // Shared path, or the zvol such as "zvol/tank/lun0" for iSCSI extents backed by one
+	Enabled bool
+
+	// Consumers are the hosts and networks allowed to use the share, empty if any may, or
+	// the targets exposing an iSCSI extent
+	Consumers []string
+}
+
+// iscsiExtent is an iSCSI extent as returned by iscsi.extent.query
+type iscsiExtent struct {
+	ID      int    `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"` // DISK or FILE
+	Disk    string `json:"disk"`
+	Path    string `json:"path"`
+	Enabled bool   `json:"enabled"`
+}
+
+// iscsiTargetExtent associates an iSCSI extent with a target, as returned by iscsi.targetextent.query
+type iscsiTargetExtent struct {
+	Target int `json:"target"`
+	Extent int `json:"extent"`
+}
+
+// iscsiTarget is an iSCSI target as returned by iscsi.target.query
+type iscsiTarget struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ListAll returns the shares of every protocol the server supports in a single batch of
+// calls: SMB, NFS, iSCSI extents, and WebDAV and AFP on versions that still have them
+func (s *SharingClient) ListAll(ctx context.Context) ([]Share, error) {
+	var (
+		smb           []SMBShare
+		nfs           []NFSShare
+		extents       []iscsiExtent
+		targetExtents []iscsiTargetExtent
+		targets       []iscsiTarget
+		webdav        []WebDAVShare
+		afp           []AFPShare
+	)
+	batch := s.client.Batch()
+	batch.Add("sharing.smb.query", nil, &smb)
+	batch.Add("sharing.nfs.query", nil, &nfs)
+	batch.Add("iscsi.extent.query", nil, &extents)
+	batch.Add("iscsi.targetextent.query", nil, &targetExtents)
+	batch.Add("iscsi.target.query", nil, &targets)
+	if s.client.Supports(CapabilityWebDAV) {
+		batch.Add("sharing.webdav.query", nil, &webdav)
+	}
+	if !s.AFP.routeToSMB() {
+		batch.Add("sharing.afp.query", nil, &afp)
+	}
+	if err := batch.Do(ctx); err != nil {
+		return nil, fmt.Errorf("list shares: %w", err)
+	}
+
+	var shares []Share
+	for _, share := range smb {
+		shares = append(shares, Share{Type: ShareProtocolSMB, ID: share.ID, Name: share.Name, Path: share.Path, Enabled: share.Enabled, Consumers: share.HostsAllow})
+	}
+	for _, share := range nfs {
+		consumers := append(append([]string{}, share.Hosts...), share.Networks...)
+		shares = append(shares, Share{Type: ShareProtocolNFS, ID: share.ID, Path: share.Path, Enabled: share.Enabled, Consumers: consumers})
+	}
+
+	targetNames := make(map[int]string, len(targets))
+	for _, target := range targets {
+		targetNames[target.ID] = target.Name
+	}
+	extentTargets := make(map[int][]string)
+	for _, te := range targetExtents {
+		extentTargets[te.Extent] = append(extentTargets[te.Extent], targetNames[te.Target])
+	}
+	for _, extent := range extents {
+		p := extent.Path
+		if extent.Type == "DISK" {
+			p = extent.Disk
+		}
+		shares = append(shares, Share{Type: ShareProtocolISCSI, ID: extent.ID, Name: extent.Name, Path: p, Enabled: extent.Enabled, Consumers: extentTargets[extent.ID]})
+	}
+
+	for _, share := range webdav {
+		shares = append(shares, Share{Type: ShareProtocolWebDAV, ID: share.ID, Name: share.Name, Path: share.Path, Enabled: share.Enabled})
+	}
+	for _, share := range afp {
+		shares = append(shares, Share{Type: ShareProtocolAFP, ID: share.ID, Name: share.Name, Path: share.Path, Enabled: share.Enabled, Consumers: share.HostsAllow})
+	}
+	return shares, nil
+}
+
 // AFP (Apple Filing Protocol) Client
 
-// SharingAFPClient provides methods for AFP share management
+// SharingAFPClient provides methods for AFP share management. AFP is only available on
+// TrueNAS CORE; on SCALE the client routes calls to SMB shares with the Time Machine or
+// multi-protocol AFP preset, which serve macOS clients in its place.
 type SharingAFPClient struct {
 	client *Client
 }
@@ -88,17 +203,88 @@ type AFPShareRequest struct {
 	Enabled          bool     `json:"enabled"`
 }
 
-// List returns all AFP shares
+// afpPurposes are the SMB presets of shares standing in for AFP shares on servers without AFP
+var afpPurposes = []SMBPurpose{SMBPurposeEnhancedTimeMachine, SMBPurposeMultiProtocolAFP}
+
+// routeToSMB reports whether AFP calls are routed to SMB because the server has no AFP service
+func (a *SharingAFPClient) routeToSMB() bool {
+	return !a.client.Supports(CapabilityAFP)
+}
+
+// smbRequest converts an AFP share request to an SMB share request. Time Machine shares use
+// the ENHANCED_TIMEMACHINE preset and other shares use MULTI_PROTOCOL_AFP. Per-user access
+// lists and AFP permission settings have no SMB equivalent and are dropped.
+func (r *AFPShareRequest) smbRequest() *SMBShareRequest {
+	purpose := SMBPurposeMultiProtocolAFP
+	if r.TimeMachine {
+		purpose = SMBPurposeEnhancedTimeMachine
+	}
+	return &SMBShareRequest{
+		Purpose:          purpose,
+		Path:             r.Path,
+		Home:             r.Home,
+		Name:             r.Name,
+		Comment:          r.Comment,
+		Browsable:        true,
+		TimeMachine:      r.TimeMachine,
+		HostsAllow:       r.HostsAllow,
+		HostsDeny:        r.HostsDeny,
+		AAPLNameMangling: true,
+		Streams:          true,
+		Enabled:          r.Enabled,
+	}
+}
+
+// afpShare converts an SMB share standing in for an AFP share back to an AFP share
+func (s *SMBShare) afpShare() AFPShare {
+	return AFPShare{
+		ID:          s.ID,
+		Path:        s.Path,
+		Home:        s.Home,
+		Name:        s.Name,
+		Comment:     s.Comment,
+		TimeMachine: s.TimeMachine,
+		HostsAllow:  s.HostsAllow,
+		HostsDeny:   s.HostsDeny,
+		Enabled:     s.Enabled,
+	}
+}
+
+func afpShares(shares []SMBShare) []AFPShare {
+	result := make([]AFPShare, len(shares))
+	for i := range shares {
+		result[i] = shares[i].afpShare()
+	}
+	return result
+}
+
+// List returns all AFP shares. On servers without AFP it returns the SMB shares created with
+// the ENHANCED_TIMEMACHINE or MULTI_PROTOCOL_AFP presets.
 func (a *SharingAFPClient) List(ctx context.Context) ([]AFPShare, error) {
-	var result []AFPShare
-	err := a.client.Call(ctx, "sharing.afp.query", []any{}, &result)
-	return result, err
+	return a.ListWithQuery(ctx, nil)
+}
+
+// ListWithQuery returns AFP shares matching q. On servers without AFP, q is applied to the
+// SMB shares returned by List.
+func (a *SharingAFPClient) ListWithQuery(ctx context.Context, q *Query) ([]AFPShare, error) {
+	if a.routeToSMB() {
+		shares, err := query[SMBShare](ctx, a.client, "sharing.smb.query", q.withFilter("purpose", "in", afpPurposes))
+		if err != nil {
+			return nil, err
+		}
+		return afpShares(shares), nil
+	}
+	return query[AFPShare](ctx, a.client, "sharing.afp.query", q)
+}
+
+// ListIter returns an iterator over the AFP shares matching q, fetched a page at a time
+func (a *SharingAFPClient) ListIter(ctx context.Context, q *Query) iter.Seq2[AFPShare, error] {
+	return paginate(ctx, q, a.ListWithQuery)
 }
 
 // Get returns a specific AFP share by ID
 func (a *SharingAFPClient) Get(ctx context.Context, id int) (*AFPShare, error) {
-	var result []AFPShare
-	err := a.client.Call(ctx, "sharing.afp.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	result, err := a.ListWithQuery(ctx, NewQuery().Filter("id", "=", id))
 	if err != nil {
 		return nil, err
 	}
@@ -108,22 +294,43 @@ func (a *SharingAFPClient) Get(ctx context.Context, id int) (*AFPShare, error) {
 	return &result[0], nil
 }
 
-// Create creates a new AFP share
+// Create creates a new AFP share. On servers without AFP it creates an SMB share with the
+// Time Machine or multi-protocol AFP preset instead.
 func (a *SharingAFPClient) Create(ctx context.Context, req *AFPShareRequest) (*AFPShare, error) {
+	if a.routeToSMB() {
+		var share SMBShare
+		if err := a.client.Call(ctx, "sharing.smb.create", []any{*req.smbRequest()}, &share); err != nil {
+			return nil, err
+		}
+		result := share.afpShare()
+		return &result, nil
+	}
 	var result AFPShare
 	err := a.client.Call(ctx, "sharing.afp.create", []any{*req}, &result)
 	return &result, err
 }
 
-// Update updates an existing AFP share
+// Update updates an existing AFP share. On servers without AFP it updates the SMB share
+// created in its place.
 func (a *SharingAFPClient) Update(ctx context.Context, id int, req *AFPShareRequest) (*AFPShare, error) {
+	if a.routeToSMB() {
+		var share SMBShare
+		if err := a.client.Call(ctx, "sharing.smb.update", []any{id, *req.smbRequest()}, &share); err != nil {
+			return nil, err
+		}
+		result := share.afpShare()
+		return &result, nil
+	}
 	var result AFPShare
 	err := a.client.Call(ctx, "sharing.afp.update", []any{id, *req}, &result)
 	return &result, err
 }
 
-// Delete deletes an AFP share
+// Delete deletes an AFP share. On servers without AFP it deletes the SMB share created in its place.
 func (a *SharingAFPClient) Delete(ctx context.Context, id int) error {
+	if a.routeToSMB() {
+		return a.client.Call(ctx, "sharing.smb.delete", []any{id}, nil)
+	}
 	return a.client.Call(ctx, "sharing.afp.delete", []any{id}, nil)
 }
 
@@ -179,6 +386,16 @@ func (n *SharingNFSClient) List(ctx context.Context) ([]NFSShare, error) {
 	return result, err
 }
 
+// ListWithQuery returns NFS shares matching q
+func (n *SharingNFSClient) ListWithQuery(ctx context.Context, q *Query) ([]NFSShare, error) {
+	return query[NFSShare](ctx, n.client, "sharing.nfs.query", q)
+}
+
+// ListIter returns an iterator over the NFS shares matching q, fetched a page at a time
+func (n *SharingNFSClient) ListIter(ctx context.Context, q *Query) iter.Seq2[NFSShare, error] {
+	return paginate(ctx, q, n.ListWithQuery)
+}
+
 // Get returns a specific NFS share by ID
 func (n *SharingNFSClient) Get(ctx context.Context, id int) (*NFSShare, error) {
 	var result []NFSShare
@@ -206,6 +423,17 @@ func (n *SharingNFSClient) Update(ctx context.Context, id int, req *NFSShareRequ
 	return &result, err
 }
 
+// Diff returns the fields of NFS share id whose live values differ from those desired sets,
+// sorted by name, such as to show what Update would change. It returns none if the share
+// matches.
+func (n *SharingNFSClient) Diff(ctx context.Context, id int, desired *NFSShareRequest) ([]FieldDiff, error) {
+	share, err := n.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return diffFields(share, desired)
+}
+
 // Delete deletes an NFS share
 func (n *SharingNFSClient) Delete(ctx context.Context, id int) error {
 	return n.client.Call(ctx, "sharing.nfs.delete", []any{id}, nil)
@@ -218,6 +446,107 @@ func (n *SharingNFSClient) GetHumanIdentifier(ctx context.Context, id int) (stri
 	return result, err
 }
 
+// NFSConnectedClient is a host with an NFS mount, as listed by SharingNFSClient.GetClients
+type NFSConnectedClient struct {
+	Version NFSProtocol
+	Address string         // IP address of the host
+	Export  string         // Mounted path, only known for NFSv3 mounts
+	Name    string         // The host's own description, such as "Linux NFSv4.2 host", for NFSv4
+	Info    map[string]any // Client details reported by the kernel, for NFSv4
+}
+
+// GetClients returns the hosts with NFSv3 and NFSv4 mounts, such as to check that an export
+// is unused before disabling it
+func (n *SharingNFSClient) GetClients(ctx context.Context) ([]NFSConnectedClient, error) {
+	var (
+		v3 []struct {
+			IP     string `json:"ip"`
+			Export string `json:"export"`
+		}
+		v4 []struct {
+			Info map[string]any `json:"info"`
+		}
+	)
+	batch := n.client.Batch()
+	batch.Add("nfs.get_nfs3_clients", nil, &v3)
+	batch.Add("nfs.get_nfs4_clients", nil, &v4)
+	if err := batch.Do(ctx); err != nil {
+		return nil, fmt.Errorf("list NFS clients: %w", err)
+	}
+
+	clients := make([]NFSConnectedClient, 0, len(v3)+len(v4))
+	for _, c := range v3 {
+		clients = append(clients, NFSConnectedClient{Version: NFSProtocolV3, Address: c.IP, Export: c.Export})
+	}
+	for _, c := range v4 {
+		address, _ := c.Info["address"].(string)
+		if host, _, err := net.SplitHostPort(address); err == nil {
+			address = host
+		}
+		name, _ := c.Info["name"].(string)
+		clients = append(clients, NFSConnectedClient{Version: NFSProtocolV4, Address: address, Name: name, Info: c.Info})
+	}
+	return clients, nil
+}
+
+// Validate checks a share before it is created: the path must be an existing directory under
+// /mnt without another share, and networks must be CIDR prefixes. Problems with the request
+// are returned as a *ValidationError; other errors come from the calls made to check it.
+func (n *SharingNFSClient) Validate(ctx context.Context, req *NFSShareRequest) error {
+	return n.validate(ctx, "sharingnfs_create", 0, req)
+}
+
+// ValidateUpdate checks a request to update the share with the given ID, as Validate does
+func (n *SharingNFSClient) ValidateUpdate(ctx context.Context, id int, req *NFSShareRequest) error {
+	return n.validate(ctx, "sharingnfs_update", id, req)
+}
+
+func (n *SharingNFSClient) validate(ctx context.Context, schema string, id int, req *NFSShareRequest) error {
+	var fields []FieldError
+	invalid := func(field, message string, errno int) {
+		fields = append(fields, FieldError{Attribute: schema + "." + field, Message: message, Errno: errno})
+	}
+
+	for i, network := range req.Networks {
+		if _, err := netip.ParsePrefix(network); err != nil {
+			invalid(fmt.Sprintf("networks.%d", i), fmt.Sprintf("%q is not a valid network", network), errnoEINVAL)
+		}
+	}
+
+	switch {
+	case req.Path == "":
+		invalid("path", "This field is required", errnoEINVAL)
+	case !strings.HasPrefix(path.Clean(req.Path), "/mnt/"):
+		invalid("path", "Path must reside within a pool under /mnt", errnoEINVAL)
+	default:
+		stat, err := n.client.Filesystem.Stat(ctx, req.Path)
+		switch {
+		case IsNotFound(err):
+			invalid("path", "Path does not exist", errnoENOENT)
+		case err != nil:
+			return fmt.Errorf("stat %s: %w", req.Path, err)
+		case !stat.IsDir:
+			invalid("path", "Path is not a directory", errnoEINVAL)
+		}
+
+		shares, err := n.ListWithQuery(ctx, NewQuery().Filter("path", "=", req.Path))
+		if err != nil {
+			return fmt.Errorf("list shares: %w", err)
+		}
+		for _, share := range shares {
+			if share.ID != id {
+				invalid("path", fmt.Sprintf("Path is already shared by share %d", share.ID), errnoEEXIST)
+				break
+			}
+		}
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+	return newValidationError(fields)
+}
+
 // SMB (Server Message Block) Client
 
 // SharingSMBClient provides methods for SMB share management
@@ -310,6 +639,16 @@ func (s *SharingSMBClient) List(ctx context.Context) ([]SMBShare, error) {
 	return result, err
 }
 
+// ListWithQuery returns SMB shares matching q
+func (s *SharingSMBClient) ListWithQuery(ctx context.Context, q *Query) ([]SMBShare, error) {
+	return query[SMBShare](ctx, s.client, "sharing.smb.query", q)
+}
+
+// ListIter returns an iterator over the SMB shares matching q, fetched a page at a time
+func (s *SharingSMBClient) ListIter(ctx context.Context, q *Query) iter.Seq2[SMBShare, error] {
+	return paginate(ctx, q, s.ListWithQuery)
+}
+
 // Get returns a specific SMB share by ID
 func (s *SharingSMBClient) Get(ctx context.Context, id int) (*SMBShare, error) {
 	var result []SMBShare
@@ -337,6 +676,17 @@ func (s *SharingSMBClient) Update(ctx context.Context, id int, req *SMBShareRequ
 	return &result, err
 }
 
+// Diff returns the fields of SMB share id whose live values differ from those desired sets,
+// sorted by name, such as to show what Update would change. It returns none if the share
+// matches.
+func (s *SharingSMBClient) Diff(ctx context.Context, id int, desired *SMBShareRequest) ([]FieldDiff, error) {
+	share, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return diffFields(share, desired)
+}
+
 // Delete deletes an SMB share (forcibly disconnects clients)
 func (s *SharingSMBClient) Delete(ctx context.Context, id int) error {
 	return s.client.Call(ctx, "sharing.smb.delete", []any{id}, nil)
@@ -349,9 +699,59 @@ func (s *SharingSMBClient) GetPresets(ctx context.Context) ([]SMBPreset, error)
 	return result, err
 }
 
+// CreateTimeMachine shares the dataset mounted at path as a Time Machine destination named
+// name. It enables the Apple SMB2/3 protocol extensions that Time Machine shares require,
+// limits the dataset to quotaGiB if it is positive, and creates the share with the
+// ENHANCED_TIMEMACHINE preset. macOS is told the same limit, so that it prunes old backups
+// before the quota is reached.
+func (s *SharingSMBClient) CreateTimeMachine(ctx context.Context, path, name string, quotaGiB int) (*SMBShare, error) {
+	config, err := s.client.SMB.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create Time Machine share: %w", err)
+	}
+	if !config.AAAPLExtensions {
+		if err := s.client.Call(ctx, "smb.update", []any{map[string]any{"aapl_extensions": true}}, nil); err != nil {
+			return nil, fmt.Errorf("create Time Machine share: enable Apple SMB2/3 extensions: %w", err)
+		}
+	}
+
+	var auxParams string
+	if quotaGiB > 0 {
+		dataset, ok := strings.CutPrefix(path, "/mnt/")
+		if !ok {
+			return nil, fmt.Errorf("create Time Machine share: %s is not a dataset mountpoint", path)
+		}
+		quota := int64(quotaGiB) << 30
+		if _, err := s.client.Dataset.Update(ctx, dataset, DatasetUpdateRequest{Quota: &quota}); err != nil {
+			return nil, fmt.Errorf("create Time Machine share: set quota of %s: %w", dataset, err)
+		}
+		auxParams = fmt.Sprintf("fruit:time machine max size = %dG", quotaGiB)
+	}
+
+	share, err := s.Create(ctx, &SMBShareRequest{
+		Purpose:          SMBPurposeEnhancedTimeMachine,
+		Path:             path,
+		Name:             name,
+		Browsable:        true,
+		TimeMachine:      true,
+		AAPLNameMangling: true,
+		ACL:              true,
+		DurableHandle:    true,
+		ShadowCopy:       true,
+		Streams:          true,
+		AuxSMBConf:       auxParams,
+		Enabled:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create Time Machine share: %w", err)
+	}
+	return share, nil
+}
+
 // WebDAV Client
 
-// SharingWebDAVClient provides methods for WebDAV share management
+// SharingWebDAVClient provides methods for WebDAV share management. WebDAV was removed in
+// SCALE 24.04 without a replacement, so calls to later versions fail with ErrUnsupportedVersion.
 type SharingWebDAVClient struct {
 	client *Client
 }
@@ -389,6 +789,16 @@ func (w *SharingWebDAVClient) List(ctx context.Context) ([]WebDAVShare, error) {
 	return result, err
 }
 
+// ListWithQuery returns WebDAV shares matching q
+func (w *SharingWebDAVClient) ListWithQuery(ctx context.Context, q *Query) ([]WebDAVShare, error) {
+	return query[WebDAVShare](ctx, w.client, "sharing.webdav.query", q)
+}
+
+// ListIter returns an iterator over the WebDAV shares matching q, fetched a page at a time
+func (w *SharingWebDAVClient) ListIter(ctx context.Context, q *Query) iter.Seq2[WebDAVShare, error] {
+	return paginate(ctx, q, w.ListWithQuery)
+}
+
 // Get returns a specific WebDAV share by ID
 func (w *SharingWebDAVClient) Get(ctx context.Context, id int) (*WebDAVShare, error) {
 	var result []WebDAVShare
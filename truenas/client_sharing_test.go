@@ -1,6 +1,8 @@
 package truenas
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -386,6 +388,139 @@ func TestSharingAFPClient_Delete_Error(t *testing.T) {
 	assert.Contains(t, err.Error(), "Share not found")
 }
 
+func TestSharingAFPClient_RoutesToSMB(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.10.1")
+	server.SetResponse("sharing.smb.create", SMBShare{
+		ID:          7,
+		Purpose:     SMBPurposeEnhancedTimeMachine,
+		Path:        "/mnt/tank/afp-share",
+		Name:        "test-afp-share",
+		TimeMachine: true,
+		Enabled:     true,
+	})
+	server.SetResponse("sharing.smb.query", []SMBShare{{ID: 7, Name: "test-afp-share", TimeMachine: true}})
+	server.SetResponse("sharing.smb.delete", true)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	share, err := client.Sharing.AFP.Create(ctx, &TestAFPShareRequest)
+	require.NoError(t, err)
+	assert.Equal(t, 7, share.ID)
+	assert.True(t, share.TimeMachine)
+
+	calls := server.Calls("sharing.smb.create")
+	require.Len(t, calls, 1)
+	raw, err := json.Marshal(calls[0].Params[0])
+	require.NoError(t, err)
+	var req SMBShareRequest
+	require.NoError(t, json.Unmarshal(raw, &req))
+	assert.Equal(t, SMBPurposeEnhancedTimeMachine, req.Purpose)
+	assert.Equal(t, TestAFPShareRequest.Path, req.Path)
+	assert.Equal(t, TestAFPShareRequest.Name, req.Name)
+	assert.True(t, req.TimeMachine)
+
+	shares, err := client.Sharing.AFP.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	assert.Equal(t, "test-afp-share", shares[0].Name)
+	server.AssertCalled(t, "sharing.smb.query",
+		[]any{[]any{"purpose", "in", []string{"ENHANCED_TIMEMACHINE", "MULTI_PROTOCOL_AFP"}}},
+		QueryOptions{})
+
+	require.NoError(t, client.Sharing.AFP.Delete(ctx, 7))
+	server.AssertCalled(t, "sharing.smb.delete", 7)
+	server.AssertNotCalled(t, "sharing.afp.create")
+	server.AssertNotCalled(t, "sharing.afp.query")
+	server.AssertNotCalled(t, "sharing.afp.delete")
+}
+
+func TestSharingAFPClient_CORE(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-13.0-U6.1")
+	server.SetResponse("sharing.afp.create", TestAFPShare)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Sharing.AFP.Create(NewTestContext(t), &TestAFPShareRequest)
+	require.NoError(t, err)
+	server.AssertCalled(t, "sharing.afp.create", TestAFPShareRequest)
+	server.AssertNotCalled(t, "sharing.smb.create")
+}
+
+func TestSharingClient_ListAll(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.10.1")
+	server.SetResponse("sharing.smb.query", []SMBShare{{ID: 1, Name: "media", Path: "/mnt/tank/media", Enabled: true, HostsAllow: []string{"10.0.0.0/24"}}})
+	server.SetResponse("sharing.nfs.query", []NFSShare{{ID: 2, Path: "/mnt/tank/nfs", Hosts: []string{"client1"}, Networks: []string{"10.0.1.0/24"}}})
+	server.SetResponse("iscsi.extent.query", []map[string]any{
+		{"id": 3, "name": "lun0", "type": "DISK", "disk": "zvol/tank/lun0", "enabled": true},
+		{"id": 4, "name": "file0", "type": "FILE", "path": "/mnt/tank/file0", "enabled": true},
+	})
+	server.SetResponse("iscsi.targetextent.query", []map[string]any{{"id": 1, "target": 7, "extent": 3}})
+	server.SetResponse("iscsi.target.query", []map[string]any{{"id": 7, "name": "vmstore"}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	shares, err := client.Sharing.ListAll(NewTestContext(t))
+	require.NoError(t, err)
+	assert.Equal(t, []Share{
+		{Type: ShareProtocolSMB, ID: 1, Name: "media", Path: "/mnt/tank/media", Enabled: true, Consumers: []string{"10.0.0.0/24"}},
+		{Type: ShareProtocolNFS, ID: 2, Path: "/mnt/tank/nfs", Consumers: []string{"client1", "10.0.1.0/24"}},
+		{Type: ShareProtocolISCSI, ID: 3, Name: "lun0", Path: "zvol/tank/lun0", Enabled: true, Consumers: []string{"vmstore"}},
+		{Type: ShareProtocolISCSI, ID: 4, Name: "file0", Path: "/mnt/tank/file0", Enabled: true},
+	}, shares)
+	server.AssertNotCalled(t, "sharing.webdav.query")
+	server.AssertNotCalled(t, "sharing.afp.query")
+}
+
+func TestSharingClient_ListAll_CORE(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-13.0-U6.1")
+	for _, method := range []string{"sharing.smb.query", "sharing.nfs.query", "iscsi.extent.query", "iscsi.targetextent.query", "iscsi.target.query"} {
+		server.SetResponse(method, []any{})
+	}
+	server.SetResponse("sharing.webdav.query", []WebDAVShare{{ID: 1, Name: "dav", Path: "/mnt/tank/dav", Enabled: true}})
+	server.SetResponse("sharing.afp.query", []AFPShare{{ID: 2, Name: "mac", Path: "/mnt/tank/mac", HostsAllow: []string{"10.0.0.5"}}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	shares, err := client.Sharing.ListAll(NewTestContext(t))
+	require.NoError(t, err)
+	assert.Equal(t, []Share{
+		{Type: ShareProtocolWebDAV, ID: 1, Name: "dav", Path: "/mnt/tank/dav", Enabled: true},
+		{Type: ShareProtocolAFP, ID: 2, Name: "mac", Path: "/mnt/tank/mac", Consumers: []string{"10.0.0.5"}},
+	}, shares)
+}
+
+func TestSharingClient_ListAll_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetError("iscsi.extent.query", 500, "iSCSI unavailable")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	shares, err := client.Sharing.ListAll(NewTestContext(t))
+	require.Error(t, err)
+	assert.Nil(t, shares)
+	assert.ErrorContains(t, err, "iscsi.extent.query")
+}
+
 // NFS Sharing Client Tests
 
 func TestSharingNFSClient_List(t *testing.T) {
@@ -572,6 +707,46 @@ func TestSharingNFSClient_Delete(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestSharingNFSClient_GetClients(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("nfs.get_nfs3_clients", []map[string]any{{"ip": "10.0.0.5", "export": "/mnt/tank/nfs"}})
+	server.SetResponse("nfs.get_nfs4_clients", []map[string]any{
+		{"id": "3", "info": map[string]any{"clientid": "0x1", "address": "10.0.0.6:871", "name": "Linux NFSv4.2 host", "minor version": 2}},
+		{"id": "4", "info": map[string]any{"address": "[fd00::7]:760", "name": "FreeBSD NFSv4.1 host"}},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	clients, err := client.Sharing.NFS.GetClients(NewTestContext(t))
+	require.NoError(t, err)
+	require.Len(t, clients, 3)
+	assert.Equal(t, NFSConnectedClient{Version: NFSProtocolV3, Address: "10.0.0.5", Export: "/mnt/tank/nfs"}, clients[0])
+	assert.Equal(t, NFSProtocolV4, clients[1].Version)
+	assert.Equal(t, "10.0.0.6", clients[1].Address)
+	assert.Equal(t, "Linux NFSv4.2 host", clients[1].Name)
+	assert.Equal(t, json.Number("2"), clients[1].Info["minor version"])
+	assert.Equal(t, "fd00::7", clients[2].Address)
+}
+
+func TestSharingNFSClient_GetClients_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("nfs.get_nfs3_clients", []any{})
+	server.SetError("nfs.get_nfs4_clients", 2, "[Errno 2] No such file or directory: '/proc/fs/nfsd/clients'")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Sharing.NFS.GetClients(NewTestContext(t))
+	assert.ErrorContains(t, err, "nfs.get_nfs4_clients")
+}
+
 func TestSharingNFSClient_Delete_Error(t *testing.T) {
 	t.Parallel()
 	server := NewTestServer(t)
@@ -621,6 +796,73 @@ func TestSharingNFSClient_GetHumanIdentifier_Error(t *testing.T) {
 	assert.Contains(t, err.Error(), "Share not found")
 }
 
+// newNFSValidateServer serves a share at /mnt/tank/shared, a directory at /mnt/tank/data
+// and a file at /mnt/tank/file
+func newNFSValidateServer(t *testing.T) *TestServer {
+	return NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		params, _ := json.Marshal(msg.Params)
+		switch msg.Method {
+		case "filesystem.stat":
+			switch string(params) {
+			case `["/mnt/tank/data"]`, `["/mnt/tank/shared"]`:
+				response.Result = json.RawMessage(`{"is_dir": true}`)
+			case `["/mnt/tank/file"]`:
+				response.Result = json.RawMessage(`{"is_file": true}`)
+			default:
+				response.Result = nil
+				response.Error = &ErrorMsg{Code: 2, Errname: "ENOENT", Message: "Path not found"}
+			}
+		case "sharing.nfs.query":
+			if strings.Contains(string(params), "/mnt/tank/shared") {
+				response.Result = json.RawMessage(`[{"id": 3, "path": "/mnt/tank/shared"}]`)
+			} else {
+				response.Result = json.RawMessage(`[]`)
+			}
+		}
+		return response, true
+	}))
+}
+
+func TestSharingNFSClient_Validate(t *testing.T) {
+	t.Parallel()
+	server := newNFSValidateServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	require.NoError(t, client.Sharing.NFS.Validate(ctx, &NFSShareRequest{
+		Path:     "/mnt/tank/data",
+		Networks: []string{"192.168.1.0/24", "fd00::/64"},
+	}))
+	// A share may keep its own path when updated
+	require.NoError(t, client.Sharing.NFS.ValidateUpdate(ctx, 3, &NFSShareRequest{Path: "/mnt/tank/shared"}))
+
+	tests := []struct {
+		name   string
+		req    NFSShareRequest
+		fields map[string][]string
+	}{
+		{"missing_path", NFSShareRequest{}, map[string][]string{"path": {"This field is required"}}},
+		{"outside_pool", NFSShareRequest{Path: "/etc"}, map[string][]string{"path": {"Path must reside within a pool under /mnt"}}},
+		{"not_found", NFSShareRequest{Path: "/mnt/tank/missing"}, map[string][]string{"path": {"Path does not exist"}}},
+		{"file", NFSShareRequest{Path: "/mnt/tank/file"}, map[string][]string{"path": {"Path is not a directory"}}},
+		{"duplicate", NFSShareRequest{Path: "/mnt/tank/shared"}, map[string][]string{"path": {"Path is already shared by share 3"}}},
+		{"bad_network", NFSShareRequest{Path: "/mnt/tank/data", Networks: []string{"10.0.0.0/8", "10.0.0.300/8"}},
+			map[string][]string{"networks.1": {`"10.0.0.300/8" is not a valid network`}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := client.Sharing.NFS.Validate(ctx, &tt.req)
+			var validation *ValidationError
+			require.ErrorAs(t, err, &validation)
+			assert.Equal(t, tt.fields, validation.Fields())
+		})
+	}
+}
+
 // SMB Sharing Client Tests
 
 func TestSharingSMBClient_List(t *testing.T) {
@@ -859,6 +1101,82 @@ func TestSharingSMBClient_GetPresets_Error(t *testing.T) {
 	assert.Contains(t, err.Error(), "Service unavailable")
 }
 
+func TestSharingSMBClient_CreateTimeMachine(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("smb.config", SMBConfig{AAAPLExtensions: false})
+	server.SetResponse("smb.update", SMBConfig{AAAPLExtensions: true})
+	server.SetResponse("pool.dataset.update", Dataset{ID: "tank/timemachine"})
+	server.SetResponse("sharing.smb.create", SMBShare{ID: 9, Name: "TimeMachine", Path: "/mnt/tank/timemachine", TimeMachine: true})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	share, err := client.Sharing.SMB.CreateTimeMachine(ctx, "/mnt/tank/timemachine", "TimeMachine", 500)
+	require.NoError(t, err)
+	assert.Equal(t, 9, share.ID)
+
+	server.AssertCalled(t, "smb.update", map[string]any{"aapl_extensions": true})
+	server.AssertCalled(t, "pool.dataset.update", "tank/timemachine", DatasetUpdateRequest{Quota: Ptr(int64(500) << 30)})
+	server.AssertCalled(t, "sharing.smb.create", SMBShareRequest{
+		Purpose:          SMBPurposeEnhancedTimeMachine,
+		Path:             "/mnt/tank/timemachine",
+		Name:             "TimeMachine",
+		Browsable:        true,
+		TimeMachine:      true,
+		AAPLNameMangling: true,
+		ACL:              true,
+		DurableHandle:    true,
+		ShadowCopy:       true,
+		Streams:          true,
+		AuxSMBConf:       "fruit:time machine max size = 500G",
+		Enabled:          true,
+	})
+}
+
+func TestSharingSMBClient_CreateTimeMachine_NoQuota(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("smb.config", SMBConfig{AAAPLExtensions: true})
+	server.SetResponse("sharing.smb.create", SMBShare{ID: 9, Name: "TimeMachine"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Sharing.SMB.CreateTimeMachine(ctx, "/mnt/tank/timemachine", "TimeMachine", 0)
+	require.NoError(t, err)
+	server.AssertNotCalled(t, "smb.update")
+	server.AssertNotCalled(t, "pool.dataset.update")
+
+	calls := server.Calls("sharing.smb.create")
+	require.Len(t, calls, 1)
+	req := calls[0].Params[0].(map[string]any)
+	assert.Equal(t, "", req["auxsmbconf"])
+}
+
+func TestSharingSMBClient_CreateTimeMachine_NotDataset(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("smb.config", SMBConfig{AAAPLExtensions: true})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Sharing.SMB.CreateTimeMachine(ctx, "/home/backups", "TimeMachine", 100)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "not a dataset mountpoint")
+	server.AssertNotCalled(t, "sharing.smb.create")
+}
+
 // WebDAV Sharing Client Tests
 
 func TestSharingWebDAVClient_List(t *testing.T) {
@@ -1132,3 +1450,97 @@ func TestNewSharingWebDAVClient(t *testing.T) {
 	require.NotNil(t, webdavClient)
 	assert.Equal(t, client, webdavClient.client)
 }
+
+func TestSharingSMBClient_Diff(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	live := SMBShare{
+		ID:         3,
+		Purpose:    SMBPurposeDefaultShare,
+		Path:       "/mnt/tank/media",
+		Name:       "media",
+		Comment:    "Media",
+		Browsable:  true,
+		ACL:        true,
+		Streams:    true,
+		HostsAllow: []string{},
+		Enabled:    true,
+	}
+	server.SetResponse("sharing.smb.query", []SMBShare{live})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	desired := &SMBShareRequest{
+		Purpose:   SMBPurposeDefaultShare,
+		Path:      "/mnt/tank/media",
+		Name:      "media",
+		Comment:   "Media",
+		Browsable: true,
+		ACL:       true,
+		Streams:   true,
+		Enabled:   true,
+	}
+	diffs, err := client.Sharing.SMB.Diff(ctx, 3, desired)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+
+	desired.Comment = "Media library"
+	desired.RO = true
+	desired.HostsAllow = []string{"10.0.0.0/24"}
+	diffs, err = client.Sharing.SMB.Diff(ctx, 3, desired)
+	require.NoError(t, err)
+	assert.Equal(t, []FieldDiff{
+		{Field: "comment", Current: "Media", Desired: "Media library"},
+		{Field: "hostsallow", Current: []any{}, Desired: []any{"10.0.0.0/24"}},
+		{Field: "ro", Current: false, Desired: true},
+	}, diffs)
+	assert.Equal(t, "comment: Media -> Media library", diffs[0].String())
+}
+
+func TestSharingNFSClient_Diff(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("sharing.nfs.query", []NFSShare{{
+		ID:         5,
+		Path:       "/mnt/tank/backups",
+		Networks:   []string{"10.0.0.0/24"},
+		MapAllUser: Ptr("backup"),
+		Security:   []string{"SYS"},
+		Enabled:    true,
+	}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	// Fields the request omits, such as the comment and hosts, are not compared
+	diffs, err := client.Sharing.NFS.Diff(NewTestContext(t), 5, &NFSShareRequest{
+		Path:       "/mnt/tank/backups",
+		Networks:   []string{"10.0.0.0/24"},
+		MapAllUser: Ptr("nobody"),
+		Security:   []string{"SYS"},
+		Enabled:    true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []FieldDiff{{Field: "mapall_user", Current: "backup", Desired: "nobody"}}, diffs)
+}
+
+func TestSharingSMBClient_Diff_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("sharing.smb.query", []SMBShare{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	diffs, err := client.Sharing.SMB.Diff(NewTestContext(t), 3, &SMBShareRequest{})
+	assert.Nil(t, diffs)
+	assert.True(t, IsNotFound(err))
+}
@@ -2,7 +2,10 @@ package truenas
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 )
 
 // SmartClient provides methods for SMART monitoring and testing
@@ -54,10 +57,24 @@ type SmartTestCreateRequest struct {
 
 // SmartTestResult represents SMART test results for a disk
 type SmartTestResult struct {
-	Disk  string      `json:"disk"`
-	Tests []SmartTest `json:"tests"`
+	Disk        string            `json:"disk"`
+	Tests       []SmartTestDetail `json:"tests"`                  // Most recent first
+	CurrentTest *SmartCurrentTest `json:"current_test,omitempty"` // Set while a test is running
 }
 
+// SmartCurrentTest represents a SMART test in progress
+type SmartCurrentTest struct {
+	Progress int `json:"progress"` // Percent complete
+}
+
+// SMART test statuses reported in SmartTestDetail.Status
+const (
+	SmartTestStatusSuccess = "SUCCESS"
+	SmartTestStatusFailed  = "FAILED"
+	SmartTestStatusRunning = "RUNNING"
+	SmartTestStatusAborted = "ABORTED"
+)
+
 // SmartTestDetail represents individual test details
 type SmartTestDetail struct {
 	Num             int     `json:"num"`
@@ -76,6 +93,14 @@ type SmartManualTestRequest struct {
 	Type string `json:"type"`
 }
 
+// SmartManualTestResult reports a manual SMART test started on a disk
+type SmartManualTestResult struct {
+	Disk               string       `json:"disk"`
+	Identifier         string       `json:"identifier"`
+	Error              *string      `json:"error"`                // Why the test could not be started
+	ExpectedResultTime *TrueNASTime `json:"expected_result_time"` // When the test should complete
+}
+
 // SmartAttributes represents SMART attributes for a disk
 type SmartAttributes struct {
 	ID         int    `json:"id"`
@@ -132,6 +157,11 @@ func (s *SmartClient) ListTests(ctx context.Context) ([]SmartTest, error) {
 	return result, err
 }
 
+// ListTestsWithQuery returns SMART test tasks matching q
+func (s *SmartClient) ListTestsWithQuery(ctx context.Context, q *Query) ([]SmartTest, error) {
+	return query[SmartTest](ctx, s.client, "smart.test.query", q)
+}
+
 // GetTest returns a specific SMART test by ID
 func (s *SmartClient) GetTest(ctx context.Context, id int) (*SmartTest, error) {
 	var result []SmartTest
@@ -171,9 +201,48 @@ func (s *SmartClient) GetDiskChoices(ctx context.Context, fullDisk bool) (any, e
 	return result, err
 }
 
-// RunManualTest runs manual SMART tests for specified disks
-func (s *SmartClient) RunManualTest(ctx context.Context, tests []SmartManualTestRequest) error {
-	return s.client.Call(ctx, "smart.test.manual_test", []any{tests}, nil)
+// RunManualTest runs manual SMART tests for specified disks and returns when each is expected to
+// complete. A disk whose test could not be started has its Error set.
+func (s *SmartClient) RunManualTest(ctx context.Context, tests []SmartManualTestRequest) ([]SmartManualTestResult, error) {
+	var result []SmartManualTestResult
+	err := s.client.Call(ctx, "smart.test.manual_test", []any{tests}, &result)
+	return result, err
+}
+
+// WaitForTests polls the test results of disks until none of them has a test running, then
+// returns the results. Polling backs off from Options.JobPollInterval to JobPollMaxInterval; use
+// the context to bound the wait, allowing for the ExpectedResultTime reported by RunManualTest.
+func (s *SmartClient) WaitForTests(ctx context.Context, disks []string) ([]SmartTestResult, error) {
+	interval := s.client.opts.JobPollInterval
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for SMART tests: %w", ctx.Err())
+		case <-timer.C:
+		}
+
+		var results []SmartTestResult
+		err := s.client.Call(ctx, "smart.test.results", []any{[]any{[]any{"disk", "in", disks}}}, &results)
+		if err != nil {
+			return nil, err
+		}
+		running := false
+		for _, disk := range disks {
+			i := slices.IndexFunc(results, func(r SmartTestResult) bool { return r.Disk == disk })
+			if i < 0 {
+				return nil, NewNotFoundError("smart_test_result", fmt.Sprintf("disk %s", disk))
+			}
+			running = running || results[i].CurrentTest != nil
+		}
+		if !running {
+			return results, nil
+		}
+
+		timer.Reset(interval)
+		interval = min(time.Duration(float64(interval)*jobPollMultiplier), s.client.opts.JobPollMaxInterval)
+	}
 }
 
 // SMART Test Results
@@ -200,3 +269,26 @@ func (s *SmartClient) GetDiskAttributes(ctx context.Context, diskName string) ([
 	err := s.client.Call(ctx, "disk.smart_attributes", []any{diskName}, &result)
 	return result, err
 }
+
+// GetDiskSmartOutput returns the full smartctl report for a disk as JSON (smartctl -x --json),
+// including the NVMe health log, error logs and self-test logs not covered by SmartAttributes
+func (s *SmartClient) GetDiskSmartOutput(ctx context.Context, diskName string) (json.RawMessage, error) {
+	output, err := s.Smartctl(ctx, diskName, "-x", "--json=c")
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid([]byte(output)) {
+		return nil, fmt.Errorf("smartctl output for %s is not valid JSON", diskName)
+	}
+	return json.RawMessage(output), nil
+}
+
+// Smartctl runs smartctl on a disk with the given arguments and returns its output
+func (s *SmartClient) Smartctl(ctx context.Context, diskName string, args ...string) (string, error) {
+	if args == nil {
+		args = []string{}
+	}
+	var result string
+	err := s.client.Call(ctx, "disk.smartctl", []any{diskName, args}, &result)
+	return result, err
+}
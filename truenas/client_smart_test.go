@@ -1,7 +1,11 @@
 package truenas
 
 import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -535,7 +539,7 @@ func TestSmartClient_RunManualTest(t *testing.T) {
 	}
 
 	ctx := NewTestContext(t)
-	err := client.Smart.RunManualTest(ctx, tests)
+	_, err := client.Smart.RunManualTest(ctx, tests)
 	assert.NoError(t, err)
 }
 
@@ -557,7 +561,7 @@ func TestSmartClient_RunManualTest_SingleDisk(t *testing.T) {
 	}
 
 	ctx := NewTestContext(t)
-	err := client.Smart.RunManualTest(ctx, tests)
+	_, err := client.Smart.RunManualTest(ctx, tests)
 	assert.NoError(t, err)
 }
 
@@ -579,7 +583,7 @@ func TestSmartClient_RunManualTest_Error(t *testing.T) {
 	}
 
 	ctx := NewTestContext(t)
-	err := client.Smart.RunManualTest(ctx, tests)
+	_, err := client.Smart.RunManualTest(ctx, tests)
 	require.Error(t, err)
 
 	var apiErr *ErrorMsg
@@ -596,21 +600,21 @@ func TestSmartClient_GetAllTestResults(t *testing.T) {
 	mockResults := []SmartTestResult{
 		{
 			Disk: "sda",
-			Tests: []SmartTest{
+			Tests: []SmartTestDetail{
 				{
-					ID:   1,
-					Desc: "Short self-test",
-					Type: string(SmartTestTypeShort),
+					Num:         1,
+					Description: "Short self-test",
+					Status:      SmartTestStatusSuccess,
 				},
 			},
 		},
 		{
 			Disk: "sdb",
-			Tests: []SmartTest{
+			Tests: []SmartTestDetail{
 				{
-					ID:   2,
-					Desc: "Extended self-test",
-					Type: string(SmartTestTypeLong),
+					Num:         2,
+					Description: "Extended self-test",
+					Status:      SmartTestStatusSuccess,
 				},
 			},
 		},
@@ -627,7 +631,7 @@ func TestSmartClient_GetAllTestResults(t *testing.T) {
 	assert.Equal(t, "sda", results[0].Disk)
 	assert.Equal(t, "sdb", results[1].Disk)
 	assert.Len(t, results[0].Tests, 1)
-	assert.Equal(t, "Short self-test", results[0].Tests[0].Desc)
+	assert.Equal(t, "Short self-test", results[0].Tests[0].Description)
 }
 
 func TestSmartClient_GetAllTestResults_Empty(t *testing.T) {
@@ -673,16 +677,16 @@ func TestSmartClient_GetDiskTestResults(t *testing.T) {
 
 	mockResult := &SmartTestResult{
 		Disk: "sda",
-		Tests: []SmartTest{
+		Tests: []SmartTestDetail{
 			{
-				ID:   1,
-				Desc: "Short self-test",
-				Type: string(SmartTestTypeShort),
+				Num:         1,
+				Description: "Short self-test",
+				Status:      SmartTestStatusSuccess,
 			},
 			{
-				ID:   2,
-				Desc: "Extended self-test",
-				Type: string(SmartTestTypeLong),
+				Num:         2,
+				Description: "Extended self-test",
+				Status:      SmartTestStatusSuccess,
 			},
 		},
 	}
@@ -697,8 +701,8 @@ func TestSmartClient_GetDiskTestResults(t *testing.T) {
 	require.NotNil(t, result)
 	assert.Equal(t, "sda", result.Disk)
 	assert.Len(t, result.Tests, 2)
-	assert.Equal(t, "Short self-test", result.Tests[0].Desc)
-	assert.Equal(t, "Extended self-test", result.Tests[1].Desc)
+	assert.Equal(t, "Short self-test", result.Tests[0].Description)
+	assert.Equal(t, "Extended self-test", result.Tests[1].Description)
 }
 
 func TestSmartClient_GetDiskTestResults_Error(t *testing.T) {
@@ -777,7 +781,7 @@ func TestSmartClient_GetDiskAttributes(t *testing.T) {
 	assert.Equal(t, "Pre-fail", attributes[0].Type)
 	assert.Equal(t, 5, attributes[1].ID)
 	assert.Equal(t, 9, attributes[2].ID)
-	assert.Equal(t, 1234.0, attributes[2].RawValue)
+	assert.Equal(t, json.Number("1234"), attributes[2].RawValue)
 }
 
 func TestSmartClient_GetDiskAttributes_Empty(t *testing.T) {
@@ -915,7 +919,7 @@ func TestSmartClient_RunManualTest_EmptySlice(t *testing.T) {
 	defer client.Close()
 
 	ctx := NewTestContext(t)
-	err := client.Smart.RunManualTest(ctx, []SmartManualTestRequest{})
+	_, err := client.Smart.RunManualTest(ctx, []SmartManualTestRequest{})
 	assert.NoError(t, err)
 }
 
@@ -960,3 +964,120 @@ func TestSmartClient_ComplexSchedule(t *testing.T) {
 	assert.Equal(t, "1,3,5,7,9,11", test.Schedule.Month)
 	assert.Equal(t, "1-5", test.Schedule.DOW)
 }
+
+func TestSmartClient_GetDiskSmartOutput(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 2)
+	output := `{"device":{"name":"/dev/nvme0","type":"nvme"},"nvme_smart_health_information_log":{"critical_warning":0,"media_errors":0},"nvme_error_information_log":{"size":64,"read":16,"unread":0}}`
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "disk.smartctl" {
+			received <- msg.Params
+			result := output
+			params, _ := json.Marshal(msg.Params)
+			if string(params) == `["nvme1",["-x","--json=c"]]` {
+				result = "not json"
+			}
+			data, _ := json.Marshal(result)
+			return Message{ID: msg.ID, Result: data}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	report, err := client.Smart.GetDiskSmartOutput(ctx, "nvme0")
+	require.NoError(t, err)
+	assert.JSONEq(t, output, string(report))
+	params, err := json.Marshal(<-received)
+	require.NoError(t, err)
+	assert.JSONEq(t, `["nvme0", ["-x", "--json=c"]]`, string(params))
+
+	_, err = client.Smart.GetDiskSmartOutput(ctx, "nvme1")
+	require.Error(t, err)
+	<-received
+}
+
+func TestSmartClient_RunManualTest_ExpectedResultTime(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "smart.test.manual_test" {
+			return Message{ID: msg.ID, Result: json.RawMessage(`[
+				{"disk": "sda", "identifier": "{serial}S1", "error": null, "expected_result_time": {"$date": 1760000000000}},
+				{"disk": "sdb", "identifier": "{serial}S2", "error": "Disk is in standby", "expected_result_time": null}
+			]`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	results, err := client.Smart.RunManualTest(NewTestContext(t), []SmartManualTestRequest{
+		{Disk: "sda", Type: string(SmartTestTypeShort)},
+		{Disk: "sdb", Type: string(SmartTestTypeShort)},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Nil(t, results[0].Error)
+	require.NotNil(t, results[0].ExpectedResultTime)
+	assert.Equal(t, int64(1760000000), results[0].ExpectedResultTime.Unix())
+	require.NotNil(t, results[1].Error)
+	assert.Equal(t, "Disk is in standby", *results[1].Error)
+}
+
+func TestSmartClient_WaitForTests(t *testing.T) {
+	t.Parallel()
+	var polls atomic.Int32
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "smart.test.results" {
+			// sdb finishes its test on the third poll
+			sdb := `{"disk": "sdb", "tests": [], "current_test": {"progress": 50}}`
+			if polls.Add(1) >= 3 {
+				sdb = `{"disk": "sdb", "tests": [{"id": 1}], "current_test": null}`
+			}
+			return Message{ID: msg.ID, Result: json.RawMessage(`[{"disk": "sda", "tests": [{"id": 1}]}, ` + sdb + `]`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:           "test",
+		Password:           "test",
+		JobPollInterval:    10 * time.Millisecond,
+		JobPollMaxInterval: 20 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	results, err := client.Smart.WaitForTests(ctx, []string{"sda", "sdb"})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Nil(t, results[1].CurrentTest)
+	assert.Equal(t, int32(3), polls.Load())
+
+	// A disk without results cannot be waited for
+	_, err = client.Smart.WaitForTests(ctx, []string{"sdc"})
+	assert.True(t, IsNotFound(err))
+}
+
+func TestSmartClient_WaitForTests_Timeout(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("smart.test.results", []SmartTestResult{{Disk: "sda", CurrentTest: &SmartCurrentTest{Progress: 10}}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(NewTestContext(t), 100*time.Millisecond)
+	defer cancel()
+	_, err := client.Smart.WaitForTests(ctx, []string{"sda"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
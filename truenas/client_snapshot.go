@@ -0,0 +1,173 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// SnapshotClient provides methods for ZFS snapshot management
+type SnapshotClient struct {
+	client *Client
+}
+
+// NewSnapshotClient creates a new snapshot client
+func NewSnapshotClient(client *Client) *SnapshotClient {
+	return &SnapshotClient{client: client}
+}
+
+// Snapshot represents a ZFS snapshot
+type Snapshot struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	Pool         string             `json:"pool"`
+	Type         string             `json:"type"`
+	Dataset      string             `json:"dataset"`
+	SnapshotName string             `json:"snapshot_name"`
+	CreateTXG    string             `json:"createtxg"`
+	Properties   SnapshotProperties `json:"properties"`
+	Holds        map[string]any     `json:"holds,omitempty"`
+	Retention    *SnapshotRetention `json:"retention,omitempty"`
+}
+
+// SnapshotProperties represents the ZFS properties of a snapshot
+type SnapshotProperties struct {
+	Creation          *DatasetProperty `json:"creation,omitempty"`
+	Used              *DatasetProperty `json:"used,omitempty"`
+	Referenced        *DatasetProperty `json:"referenced,omitempty"`
+	LogicalReferenced *DatasetProperty `json:"logicalreferenced,omitempty"`
+	CompressRatio     *DatasetProperty `json:"compressratio,omitempty"`
+	RefCompressRatio  *DatasetProperty `json:"refcompressratio,omitempty"`
+	Written           *DatasetProperty `json:"written,omitempty"`
+	Clones            *DatasetProperty `json:"clones,omitempty"`
+	DeferDestroy      *DatasetProperty `json:"defer_destroy,omitempty"`
+	UserRefs          *DatasetProperty `json:"userrefs,omitempty"`
+	Encryption        *DatasetProperty `json:"encryption,omitempty"`
+	GUID              *DatasetProperty `json:"guid,omitempty"`
+}
+
+// SnapshotRetention describes when a snapshot will be removed by its owning task
+type SnapshotRetention struct {
+	Datetime               TrueNASTime `json:"datetime"`
+	Source                 string      `json:"source"`
+	PeriodicSnapshotTaskID *int        `json:"periodic_snapshot_task_id,omitempty"`
+}
+
+// SnapshotCreateRequest represents parameters for zfs.snapshot.create
+type SnapshotCreateRequest struct {
+	Dataset      string            `json:"dataset"`
+	Name         string            `json:"name,omitempty"`
+	NamingSchema string            `json:"naming_schema,omitempty"`
+	Recursive    bool              `json:"recursive"`
+	Exclude      []string          `json:"exclude,omitempty"`
+	SuspendVMs   bool              `json:"suspend_vms,omitempty"`
+	VMwareSync   bool              `json:"vmware_sync,omitempty"`
+	Properties   map[string]string `json:"properties,omitempty"`
+}
+
+// SnapshotDeleteOptions represents options for zfs.snapshot.delete
+type SnapshotDeleteOptions struct {
+	Defer     bool `json:"defer"`
+	Recursive bool `json:"recursive"`
+}
+
+// SnapshotCloneRequest represents parameters for zfs.snapshot.clone
+type SnapshotCloneRequest struct {
+	Snapshot          string         `json:"snapshot"`
+	DatasetDst        string         `json:"dataset_dst"`
+	DatasetProperties map[string]any `json:"dataset_properties,omitempty"`
+}
+
+// SnapshotRollbackOptions represents options for zfs.snapshot.rollback
+type SnapshotRollbackOptions struct {
+	// Recursive destroys any snapshots and bookmarks more recent than the one specified
+	Recursive bool `json:"recursive"`
+	// RecursiveClones is like Recursive, but also destroys any clones
+	RecursiveClones bool `json:"recursive_clones"`
+	// Force unmounts any clones
+	Force bool `json:"force"`
+	// RecursiveRollback does a complete recursive rollback of each child snapshot
+	RecursiveRollback bool `json:"recursive_rollback"`
+}
+
+// List returns all snapshots
+func (s *SnapshotClient) List(ctx context.Context) ([]Snapshot, error) {
+	var result []Snapshot
+	err := s.client.Call(ctx, "zfs.snapshot.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns snapshots matching q. Use Extra("retention", true) or
+// Extra("holds", true) to include retention and hold metadata.
+func (s *SnapshotClient) ListWithQuery(ctx context.Context, q *Query) ([]Snapshot, error) {
+	return query[Snapshot](ctx, s.client, "zfs.snapshot.query", q)
+}
+
+// ListIter returns an iterator over the snapshots matching q, fetched a page at a time
+func (s *SnapshotClient) ListIter(ctx context.Context, q *Query) iter.Seq2[Snapshot, error] {
+	return paginate(ctx, q, s.ListWithQuery)
+}
+
+// ListByDataset returns the snapshots of a dataset
+func (s *SnapshotClient) ListByDataset(ctx context.Context, dataset string) ([]Snapshot, error) {
+	var result []Snapshot
+	err := s.client.Call(ctx, "zfs.snapshot.query", []any{[]any{[]any{"dataset", "=", dataset}}}, &result)
+	return result, err
+}
+
+// Get returns a specific snapshot by ID, e.g. "tank/data@auto-2024-01-01"
+func (s *SnapshotClient) Get(ctx context.Context, id string) (*Snapshot, error) {
+	var result []Snapshot
+	params := []any{
+		[]any{[]any{"id", "=", id}},
+		map[string]any{"extra": map[string]any{"holds": true, "retention": true}},
+	}
+	err := s.client.Call(ctx, "zfs.snapshot.query", params, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("snapshot", fmt.Sprintf("ID %s", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates a new snapshot
+func (s *SnapshotClient) Create(ctx context.Context, req *SnapshotCreateRequest) (*Snapshot, error) {
+	var result Snapshot
+	err := s.client.Call(ctx, "zfs.snapshot.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Delete deletes a snapshot
+func (s *SnapshotClient) Delete(ctx context.Context, id string, options *SnapshotDeleteOptions) error {
+	params := []any{id}
+	if options != nil {
+		params = append(params, *options)
+	}
+	return s.client.Call(ctx, "zfs.snapshot.delete", params, nil)
+}
+
+// Clone creates a new dataset from a snapshot
+func (s *SnapshotClient) Clone(ctx context.Context, req *SnapshotCloneRequest) error {
+	return s.client.Call(ctx, "zfs.snapshot.clone", []any{*req}, nil)
+}
+
+// Rollback rolls the snapshot's dataset back to the snapshot
+func (s *SnapshotClient) Rollback(ctx context.Context, id string, options *SnapshotRollbackOptions) error {
+	params := []any{id}
+	if options != nil {
+		params = append(params, *options)
+	}
+	return s.client.Call(ctx, "zfs.snapshot.rollback", params, nil)
+}
+
+// Hold places a hold on a snapshot, preventing it from being destroyed
+func (s *SnapshotClient) Hold(ctx context.Context, id string, recursive bool) error {
+	return s.client.Call(ctx, "zfs.snapshot.hold", []any{id, map[string]any{"recursive": recursive}}, nil)
+}
+
+// Release removes all holds from a snapshot
+func (s *SnapshotClient) Release(ctx context.Context, id string, recursive bool) error {
+	return s.client.Call(ctx, "zfs.snapshot.release", []any{id, map[string]any{"recursive": recursive}}, nil)
+}
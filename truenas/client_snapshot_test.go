@@ -0,0 +1,162 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const mockSnapshotJSON = `{
+	"id": "tank/data@auto-2024-01-01_00-00",
+	"name": "tank/data@auto-2024-01-01_00-00",
+	"pool": "tank",
+	"type": "SNAPSHOT",
+	"dataset": "tank/data",
+	"snapshot_name": "auto-2024-01-01_00-00",
+	"createtxg": "1234",
+	"properties": {
+		"used": {"value": "1.5M", "rawvalue": "1572864", "parsed": 1572864, "source": "NONE"},
+		"referenced": {"value": "10G", "rawvalue": "10737418240", "parsed": 10737418240, "source": "NONE"},
+		"userrefs": {"value": "1", "rawvalue": "1", "parsed": 1, "source": "NONE"}
+	},
+	"holds": {"truenas": 1704067200},
+	"retention": {
+		"datetime": {"$date": 1706745600000},
+		"source": "periodic_snapshot_task",
+		"periodic_snapshot_task_id": 3
+	}
+}`
+
+func TestNewSnapshotClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	snapshotClient := NewSnapshotClient(client)
+	require.NotNil(t, snapshotClient)
+	assert.Equal(t, client, snapshotClient.client)
+}
+
+func TestSnapshotClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("zfs.snapshot.query", json.RawMessage(`[`+mockSnapshotJSON+`]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	snapshots, err := client.Snapshot.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 1)
+
+	snap := snapshots[0]
+	assert.Equal(t, "tank/data@auto-2024-01-01_00-00", snap.ID)
+	assert.Equal(t, "tank/data", snap.Dataset)
+	assert.Equal(t, "auto-2024-01-01_00-00", snap.SnapshotName)
+	require.NotNil(t, snap.Properties.Used)
+	assert.Equal(t, "1572864", snap.Properties.Used.RawValue)
+	assert.Equal(t, "10G", snap.Properties.Referenced.Value)
+	assert.Contains(t, snap.Holds, "truenas")
+	require.NotNil(t, snap.Retention)
+	assert.Equal(t, "periodic_snapshot_task", snap.Retention.Source)
+	assert.Equal(t, 3, value(snap.Retention.PeriodicSnapshotTaskID))
+	assert.Equal(t, int64(1706745600), snap.Retention.Datetime.Unix())
+}
+
+func TestSnapshotClient_Get(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("zfs.snapshot.query", json.RawMessage(`[`+mockSnapshotJSON+`]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	snap, err := client.Snapshot.Get(ctx, "tank/data@auto-2024-01-01_00-00")
+	require.NoError(t, err)
+	assert.Equal(t, "tank", snap.Pool)
+}
+
+func TestSnapshotClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("zfs.snapshot.query", []Snapshot{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	snap, err := client.Snapshot.Get(ctx, "tank/data@missing")
+	assert.Nil(t, snap)
+
+	var notFoundErr *NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+	assert.Equal(t, "snapshot", notFoundErr.ResourceType)
+}
+
+func TestSnapshotClient_Create(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("zfs.snapshot.create", json.RawMessage(mockSnapshotJSON))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	snap, err := client.Snapshot.Create(ctx, &SnapshotCreateRequest{
+		Dataset:   "tank/data",
+		Name:      "auto-2024-01-01_00-00",
+		Recursive: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tank/data@auto-2024-01-01_00-00", snap.Name)
+}
+
+func TestSnapshotClient_Lifecycle(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	id := "tank/data@manual"
+
+	require.NoError(t, client.Snapshot.Hold(ctx, id, false))
+	require.NoError(t, client.Snapshot.Release(ctx, id, true))
+	require.NoError(t, client.Snapshot.Clone(ctx, &SnapshotCloneRequest{Snapshot: id, DatasetDst: "tank/clone"}))
+	require.NoError(t, client.Snapshot.Rollback(ctx, id, &SnapshotRollbackOptions{Force: true}))
+	require.NoError(t, client.Snapshot.Delete(ctx, id, &SnapshotDeleteOptions{Defer: true}))
+	require.NoError(t, client.Snapshot.Delete(ctx, id, nil))
+}
+
+func TestSnapshotClient_Delete_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetError("zfs.snapshot.delete", 16, "snapshot has dependent clones")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	err := client.Snapshot.Delete(ctx, "tank/data@manual", nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "dependent clones")
+}
@@ -0,0 +1,122 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// SnapshotLifetimeUnit represents the unit of how long a periodic snapshot is kept
+type SnapshotLifetimeUnit string
+
+const (
+	SnapshotLifetimeHour  SnapshotLifetimeUnit = "HOUR"
+	SnapshotLifetimeDay   SnapshotLifetimeUnit = "DAY"
+	SnapshotLifetimeWeek  SnapshotLifetimeUnit = "WEEK"
+	SnapshotLifetimeMonth SnapshotLifetimeUnit = "MONTH"
+	SnapshotLifetimeYear  SnapshotLifetimeUnit = "YEAR"
+)
+
+// DefaultSnapshotNamingSchema is the naming schema the UI gives periodic snapshot tasks
+const DefaultSnapshotNamingSchema = "auto-%Y-%m-%d_%H-%M"
+
+// SnapshotTaskClient provides methods for periodic snapshot task management
+type SnapshotTaskClient struct {
+	client *Client
+}
+
+// NewSnapshotTaskClient creates a new periodic snapshot task client
+func NewSnapshotTaskClient(client *Client) *SnapshotTaskClient {
+	return &SnapshotTaskClient{client: client}
+}
+
+// SnapshotTask represents a periodic snapshot task
+type SnapshotTask struct {
+	ID            int                  `json:"id"`
+	Dataset       string               `json:"dataset"`
+	Recursive     bool                 `json:"recursive"`
+	Exclude       []string             `json:"exclude"`
+	LifetimeValue int                  `json:"lifetime_value"`
+	LifetimeUnit  SnapshotLifetimeUnit `json:"lifetime_unit"`
+	NamingSchema  string               `json:"naming_schema"`
+	AllowEmpty    bool                 `json:"allow_empty"`
+	Schedule      Schedule             `json:"schedule"`
+	Enabled       bool                 `json:"enabled"`
+	VMwareSync    bool                 `json:"vmware_sync"`
+	State         *TaskState           `json:"state"`
+}
+
+// TaskState is the outcome of the last run of a periodic snapshot or replication task
+type TaskState struct {
+	State        string       `json:"state"` // PENDING, RUNNING, FINISHED or ERROR
+	Datetime     *TrueNASTime `json:"datetime,omitempty"`
+	Error        string       `json:"error,omitempty"`
+	LastSnapshot string       `json:"last_snapshot,omitempty"`
+}
+
+// SnapshotTaskRequest represents parameters for pool.snapshottask.create and pool.snapshottask.update
+type SnapshotTaskRequest struct {
+	Dataset       string               `json:"dataset"`
+	Recursive     bool                 `json:"recursive"`
+	Exclude       []string             `json:"exclude,omitempty"`
+	LifetimeValue int                  `json:"lifetime_value"`
+	LifetimeUnit  SnapshotLifetimeUnit `json:"lifetime_unit"`
+	NamingSchema  string               `json:"naming_schema"`
+	AllowEmpty    *bool                `json:"allow_empty,omitempty"`
+	Schedule      Schedule             `json:"schedule"`
+	Enabled       *bool                `json:"enabled,omitempty"`
+}
+
+// List returns all periodic snapshot tasks
+func (s *SnapshotTaskClient) List(ctx context.Context) ([]SnapshotTask, error) {
+	var result []SnapshotTask
+	err := s.client.Call(ctx, "pool.snapshottask.query", []any{}, &result)
+	return result, err
+}
+
+// ListWithQuery returns periodic snapshot tasks matching q
+func (s *SnapshotTaskClient) ListWithQuery(ctx context.Context, q *Query) ([]SnapshotTask, error) {
+	return query[SnapshotTask](ctx, s.client, "pool.snapshottask.query", q)
+}
+
+// ListIter returns an iterator over the periodic snapshot tasks matching q, fetched a page at a time
+func (s *SnapshotTaskClient) ListIter(ctx context.Context, q *Query) iter.Seq2[SnapshotTask, error] {
+	return paginate(ctx, q, s.ListWithQuery)
+}
+
+// Get returns a specific periodic snapshot task by ID
+func (s *SnapshotTaskClient) Get(ctx context.Context, id int) (*SnapshotTask, error) {
+	var result []SnapshotTask
+	err := s.client.Call(ctx, "pool.snapshottask.query", []any{[]any{[]any{"id", "=", id}}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, NewNotFoundError("snapshot_task", fmt.Sprintf("ID %d", id))
+	}
+	return &result[0], nil
+}
+
+// Create creates a new periodic snapshot task
+func (s *SnapshotTaskClient) Create(ctx context.Context, req *SnapshotTaskRequest) (*SnapshotTask, error) {
+	var result SnapshotTask
+	err := s.client.Call(ctx, "pool.snapshottask.create", []any{*req}, &result)
+	return &result, err
+}
+
+// Update updates an existing periodic snapshot task
+func (s *SnapshotTaskClient) Update(ctx context.Context, id int, req *SnapshotTaskRequest) (*SnapshotTask, error) {
+	var result SnapshotTask
+	err := s.client.Call(ctx, "pool.snapshottask.update", []any{id, *req}, &result)
+	return &result, err
+}
+
+// Delete deletes a periodic snapshot task. The snapshots it took are kept.
+func (s *SnapshotTaskClient) Delete(ctx context.Context, id int) error {
+	return s.client.Call(ctx, "pool.snapshottask.delete", []any{id}, nil)
+}
+
+// Run takes a snapshot now, without waiting for the task's schedule
+func (s *SnapshotTaskClient) Run(ctx context.Context, id int) error {
+	return s.client.Call(ctx, "pool.snapshottask.run", []any{id}, nil)
+}
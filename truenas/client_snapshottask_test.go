@@ -0,0 +1,94 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshotTaskClient_List(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("pool.snapshottask.query", json.RawMessage(`[{
+		"id": 1,
+		"dataset": "tank/data",
+		"recursive": true,
+		"exclude": [],
+		"lifetime_value": 2,
+		"lifetime_unit": "WEEK",
+		"naming_schema": "auto-%Y-%m-%d_%H-%M",
+		"allow_empty": true,
+		"schedule": {"minute": "0", "hour": "*", "dom": "*", "month": "*", "dow": "*", "begin": "00:00", "end": "23:59"},
+		"enabled": true,
+		"vmware_sync": false,
+		"state": {"state": "FINISHED", "datetime": {"$date": 1704067200000}}
+	}]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	tasks, err := client.SnapshotTask.List(NewTestContext(t))
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+
+	task := tasks[0]
+	assert.Equal(t, "tank/data", task.Dataset)
+	assert.Equal(t, SnapshotLifetimeWeek, task.LifetimeUnit)
+	assert.Equal(t, DefaultSnapshotNamingSchema, task.NamingSchema)
+	assert.Equal(t, "*", task.Schedule.Hour)
+	require.NotNil(t, task.State)
+	assert.Equal(t, "FINISHED", task.State.State)
+	require.NotNil(t, task.State.Datetime)
+	assert.Equal(t, int64(1704067200), task.State.Datetime.Unix())
+}
+
+func TestSnapshotTaskClient_Get_NotFound(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("pool.snapshottask.query", []SnapshotTask{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	task, err := client.SnapshotTask.Get(NewTestContext(t), 42)
+	assert.Nil(t, task)
+	assert.True(t, IsNotFound(err))
+}
+
+func TestSnapshotTaskClient_CreateDeleteRun(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("pool.snapshottask.create", SnapshotTask{ID: 3, Dataset: "tank/data"})
+	server.SetResponse("pool.snapshottask.run", nil)
+	server.SetResponse("pool.snapshottask.delete", true)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	req := &SnapshotTaskRequest{
+		Dataset:       "tank/data",
+		LifetimeValue: 1,
+		LifetimeUnit:  SnapshotLifetimeDay,
+		NamingSchema:  DefaultSnapshotNamingSchema,
+		Schedule:      Schedule{Minute: "0", Hour: "*", DOM: "*", Month: "*", DOW: "*"},
+	}
+	task, err := client.SnapshotTask.Create(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, 3, task.ID)
+	server.AssertCalled(t, "pool.snapshottask.create", req)
+
+	require.NoError(t, client.SnapshotTask.Run(ctx, 3))
+	server.AssertCalled(t, "pool.snapshottask.run", 3)
+
+	require.NoError(t, client.SnapshotTask.Delete(ctx, 3))
+	server.AssertCalled(t, "pool.snapshottask.delete", 3)
+}
@@ -0,0 +1,106 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+)
+
+// StorageClient provides reports that combine pools, datasets and disks
+type StorageClient struct {
+	client *Client
+}
+
+// NewStorageClient creates a new storage client
+func NewStorageClient(client *Client) *StorageClient {
+	return &StorageClient{client: client}
+}
+
+// StorageUsage reports the capacity and health of every pool
+type StorageUsage struct {
+	Pools []PoolUsage
+}
+
+// PoolUsage reports the capacity and health of a pool with its datasets and disks
+type PoolUsage struct {
+	Name          string
+	Status        PoolStatus
+	Healthy       bool
+	Size          int64 // Bytes
+	Used          int64
+	Free          int64
+	Fragmentation string
+	Datasets      []DatasetUsage // In the order returned by pool.dataset.query
+	Disks         []DiskUsage
+}
+
+// DatasetUsage reports the space used by a dataset
+type DatasetUsage struct {
+	Name      string
+	Type      DatasetType
+	Used      int64 // Bytes, including descendants and snapshots
+	Available int64
+	Quota     int64 // 0 if no quota is set
+	Locked    bool
+}
+
+// DiskUsage describes a disk in a pool
+type DiskUsage struct {
+	Name   string
+	Serial string
+	Model  string
+	Size   int64
+}
+
+// Usage queries pools, datasets and disks in one batch and combines them into a report
+func (s *StorageClient) Usage(ctx context.Context) (*StorageUsage, error) {
+	var (
+		pools    []Pool
+		datasets []Dataset
+		disks    []Disk
+	)
+	batch := s.client.Batch()
+	batch.Add("pool.query", []any{}, &pools)
+	batch.Add("pool.dataset.query", NewQuery().Extra("retrieve_children", false).Params(), &datasets)
+	batch.Add("disk.query", NewQuery().Extra("pools", true).Params(), &disks)
+	if err := batch.Do(ctx); err != nil {
+		return nil, fmt.Errorf("storage usage: %w", err)
+	}
+
+	usage := &StorageUsage{Pools: make([]PoolUsage, 0, len(pools))}
+	byName := make(map[string]*PoolUsage, len(pools))
+	for _, pool := range pools {
+		usage.Pools = append(usage.Pools, PoolUsage{
+			Name:          pool.Name,
+			Status:        pool.Status,
+			Healthy:       pool.Healthy,
+			Size:          pool.Size,
+			Used:          pool.Allocated,
+			Free:          pool.Free,
+			Fragmentation: pool.Fragmentation,
+		})
+	}
+	for i := range usage.Pools {
+		byName[usage.Pools[i].Name] = &usage.Pools[i]
+	}
+	for _, dataset := range datasets {
+		if pool, ok := byName[dataset.Pool]; ok {
+			pool.Datasets = append(pool.Datasets, DatasetUsage{
+				Name:      dataset.Name,
+				Type:      dataset.Type,
+				Used:      dataset.Used.Int64(),
+				Available: dataset.Available.Int64(),
+				Quota:     dataset.Quota.Int64(),
+				Locked:    dataset.Locked,
+			})
+		}
+	}
+	for _, disk := range disks {
+		if disk.Pool == nil {
+			continue
+		}
+		if pool, ok := byName[*disk.Pool]; ok {
+			pool.Disks = append(pool.Disks, DiskUsage{Name: disk.Name, Serial: disk.Serial, Model: disk.Model, Size: disk.Size})
+		}
+	}
+	return usage, nil
+}
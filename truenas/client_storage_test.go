@@ -0,0 +1,81 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageClient_Usage(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		switch msg.Method {
+		case "pool.query":
+			response.Result = json.RawMessage(`[
+				{"id": 1, "name": "tank", "status": "ONLINE", "healthy": true, "size": 1000, "allocated": 400, "free": 600, "fragmentation": "3"},
+				{"id": 2, "name": "backup", "status": "DEGRADED", "healthy": false, "size": 500, "allocated": 100, "free": 400}
+			]`)
+		case "pool.dataset.query":
+			response.Result = json.RawMessage(`[
+				{"id": "tank", "name": "tank", "pool": "tank", "type": "FILESYSTEM", "used": {"rawvalue": "400"}, "available": {"rawvalue": "600"}, "quota": {"rawvalue": "0"}},
+				{"id": "tank/apps", "name": "tank/apps", "pool": "tank", "type": "FILESYSTEM", "used": {"rawvalue": "150"}, "available": {"rawvalue": "50"}, "quota": {"rawvalue": "200"}},
+				{"id": "backup", "name": "backup", "pool": "backup", "type": "FILESYSTEM", "locked": true}
+			]`)
+		case "disk.query":
+			response.Result = json.RawMessage(`[
+				{"name": "sda", "serial": "S1", "model": "HDD", "size": 1000, "pool": "tank"},
+				{"name": "sdb", "serial": "S2", "size": 500, "pool": "backup"},
+				{"name": "sdc", "serial": "S3", "size": 250, "pool": null}
+			]`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	usage, err := client.Storage.Usage(NewTestContext(t))
+	require.NoError(t, err)
+	require.Len(t, usage.Pools, 2)
+
+	tank := usage.Pools[0]
+	assert.Equal(t, "tank", tank.Name)
+	assert.True(t, tank.Healthy)
+	assert.Equal(t, int64(1000), tank.Size)
+	assert.Equal(t, int64(400), tank.Used)
+	assert.Equal(t, int64(600), tank.Free)
+	assert.Equal(t, []DatasetUsage{
+		{Name: "tank", Type: DatasetTypeFilesystem, Used: 400, Available: 600},
+		{Name: "tank/apps", Type: DatasetTypeFilesystem, Used: 150, Available: 50, Quota: 200},
+	}, tank.Datasets)
+	assert.Equal(t, []DiskUsage{{Name: "sda", Serial: "S1", Model: "HDD", Size: 1000}}, tank.Disks)
+
+	backup := usage.Pools[1]
+	assert.Equal(t, PoolStatusDegraded, backup.Status)
+	assert.False(t, backup.Healthy)
+	require.Len(t, backup.Datasets, 1)
+	assert.True(t, backup.Datasets[0].Locked)
+	assert.Zero(t, backup.Datasets[0].Used)
+	require.Len(t, backup.Disks, 1)
+	assert.Equal(t, "sdb", backup.Disks[0].Name)
+}
+
+func TestStorageClient_Usage_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetError("disk.query", 13, "Permission denied")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	usage, err := client.Storage.Usage(NewTestContext(t))
+	require.Error(t, err)
+	assert.Nil(t, usage)
+	assert.Contains(t, err.Error(), "disk.query")
+}
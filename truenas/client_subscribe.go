@@ -2,86 +2,224 @@ package truenas
 
 import (
 	"context"
-	"log"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
 
 	"github.com/puzpuzpuz/xsync/v3"
 )
 
+// EventType represents the kind of change reported by a collection update
+type EventType string
+
+const (
+	EventTypeAdded   EventType = "added"
+	EventTypeChanged EventType = "changed"
+	EventTypeRemoved EventType = "removed"
+)
+
+// eventBufferSize is the number of events Watch buffers per subscription before new events are dropped
+const eventBufferSize = 100
+
+// Event represents a collection update pushed by the middleware
+type Event struct {
+	Type       EventType       `json:"msg"`
+	Collection string          `json:"collection"`
+	ID         string          `json:"id,omitempty"`
+	Fields     json.RawMessage `json:"fields,omitempty"`
+}
+
+// Unmarshal decodes the event fields into v
+func (e *Event) Unmarshal(v any) error {
+	if err := unmarshalJSON(e.Fields, v); err != nil {
+		return fmt.Errorf("unmarshal event fields: %s: %w", string(e.Fields), err)
+	}
+	return nil
+}
+
+// Subscription represents an active event subscription
+type Subscription struct {
+	cs     *ClientSubscribe
+	id     string
+	name   string
+	events chan Event
+	mu     sync.Mutex
+	done   bool
+}
+
+// Name returns the event name the subscription was created for
+func (s *Subscription) Name() string {
+	return s.name
+}
+
+// Events returns the channel that receives events. It is closed once the subscription ends.
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Unsubscribe stops the subscription and closes its event channel
+func (s *Subscription) Unsubscribe(ctx context.Context) error {
+	if _, ok := s.cs.subs.LoadAndDelete(s.id); !ok {
+		return nil
+	}
+	s.close()
+	return s.cs.client.write(ctx, &Message{ID: s.id, Msg: "unsub"})
+}
+
+func (s *Subscription) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.done {
+		s.done = true
+		close(s.events)
+	}
+}
+
+// deliver queues an event without blocking, reporting whether it was accepted.
+func (s *Subscription) deliver(event Event) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.done {
+		return true
+	}
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+// matches reports whether a collection update belongs to this subscription.
+// Subscriptions may carry arguments after a colon, e.g. "reporting.realtime:{...}".
+func (s *Subscription) matches(collection string) bool {
+	name, _, _ := strings.Cut(s.name, ":")
+	return s.name == collection || name == collection
+}
+
+// ClientSubscribe provides methods for subscribing to middleware events
 type ClientSubscribe struct {
-	client      *Client
-	subscribeId *xsync.MapOf[string, string]
-	subscribeCh *xsync.MapOf[string, chan struct{}]
+	client    *Client
+	subs      *xsync.MapOf[string, *Subscription]
+	callbacks *xsync.MapOf[string, *Subscription]
 }
 
+// NewClientSubscribe creates a new subscription client
 func NewClientSubscribe(client *Client) *ClientSubscribe {
 	return &ClientSubscribe{
-		client:      client,
-		subscribeId: xsync.NewMapOf[string, string](),
-		subscribeCh: xsync.NewMapOf[string, chan struct{}](),
+		client:    client,
+		subs:      xsync.NewMapOf[string, *Subscription](),
+		callbacks: xsync.NewMapOf[string, *Subscription](),
 	}
 }
 
-// Subscribe allows subscribing to TrueNAS events via WebSocket
-func (cs *ClientSubscribe) Subscribe(ctx context.Context, collection string, collectionUpdate func(Message) error) error {
-	var subscribeId string
-	ch := make(chan Message, 10)
-	cs.client.pending.Store(collection, ch) // add pending channels
+// Watch subscribes to an event such as "core.get_jobs", "alert.list" or "reporting.realtime"
+// using the DDP sub/unsub protocol, or core.subscribe over JSON-RPC. Events are delivered
+// on the returned subscription's channel, which buffers a limited number of events; events
+// are dropped while the buffer is full. Subscriptions are restored automatically after a reconnect.
+func (cs *ClientSubscribe) Watch(ctx context.Context, name string) (*Subscription, error) {
+	return cs.WatchBuffered(ctx, name, eventBufferSize)
+}
 
-	if err := cs.client.Call(ctx, "core.subscribe", []any{collection}, &subscribeId); err != nil {
-		log.Printf("error subscribing to collection %s: %v", collection, err)
-		return err
+// WatchBuffered subscribes to an event like Watch, buffering up to size events, such as for
+// events the server sends in bursts larger than Watch buffers
+func (cs *ClientSubscribe) WatchBuffered(ctx context.Context, name string, size int) (*Subscription, error) {
+	ctx, cancel := cs.client.callContext(ctx)
+	defer cancel()
+
+	sub := &Subscription{
+		cs:     cs,
+		id:     cs.client.nextID(),
+		name:   name,
+		events: make(chan Event, size),
 	}
+	cs.subs.Store(sub.id, sub)
 
-	log.Printf("subscribed to collection %s with subscribeId %s", collection, subscribeId)
+	result, err := cs.client.send(ctx, &Message{ID: sub.id, Msg: "sub", Name: name})
+	if err == nil && result.Error != nil {
+		err = result.Error
+	}
+	if err != nil {
+		cs.subs.Delete(sub.id)
+		sub.close()
+		return nil, fmt.Errorf("subscribe %s: %w", name, err)
+	}
+	return sub, nil
+}
 
-	unsubscribe := make(chan struct{}, 1)
-	cs.subscribeId.Store(collection, subscribeId)
-	cs.subscribeCh.Store(collection, unsubscribe)
+// Subscribe subscribes to a collection and invokes collectionUpdate for every event received.
+// Only one callback subscription per collection is kept; use Unsubscribe to stop it.
+func (cs *ClientSubscribe) Subscribe(ctx context.Context, collection string, collectionUpdate func(Message) error) error {
+	sub, err := cs.Watch(ctx, collection)
+	if err != nil {
+		return err
+	}
+	if prev, ok := cs.callbacks.LoadAndStore(collection, sub); ok {
+		_ = prev.Unsubscribe(ctx)
+	}
 
 	go func() {
-		for {
-			select {
-			case msg, ok := <-ch:
-				if !ok {
-					log.Panicf("channel closed unexpectedly for collection %s", collection)
-					return
-				}
-
-				if err := collectionUpdate(msg); err != nil {
-					// Handle error (e.g., log it)
-					log.Printf("error handling collection update: %v", err)
-				}
-			case <-unsubscribe:
-				log.Printf("received unsubscribe signal for collection %s", collection)
-				close(ch)
-				// stop the goroutine
-				return
+		for event := range sub.Events() {
+			msg := Message{
+				ID:         event.ID,
+				Msg:        string(event.Type),
+				Collection: event.Collection,
+				Fields:     event.Fields,
+			}
+			if err := collectionUpdate(msg); err != nil {
+				cs.client.logConnection(slog.LevelWarn, "truenas event handler failed", "event", collection, "error", err)
 			}
 		}
-		log.Printf("stopped listening to collection %s", collection)
 	}()
 
 	return nil
 }
 
+// Unsubscribe stops a callback subscription created with Subscribe
 func (cs *ClientSubscribe) Unsubscribe(ctx context.Context, collection string) error {
-	subscribeId, ok := cs.subscribeId.LoadAndDelete(collection)
+	sub, ok := cs.callbacks.LoadAndDelete(collection)
 	if !ok {
-		log.Printf("no active subscription found for collection %s", collection)
 		return nil
 	}
+	return sub.Unsubscribe(ctx)
+}
 
-	if err := cs.client.Call(ctx, "core.unsubscribe", []any{subscribeId}, nil); err != nil {
-		log.Printf("error unsubscribing from collection %s: %v", collection, err)
-		return err
+// dispatch fans a collection update out to all matching subscriptions.
+func (cs *ClientSubscribe) dispatch(msg Message) {
+	event := Event{
+		Type:       EventType(msg.Msg),
+		Collection: msg.Collection,
+		ID:         msg.ID,
+		Fields:     msg.Fields,
 	}
-	cs.client.pending.Delete(collection)
+	cs.subs.Range(func(_ string, sub *Subscription) bool {
+		if !sub.matches(msg.Collection) {
+			return true
+		}
+		if !sub.deliver(event) {
+			cs.client.logConnection(slog.LevelWarn, "truenas event dropped: subscriber is not keeping up", "event", sub.name)
+		}
+		return true
+	})
+}
 
-	ch, ok := cs.subscribeCh.LoadAndDelete(collection)
-	if ok {
-		ch <- struct{}{}
-		close(ch)
+// closed ends a subscription that was terminated by the server.
+func (cs *ClientSubscribe) closed(id string) {
+	if sub, ok := cs.subs.LoadAndDelete(id); ok {
+		sub.close()
 	}
-	log.Printf("unsubscribed from collection %s with subscribeId %s", collection, subscribeId)
-	return nil
+}
+
+// resubscribe replays all active subscriptions on a new connection.
+func (cs *ClientSubscribe) resubscribe() {
+	cs.subs.Range(func(id string, sub *Subscription) bool {
+		ctx, cancel := context.WithTimeout(context.Background(), cs.client.opts.DefaultWriteTimeout)
+		defer cancel()
+		if err := cs.client.write(ctx, &Message{ID: id, Msg: "sub", Name: sub.name}); err != nil {
+			cs.client.logConnection(slog.LevelWarn, "truenas resubscribe failed", "event", sub.name, "error", err)
+		}
+		return true
+	})
 }
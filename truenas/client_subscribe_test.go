@@ -0,0 +1,249 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEventServer starts a server that acknowledges DDP subscriptions and
+// pushes the given events once a subscription becomes ready.
+func newEventServer(t *testing.T, events []map[string]any, unsubbed chan<- string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var connectMsg map[string]any
+		_ = conn.ReadJSON(&connectMsg)
+		_ = conn.WriteJSON(map[string]any{"msg": "connected", "session": "test-session"})
+
+		for {
+			var msg Message
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			switch msg.Msg {
+			case "sub":
+				if msg.Name == "forbidden.event" {
+					_ = conn.WriteJSON(Message{ID: msg.ID, Msg: "nosub", Error: &ErrorMsg{Code: 13, Message: "Not authorized"}})
+					continue
+				}
+				_ = conn.WriteJSON(map[string]any{"msg": "ready", "subs": []string{msg.ID}})
+				for _, event := range events {
+					_ = conn.WriteJSON(event)
+				}
+			case "unsub":
+				if unsubbed != nil {
+					unsubbed <- msg.ID
+				}
+			default:
+				_ = conn.WriteJSON(Message{ID: msg.ID, Result: json.RawMessage(`true`)})
+			}
+		}
+	}))
+}
+
+func TestClientSubscribe_Watch(t *testing.T) {
+	t.Parallel()
+	events := []map[string]any{
+		{"msg": "added", "collection": "alert.list", "id": "abc", "fields": map[string]any{"uuid": "abc", "level": "WARNING"}},
+		{"msg": "changed", "collection": "core.get_jobs", "id": 42, "fields": map[string]any{"id": 42, "state": "RUNNING"}},
+		{"msg": "removed", "collection": "alert.list", "id": "abc"},
+	}
+	unsubbed := make(chan string, 1)
+	server := newEventServer(t, events, unsubbed)
+	defer server.Close()
+
+	client, err := NewClient(strings.Replace(server.URL, "http://", "ws://", 1)+"/websocket", Options{})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	sub, err := client.Subscribe.Watch(ctx, "alert.list")
+	require.NoError(t, err)
+	assert.Equal(t, "alert.list", sub.Name())
+
+	var received []Event
+	for len(received) < 2 {
+		select {
+		case event := <-sub.Events():
+			received = append(received, event)
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for events")
+		}
+	}
+
+	// Events for other collections must not be delivered
+	assert.Equal(t, EventTypeAdded, received[0].Type)
+	assert.Equal(t, "abc", received[0].ID)
+	var alert Alert
+	require.NoError(t, received[0].Unmarshal(&alert))
+	assert.Equal(t, "WARNING", alert.Level)
+	assert.Equal(t, EventTypeRemoved, received[1].Type)
+
+	require.NoError(t, sub.Unsubscribe(ctx))
+	select {
+	case id := <-unsubbed:
+		assert.Equal(t, sub.id, id)
+	case <-ctx.Done():
+		t.Fatal("server did not receive unsub")
+	}
+	_, open := <-sub.Events()
+	assert.False(t, open)
+
+	// Calls keep working alongside subscriptions
+	var ok bool
+	require.NoError(t, client.Call(ctx, "system.ready", nil, &ok))
+	assert.True(t, ok)
+}
+
+func TestClientSubscribe_Watch_NumericEventID(t *testing.T) {
+	t.Parallel()
+	events := []map[string]any{
+		{"msg": "changed", "collection": "core.get_jobs", "id": 1, "fields": map[string]any{"id": 1, "state": "RUNNING"}},
+	}
+	server := newEventServer(t, events, nil)
+	defer server.Close()
+
+	client, err := NewClient(strings.Replace(server.URL, "http://", "ws://", 1)+"/websocket", Options{})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	sub, err := client.Subscribe.Watch(ctx, "core.get_jobs")
+	require.NoError(t, err)
+
+	select {
+	case event := <-sub.Events():
+		assert.Equal(t, "1", event.ID)
+		var job Job
+		require.NoError(t, event.Unmarshal(&job))
+		assert.Equal(t, "RUNNING", job.State)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestClientSubscribe_Watch_Rejected(t *testing.T) {
+	t.Parallel()
+	server := newEventServer(t, nil, nil)
+	defer server.Close()
+
+	client, err := NewClient(strings.Replace(server.URL, "http://", "ws://", 1)+"/websocket", Options{})
+	require.NoError(t, err)
+	defer client.Close()
+
+	sub, err := client.Subscribe.Watch(NewTestContext(t), "forbidden.event")
+	assert.Error(t, err)
+	assert.Nil(t, sub)
+	assert.Contains(t, err.Error(), "Not authorized")
+}
+
+func TestClientSubscribe_WatchBuffered_Dropped(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	var buf syncBuffer
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username: "root",
+		Password: "hunter2",
+		Logger:   slog.New(slog.NewJSONHandler(&buf, nil)),
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	sub, err := client.Subscribe.WatchBuffered(NewTestContext(t), "alert.list", 2)
+	require.NoError(t, err)
+	for i := range 3 {
+		server.EmitEvent("alert.list", map[string]any{"id": i})
+	}
+
+	// Events beyond the buffer are dropped and reported to Options.Logger
+	assert.Eventually(t, func() bool {
+		return strings.Contains(buf.String(), `"msg":"truenas event dropped: subscriber is not keeping up","event":"alert.list"`)
+	}, time.Second, 10*time.Millisecond)
+	assert.Len(t, sub.Events(), 2)
+}
+
+func TestClientSubscribe_SubscribeCallback(t *testing.T) {
+	t.Parallel()
+	events := []map[string]any{
+		{"msg": "added", "collection": "app.stats", "fields": []map[string]any{{"app_name": "plex", "memory": 1024}}},
+	}
+	server := newEventServer(t, events, nil)
+	defer server.Close()
+
+	client, err := NewClient(strings.Replace(server.URL, "http://", "ws://", 1)+"/websocket", Options{})
+	require.NoError(t, err)
+	defer client.Close()
+
+	stats := make(chan []AppStats, 1)
+	ctx := NewTestContext(t)
+	err = client.App.SubscribeStats(ctx, func(s []AppStats) error {
+		stats <- s
+		return nil
+	})
+	require.NoError(t, err)
+
+	select {
+	case s := <-stats:
+		require.Len(t, s, 1)
+		assert.Equal(t, "plex", s[0].AppName)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for stats")
+	}
+
+	require.NoError(t, client.App.UnsubscribeStats(context.Background()))
+	assert.NoError(t, client.Subscribe.Unsubscribe(context.Background(), "app.stats"))
+}
+
+func TestClientSubscribe_SubscribeCallback_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	var buf syncBuffer
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username: "root",
+		Password: "hunter2",
+		Logger:   slog.New(slog.NewJSONHandler(&buf, nil)),
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	err = client.Subscribe.Subscribe(NewTestContext(t), "alert.list", func(Message) error {
+		return errors.New("unexpected alert")
+	})
+	require.NoError(t, err)
+	server.EmitEvent("alert.list", map[string]any{"id": 1})
+
+	// Callback errors are reported to Options.Logger
+	assert.Eventually(t, func() bool {
+		return strings.Contains(buf.String(), `"msg":"truenas event handler failed","event":"alert.list","error":"unexpected alert"`)
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestMessage_UnmarshalNumericID(t *testing.T) {
+	t.Parallel()
+	var msg Message
+	require.NoError(t, json.Unmarshal([]byte(`{"msg":"changed","collection":"core.get_jobs","id":7}`), &msg))
+	assert.Equal(t, "7", msg.ID)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"id":"12","result":true}`), &msg))
+	assert.Equal(t, "12", msg.ID)
+	assert.Equal(t, json.RawMessage(`true`), msg.Result)
+}
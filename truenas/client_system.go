@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 )
 
@@ -21,9 +22,11 @@ const (
 type UpdateStatus string
 
 const (
-	UpdateStatusAvailable   UpdateStatus = "AVAILABLE"
-	UpdateStatusUnavailable UpdateStatus = "UNAVAILABLE"
-	UpdateStatusDownloaded  UpdateStatus = "DOWNLOADED"
+	UpdateStatusAvailable      UpdateStatus = "AVAILABLE"
+	UpdateStatusUnavailable    UpdateStatus = "UNAVAILABLE"
+	UpdateStatusDownloaded     UpdateStatus = "DOWNLOADED"
+	UpdateStatusRebootRequired UpdateStatus = "REBOOT_REQUIRED"
+	UpdateStatusHAUnavailable  UpdateStatus = "HA_UNAVAILABLE"
 )
 
 // TrueNASTime handles MongoDB-style date objects from TrueNAS API
@@ -33,6 +36,10 @@ type TrueNASTime struct {
 
 // UnmarshalJSON handles both MongoDB-style dates {"$date": timestamp} and standard JSON dates
 func (t *TrueNASTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
 	// Try to unmarshal as MongoDB-style date object first
 	var mongoDate struct {
 		Date int64 `json:"$date"`
@@ -63,6 +70,41 @@ func (t TrueNASTime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.Format(time.RFC3339))
 }
 
+// TrueNASDate handles calendar dates, which the middleware encodes as {"$type": "date", "$value": "2006-01-02"}
+type TrueNASDate struct {
+	time.Time
+}
+
+// UnmarshalJSON handles both typed date objects and plain "2006-01-02" strings
+func (d *TrueNASDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var typed struct {
+		Value string `json:"$value"`
+	}
+	dateStr := ""
+	if err := json.Unmarshal(data, &typed); err == nil {
+		dateStr = typed.Value
+	} else if err := json.Unmarshal(data, &dateStr); err != nil {
+		return fmt.Errorf("unable to unmarshal date: %w", err)
+	}
+
+	parsedDate, err := time.Parse(time.DateOnly, dateStr)
+	if err != nil {
+		return fmt.Errorf("unable to parse date string %q: %w", dateStr, err)
+	}
+
+	d.Time = parsedDate
+	return nil
+}
+
+// MarshalJSON marshals TrueNASDate as a "2006-01-02" string
+func (d TrueNASDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Format(time.DateOnly))
+}
+
 // SystemClient provides methods for system management
 type SystemClient struct {
 	client *Client
@@ -75,47 +117,157 @@ func NewSystemClient(client *Client) *SystemClient {
 
 // SystemInfo represents detailed system information
 type SystemInfo struct {
-	Version              string      `json:"version"`
-	BuildDate            TrueNASTime `json:"buildtime"`
-	Hostname             string      `json:"hostname"`
-	PhysicalMemory       uint64      `json:"physmem"`
-	Model                string      `json:"model"`
-	Cores                int         `json:"cores"`
-	PhysicalCores        int         `json:"physical_cores"`
-	LoadAvg              []float64   `json:"loadavg"`
-	Uptime               string      `json:"uptime"`
-	UptimeSeconds        float64     `json:"uptime_seconds"`
-	SystemSerial         string      `json:"system_serial"`
-	SystemProduct        string      `json:"system_product"`
-	SystemProductVersion string      `json:"system_product_version"`
-	License              any         `json:"license"`
-	BootTime             TrueNASTime `json:"boottime"`
-	DateTime             TrueNASTime `json:"datetime"`
-	Timezone             string      `json:"timezone"`
-	SystemManufacturer   string      `json:"system_manufacturer"`
-	ECC                  bool        `json:"ecc_memory"`
+	Version              string         `json:"version"`
+	BuildDate            TrueNASTime    `json:"buildtime"`
+	Hostname             string         `json:"hostname"`
+	PhysicalMemory       uint64         `json:"physmem"`
+	Model                string         `json:"model"`
+	Cores                int            `json:"cores"`
+	PhysicalCores        int            `json:"physical_cores"`
+	LoadAvg              []float64      `json:"loadavg"`
+	Uptime               string         `json:"uptime"`
+	UptimeSeconds        float64        `json:"uptime_seconds"`
+	SystemSerial         string         `json:"system_serial"`
+	SystemProduct        string         `json:"system_product"`
+	SystemProductVersion string         `json:"system_product_version"`
+	License              *SystemLicense `json:"license"` // Nil on systems without a license
+	BootTime             TrueNASTime    `json:"boottime"`
+	DateTime             TrueNASTime    `json:"datetime"`
+	Birthday             TrueNASTime    `json:"birthday"` // When the system was installed, if known
+	Timezone             string         `json:"timezone"`
+	SystemManufacturer   string         `json:"system_manufacturer"`
+	ECC                  bool           `json:"ecc_memory"`
 }
 
+// SystemLicense describes the license of a TrueNAS Enterprise system
+type SystemLicense struct {
+	Model          string      `json:"model"`
+	SystemSerial   string      `json:"system_serial"`
+	SystemSerialHA string      `json:"system_serial_ha"`
+	ContractType   string      `json:"contract_type"`
+	ContractStart  TrueNASDate `json:"contract_start"`
+	ContractEnd    TrueNASDate `json:"contract_end"`
+	CustomerName   string      `json:"customer_name"`
+	Expired        bool        `json:"expired"`
+	Features       []string    `json:"features"`
+	AddHW          [][]int     `json:"addhw"` // Pairs of quantity and hardware code
+	AddHWDetail    []string    `json:"addhw_detail"`
+}
+
+// SystemFeature is a licensed feature checked by system.feature_enabled
+type SystemFeature string
+
+const (
+	SystemFeatureDedup        SystemFeature = "DEDUP"
+	SystemFeatureFibreChannel SystemFeature = "FIBRECHANNEL"
+	SystemFeatureVM           SystemFeature = "VM"
+	SystemFeatureJails        SystemFeature = "JAILS" // CORE only
+)
+
 // SystemGeneralConfig represents general system configuration
 type SystemGeneralConfig struct {
-	ID                  int      `json:"id"`
-	UIAddress           []string `json:"ui_address"`
-	UIV6Address         []string `json:"ui_v6address"`
-	UIPort              int      `json:"ui_port"`
-	UIHTTPSPort         int      `json:"ui_httpsport"`
-	UIHTTPSProtocols    []string `json:"ui_httpsprotocols"`
-	UIHTTPSRedirect     bool     `json:"ui_httpsredirect"`
-	UIXFrameOptions     string   `json:"ui_x_frame_options"`
-	UIAllowlist         []string `json:"ui_allowlist"`
-	UIConsoleMsgEnabled bool     `json:"ui_consolemsg"`
-	KBDMap              string   `json:"kbdmap"`
-	Language            string   `json:"language"`
-	Timezone            string   `json:"timezone"`
-	CrashReporting      bool     `json:"crash_reporting"`
-	UsageCollection     bool     `json:"usage_collection"`
-	Birthday            any      `json:"birthday"`
-	WizardShown         bool     `json:"wizardshown"`
-	DSAuth              bool     `json:"ds_auth"`
+	ID                  int          `json:"id"`
+	UIAddress           []string     `json:"ui_address"`
+	UIV6Address         []string     `json:"ui_v6address"`
+	UIPort              int          `json:"ui_port"`
+	UIHTTPSPort         int          `json:"ui_httpsport"`
+	UIHTTPSProtocols    []string     `json:"ui_httpsprotocols"`
+	UIHTTPSRedirect     bool         `json:"ui_httpsredirect"`
+	UIXFrameOptions     string       `json:"ui_x_frame_options"`
+	UIAllowlist         []string     `json:"ui_allowlist"`
+	UIConsoleMsgEnabled bool         `json:"ui_consolemsg"`
+	UICertificate       *Certificate `json:"ui_certificate,omitempty"` // Sent to the server as the certificate ID
+	KBDMap              string       `json:"kbdmap"`
+	Language            string       `json:"language"`
+	Timezone            string       `json:"timezone"`
+	CrashReporting      bool         `json:"crash_reporting"`
+	UsageCollection     bool         `json:"usage_collection"`
+	Birthday            any          `json:"birthday"`
+	WizardShown         bool         `json:"wizardshown"`
+	DSAuth              bool         `json:"ds_auth"`
+}
+
+// SyslogLevel represents the minimum severity forwarded to a remote syslog server
+type SyslogLevel string
+
+const (
+	SyslogLevelEmergency SyslogLevel = "F_EMERG"
+	SyslogLevelAlert     SyslogLevel = "F_ALERT"
+	SyslogLevelCritical  SyslogLevel = "F_CRIT"
+	SyslogLevelError     SyslogLevel = "F_ERR"
+	SyslogLevelWarning   SyslogLevel = "F_WARNING"
+	SyslogLevelNotice    SyslogLevel = "F_NOTICE"
+	SyslogLevelInfo      SyslogLevel = "F_INFO"
+	SyslogLevelDebug     SyslogLevel = "F_DEBUG"
+)
+
+// SyslogTransport represents the transport used to reach a remote syslog server
+type SyslogTransport string
+
+const (
+	SyslogTransportUDP SyslogTransport = "UDP"
+	SyslogTransportTCP SyslogTransport = "TCP"
+	SyslogTransportTLS SyslogTransport = "TLS"
+)
+
+// SystemAdvancedConfig represents advanced system configuration
+type SystemAdvancedConfig struct {
+	ID                            int             `json:"id"`
+	ConsoleMenu                   bool            `json:"consolemenu"`
+	SerialConsole                 bool            `json:"serialconsole"`
+	SerialPort                    string          `json:"serialport"`
+	SerialSpeed                   string          `json:"serialspeed"`
+	PowerDaemon                   bool            `json:"powerdaemon"`
+	Overprovision                 *int            `json:"overprovision"`
+	Traceback                     bool            `json:"traceback"`
+	AdvancedMode                  bool            `json:"advancedmode"`
+	Autotune                      bool            `json:"autotune"`
+	DebugKernel                   bool            `json:"debugkernel"`
+	UploadCrash                   bool            `json:"uploadcrash"`
+	MOTD                          string          `json:"motd"`
+	LoginBanner                   string          `json:"login_banner"`
+	BootScrub                     int             `json:"boot_scrub"` // Days between boot pool scrubs
+	FQDNSyslog                    bool            `json:"fqdn_syslog"`
+	SyslogLevel                   SyslogLevel     `json:"sysloglevel"`
+	SyslogServer                  string          `json:"syslogserver"`
+	SyslogTransport               SyslogTransport `json:"syslog_transport"`
+	SyslogTLSCertificate          *int            `json:"syslog_tls_certificate"`
+	SyslogTLSCertificateAuthority *int            `json:"syslog_tls_certificate_authority"`
+	KdumpEnabled                  bool            `json:"kdump_enabled"`
+	IsolatedGPUPCIIDs             []string        `json:"isolated_gpu_pci_ids"`
+	KernelExtraOptions            string          `json:"kernel_extra_options"`
+	ConsoleMsg                    bool            `json:"consolemsg"`
+	SEDUser                       string          `json:"sed_user"`
+}
+
+// SystemAdvancedUpdateRequest represents parameters for system.advanced.update
+type SystemAdvancedUpdateRequest struct {
+	ConsoleMenu                   *bool            `json:"consolemenu,omitempty"`
+	SerialConsole                 *bool            `json:"serialconsole,omitempty"`
+	SerialPort                    *string          `json:"serialport,omitempty"`
+	SerialSpeed                   *string          `json:"serialspeed,omitempty"`
+	PowerDaemon                   *bool            `json:"powerdaemon,omitempty"`
+	Overprovision                 *int             `json:"overprovision,omitempty"`
+	Traceback                     *bool            `json:"traceback,omitempty"`
+	AdvancedMode                  *bool            `json:"advancedmode,omitempty"`
+	Autotune                      *bool            `json:"autotune,omitempty"`
+	DebugKernel                   *bool            `json:"debugkernel,omitempty"`
+	UploadCrash                   *bool            `json:"uploadcrash,omitempty"`
+	MOTD                          *string          `json:"motd,omitempty"`
+	LoginBanner                   *string          `json:"login_banner,omitempty"`
+	BootScrub                     *int             `json:"boot_scrub,omitempty"`
+	FQDNSyslog                    *bool            `json:"fqdn_syslog,omitempty"`
+	SyslogLevel                   *SyslogLevel     `json:"sysloglevel,omitempty"`
+	SyslogServer                  *string          `json:"syslogserver,omitempty"`
+	SyslogTransport               *SyslogTransport `json:"syslog_transport,omitempty"`
+	SyslogTLSCertificate          *int             `json:"syslog_tls_certificate,omitempty"`
+	SyslogTLSCertificateAuthority *int             `json:"syslog_tls_certificate_authority,omitempty"`
+	KdumpEnabled                  *bool            `json:"kdump_enabled,omitempty"`
+	IsolatedGPUPCIIDs             []string         `json:"isolated_gpu_pci_ids,omitempty"`
+	KernelExtraOptions            *string          `json:"kernel_extra_options,omitempty"`
+	ConsoleMsg                    *bool            `json:"consolemsg,omitempty"`
+	SEDUser                       *string          `json:"sed_user,omitempty"`
+	SEDPassword                   *string          `json:"sed_passwd,omitempty"`
 }
 
 // BootEnv represents boot environment information
@@ -149,13 +301,20 @@ type UpdateInfo struct {
 	Error      *string      `json:"error,omitempty"`
 }
 
-// GetInfo returns system information
-func (s *SystemClient) GetInfo(ctx context.Context) (*SystemInfo, error) {
+// Info returns system information
+func (s *SystemClient) Info(ctx context.Context) (*SystemInfo, error) {
 	var result SystemInfo
 	err := s.client.Call(ctx, "system.info", []any{}, &result)
 	return &result, err
 }
 
+// GetInfo returns system information
+//
+// Deprecated: Use Info.
+func (s *SystemClient) GetInfo(ctx context.Context) (*SystemInfo, error) {
+	return s.Info(ctx)
+}
+
 // GetGeneralConfig returns general system configuration
 func (s *SystemClient) GetGeneralConfig(ctx context.Context) (*SystemGeneralConfig, error) {
 	var result SystemGeneralConfig
@@ -165,27 +324,124 @@ func (s *SystemClient) GetGeneralConfig(ctx context.Context) (*SystemGeneralConf
 
 // UpdateGeneralConfig updates general system configuration
 func (s *SystemClient) UpdateGeneralConfig(ctx context.Context, config *SystemGeneralConfig) (*SystemGeneralConfig, error) {
+	params, err := toMap(config)
+	if err != nil {
+		return nil, err
+	}
+	// system.general.config expands the GUI certificate, but system.general.update expects its ID
+	if config.UICertificate != nil {
+		params["ui_certificate"] = config.UICertificate.ID
+	}
+
 	var result SystemGeneralConfig
-	err := s.client.Call(ctx, "system.general.update", []any{*config}, &result)
+	err = s.client.Call(ctx, "system.general.update", []any{params}, &result)
 	return &result, err
 }
 
-// Reboot reboots the system
-func (s *SystemClient) Reboot(ctx context.Context, delay int) error {
+// SetUICertificate sets the certificate used by the web interface
+func (s *SystemClient) SetUICertificate(ctx context.Context, certificateID int) error {
+	return s.client.Call(ctx, "system.general.update", []any{map[string]any{"ui_certificate": certificateID}}, nil)
+}
+
+// GetAdvancedConfig returns advanced system configuration
+func (s *SystemClient) GetAdvancedConfig(ctx context.Context) (*SystemAdvancedConfig, error) {
+	var result SystemAdvancedConfig
+	err := s.client.Call(ctx, "system.advanced.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateAdvancedConfig updates advanced system configuration
+func (s *SystemClient) UpdateAdvancedConfig(ctx context.Context, req *SystemAdvancedUpdateRequest) (*SystemAdvancedConfig, error) {
+	var result SystemAdvancedConfig
+	err := s.client.Call(ctx, "system.advanced.update", []any{*req}, &result)
+	return &result, err
+}
+
+// GetSerialPortChoices returns the serial ports available for the serial console
+func (s *SystemClient) GetSerialPortChoices(ctx context.Context) (map[string]string, error) {
+	var result map[string]string
+	err := s.client.Call(ctx, "system.advanced.serial_port_choices", []any{}, &result)
+	return result, err
+}
+
+// ConfigSaveOptions represents options for config.save
+type ConfigSaveOptions struct {
+	SecretSeed         bool `json:"secretseed,omitempty"`           // Include the seed that decrypts stored secrets
+	RootAuthorizedKeys bool `json:"root_authorized_keys,omitempty"` // Include root's SSH authorized keys
+}
+
+// ConfigSave downloads the system configuration database into w. With SecretSeed
+// or RootAuthorizedKeys set the download is a tar archive instead of a bare database.
+func (s *SystemClient) ConfigSave(ctx context.Context, w io.Writer, options *ConfigSaveOptions) error {
 	params := []any{}
-	if delay > 0 {
-		params = append(params, map[string]any{"delay": delay})
+	filename := "truenas-config.db"
+	if options != nil {
+		params = append(params, *options)
+		if options.SecretSeed || options.RootAuthorizedKeys {
+			filename = "truenas-config.tar"
+		}
 	}
-	return s.client.CallJob(ctx, "system.reboot", params, nil)
+	return s.client.CallDownload(ctx, "config.save", params, filename, w)
+}
+
+// ConfigUpload uploads a configuration database or archive produced by ConfigSave and applies it.
+// The system reboots once the job completes.
+func (s *SystemClient) ConfigUpload(ctx context.Context, r io.Reader) error {
+	return s.client.CallUpload(ctx, "config.upload", []any{}, r, nil, nil)
+}
+
+// ConfigReset resets the configuration database to factory defaults, optionally rebooting afterwards
+func (s *SystemClient) ConfigReset(ctx context.Context, reboot bool) error {
+	return s.client.CallJob(ctx, "config.reset", []any{map[string]any{"reboot": reboot}}, nil)
 }
 
-// Shutdown shuts down the system
+// SystemPowerOptions represents options for RebootWithOptions and ShutdownWithOptions
+type SystemPowerOptions struct {
+	Delay int  // Seconds to wait before rebooting or shutting down
+	Force bool // Proceed even if other jobs are running
+}
+
+// Reboot reboots the system without checking for running jobs
+func (s *SystemClient) Reboot(ctx context.Context, delay int) error {
+	return s.RebootWithOptions(ctx, &SystemPowerOptions{Delay: delay, Force: true})
+}
+
+// RebootWithOptions reboots the system. Unless Force is set, it returns a *RunningJobsError
+// instead of rebooting while other jobs are running.
+func (s *SystemClient) RebootWithOptions(ctx context.Context, options *SystemPowerOptions) error {
+	return s.power(ctx, "system.reboot", options)
+}
+
+// Shutdown shuts down the system without checking for running jobs
 func (s *SystemClient) Shutdown(ctx context.Context, delay int) error {
+	return s.ShutdownWithOptions(ctx, &SystemPowerOptions{Delay: delay, Force: true})
+}
+
+// ShutdownWithOptions shuts down the system. Unless Force is set, it returns a *RunningJobsError
+// instead of shutting down while other jobs are running.
+func (s *SystemClient) ShutdownWithOptions(ctx context.Context, options *SystemPowerOptions) error {
+	return s.power(ctx, "system.shutdown", options)
+}
+
+func (s *SystemClient) power(ctx context.Context, method string, options *SystemPowerOptions) error {
+	if options == nil {
+		options = &SystemPowerOptions{}
+	}
+	if !options.Force {
+		running, err := s.client.Job.ListWithQuery(ctx, NewQuery().Filter("state", "=", string(JobStateRunning)))
+		if err != nil {
+			return fmt.Errorf("check running jobs: %w", err)
+		}
+		if len(running) > 0 {
+			return &RunningJobsError{Jobs: running}
+		}
+	}
+
 	params := []any{}
-	if delay > 0 {
-		params = append(params, map[string]any{"delay": delay})
+	if options.Delay > 0 {
+		params = append(params, map[string]any{"delay": options.Delay})
 	}
-	return s.client.CallJob(ctx, "system.shutdown", params, nil)
+	return s.client.CallJob(ctx, method, params, nil)
 }
 
 // Ready checks if the system is ready
@@ -195,25 +451,74 @@ func (s *SystemClient) Ready(ctx context.Context) (bool, error) {
 	return result, err
 }
 
+// Version returns the parsed system version. Versions that cannot be parsed are returned
+// with only Raw set, along with the parse error.
+func (s *SystemClient) Version(ctx context.Context) (ServerVersion, error) {
+	var raw string
+	if err := s.client.Call(ctx, "system.version", []any{}, &raw); err != nil {
+		return ServerVersion{}, err
+	}
+	return ParseServerVersion(raw)
+}
+
 // GetVersion returns system version
+//
+// Deprecated: Use Version, whose Raw field holds the version string.
 func (s *SystemClient) GetVersion(ctx context.Context) (string, error) {
 	var result string
 	err := s.client.Call(ctx, "system.version", []any{}, &result)
 	return result, err
 }
 
-// GetHostname returns system hostname
-func (s *SystemClient) GetHostname(ctx context.Context) (string, error) {
+// Hostname returns system hostname
+func (s *SystemClient) Hostname(ctx context.Context) (string, error) {
 	var result string
 	err := s.client.Call(ctx, "system.hostname", []any{}, &result)
 	return result, err
 }
 
+// GetHostname returns system hostname
+//
+// Deprecated: Use Hostname.
+func (s *SystemClient) GetHostname(ctx context.Context) (string, error) {
+	return s.Hostname(ctx)
+}
+
 // SetHostname sets system hostname
 func (s *SystemClient) SetHostname(ctx context.Context, hostname string) error {
 	return s.client.Call(ctx, "system.hostname", []any{hostname}, nil)
 }
 
+// Product returns the product type, such as SCALE or SCALE_ENTERPRISE
+func (s *SystemClient) Product(ctx context.Context) (ProductType, error) {
+	var result ProductType
+	err := s.client.Call(ctx, "system.product_type", []any{}, &result)
+	return result, err
+}
+
+// FeatureEnabled reports whether a licensed feature is enabled
+func (s *SystemClient) FeatureEnabled(ctx context.Context, feature SystemFeature) (bool, error) {
+	var result bool
+	err := s.client.Call(ctx, "system.feature_enabled", []any{feature}, &result)
+	return result, err
+}
+
+// License returns the system license, or nil if the system is not licensed
+func (s *SystemClient) License(ctx context.Context) (*SystemLicense, error) {
+	var result *SystemLicense
+	if err := s.client.Call(ctx, "system.license", []any{}, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// IsStable reports whether the system runs a stable release rather than a nightly or beta build
+func (s *SystemClient) IsStable(ctx context.Context) (bool, error) {
+	var result bool
+	err := s.client.Call(ctx, "system.is_stable", []any{}, &result)
+	return result, err
+}
+
 // Boot Environment Methods
 
 // ListBootEnvs returns all boot environments
@@ -223,6 +528,11 @@ func (s *SystemClient) ListBootEnvs(ctx context.Context) ([]BootEnv, error) {
 	return result, err
 }
 
+// ListBootEnvsWithQuery returns boot environments matching q
+func (s *SystemClient) ListBootEnvsWithQuery(ctx context.Context, q *Query) ([]BootEnv, error) {
+	return query[BootEnv](ctx, s.client, "bootenv.query", q)
+}
+
 // CreateBootEnv creates a new boot environment
 func (s *SystemClient) CreateBootEnv(ctx context.Context, name, source string) (*BootEnv, error) {
 	var result BootEnv
@@ -252,6 +562,8 @@ func (s *SystemClient) SetBootEnvAttr(ctx context.Context, id string, attrs map[
 // Update Methods
 
 // GetUpdateConfig returns update configuration
+//
+// Deprecated: Use Client.Update.GetConfig.
 func (s *SystemClient) GetUpdateConfig(ctx context.Context) (*UpdateConfig, error) {
 	var result UpdateConfig
 	err := s.client.Call(ctx, "update.config", []any{}, &result)
@@ -259,6 +571,8 @@ func (s *SystemClient) GetUpdateConfig(ctx context.Context) (*UpdateConfig, erro
 }
 
 // CheckForUpdate checks for available updates
+//
+// Deprecated: Use Client.Update.CheckAvailable.
 func (s *SystemClient) CheckForUpdate(ctx context.Context) (*UpdateInfo, error) {
 	var result UpdateInfo
 	err := s.client.Call(ctx, "update.check_available", []any{}, &result)
@@ -273,6 +587,8 @@ func (s *SystemClient) GetPendingUpdate(ctx context.Context) (*UpdateInfo, error
 }
 
 // DownloadUpdate downloads available updates
+//
+// Deprecated: Use Client.Update.Download.
 func (s *SystemClient) DownloadUpdate(ctx context.Context) error {
 	return s.client.CallJob(ctx, "update.download", []any{}, nil)
 }
@@ -287,6 +603,8 @@ func (s *SystemClient) ManualUpdate(ctx context.Context, path string, rebootAfte
 }
 
 // GetTrains returns available update trains
+//
+// Deprecated: Use Client.Update.GetTrains.
 func (s *SystemClient) GetTrains(ctx context.Context) (map[string]any, error) {
 	var result map[string]any
 	err := s.client.Call(ctx, "update.get_trains", []any{}, &result)
@@ -294,6 +612,8 @@ func (s *SystemClient) GetTrains(ctx context.Context) (map[string]any, error) {
 }
 
 // SetTrain sets the update train
+//
+// Deprecated: Use Client.Update.SetTrain.
 func (s *SystemClient) SetTrain(ctx context.Context, train string) error {
 	return s.client.Call(ctx, "update.set_train", []any{train}, nil)
 }
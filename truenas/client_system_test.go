@@ -1,6 +1,9 @@
 package truenas
 
 import (
+	"bytes"
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -125,6 +128,142 @@ func TestSystemClient_GetHostname(t *testing.T) {
 	assert.Equal(t, "truenas.local", hostname)
 }
 
+func TestSystemClient_Info(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.info", json.RawMessage(`{
+		"version": "TrueNAS-SCALE-24.10.1",
+		"hostname": "truenas.local",
+		"physmem": 34359738368,
+		"birthday": {"$date": 1700000000000},
+		"license": {
+			"model": "M50",
+			"system_serial": "A1-12345",
+			"contract_type": "GOLD",
+			"contract_end": {"$type": "date", "$value": "2027-06-30"},
+			"features": ["DEDUP", "FIBRECHANNEL"],
+			"addhw": [[2, 1]]
+		}
+	}`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	info, err := client.System.Info(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "truenas.local", info.Hostname)
+	assert.Equal(t, uint64(34359738368), info.PhysicalMemory)
+	assert.Equal(t, int64(1700000000), info.Birthday.Unix())
+	require.NotNil(t, info.License)
+	assert.Equal(t, "M50", info.License.Model)
+	assert.Equal(t, "GOLD", info.License.ContractType)
+	assert.Equal(t, time.Date(2027, 6, 30, 0, 0, 0, 0, time.UTC), info.License.ContractEnd.Time)
+	assert.True(t, info.License.ContractStart.IsZero())
+	assert.Equal(t, []string{"DEDUP", "FIBRECHANNEL"}, info.License.Features)
+	assert.Equal(t, [][]int{{2, 1}}, info.License.AddHW)
+}
+
+func TestSystemClient_Version(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.10.1")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	version, err := client.System.Version(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ServerVersion{Raw: "TrueNAS-SCALE-24.10.1", Product: ProductSCALE, Major: 24, Minor: 10, Patch: 1}, version)
+
+	server.SetResponse("system.version", "custom-build")
+	version, err = client.System.Version(ctx)
+	require.Error(t, err)
+	assert.Equal(t, "custom-build", version.Raw)
+}
+
+func TestSystemClient_Hostname(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.hostname", "truenas.local")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	hostname, err := client.System.Hostname(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "truenas.local", hostname)
+}
+
+func TestSystemClient_Product(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.product_type", "SCALE_ENTERPRISE")
+	server.SetResponse("system.is_stable", true)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	product, err := client.System.Product(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, ProductSCALEEnterprise, product)
+
+	stable, err := client.System.IsStable(ctx)
+	require.NoError(t, err)
+	assert.True(t, stable)
+}
+
+func TestSystemClient_FeatureEnabled(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.feature_enabled", true)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	enabled, err := client.System.FeatureEnabled(ctx, SystemFeatureDedup)
+	require.NoError(t, err)
+	assert.True(t, enabled)
+	server.AssertCalled(t, "system.feature_enabled", "DEDUP")
+}
+
+func TestSystemClient_License(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.license", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	license, err := client.System.License(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, license)
+
+	server.SetResponse("system.license", json.RawMessage(`{"model": "M50", "contract_start": "2024-07-01", "expired": true}`))
+	license, err = client.System.License(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, license)
+	assert.True(t, license.Expired)
+	assert.Equal(t, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), license.ContractStart.Time)
+}
+
 func TestSystemClient_SetHostname(t *testing.T) {
 	t.Parallel()
 	server := NewTestServer(t)
@@ -452,3 +591,214 @@ func TestSystemClient_ErrorHandling(t *testing.T) {
 	assert.Equal(t, 500, apiErr.Code)
 	assert.Equal(t, "System unavailable", apiErr.Message)
 }
+
+func TestSystemClient_UpdateGeneralConfig_UICertificate(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "system.general.update" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`{"id": 1, "ui_certificate": {"id": 3, "name": "gui"}}`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	updated, err := client.System.UpdateGeneralConfig(ctx, &SystemGeneralConfig{UICertificate: &Certificate{ID: 3, Name: "gui"}})
+	require.NoError(t, err)
+	require.NotNil(t, updated.UICertificate)
+	assert.Equal(t, "gui", updated.UICertificate.Name)
+
+	params := (<-received).([]any)
+	require.Len(t, params, 1)
+	assert.EqualValues(t, 3, params[0].(map[string]any)["ui_certificate"])
+}
+
+func TestSystemClient_AdvancedConfig(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		config := json.RawMessage(`{
+			"id": 1,
+			"serialconsole": true,
+			"serialport": "ttyS0",
+			"serialspeed": "115200",
+			"sysloglevel": "F_INFO",
+			"syslogserver": "logs.example.com:514",
+			"syslog_transport": "TLS",
+			"syslog_tls_certificate": 2,
+			"syslog_tls_certificate_authority": null,
+			"kernel_extra_options": "mitigations=off",
+			"isolated_gpu_pci_ids": []
+		}`)
+		switch msg.Method {
+		case "system.advanced.config":
+			return Message{ID: msg.ID, Result: config}, true
+		case "system.advanced.update":
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: config}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	config, err := client.System.GetAdvancedConfig(ctx)
+	require.NoError(t, err)
+	assert.True(t, config.SerialConsole)
+	assert.Equal(t, SyslogLevelInfo, config.SyslogLevel)
+	assert.Equal(t, SyslogTransportTLS, config.SyslogTransport)
+	assert.Equal(t, 2, value(config.SyslogTLSCertificate))
+	assert.Nil(t, config.SyslogTLSCertificateAuthority)
+	assert.Equal(t, "mitigations=off", config.KernelExtraOptions)
+
+	_, err = client.System.UpdateAdvancedConfig(ctx, &SystemAdvancedUpdateRequest{
+		SyslogLevel:        Ptr(SyslogLevelInfo),
+		KernelExtraOptions: Ptr("mitigations=off"),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"sysloglevel": "F_INFO", "kernel_extra_options": "mitigations=off"}]`, tryMarshal(<-received))
+}
+
+func TestSystemClient_ConfigSave(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetDownload("config.save", []byte("SQLite format 3\x00"))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	var buf bytes.Buffer
+	require.NoError(t, client.System.ConfigSave(ctx, &buf, &ConfigSaveOptions{SecretSeed: true}))
+	assert.Equal(t, "SQLite format 3\x00", buf.String())
+}
+
+func TestSystemClient_ConfigSave_DownloadFailed(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("core.download", []any{999, "/_download/999?auth_token=test-token"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	var buf bytes.Buffer
+	err := client.System.ConfigSave(ctx, &buf, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+}
+
+func TestSystemClient_ConfigUpload(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("config.upload", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.System.ConfigUpload(ctx, strings.NewReader("SQLite format 3")))
+
+	uploads := server.Uploads()
+	require.Len(t, uploads, 1)
+	assert.Equal(t, "config.upload", uploads[0].Method)
+	assert.Equal(t, "SQLite format 3", string(uploads[0].Data))
+}
+
+func TestSystemClient_ConfigReset(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("config.reset", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.System.ConfigReset(ctx, false))
+}
+
+func TestSystemClient_RebootWithOptions_RunningJobs(t *testing.T) {
+	t.Parallel()
+	rebooted := make(chan struct{}, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "core.get_jobs":
+			return Message{ID: msg.ID, Result: json.RawMessage(`[{"id": 5, "method": "pool.scrub.scrub", "state": "RUNNING"}]`)}, true
+		case "system.reboot":
+			rebooted <- struct{}{}
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	err := client.System.RebootWithOptions(ctx, &SystemPowerOptions{Delay: 10})
+
+	var runningErr *RunningJobsError
+	require.ErrorAs(t, err, &runningErr)
+	require.Len(t, runningErr.Jobs, 1)
+	assert.Equal(t, "pool.scrub.scrub", runningErr.Jobs[0].Method)
+	assert.Contains(t, err.Error(), "pool.scrub.scrub (5)")
+	assert.Empty(t, rebooted)
+}
+
+func TestSystemClient_ShutdownWithOptions(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "core.get_jobs":
+			if strings.Contains(tryMarshal(msg.Params), `"state"`) {
+				return Message{ID: msg.ID, Result: json.RawMessage(`[]`)}, true
+			}
+			return Message{ID: msg.ID, Result: json.RawMessage(`[{"id": 8, "method": "system.shutdown", "state": "SUCCESS"}]`)}, true
+		case "system.shutdown":
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`8`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.System.ShutdownWithOptions(ctx, &SystemPowerOptions{Delay: 30}))
+	assert.JSONEq(t, `[{"delay": 30}]`, tryMarshal(<-received))
+}
+
+func TestSystemClient_RebootWithOptions_Force(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	// core.get_jobs reports the reboot job itself; Force skips the running jobs check
+	server.SetJobResponse("system.reboot", nil)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.System.RebootWithOptions(ctx, &SystemPowerOptions{Force: true}))
+}
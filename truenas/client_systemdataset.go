@@ -0,0 +1,52 @@
+package truenas
+
+import (
+	"context"
+)
+
+// SystemDatasetClient provides methods for managing the system dataset location
+type SystemDatasetClient struct {
+	client *Client
+}
+
+// NewSystemDatasetClient creates a new system dataset client
+func NewSystemDatasetClient(client *Client) *SystemDatasetClient {
+	return &SystemDatasetClient{client: client}
+}
+
+// SystemDatasetConfig represents the system dataset configuration
+type SystemDatasetConfig struct {
+	ID       int    `json:"id"`
+	Pool     string `json:"pool"`
+	PoolSet  bool   `json:"pool_set"`
+	UUID     string `json:"uuid"`
+	Basename string `json:"basename"`
+	Path     string `json:"path"`
+}
+
+// SystemDatasetUpdateRequest represents parameters for systemdataset.update
+type SystemDatasetUpdateRequest struct {
+	Pool        *string `json:"pool,omitempty"`
+	PoolExclude *string `json:"pool_exclude,omitempty"` // Pool to move the system dataset away from, e.g. before export
+}
+
+// GetConfig returns the system dataset configuration
+func (s *SystemDatasetClient) GetConfig(ctx context.Context) (*SystemDatasetConfig, error) {
+	var result SystemDatasetConfig
+	err := s.client.Call(ctx, "systemdataset.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig moves the system dataset (asynchronous job)
+func (s *SystemDatasetClient) UpdateConfig(ctx context.Context, req *SystemDatasetUpdateRequest) (*SystemDatasetConfig, error) {
+	var result SystemDatasetConfig
+	err := s.client.CallJob(ctx, "systemdataset.update", []any{*req}, &result)
+	return &result, err
+}
+
+// PoolChoices returns the pools the system dataset can be placed on
+func (s *SystemDatasetClient) PoolChoices(ctx context.Context, includeCurrentPool bool) (map[string]string, error) {
+	var result map[string]string
+	err := s.client.Call(ctx, "systemdataset.pool_choices", []any{includeCurrentPool}, &result)
+	return result, err
+}
@@ -0,0 +1,82 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSystemDatasetClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	systemDatasetClient := NewSystemDatasetClient(client)
+	require.NotNil(t, systemDatasetClient)
+	assert.Equal(t, client, systemDatasetClient.client)
+}
+
+func TestSystemDatasetClient_GetConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("systemdataset.config", SystemDatasetConfig{
+		ID:       1,
+		Pool:     "boot-pool",
+		PoolSet:  true,
+		UUID:     "0f8e3f3c",
+		Basename: "boot-pool/.system",
+		Path:     "/var/db/system",
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	config, err := client.SystemDataset.GetConfig(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "boot-pool", config.Pool)
+	assert.Equal(t, "boot-pool/.system", config.Basename)
+}
+
+func TestSystemDatasetClient_UpdateConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("systemdataset.update", SystemDatasetConfig{ID: 1, Pool: "tank", PoolSet: true, Basename: "tank/.system"})
+	server.SetResponse("systemdataset.pool_choices", map[string]string{"boot-pool": "boot-pool", "tank": "tank"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	choices, err := client.SystemDataset.PoolChoices(ctx, true)
+	require.NoError(t, err)
+	require.Contains(t, choices, "tank")
+
+	config, err := client.SystemDataset.UpdateConfig(ctx, &SystemDatasetUpdateRequest{Pool: Ptr("tank")})
+	require.NoError(t, err)
+	assert.Equal(t, "tank/.system", config.Basename)
+}
+
+func TestSystemDatasetClient_UpdateConfig_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("systemdataset.update", "Pool tank is not available")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.SystemDataset.UpdateConfig(ctx, &SystemDatasetUpdateRequest{Pool: Ptr("tank")})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Pool tank is not available")
+}
@@ -3,6 +3,7 @@ package truenas
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -196,6 +197,73 @@ func TestReconnection_ConnectionDropHandling(t *testing.T) {
 	assert.Less(t, duration, 2*time.Second, "Close should complete quickly even during reconnection attempts")
 }
 
+func TestReconnection_HalfOpenConnection(t *testing.T) {
+	t.Parallel()
+	// The server stops reading on the first system.hang call, so pings go unanswered
+	var hung atomic.Bool
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "system.hang" && hung.CompareAndSwap(false, true) {
+			time.Sleep(2 * time.Second)
+			return Message{}, false
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:     "test",
+		Password:     "test",
+		PingInterval: 50 * time.Millisecond,
+		PongTimeout:  50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	start := time.Now()
+	err = client.Call(NewTestContext(t), "system.hang", nil, nil)
+	assert.ErrorIs(t, err, ErrConnectionLost)
+	assert.Less(t, time.Since(start), time.Second)
+
+	assert.Eventually(t, func() bool { return client.Stats().Reconnects == 1 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, client.Call(NewTestContext(t), "system.hang", nil, nil))
+}
+
+func TestClient_ConnectionCallbacks(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithDroppedCalls("system.info", 1))
+	defer server.Close()
+
+	events := make(chan string, 3)
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:  "test",
+		Password:  "test",
+		OnConnect: func() { events <- "connect" },
+		OnDisconnect: func(err error) {
+			assert.Error(t, err)
+			events <- "disconnect"
+		},
+		OnReconnect: func() { events <- "reconnect" },
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StateConnected, client.State())
+
+	err = client.Call(NewTestContext(t), "system.info", nil, nil)
+	require.ErrorIs(t, err, ErrConnectionLost)
+
+	for _, want := range []string{"connect", "disconnect", "reconnect"} {
+		select {
+		case got := <-events:
+			assert.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %s", want)
+		}
+	}
+	assert.Equal(t, StateConnected, client.State())
+
+	require.NoError(t, client.Close())
+	assert.Equal(t, StateClosed, client.State())
+}
+
 func TestReconnection_CallAfterServerDown(t *testing.T) {
 	t.Parallel()
 	// Test that calls fail gracefully when server is down
@@ -1013,3 +1081,92 @@ func TestClient_ReconnectCoverage(t *testing.T) {
 	err = client.Close()
 	assert.NoError(t, err)
 }
+
+func TestClient_CallRaw(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("system.info", map[string]any{"hostname": "nas01"})
+	server.SetError("system.version", 13, "Not authorized")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	raw, err := client.CallRaw(ctx, "system.info", nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"hostname": "nas01"}`, string(raw))
+
+	_, err = client.CallRaw(ctx, "system.version", nil)
+	var apiErr *ErrorMsg
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "Not authorized", apiErr.Message)
+}
+
+func TestClient_CallStream(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	snapshots := []map[string]any{
+		{"name": "tank@a"}, {"name": "tank@b"}, {"name": "tank@c"}, {"name": "tank@d"}, {"name": "tank@e"},
+	}
+	server.SetResponseFunc("zfs.snapshot.query", func(params []any) any {
+		var options QueryOptions
+		if err := json.Unmarshal([]byte(tryMarshal(params[1])), &options); err != nil {
+			return &ErrorMsg{Code: 22, Message: err.Error()}
+		}
+		start := min(options.Offset, len(snapshots))
+		return snapshots[start:min(start+options.Limit, len(snapshots))]
+	})
+	server.SetResponse("system.info", map[string]any{"hostname": "nas01"})
+	server.SetError("system.version", 13, "Not authorized")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	var names []string
+	err := client.CallStream(ctx, "zfs.snapshot.query", NewQuery(OrderBy("name")).PageSize(2), func(raw json.RawMessage) error {
+		var snap Snapshot
+		if err := json.Unmarshal(raw, &snap); err != nil {
+			return err
+		}
+		names = append(names, snap.Name)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tank@a", "tank@b", "tank@c", "tank@d", "tank@e"}, names)
+
+	// Records are fetched a page at a time
+	calls := server.Calls("zfs.snapshot.query")
+	require.Len(t, calls, 3)
+	for i, call := range calls {
+		var options QueryOptions
+		require.NoError(t, json.Unmarshal([]byte(tryMarshal(call.Params[1])), &options))
+		assert.Equal(t, QueryOptions{OrderBy: []string{"name"}, Limit: 2, Offset: 2 * i}, options)
+	}
+
+	t.Run("StopsOnCallbackError", func(t *testing.T) {
+		errStop := errors.New("stop")
+		n := 0
+		err := client.CallStream(ctx, "zfs.snapshot.query", nil, func(json.RawMessage) error {
+			n++
+			return errStop
+		})
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("NotArray", func(t *testing.T) {
+		err := client.CallStream(ctx, "system.info", nil, func(json.RawMessage) error { return nil })
+		assert.Error(t, err)
+	})
+
+	t.Run("CallError", func(t *testing.T) {
+		err := client.CallStream(ctx, "system.version", nil, func(json.RawMessage) error { return nil })
+		var apiErr *ErrorMsg
+		require.ErrorAs(t, err, &apiErr)
+	})
+}
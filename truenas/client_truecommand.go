@@ -0,0 +1,73 @@
+package truenas
+
+import (
+	"context"
+)
+
+// TrueCommandStatus represents the state of the connection to TrueCommand
+type TrueCommandStatus string
+
+const (
+	TrueCommandStatusConnected  TrueCommandStatus = "CONNECTED"
+	TrueCommandStatusConnecting TrueCommandStatus = "CONNECTING"
+	TrueCommandStatusDisabled   TrueCommandStatus = "DISABLED"
+	TrueCommandStatusFailed     TrueCommandStatus = "FAILED"
+)
+
+// TrueCommandClient provides methods for registering the system with TrueCommand
+type TrueCommandClient struct {
+	client *Client
+}
+
+// NewTrueCommandClient creates a new TrueCommand client
+func NewTrueCommandClient(client *Client) *TrueCommandClient {
+	return &TrueCommandClient{client: client}
+}
+
+// TrueCommandConfig represents the TrueCommand configuration
+type TrueCommandConfig struct {
+	ID              int               `json:"id"`
+	APIKey          *string           `json:"api_key"`
+	Enabled         bool              `json:"enabled"`
+	Status          TrueCommandStatus `json:"status"`
+	StatusReason    string            `json:"status_reason"`
+	RemoteURL       *string           `json:"remote_url"`
+	RemoteIPAddress *string           `json:"remote_ip_address"`
+}
+
+// TrueCommandUpdateRequest represents parameters for truecommand.update
+type TrueCommandUpdateRequest struct {
+	APIKey  *string `json:"api_key,omitempty"` // Key generated in TrueCommand for this system
+	Enabled *bool   `json:"enabled,omitempty"`
+}
+
+// TrueCommandConnection reports whether the system is connected to TrueCommand
+type TrueCommandConnection struct {
+	Connected      bool              `json:"connected"`
+	TrueCommandIP  *string           `json:"truecommand_ip"`
+	TrueCommandURL *string           `json:"truecommand_url"`
+	Status         TrueCommandStatus `json:"status"`
+	StatusReason   string            `json:"status_reason"`
+}
+
+// GetConfig returns the TrueCommand configuration
+func (t *TrueCommandClient) GetConfig(ctx context.Context) (*TrueCommandConfig, error) {
+	var result TrueCommandConfig
+	err := t.client.Call(ctx, "truecommand.config", []any{}, &result)
+	return &result, err
+}
+
+// UpdateConfig updates the TrueCommand configuration. Enabling it with an API key starts
+// registration, which completes asynchronously; use Connected to follow its progress.
+func (t *TrueCommandClient) UpdateConfig(ctx context.Context, req *TrueCommandUpdateRequest) (*TrueCommandConfig, error) {
+	var result TrueCommandConfig
+	err := t.client.Call(ctx, "truecommand.update", []any{*req}, &result)
+	return &result, err
+}
+
+// Connected returns the state of the connection to TrueCommand
+func (t *TrueCommandClient) Connected(ctx context.Context) (*TrueCommandConnection, error) {
+	var result TrueCommandConnection
+	err := t.client.Call(ctx, "truecommand.connected", []any{}, &result)
+	return &result, err
+}
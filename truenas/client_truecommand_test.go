@@ -0,0 +1,88 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTrueCommandClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	require.NotNil(t, client.TrueCommand)
+	assert.Equal(t, client, client.TrueCommand.client)
+}
+
+func TestTrueCommandClient_GetConfig(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("truecommand.config", TrueCommandConfig{
+		ID:           1,
+		Enabled:      false,
+		Status:       TrueCommandStatusDisabled,
+		StatusReason: "TrueCommand service is disabled.",
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	config, err := client.TrueCommand.GetConfig(NewTestContext(t))
+	require.NoError(t, err)
+	assert.False(t, config.Enabled)
+	assert.Nil(t, config.APIKey)
+	assert.Equal(t, TrueCommandStatusDisabled, config.Status)
+}
+
+func TestTrueCommandClient_UpdateConfig(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "truecommand.update" {
+			received <- msg.Params
+			return Message{ID: msg.ID, Result: json.RawMessage(`{"id": 1, "api_key": "tc-key", "enabled": true, "status": "CONNECTING", "status_reason": "Waiting for TrueCommand to approve the connection."}`)}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	config, err := client.TrueCommand.UpdateConfig(NewTestContext(t), &TrueCommandUpdateRequest{APIKey: Ptr("tc-key"), Enabled: Ptr(true)})
+	require.NoError(t, err)
+	assert.Equal(t, TrueCommandStatusConnecting, config.Status)
+
+	params, err := json.Marshal(<-received)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"api_key": "tc-key", "enabled": true}]`, string(params))
+}
+
+func TestTrueCommandClient_Connected(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("truecommand.connected", TrueCommandConnection{
+		Connected:      true,
+		TrueCommandIP:  Ptr("172.16.0.2"),
+		TrueCommandURL: Ptr("https://tc.example.com"),
+		Status:         TrueCommandStatusConnected,
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	connection, err := client.TrueCommand.Connected(NewTestContext(t))
+	require.NoError(t, err)
+	assert.True(t, connection.Connected)
+	assert.Equal(t, "https://tc.example.com", *connection.TrueCommandURL)
+}
@@ -0,0 +1,103 @@
+package truenas
+
+import (
+	"context"
+)
+
+// UpdateClient provides methods for checking for, downloading and applying system updates
+type UpdateClient struct {
+	client *Client
+}
+
+// NewUpdateClient creates a new update client
+func NewUpdateClient(client *Client) *UpdateClient {
+	return &UpdateClient{client: client}
+}
+
+// UpdateTrains represents the available update trains
+type UpdateTrains struct {
+	Trains   map[string]UpdateTrain `json:"trains"`
+	Current  string                 `json:"current"`
+	Selected string                 `json:"selected"`
+}
+
+// UpdateTrain describes an update train
+type UpdateTrain struct {
+	Description string `json:"description"`
+}
+
+// UpdatePendingChange describes a package change in a downloaded update
+type UpdatePendingChange struct {
+	Operation string         `json:"operation"`
+	Old       map[string]any `json:"old"`
+	New       map[string]any `json:"new"`
+}
+
+// UpdateApplyOptions represents options for update.update
+type UpdateApplyOptions struct {
+	Train  string `json:"train,omitempty"`
+	Reboot bool   `json:"reboot"`
+	Resume bool   `json:"resume,omitempty"` // Continue an update that previously failed its space check
+}
+
+// GetConfig returns the update configuration
+func (u *UpdateClient) GetConfig(ctx context.Context) (*UpdateConfig, error) {
+	var result UpdateConfig
+	err := u.client.Call(ctx, "update.config", []any{}, &result)
+	return &result, err
+}
+
+// SetAutoCheck sets whether updates are checked for and downloaded automatically
+func (u *UpdateClient) SetAutoCheck(ctx context.Context, autoCheck bool) (*UpdateConfig, error) {
+	var result UpdateConfig
+	err := u.client.Call(ctx, "update.update_config", []any{map[string]any{"autocheck": autoCheck}}, &result)
+	return &result, err
+}
+
+// CheckAvailable checks whether an update is available. An empty train checks the selected train.
+func (u *UpdateClient) CheckAvailable(ctx context.Context, train string) (*UpdateInfo, error) {
+	var result UpdateInfo
+	params := []any{}
+	if train != "" {
+		params = append(params, map[string]any{"train": train})
+	}
+	err := u.client.Call(ctx, "update.check_available", params, &result)
+	return &result, err
+}
+
+// GetPending returns the changes of an update that has been downloaded but not applied
+func (u *UpdateClient) GetPending(ctx context.Context) ([]UpdatePendingChange, error) {
+	var result []UpdatePendingChange
+	err := u.client.Call(ctx, "update.get_pending", []any{}, &result)
+	return result, err
+}
+
+// Download downloads the available update for the selected train (asynchronous job),
+// reporting progress to fn, which may be nil. It returns whether an update was downloaded.
+func (u *UpdateClient) Download(ctx context.Context, fn JobProgressFunc) (bool, error) {
+	var result bool
+	err := u.client.CallJobWithProgress(ctx, "update.download", []any{}, &result, fn)
+	return result, err
+}
+
+// Apply downloads, if necessary, and applies the available update (asynchronous job),
+// reporting progress to fn, which may be nil
+func (u *UpdateClient) Apply(ctx context.Context, options *UpdateApplyOptions, fn JobProgressFunc) error {
+	params := []any{}
+	if options != nil {
+		params = append(params, *options)
+	}
+	return u.client.CallJobWithProgress(ctx, "update.update", params, nil, fn)
+}
+
+// GetTrains returns the available update trains and the current and selected train
+func (u *UpdateClient) GetTrains(ctx context.Context) (*UpdateTrains, error) {
+	var result UpdateTrains
+	err := u.client.Call(ctx, "update.get_trains", []any{}, &result)
+	return &result, err
+}
+
+// SetTrain selects the update train
+func (u *UpdateClient) SetTrain(ctx context.Context, train string) error {
+	return u.client.Call(ctx, "update.set_train", []any{train}, nil)
+}
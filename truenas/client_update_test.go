@@ -0,0 +1,139 @@
+package truenas
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewUpdateClient(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	updateClient := NewUpdateClient(client)
+	require.NotNil(t, updateClient)
+	assert.Equal(t, client, updateClient.client)
+}
+
+func TestUpdateClient_CheckAvailable(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "update.check_available" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`{"status": "AVAILABLE", "version": "24.04.1", "filename": "update.sqsh"}`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	info, err := client.Update.CheckAvailable(ctx, "TrueNAS-SCALE-Dragonfish")
+	require.NoError(t, err)
+	assert.Equal(t, UpdateStatusAvailable, info.Status)
+	assert.Equal(t, "24.04.1", info.Version)
+	assert.JSONEq(t, `[{"train": "TrueNAS-SCALE-Dragonfish"}]`, tryMarshal(<-received))
+}
+
+func TestUpdateClient_Trains(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("update.get_trains", json.RawMessage(`{
+		"trains": {"TrueNAS-SCALE-Dragonfish": {"description": "TrueNAS SCALE Dragonfish 24.04"}},
+		"current": "TrueNAS-SCALE-Cobia",
+		"selected": "TrueNAS-SCALE-Dragonfish"
+	}`))
+	server.SetResponse("update.set_train", true)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	trains, err := client.Update.GetTrains(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "TrueNAS-SCALE-Cobia", trains.Current)
+	require.Contains(t, trains.Trains, "TrueNAS-SCALE-Dragonfish")
+	assert.Equal(t, "TrueNAS SCALE Dragonfish 24.04", trains.Trains["TrueNAS-SCALE-Dragonfish"].Description)
+
+	require.NoError(t, client.Update.SetTrain(ctx, "TrueNAS-SCALE-Dragonfish"))
+}
+
+func TestUpdateClient_GetPending(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("update.get_pending", json.RawMessage(`[
+		{"operation": "upgrade", "old": {"name": "TrueNAS", "version": "23.10.2"}, "new": {"name": "TrueNAS", "version": "24.04.1"}}
+	]`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	changes, err := client.Update.GetPending(ctx)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "upgrade", changes[0].Operation)
+	assert.Equal(t, "24.04.1", changes[0].New["version"])
+}
+
+func TestUpdateClient_DownloadAndApply(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("update.download", true)
+	server.SetJobResponse("update.update", true)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	var mu sync.Mutex
+	var updates []*Job
+	progress := func(job *Job) {
+		mu.Lock()
+		defer mu.Unlock()
+		updates = append(updates, job)
+	}
+
+	downloaded, err := client.Update.Download(ctx, progress)
+	require.NoError(t, err)
+	assert.True(t, downloaded)
+
+	require.NoError(t, client.Update.Apply(ctx, &UpdateApplyOptions{Reboot: false}, progress))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.NotEmpty(t, updates)
+}
+
+func TestUpdateClient_Apply_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("update.update", "Insufficient space to install update")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	err := client.Update.Apply(ctx, nil, nil)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Insufficient space")
+}
@@ -3,6 +3,7 @@ package truenas
 import (
 	"context"
 	"fmt"
+	"iter"
 )
 
 // UserClient provides methods for user management
@@ -123,6 +124,16 @@ func (u *UserClient) List(ctx context.Context) ([]User, error) {
 	return result, err
 }
 
+// ListWithQuery returns users matching q
+func (u *UserClient) ListWithQuery(ctx context.Context, q *Query) ([]User, error) {
+	return query[User](ctx, u.client, "user.query", q)
+}
+
+// ListIter returns an iterator over the users matching q, fetched a page at a time
+func (u *UserClient) ListIter(ctx context.Context, q *Query) iter.Seq2[User, error] {
+	return paginate(ctx, q, u.ListWithQuery)
+}
+
 // ListWithDSCache returns all users including directory service users
 func (u *UserClient) ListWithDSCache(ctx context.Context) ([]User, error) {
 	var result []User
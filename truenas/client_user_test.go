@@ -1,6 +1,7 @@
 package truenas
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -221,7 +222,7 @@ func TestUserClient_GetUserObj(t *testing.T) {
 	obj, err := client.User.GetUserObj(ctx, req)
 	require.NoError(t, err)
 	assert.Equal(t, "testuser", obj["pw_name"])
-	assert.Equal(t, float64(1000), obj["pw_uid"])
+	assert.Equal(t, json.Number("1000"), obj["pw_uid"])
 }
 
 func TestUserClient_HasRootPassword(t *testing.T) {
@@ -2,7 +2,13 @@ package truenas
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
 )
 
 // VMClient provides methods for virtual machine management
@@ -147,14 +153,52 @@ const (
 type VMDeviceType string
 
 const (
-	VMDeviceTypeNIC   VMDeviceType = "NIC"
-	VMDeviceTypeDisk  VMDeviceType = "DISK"
-	VMDeviceTypeCDROM VMDeviceType = "CDROM"
-	VMDeviceTypePCI   VMDeviceType = "PCI"
-	VMDeviceTypeVNC   VMDeviceType = "VNC"
-	VMDeviceTypeRAW   VMDeviceType = "RAW"
+	VMDeviceTypeNIC     VMDeviceType = "NIC"
+	VMDeviceTypeDisk    VMDeviceType = "DISK"
+	VMDeviceTypeCDROM   VMDeviceType = "CDROM"
+	VMDeviceTypePCI     VMDeviceType = "PCI"
+	VMDeviceTypeVNC     VMDeviceType = "VNC"
+	VMDeviceTypeDisplay VMDeviceType = "DISPLAY"
+	VMDeviceTypeRAW     VMDeviceType = "RAW"
+	VMDeviceTypeUSB     VMDeviceType = "USB"
 )
 
+// VMDisplayType represents the protocol of a display device
+type VMDisplayType string
+
+const (
+	VMDisplayTypeSPICE VMDisplayType = "SPICE"
+	VMDisplayTypeVNC   VMDisplayType = "VNC"
+)
+
+// VMDisplayAttributes represents the attributes of a DISPLAY device
+type VMDisplayAttributes struct {
+	Type       VMDisplayType `json:"type"`
+	Resolution string        `json:"resolution,omitempty"`
+	Port       int           `json:"port,omitempty"`
+	WebPort    int           `json:"web_port,omitempty"`
+	Bind       string        `json:"bind,omitempty"`
+	Wait       bool          `json:"wait"`
+	Password   string        `json:"password,omitempty"`
+	Web        bool          `json:"web"`
+}
+
+// DisplayAttributes decodes the attributes of a DISPLAY device
+func (d *VMDevice) DisplayAttributes() (*VMDisplayAttributes, error) {
+	if d.DType != VMDeviceTypeDisplay {
+		return nil, fmt.Errorf("device %d is a %s device, not %s", d.ID, d.DType, VMDeviceTypeDisplay)
+	}
+	b, err := json.Marshal(d.Attributes)
+	if err != nil {
+		return nil, fmt.Errorf("marshal attributes: %w", err)
+	}
+	var result VMDisplayAttributes
+	if err := unmarshalJSON(b, &result); err != nil {
+		return nil, fmt.Errorf("unmarshal display attributes: %w", err)
+	}
+	return &result, nil
+}
+
 // List returns all VMs
 func (v *VMClient) List(ctx context.Context) ([]VM, error) {
 	var result []VM
@@ -162,6 +206,16 @@ func (v *VMClient) List(ctx context.Context) ([]VM, error) {
 	return result, err
 }
 
+// ListWithQuery returns VMs matching q
+func (v *VMClient) ListWithQuery(ctx context.Context, q *Query) ([]VM, error) {
+	return query[VM](ctx, v.client, "vm.query", q)
+}
+
+// ListIter returns an iterator over the VMs matching q, fetched a page at a time
+func (v *VMClient) ListIter(ctx context.Context, q *Query) iter.Seq2[VM, error] {
+	return paginate(ctx, q, v.ListWithQuery)
+}
+
 // Get returns a specific VM by ID
 func (v *VMClient) Get(ctx context.Context, id int) (*VM, error) {
 	var result []VM
@@ -283,6 +337,82 @@ func (v *VMClient) GetConsole(ctx context.Context, id int) (string, error) {
 	return result, err
 }
 
+// GetDisplayDevices returns the SPICE/VNC display devices of a VM
+func (v *VMClient) GetDisplayDevices(ctx context.Context, id int) ([]VMDevice, error) {
+	var result []VMDevice
+	err := v.client.Call(ctx, "vm.get_display_devices", []any{id}, &result)
+	return result, err
+}
+
+// VMDisplayURL is the web console of a VM's display device
+type VMDisplayURL struct {
+	DeviceID int
+	URL      string // Empty if the device has no web console
+	Error    string // Why the device has no web console, such as web access being disabled
+}
+
+// vmDisplayWebURI is an entry of the vm.get_display_web_uri result
+type vmDisplayWebURI struct {
+	URI   *string `json:"uri"`
+	Error *string `json:"error"`
+}
+
+// GetDisplayURL returns the URLs of the SPICE and VNC web consoles of a VM's display devices,
+// addressed to the host and scheme the client connects to, sorted by device ID. Each URL
+// carries an auth_token from Client.Token, so that it can be opened in a browser that has
+// not logged in.
+func (v *VMClient) GetDisplayURL(ctx context.Context, id int) ([]VMDisplayURL, error) {
+	base, err := v.client.httpURL("/")
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(base)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+
+	var result map[string]vmDisplayWebURI
+	options := map[string]any{"protocol": strings.ToUpper(u.Scheme)}
+	if err := v.client.Call(ctx, "vm.get_display_web_uri", []any{id, u.Hostname(), options}, &result); err != nil {
+		return nil, err
+	}
+
+	var token string
+	urls := make([]VMDisplayURL, 0, len(result))
+	for key, uri := range result {
+		deviceID, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected display device ID %q", key)
+		}
+		display := VMDisplayURL{DeviceID: deviceID, URL: value(uri.URI), Error: value(uri.Error)}
+		if display.URL != "" {
+			if token == "" {
+				if token, err = v.client.Token(ctx); err != nil {
+					return nil, err
+				}
+			}
+			if display.URL, err = withQuery(display.URL, "auth_token", token); err != nil {
+				return nil, err
+			}
+		}
+		urls = append(urls, display)
+	}
+	slices.SortFunc(urls, func(a, b VMDisplayURL) int { return a.DeviceID - b.DeviceID })
+	return urls, nil
+}
+
+// withQuery sets a query parameter of rawURL
+func withQuery(rawURL, key, val string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	q := u.Query()
+	q.Set(key, val)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
 // VNC Methods
 
 // GetVNC returns VNC devices for a VM
@@ -352,6 +482,16 @@ func (d *VMDeviceClient) List(ctx context.Context) ([]VMDevice, error) {
 	return result, err
 }
 
+// ListWithQuery returns VM devices matching q
+func (d *VMDeviceClient) ListWithQuery(ctx context.Context, q *Query) ([]VMDevice, error) {
+	return query[VMDevice](ctx, d.client, "vm.device.query", q)
+}
+
+// ListIter returns an iterator over the VM devices matching q, fetched a page at a time
+func (d *VMDeviceClient) ListIter(ctx context.Context, q *Query) iter.Seq2[VMDevice, error] {
+	return paginate(ctx, q, d.ListWithQuery)
+}
+
 // GetDevice returns a specific VM device by ID
 func (d *VMDeviceClient) Get(ctx context.Context, id int) (*VMDevice, error) {
 	var result []VMDevice
@@ -1,6 +1,7 @@
 package truenas
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -350,7 +351,7 @@ func TestVMClient_GetVNC(t *testing.T) {
 	vnc, err := client.VM.GetVNC(ctx, 1)
 	require.NoError(t, err)
 	assert.Len(t, vnc, 1)
-	assert.Equal(t, float64(5900), vnc[0]["port"])
+	assert.Equal(t, json.Number("5900"), vnc[0]["port"])
 }
 
 func TestVMClient_GetVNCWeb(t *testing.T) {
@@ -636,3 +637,90 @@ func TestVMClient_ErrorHandling(t *testing.T) {
 	assert.Equal(t, 500, apiErr.Code)
 	assert.Equal(t, "VM service unavailable", apiErr.Message)
 }
+
+func TestVMClient_GetDisplayDevices(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	mockDevices := []VMDevice{
+		{
+			ID:    7,
+			DType: VMDeviceTypeDisplay,
+			VM:    1,
+			Attributes: map[string]any{
+				"type":       "SPICE",
+				"resolution": "1920x1080",
+				"port":       5900,
+				"web_port":   5901,
+				"bind":       "0.0.0.0",
+				"web":        true,
+			},
+		},
+	}
+	server.SetResponse("vm.get_display_devices", mockDevices)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	devices, err := client.VM.GetDisplayDevices(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+
+	display, err := devices[0].DisplayAttributes()
+	require.NoError(t, err)
+	assert.Equal(t, VMDisplayTypeSPICE, display.Type)
+	assert.Equal(t, 5900, display.Port)
+	assert.Equal(t, 5901, display.WebPort)
+	assert.True(t, display.Web)
+}
+
+func TestVMClient_GetDisplayURL(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("vm.get_display_web_uri", map[string]any{
+		"12": map[string]any{"uri": nil, "error": "Web display is not configured"},
+		"7":  map[string]any{"uri": "http://127.0.0.1/vm/display/7/spice_auto.html?path=vm/display/7", "error": nil},
+	})
+	server.SetResponse("auth.generate_token", "display-token")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	urls, err := client.VM.GetDisplayURL(NewTestContext(t), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []VMDisplayURL{
+		{DeviceID: 7, URL: "http://127.0.0.1/vm/display/7/spice_auto.html?auth_token=display-token&path=vm%2Fdisplay%2F7"},
+		{DeviceID: 12, Error: "Web display is not configured"},
+	}, urls)
+	server.AssertCalled(t, "vm.get_display_web_uri", 1, "127.0.0.1", map[string]any{"protocol": "HTTP"})
+	assert.Equal(t, 1, server.CallCount("auth.generate_token"))
+}
+
+func TestVMClient_GetDisplayURL_NoWebConsole(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("vm.get_display_web_uri", map[string]any{
+		"3": map[string]any{"uri": nil, "error": "Display device is not configured for web access"},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	urls, err := client.VM.GetDisplayURL(NewTestContext(t), 1)
+	require.NoError(t, err)
+	assert.Equal(t, []VMDisplayURL{{DeviceID: 3, Error: "Display device is not configured for web access"}}, urls)
+	server.AssertNotCalled(t, "auth.generate_token")
+}
+
+func TestVMDevice_DisplayAttributes_WrongType(t *testing.T) {
+	t.Parallel()
+	device := VMDevice{ID: 3, DType: VMDeviceTypeDisk}
+	_, err := device.DisplayAttributes()
+	assert.Error(t, err)
+}
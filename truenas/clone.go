@@ -0,0 +1,96 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CloneOptions configures DatasetClient.CloneFromSnapshot
+type CloneOptions struct {
+	Properties       map[string]any // Properties of the clone, such as {"readonly": "on"}
+	ExistingSnapshot bool           // Clone an existing snapshot instead of taking a new one
+	Replace          bool           // Delete the target and its children first if it exists
+	Promote          bool           // Promote the clone so that it no longer depends on the source
+}
+
+// CloneFromSnapshot snapshots source as source@snapName and clones the snapshot to target,
+// such as to refresh a test database from production data. opts may be nil.
+//
+// If cloning or promoting fails, whatever this call created is deleted again: the clone,
+// and the snapshot unless it already existed. With Replace, an existing target is deleted
+// before the snapshot is taken and is not restored on failure.
+func (d *DatasetClient) CloneFromSnapshot(ctx context.Context, source, snapName, target string, opts *CloneOptions) (*Dataset, error) {
+	if opts == nil {
+		opts = &CloneOptions{}
+	}
+	snapshot := source + "@" + snapName
+
+	if opts.Replace {
+		_, err := d.Get(ctx, target)
+		switch {
+		case err == nil:
+			if err := d.Delete(ctx, target, DatasetDeleteRequest{Recursive: Ptr(true)}); err != nil {
+				return nil, fmt.Errorf("clone %s: delete existing %s: %w", snapshot, target, err)
+			}
+		case !IsNotFound(err):
+			return nil, fmt.Errorf("clone %s: get existing %s: %w", snapshot, target, err)
+		}
+	}
+
+	if !opts.ExistingSnapshot {
+		if _, err := d.client.Snapshot.Create(ctx, &SnapshotCreateRequest{Dataset: source, Name: snapName}); err != nil {
+			return nil, fmt.Errorf("clone %s: create snapshot: %w", snapshot, err)
+		}
+	}
+	// rollback deletes what was created so far, clone first since it depends on the snapshot
+	rollback := func(err error, cloned bool) error {
+		ctx := context.WithoutCancel(ctx)
+		if cloned {
+			if delErr := d.Delete(ctx, target, DatasetDeleteRequest{Recursive: Ptr(true)}); delErr != nil {
+				// The snapshot cannot be deleted while the clone depends on it
+				return errors.Join(err, fmt.Errorf("delete clone %s: %w", target, delErr))
+			}
+		}
+		if !opts.ExistingSnapshot {
+			if delErr := d.client.Snapshot.Delete(ctx, snapshot, nil); delErr != nil {
+				return errors.Join(err, fmt.Errorf("delete snapshot %s: %w", snapshot, delErr))
+			}
+		}
+		return err
+	}
+
+	err := d.client.Snapshot.Clone(ctx, &SnapshotCloneRequest{
+		Snapshot:          snapshot,
+		DatasetDst:        target,
+		DatasetProperties: opts.Properties,
+	})
+	if err != nil {
+		return nil, rollback(fmt.Errorf("clone %s to %s: %w", snapshot, target, err), false)
+	}
+
+	if opts.Promote {
+		if err := d.Promote(ctx, target); err != nil {
+			return nil, rollback(fmt.Errorf("clone %s: promote %s: %w", snapshot, target, err), true)
+		}
+	}
+	return d.Get(ctx, target)
+}
+
+// PromoteClone promotes a clone so that it no longer depends on the snapshot it was cloned
+// from, and returns the promoted dataset. The snapshot moves to the clone and the former
+// origin dataset becomes its dependent, so the origin can then be deleted without losing
+// the clone.
+func (d *DatasetClient) PromoteClone(ctx context.Context, id string) (*Dataset, error) {
+	dataset, err := d.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if dataset.Origin == nil || dataset.Origin.Value == "" {
+		return nil, fmt.Errorf("promote %s: dataset is not a clone", id)
+	}
+	if err := d.Promote(ctx, id); err != nil {
+		return nil, fmt.Errorf("promote %s: %w", id, err)
+	}
+	return d.Get(ctx, id)
+}
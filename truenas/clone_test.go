@@ -0,0 +1,129 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setCloneResponses(server *TestServer) {
+	server.SetResponse("zfs.snapshot.create", Snapshot{ID: "tank/db@refresh", Name: "tank/db@refresh"})
+	server.SetResponse("zfs.snapshot.clone", true)
+	server.SetResponse("zfs.snapshot.delete", true)
+	server.SetResponse("pool.dataset.promote", true)
+	server.SetResponse("pool.dataset.delete", true)
+	server.SetResponse("pool.dataset.query", []Dataset{{
+		ID:     "tank/db-test",
+		Name:   "tank/db-test",
+		Origin: &DatasetProperty{Value: "tank/db@refresh"},
+	}})
+}
+
+func TestDatasetClient_CloneFromSnapshot(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setCloneResponses(server)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	dataset, err := client.Dataset.CloneFromSnapshot(NewTestContext(t), "tank/db", "refresh", "tank/db-test", &CloneOptions{
+		Properties: map[string]any{"readonly": "on"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tank/db-test", dataset.ID)
+
+	server.AssertCalled(t, "zfs.snapshot.create", SnapshotCreateRequest{Dataset: "tank/db", Name: "refresh"})
+	server.AssertCalled(t, "zfs.snapshot.clone", SnapshotCloneRequest{
+		Snapshot:          "tank/db@refresh",
+		DatasetDst:        "tank/db-test",
+		DatasetProperties: map[string]any{"readonly": "on"},
+	})
+	server.AssertNotCalled(t, "pool.dataset.promote")
+	server.AssertNotCalled(t, "pool.dataset.delete")
+}
+
+func TestDatasetClient_CloneFromSnapshot_ReplaceAndPromote(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setCloneResponses(server)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Dataset.CloneFromSnapshot(NewTestContext(t), "tank/db", "refresh", "tank/db-test", &CloneOptions{
+		ExistingSnapshot: true,
+		Replace:          true,
+		Promote:          true,
+	})
+	require.NoError(t, err)
+
+	server.AssertCalled(t, "pool.dataset.delete", "tank/db-test", DatasetDeleteRequest{Recursive: Ptr(true)})
+	server.AssertNotCalled(t, "zfs.snapshot.create")
+	server.AssertCalled(t, "pool.dataset.promote", "tank/db-test")
+}
+
+func TestDatasetClient_CloneFromSnapshot_RollsBackSnapshot(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setCloneResponses(server)
+	server.SetError("zfs.snapshot.clone", 17, "dataset already exists")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	dataset, err := client.Dataset.CloneFromSnapshot(NewTestContext(t), "tank/db", "refresh", "tank/db-test", nil)
+	require.Error(t, err)
+	assert.Nil(t, dataset)
+	assert.ErrorContains(t, err, "clone tank/db@refresh to tank/db-test")
+
+	var apiErr *ErrorMsg
+	assert.ErrorAs(t, err, &apiErr)
+	server.AssertCalled(t, "zfs.snapshot.delete", "tank/db@refresh")
+	server.AssertNotCalled(t, "pool.dataset.delete")
+}
+
+func TestDatasetClient_CloneFromSnapshot_RollsBackClone(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setCloneResponses(server)
+	server.SetError("pool.dataset.promote", 16, "dataset is busy")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Dataset.CloneFromSnapshot(NewTestContext(t), "tank/db", "refresh", "tank/db-test", &CloneOptions{
+		ExistingSnapshot: true,
+		Promote:          true,
+	})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "promote tank/db-test")
+	server.AssertCalled(t, "pool.dataset.delete", "tank/db-test", DatasetDeleteRequest{Recursive: Ptr(true)})
+	server.AssertNotCalled(t, "zfs.snapshot.delete")
+}
+
+func TestDatasetClient_PromoteClone(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setCloneResponses(server)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	dataset, err := client.Dataset.PromoteClone(ctx, "tank/db-test")
+	require.NoError(t, err)
+	assert.Equal(t, "tank/db-test", dataset.ID)
+	server.AssertCalled(t, "pool.dataset.promote", "tank/db-test")
+
+	server.SetResponse("pool.dataset.query", []Dataset{{ID: "tank/db", Name: "tank/db", Origin: &DatasetProperty{}}})
+	_, err = client.Dataset.PromoteClone(ctx, "tank/db")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "not a clone")
+}
@@ -0,0 +1,120 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// DatasetDependencies reports what uses a dataset or its descendants, as returned by
+// Client.Dependencies
+type DatasetDependencies struct {
+	Dataset   string
+	Processes []DatasetProcess // Processes with files open on the dataset
+	Shares    []Share          // Shares of any protocol of a path or zvol in the dataset
+	VMs       []VMDependency
+	Apps      []AppDependency
+}
+
+// DatasetProcess is a process using a dataset, as returned by pool.dataset.processes
+type DatasetProcess struct {
+	PID     int    `json:"pid"`
+	Name    string `json:"name"`
+	Service string `json:"service,omitempty"` // Middleware service the process belongs to, if any
+	Cmdline string `json:"cmdline,omitempty"`
+}
+
+// VMDependency is a VM with devices backed by a dataset
+type VMDependency struct {
+	ID    int
+	Name  string
+	Paths []string // Paths of the devices in the dataset, such as "/dev/zvol/tank/vm0"
+}
+
+// AppDependency is an app, or a chart release before SCALE 24.10, using a dataset
+type AppDependency struct {
+	Name  string
+	Paths []string // Host paths mounted into the app, or the chart release's dataset
+}
+
+// Empty reports whether nothing uses the dataset
+func (d *DatasetDependencies) Empty() bool {
+	return len(d.Processes) == 0 && len(d.Shares) == 0 && len(d.VMs) == 0 && len(d.Apps) == 0
+}
+
+// Dependencies reports the processes, shares, VMs and apps using a dataset or its descendants,
+// such as to warn before deleting or locking it. Apps are chart releases on servers before
+// SCALE 24.10 and are not reported on CORE.
+func (c *Client) Dependencies(ctx context.Context, datasetName string) (*DatasetDependencies, error) {
+	var (
+		processes []DatasetProcess
+		vms       []VM
+		apps      []App
+		releases  []ChartRelease
+	)
+	batch := c.Batch()
+	batch.Add("pool.dataset.processes", []any{datasetName}, &processes)
+	batch.Add("vm.query", nil, &vms)
+	if v := c.ServerVersion(); v != nil && v.Supports(CapabilityKubernetes) {
+		batch.Add("chart.release.query", nil, &releases)
+	} else if c.Supports(CapabilityDocker) {
+		batch.Add("app.query", nil, &apps)
+	}
+	if err := batch.Do(ctx); err != nil {
+		return nil, fmt.Errorf("dependencies of %s: %w", datasetName, err)
+	}
+	shares, err := c.Sharing.ListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("dependencies of %s: %w", datasetName, err)
+	}
+
+	deps := &DatasetDependencies{Dataset: datasetName, Processes: processes}
+	for _, share := range shares {
+		if datasetContains(datasetName, share.Path) {
+			deps.Shares = append(deps.Shares, share)
+		}
+	}
+	for _, vm := range vms {
+		var paths []string
+		for _, device := range vm.Devices {
+			if p, _ := device.Attributes["path"].(string); datasetContains(datasetName, p) {
+				paths = append(paths, p)
+			}
+		}
+		if len(paths) > 0 {
+			deps.VMs = append(deps.VMs, VMDependency{ID: vm.ID, Name: vm.Name, Paths: paths})
+		}
+	}
+	for _, app := range apps {
+		if app.ActiveWorkloads == nil {
+			continue
+		}
+		var paths []string
+		for _, volume := range app.ActiveWorkloads.Volumes {
+			if datasetContains(datasetName, volume.Source) {
+				paths = append(paths, volume.Source)
+			}
+		}
+		if len(paths) > 0 {
+			deps.Apps = append(deps.Apps, AppDependency{Name: app.Name, Paths: paths})
+		}
+	}
+	for _, release := range releases {
+		if datasetContains(datasetName, release.Dataset) {
+			deps.Apps = append(deps.Apps, AppDependency{Name: release.Name, Paths: []string{release.Dataset}})
+		}
+	}
+	return deps, nil
+}
+
+// datasetContains reports whether p is in the dataset or a descendant, where p is a dataset
+// name, a path under /mnt, or a zvol as "/dev/zvol/tank/vm0" or "zvol/tank/vm0"
+func datasetContains(dataset, p string) bool {
+	for _, prefix := range []string{"/mnt/", "/dev/zvol/", "zvol/"} {
+		if rest, ok := strings.CutPrefix(p, prefix); ok {
+			p = rest
+			break
+		}
+	}
+	return p == dataset || strings.HasPrefix(p, dataset+"/")
+}
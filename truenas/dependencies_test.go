@@ -0,0 +1,131 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setDependencyResponses(server *TestServer) {
+	server.SetResponse("pool.dataset.processes", []map[string]any{
+		{"pid": 1234, "name": "rsync", "cmdline": "rsync -a /mnt/tank/data/ /mnt/backup/"},
+		{"pid": 88, "name": "smbd", "service": "cifs"},
+	})
+	server.SetResponse("sharing.smb.query", []SMBShare{
+		{ID: 1, Name: "data", Path: "/mnt/tank/data/docs", Enabled: true},
+		{ID: 2, Name: "database", Path: "/mnt/tank/database", Enabled: true},
+	})
+	server.SetResponse("sharing.nfs.query", []NFSShare{{ID: 3, Path: "/mnt/tank/data", Enabled: true}})
+	server.SetResponse("iscsi.extent.query", []map[string]any{
+		{"id": 4, "name": "lun0", "type": "DISK", "disk": "zvol/tank/data/lun0", "enabled": true},
+	})
+	server.SetResponse("iscsi.targetextent.query", []any{})
+	server.SetResponse("iscsi.target.query", []any{})
+	server.SetResponse("vm.query", []map[string]any{
+		{"id": 1, "name": "web", "devices": []map[string]any{
+			{"dtype": "DISK", "attributes": map[string]any{"path": "/dev/zvol/tank/data/web"}},
+			{"dtype": "NIC", "attributes": map[string]any{"type": "VIRTIO"}},
+		}},
+		{"id": 2, "name": "db", "devices": []map[string]any{
+			{"dtype": "RAW", "attributes": map[string]any{"path": "/mnt/tank/database/db.img"}},
+		}},
+	})
+}
+
+func TestClient_Dependencies(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.10.1")
+	setDependencyResponses(server)
+	server.SetResponse("app.query", []map[string]any{
+		{"name": "plex", "active_workloads": map[string]any{"volumes": []map[string]any{
+			{"source": "/mnt/tank/data/media", "destination": "/media"},
+			{"source": "/mnt/.ix-apps/app_mounts/plex/config", "destination": "/config"},
+		}}},
+		{"name": "nginx", "active_workloads": map[string]any{"volumes": []map[string]any{}}},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	deps, err := client.Dependencies(NewTestContext(t), "tank/data")
+	require.NoError(t, err)
+	assert.False(t, deps.Empty())
+	assert.Equal(t, "tank/data", deps.Dataset)
+	assert.Equal(t, []DatasetProcess{
+		{PID: 1234, Name: "rsync", Cmdline: "rsync -a /mnt/tank/data/ /mnt/backup/"},
+		{PID: 88, Name: "smbd", Service: "cifs"},
+	}, deps.Processes)
+
+	var shareIDs []int
+	for _, share := range deps.Shares {
+		shareIDs = append(shareIDs, share.ID)
+	}
+	assert.Equal(t, []int{1, 3, 4}, shareIDs)
+	assert.Equal(t, []VMDependency{{ID: 1, Name: "web", Paths: []string{"/dev/zvol/tank/data/web"}}}, deps.VMs)
+	assert.Equal(t, []AppDependency{{Name: "plex", Paths: []string{"/mnt/tank/data/media"}}}, deps.Apps)
+	server.AssertCalled(t, "pool.dataset.processes", "tank/data")
+	server.AssertNotCalled(t, "chart.release.query")
+}
+
+func TestClient_Dependencies_ChartReleases(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.04.1")
+	setDependencyResponses(server)
+	server.SetResponse("pool.dataset.processes", []any{})
+	server.SetResponse("chart.release.query", []map[string]any{
+		{"name": "plex", "dataset": "tank/ix-applications/releases/plex"},
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	deps, err := client.Dependencies(NewTestContext(t), "tank/ix-applications")
+	require.NoError(t, err)
+	assert.Empty(t, deps.Processes)
+	assert.Empty(t, deps.Shares)
+	assert.Empty(t, deps.VMs)
+	assert.Equal(t, []AppDependency{{Name: "plex", Paths: []string{"tank/ix-applications/releases/plex"}}}, deps.Apps)
+	server.AssertNotCalled(t, "app.query")
+}
+
+func TestClient_Dependencies_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-13.0-U6.1")
+	server.SetError("pool.dataset.processes", 2, "tank/missing: dataset does not exist")
+	server.SetResponse("vm.query", []any{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Dependencies(NewTestContext(t), "tank/missing")
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "dependencies of tank/missing")
+	server.AssertNotCalled(t, "app.query")
+	server.AssertNotCalled(t, "chart.release.query")
+}
+
+func TestDatasetContains(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		path string
+		want bool
+	}{
+		{"/mnt/tank/data", true},
+		{"/mnt/tank/data/sub/file", true},
+		{"/mnt/tank/database", false},
+		{"/dev/zvol/tank/data/vm0", true},
+		{"zvol/tank/data/lun0", true},
+		{"tank/data/child", true},
+		{"tank", false},
+		{"", false},
+	} {
+		assert.Equal(t, tc.want, datasetContains("tank/data", tc.path), tc.path)
+	}
+}
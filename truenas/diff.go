@@ -0,0 +1,55 @@
+package truenas
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// FieldDiff is a field whose live value differs from the desired one
+type FieldDiff struct {
+	Field   string // Middleware field name, such as "browsable"
+	Current any
+	Desired any
+}
+
+// String formats the difference as "field: current -> desired"
+func (d FieldDiff) String() string {
+	return fmt.Sprintf("%s: %v -> %v", d.Field, d.Current, d.Desired)
+}
+
+// diffFields compares the fields desired sets, as it is sent to the middleware, with the same
+// fields of current, and returns those that differ sorted by name. A null value and an empty
+// list are equal.
+func diffFields(current, desired any) ([]FieldDiff, error) {
+	have, err := toMap(current)
+	if err != nil {
+		return nil, err
+	}
+	want, err := toMap(desired)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []FieldDiff
+	for field, value := range want {
+		if !sameValue(have[field], value) {
+			diffs = append(diffs, FieldDiff{Field: field, Current: have[field], Desired: value})
+		}
+	}
+	slices.SortFunc(diffs, func(a, b FieldDiff) int {
+		return cmp.Compare(a.Field, b.Field)
+	})
+	return diffs, nil
+}
+
+func sameValue(a, b any) bool {
+	isEmpty := func(v any) bool {
+		list, ok := v.([]any)
+		return v == nil || ok && len(list) == 0
+	}
+	if isEmpty(a) && isEmpty(b) {
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
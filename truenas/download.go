@@ -0,0 +1,82 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// CallDownload calls a job method that writes its output to a file, such as config.save,
+// via core.download and streams the file into w. It then waits for the job to complete.
+// filename is the name the middleware suggests for the download.
+func (c *Client) CallDownload(ctx context.Context, method string, params []any, filename string, w io.Writer) error {
+	if params == nil {
+		params = []any{}
+	}
+	var result []any
+	if err := c.Call(ctx, "core.download", []any{method, params, filename}, &result); err != nil {
+		return fmt.Errorf("download %s: %w", method, err)
+	}
+	if len(result) != 2 {
+		return fmt.Errorf("download %s: unexpected core.download result: %v", method, result)
+	}
+	n, _ := result[0].(json.Number)
+	jobID, err := n.Int64()
+	if err != nil {
+		return fmt.Errorf("download %s: unexpected job ID: %v", method, result[0])
+	}
+	path, ok := result[1].(string)
+	if !ok {
+		return fmt.Errorf("download %s: unexpected download URL: %v", method, result[1])
+	}
+
+	if err := c.download(ctx, path, w); err != nil {
+		return fmt.Errorf("download %s: %w", method, err)
+	}
+	return c.waitJob(ctx, method, int(jobID), nil, nil)
+}
+
+// download fetches a path returned by core.download, which carries its own auth token.
+func (c *Client) download(ctx context.Context, path string, w io.Writer) error {
+	ref, err := url.Parse(path)
+	if err != nil {
+		return fmt.Errorf("invalid download URL: %w", err)
+	}
+	base, err := c.httpURL("/")
+	if err != nil {
+		return err
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	endpoint := baseURL.ResolveReference(ref).String()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("create request: %w", err)
+	}
+	c.setHTTPAuth(req)
+
+	if c.opts.Debug {
+		c.logger.Printf("download: %s\n", redactURL(ref.Path))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("get %s: %w", ref.Path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, string(body))
+	}
+	if _, err := io.Copy(w, resp.Body); err != nil {
+		return fmt.Errorf("copy response: %w", err)
+	}
+	return nil
+}
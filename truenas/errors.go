@@ -1,6 +1,20 @@
 package truenas
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Error numbers reported by the middleware
+const (
+	errnoEPERM  = 1
+	errnoENOENT = 2
+	errnoEACCES = 13
+	errnoEEXIST = 17
+	errnoEINVAL = 22
+)
 
 // NotFoundError represents an error when a resource is not found
 type NotFoundError struct {
@@ -26,3 +40,170 @@ func NewNotFoundError(resourceType, identifier string) *NotFoundError {
 		Identifier:   identifier,
 	}
 }
+
+// RunningJobsError is returned when a reboot or shutdown is refused because jobs are still running
+type RunningJobsError struct {
+	Jobs []Job
+}
+
+// Error implements the error interface
+func (e *RunningJobsError) Error() string {
+	methods := make([]string, len(e.Jobs))
+	for i, job := range e.Jobs {
+		methods[i] = fmt.Sprintf("%s (%d)", job.Method, job.ID)
+	}
+	return fmt.Sprintf("%d jobs running: %s", len(e.Jobs), strings.Join(methods, ", "))
+}
+
+// ValidationError is returned when the middleware rejects a call's arguments.
+// Errors lists the rejected attributes, such as "sharingsmb_create.path".
+type ValidationError struct {
+	*ErrorMsg
+	Errors []FieldError
+}
+
+// FieldError describes why a single attribute was rejected
+type FieldError struct {
+	Attribute string
+	Message   string
+	Errno     int
+}
+
+// Field returns the attribute without its schema prefix, e.g. "path" for
+// "sharingsmb_create.path" or "options.ashift" for "pool_create.options.ashift"
+func (e FieldError) Field() string {
+	if _, field, ok := strings.Cut(e.Attribute, "."); ok {
+		return field
+	}
+	return e.Attribute
+}
+
+// Unwrap returns the underlying middleware error
+func (e *ValidationError) Unwrap() error {
+	return e.ErrorMsg
+}
+
+// Fields maps each rejected field, without its schema prefix, to its messages,
+// e.g. {"path": ["This field is required"]} for sharingsmb_create.path
+func (e *ValidationError) Fields() map[string][]string {
+	fields := make(map[string][]string, len(e.Errors))
+	for _, fe := range e.Errors {
+		fields[fe.Field()] = append(fields[fe.Field()], fe.Message)
+	}
+	return fields
+}
+
+// PermissionError is returned when the credentials lack the privileges a call requires
+type PermissionError struct {
+	*ErrorMsg
+}
+
+// Unwrap returns the underlying middleware error
+func (e *PermissionError) Unwrap() error {
+	return e.ErrorMsg
+}
+
+// JobError is returned when a job ends in the FAILED or ABORTED state
+type JobError struct {
+	JobID     int
+	Method    string
+	State     string
+	Reason    string // The job's error message, if any
+	Exception string // The job's exception, if any
+	// Validation describes the rejected arguments when the job failed validation
+	Validation *ValidationError
+}
+
+// Unwrap returns the job's ValidationError, if any
+func (e *JobError) Unwrap() error {
+	if e.Validation == nil {
+		return nil
+	}
+	return e.Validation
+}
+
+// Error implements the error interface
+func (e *JobError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("job %d failed: %s", e.JobID, e.Reason)
+	}
+	if e.Exception != "" {
+		return fmt.Sprintf("job %d failed with exception: %s", e.JobID, e.Exception)
+	}
+	return fmt.Sprintf("job %d failed", e.JobID)
+}
+
+// IsNotFound reports whether err means the requested resource does not exist
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		return true
+	}
+	var errMsg *ErrorMsg
+	return errors.As(err, &errMsg) && errMsg.Code == errnoENOENT
+}
+
+// IsValidation reports whether err is a ValidationError
+func IsValidation(err error) bool {
+	var validation *ValidationError
+	return errors.As(err, &validation)
+}
+
+// IsPermission reports whether err is a PermissionError
+func IsPermission(err error) bool {
+	var permission *PermissionError
+	return errors.As(err, &permission)
+}
+
+// newValidationError builds a ValidationError from checks made by the client, with
+// a reason formatted as the middleware formats its own
+func newValidationError(fields []FieldError) *ValidationError {
+	reasons := make([]string, len(fields))
+	extra := make([][]any, len(fields))
+	for i, fe := range fields {
+		reasons[i] = fmt.Sprintf("[EINVAL] %s: %s", fe.Attribute, fe.Message)
+		extra[i] = []any{fe.Attribute, fe.Message, fe.Errno}
+	}
+	errMsg := &ErrorMsg{Code: errnoEINVAL, Errname: "EINVAL", Type: "VALIDATION", Reason: strings.Join(reasons, "\n")}
+	errMsg.Extra, _ = json.Marshal(extra)
+	return &ValidationError{ErrorMsg: errMsg, Errors: fields}
+}
+
+// apiError wraps a middleware error in the typed error matching its kind
+func apiError(e *ErrorMsg) error {
+	if fields := e.fieldErrors(); len(fields) > 0 || e.Type == "VALIDATION" {
+		return &ValidationError{ErrorMsg: e, Errors: fields}
+	}
+	switch {
+	case e.Code == errnoEACCES, e.Code == errnoEPERM, e.Errname == "EACCES", e.Errname == "EPERM":
+		return &PermissionError{ErrorMsg: e}
+	}
+	return e
+}
+
+// fieldErrors decodes the [attribute, message, errno] entries of the error's extra data
+func (e *ErrorMsg) fieldErrors() []FieldError {
+	var extra [][]any
+	if len(e.Extra) == 0 || json.Unmarshal(e.Extra, &extra) != nil {
+		return nil
+	}
+	var fields []FieldError
+	for _, entry := range extra {
+		if len(entry) < 2 {
+			continue
+		}
+		attribute, ok := entry[0].(string)
+		if !ok {
+			continue
+		}
+		field := FieldError{Attribute: attribute}
+		field.Message, _ = entry[1].(string)
+		if len(entry) > 2 {
+			if errno, ok := entry[2].(float64); ok {
+				field.Errno = int(errno)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
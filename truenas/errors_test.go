@@ -1,10 +1,14 @@
 package truenas
 
 import (
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNotFoundError(t *testing.T) {
@@ -56,3 +60,155 @@ func TestNotFoundErrorUsage(t *testing.T) {
 		assert.Equal(t, "ID 123", extracted.Identifier)
 	}
 }
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		err        *ErrorMsg
+		validation bool
+		permission bool
+		notFound   bool
+	}{
+		{"validation", &ErrorMsg{Code: 22, Extra: json.RawMessage(`[["pool_create.name", "Field required", 22]]`)}, true, false, false},
+		{"validation_type", &ErrorMsg{Code: 22, Type: "VALIDATION"}, true, false, false},
+		{"plain_einval", &ErrorMsg{Code: 22, Message: "Invalid argument"}, false, false, false},
+		{"eacces", &ErrorMsg{Code: 13, Message: "Not authorized"}, false, true, false},
+		{"eperm_errname", &ErrorMsg{Errname: "EPERM"}, false, true, false},
+		{"enoent", &ErrorMsg{Code: 2, Message: "tank does not exist"}, false, false, true},
+		{"other", &ErrorMsg{Code: 16, Message: "Device busy"}, false, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := apiError(tt.err)
+			assert.Equal(t, tt.validation, IsValidation(err), "IsValidation")
+			assert.Equal(t, tt.permission, IsPermission(err), "IsPermission")
+			assert.Equal(t, tt.notFound, IsNotFound(err), "IsNotFound")
+
+			// The middleware error stays reachable
+			var errMsg *ErrorMsg
+			require.ErrorAs(t, err, &errMsg)
+			assert.Same(t, tt.err, errMsg)
+			assert.Equal(t, tt.err.Error(), err.Error())
+		})
+	}
+	assert.True(t, IsNotFound(NewNotFoundError("pool", "ID 1")))
+}
+
+func TestClient_ValidationError(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "sharing.smb.create" {
+			return Message{ID: msg.ID, Error: &ErrorMsg{
+				Code:    22,
+				Errname: "EINVAL",
+				Reason:  "[EINVAL] sharingsmb_create.path: This field is required",
+				Extra: json.RawMessage(`[["sharingsmb_create.path", "This field is required", 22],
+					["sharingsmb_create.name", "Share name already exists", 17], ["malformed"]]`),
+			}}, true
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	err := client.Call(NewTestContext(t), "sharing.smb.create", []any{map[string]any{}}, nil)
+	var validation *ValidationError
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, []FieldError{
+		{Attribute: "sharingsmb_create.path", Message: "This field is required", Errno: 22},
+		{Attribute: "sharingsmb_create.name", Message: "Share name already exists", Errno: 17},
+	}, validation.Errors)
+	assert.Equal(t, "EINVAL", validation.Errname)
+	assert.Equal(t, map[string][]string{
+		"path": {"This field is required"},
+		"name": {"Share name already exists"},
+	}, validation.Fields())
+}
+
+func TestFieldError_Field(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "path", FieldError{Attribute: "sharingsmb_create.path"}.Field())
+	assert.Equal(t, "options.ashift", FieldError{Attribute: "pool_create.options.ashift"}.Field())
+	assert.Equal(t, "name", FieldError{Attribute: "name"}.Field())
+}
+
+func TestClient_ValidationError_REST(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2.0/system/version", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"TrueNAS-SCALE-24.04.1"`))
+	})
+	mux.HandleFunc("POST /api/v2.0/sharing/smb", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_, _ = w.Write([]byte(`{"sharingsmb_create.path": [{"message": "This field is required", "errno": 22}],
+			"sharingsmb_create.name": [{"message": "Share name already exists", "errno": 17}]}`))
+	})
+	mux.HandleFunc("DELETE /api/v2.0/sharing/smb/id/{id}", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "Not authorized", "errno": 13}`, http.StatusForbidden)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewClient(server.URL, Options{APIKey: "key", Transport: TransportREST})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	err = client.Call(ctx, "sharing.smb.create", []any{map[string]any{}}, nil)
+	var validation *ValidationError
+	require.ErrorAs(t, err, &validation)
+	assert.Equal(t, []FieldError{
+		{Attribute: "sharingsmb_create.name", Message: "Share name already exists", Errno: 17},
+		{Attribute: "sharingsmb_create.path", Message: "This field is required", Errno: 22},
+	}, validation.Errors)
+
+	err = client.Call(ctx, "sharing.smb.delete", []any{1}, nil)
+	assert.True(t, IsPermission(err))
+}
+
+func TestJobError(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetJobError("pool.scrub.run", "pool is busy")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	err := client.CallJob(NewTestContext(t), "pool.scrub.run", []any{"tank"}, nil)
+	var jobErr *JobError
+	require.ErrorAs(t, err, &jobErr)
+	assert.Equal(t, 101, jobErr.JobID)
+	assert.Equal(t, "pool.scrub.run", jobErr.Method)
+	assert.Equal(t, "FAILED", jobErr.State)
+	assert.Equal(t, "pool is busy", jobErr.Reason)
+	assert.Equal(t, "job 101 failed: pool is busy", jobErr.Error())
+}
+
+func TestJobError_Validation(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	reason := "[EINVAL] pool_create.topology.data: At least one data vdev is required"
+	server.SetResponse("pool.create", 7)
+	server.SetResponse("core.get_jobs", []Job{{
+		ID:      7,
+		Method:  "pool.create",
+		State:   "FAILED",
+		Error:   &reason,
+		ExcInfo: map[string]any{"type": "VALIDATION", "extra": [][]any{{"pool_create.topology.data", "At least one data vdev is required", 22}}},
+	}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	err := client.CallJob(NewTestContext(t), "pool.create", []any{map[string]any{"name": "tank"}}, nil)
+	var jobErr *JobError
+	require.ErrorAs(t, err, &jobErr)
+	assert.True(t, IsValidation(err))
+	assert.Equal(t, map[string][]string{"topology.data": {"At least one data vdev is required"}}, jobErr.Validation.Fields())
+	assert.Equal(t, reason, jobErr.Validation.Message)
+}
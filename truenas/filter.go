@@ -0,0 +1,132 @@
+package truenas
+
+// QueryPart is a filter or option passed to NewQuery
+type QueryPart interface {
+	applyTo(q *Query)
+}
+
+// Filter is a query filter built with Eq, In, And, Or and the other constructors. It
+// serializes to the middleware's query-filters format, such as ["username", "=", "bob"].
+type Filter struct {
+	conditions []any // Combined with AND
+}
+
+// applyTo adds the filter's conditions to q
+func (f Filter) applyTo(q *Query) {
+	q.filters = append(q.filters, f.conditions...)
+}
+
+// condition returns a filter with a single comparison
+func condition(field, op string, value any) Filter {
+	return Filter{conditions: []any{[]any{field, op, value}}}
+}
+
+// Eq matches records whose field equals value
+func Eq(field string, value any) Filter {
+	return condition(field, "=", value)
+}
+
+// Ne matches records whose field does not equal value
+func Ne(field string, value any) Filter {
+	return condition(field, "!=", value)
+}
+
+// Gt matches records whose field is greater than value
+func Gt(field string, value any) Filter {
+	return condition(field, ">", value)
+}
+
+// Ge matches records whose field is greater than or equal to value
+func Ge(field string, value any) Filter {
+	return condition(field, ">=", value)
+}
+
+// Lt matches records whose field is less than value
+func Lt(field string, value any) Filter {
+	return condition(field, "<", value)
+}
+
+// Le matches records whose field is less than or equal to value
+func Le(field string, value any) Filter {
+	return condition(field, "<=", value)
+}
+
+// In matches records whose field equals one of values
+func In[T any](field string, values []T) Filter {
+	if values == nil {
+		values = []T{}
+	}
+	return condition(field, "in", values)
+}
+
+// NotIn matches records whose field equals none of values
+func NotIn[T any](field string, values []T) Filter {
+	if values == nil {
+		values = []T{}
+	}
+	return condition(field, "nin", values)
+}
+
+// Contains matches records whose list field contains value
+func Contains(field string, value any) Filter {
+	return condition(field, "rin", value)
+}
+
+// StartsWith matches records whose string field starts with prefix
+func StartsWith(field, prefix string) Filter {
+	return condition(field, "^", prefix)
+}
+
+// EndsWith matches records whose string field ends with suffix
+func EndsWith(field, suffix string) Filter {
+	return condition(field, "$", suffix)
+}
+
+// Match matches records whose string field matches the regular expression pattern
+func Match(field, pattern string) Filter {
+	return condition(field, "~", pattern)
+}
+
+// And matches records matching all of filters
+func And(filters ...Filter) Filter {
+	var f Filter
+	for _, filter := range filters {
+		f.conditions = append(f.conditions, filter.conditions...)
+	}
+	return f
+}
+
+// Or matches records matching any of filters
+func Or(filters ...Filter) Filter {
+	branches := make([]any, len(filters))
+	for i, filter := range filters {
+		if len(filter.conditions) == 1 {
+			branches[i] = filter.conditions[0]
+		} else {
+			branches[i] = filter.conditions
+		}
+	}
+	return Filter{conditions: []any{[]any{"OR", branches}}}
+}
+
+// Filters returns the query-filters parameter of filters, for calling *.query
+// methods directly with Client.Call
+func Filters(filters ...Filter) []any {
+	conditions := And(filters...).conditions
+	if conditions == nil {
+		conditions = []any{}
+	}
+	return conditions
+}
+
+// orderBy is the QueryPart returned by OrderBy
+type orderBy []string
+
+func (o orderBy) applyTo(q *Query) {
+	q.OrderBy(o...)
+}
+
+// OrderBy sorts the results of NewQuery by fields. Prefix a field with "-" to sort descending.
+func OrderBy(fields ...string) QueryPart {
+	return orderBy(fields)
+}
@@ -0,0 +1,69 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilters(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		filter Filter
+		want   string
+	}{
+		{"eq", Eq("username", "bob"), `[["username", "=", "bob"]]`},
+		{"ne", Ne("locked", true), `[["locked", "!=", true]]`},
+		{"gt", Gt("uid", 1000), `[["uid", ">", 1000]]`},
+		{"ge", Ge("uid", 1000), `[["uid", ">=", 1000]]`},
+		{"lt", Lt("used", 10), `[["used", "<", 10]]`},
+		{"le", Le("used", 10), `[["used", "<=", 10]]`},
+		{"in", In("id", []int{1, 2}), `[["id", "in", [1, 2]]]`},
+		{"in_nil", In[int]("id", nil), `[["id", "in", []]]`},
+		{"not_in", NotIn("name", []string{"root"}), `[["name", "nin", ["root"]]]`},
+		{"contains", Contains("groups", 41), `[["groups", "rin", 41]]`},
+		{"starts_with", StartsWith("name", "tank/"), `[["name", "^", "tank/"]]`},
+		{"ends_with", EndsWith("name", "@daily"), `[["name", "$", "@daily"]]`},
+		{"match", Match("name", "^auto-.*"), `[["name", "~", "^auto-.*"]]`},
+		{"and", And(Eq("a", 1), Eq("b", 2)), `[["a", "=", 1], ["b", "=", 2]]`},
+		{
+			"or",
+			Or(Eq("builtin", true), And(Ge("uid", 1000), Eq("locked", false))),
+			`[["OR", [["builtin", "=", true], [["uid", ">=", 1000], ["locked", "=", false]]]]]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.JSONEq(t, tt.want, tryMarshal(Filters(tt.filter)))
+		})
+	}
+	assert.Equal(t, []any{}, Filters())
+}
+
+func TestNewQuery_Parts(t *testing.T) {
+	t.Parallel()
+	q := NewQuery(Eq("locked", false), In("uid", []int{1000, 1001}), OrderBy("-uid")).
+		Where(Or(Eq("builtin", false), Eq("username", "root"))).
+		Limit(10)
+	assert.JSONEq(t, `[
+		[["locked", "=", false], ["uid", "in", [1000, 1001]], ["OR", [["builtin", "=", false], ["username", "=", "root"]]]],
+		{"order_by": ["-uid"], "limit": 10}
+	]`, tryMarshal(q.Params()))
+}
+
+func TestUserClient_ListWithQuery_Filters(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("user.query", []User{{ID: 1, Username: "bob"}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	users, err := client.User.ListWithQuery(NewTestContext(t), NewQuery(Eq("username", "bob")))
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	server.AssertCalled(t, "user.query", Filters(Eq("username", "bob")), QueryOptions{})
+}
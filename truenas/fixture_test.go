@@ -0,0 +1,129 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Fixture is a recorded reply to a method call, stored as <method>.json in a fixture directory
+type Fixture struct {
+	Method string          `json:"method"`
+	Params any             `json:"params,omitempty"` // Params of the recorded call, for reference
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *ErrorMsg       `json:"error,omitempty"`
+}
+
+// recorder forwards test server calls to a real system and records the replies.
+// Later calls to a method overwrite its fixture, so the last reply is replayed.
+type recorder struct {
+	upstream *Client
+	dir      string
+}
+
+// forward calls msg's method on the upstream client and returns the reply to send
+func (r *recorder) forward(t *testing.T, msg Message) Message {
+	params, _ := msg.Params.([]any)
+	fixture := Fixture{Method: msg.Method, Params: msg.Params}
+	result, err := r.upstream.CallRaw(context.Background(), msg.Method, params)
+	if err != nil {
+		var errMsg *ErrorMsg
+		if !errors.As(err, &errMsg) {
+			errMsg = &ErrorMsg{Message: err.Error()}
+		}
+		fixture.Error = errMsg
+	} else {
+		fixture.Result = result
+	}
+
+	if err := writeFixture(r.dir, fixture); err != nil {
+		t.Errorf("record %s: %v", msg.Method, err)
+	}
+	return Message{ID: msg.ID, Result: fixture.Result, Error: fixture.Error}
+}
+
+func writeFixture(dir string, fixture Fixture) error {
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, fixture.Method+".json"), append(data, '\n'), 0o644)
+}
+
+// LoadFixtures configures the server to reply with the fixtures recorded in dir
+func (ts *TestServer) LoadFixtures(t *testing.T, dir string) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		var fixture Fixture
+		require.NoError(t, json.Unmarshal(data, &fixture), path)
+		if fixture.Method == "" {
+			fixture.Method = strings.TrimSuffix(filepath.Base(path), ".json")
+		}
+
+		if fixture.Error != nil {
+			ts.errors[fixture.Method] = fixture.Error
+			continue
+		}
+		ts.SetResponse(fixture.Method, fixture.Result)
+	}
+}
+
+func TestTestServer_RecordReplay(t *testing.T) {
+	t.Parallel()
+	upstreamServer := NewTestServer(t)
+	defer upstreamServer.Close()
+	upstreamServer.SetResponse("pool.query", []Pool{TestPool})
+	upstreamServer.SetError("pool.dataset.create", 22, "Invalid argument")
+
+	upstream := upstreamServer.CreateTestClient(t)
+	defer upstream.Close()
+
+	dir := t.TempDir()
+	recording := NewTestServer(t, WithRecording(upstream, dir))
+	defer recording.Close()
+
+	ctx := NewTestContext(t)
+	client := recording.CreateTestClient(t)
+	pools, err := client.Pool.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	err = client.Call(ctx, "pool.dataset.create", []any{map[string]any{"name": "tank/test"}}, nil)
+	require.Error(t, err)
+	client.Close()
+
+	assert.FileExists(t, filepath.Join(dir, "pool.query.json"))
+	data, err := os.ReadFile(filepath.Join(dir, "pool.dataset.create.json"))
+	require.NoError(t, err)
+	var fixture Fixture
+	require.NoError(t, json.Unmarshal(data, &fixture))
+	assert.Equal(t, []any{map[string]any{"name": "tank/test"}}, fixture.Params)
+	require.NotNil(t, fixture.Error)
+	assert.Equal(t, 22, fixture.Error.Code)
+
+	replay := NewTestServer(t)
+	defer replay.Close()
+	replay.LoadFixtures(t, dir)
+
+	client = replay.CreateTestClient(t)
+	defer client.Close()
+	pools, err = client.Pool.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, TestPool.Name, pools[0].Name)
+	err = client.Call(ctx, "pool.dataset.create", nil, nil)
+	var errMsg *ErrorMsg
+	require.ErrorAs(t, err, &errMsg)
+	assert.Equal(t, "Invalid argument", errMsg.Message)
+}
@@ -0,0 +1,107 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// alertLevels orders alert levels from least to most severe
+var alertLevels = []AlertLevel{
+	AlertLevelInfo,
+	AlertLevelNotice,
+	AlertLevelWarning,
+	AlertLevelError,
+	AlertLevelCritical,
+	AlertLevelAlert,
+	AlertLevelEmergency,
+}
+
+// Health summarizes the state of a system, as returned by Client.Health
+type Health struct {
+	Ready            bool         // Whether the middleware has finished booting
+	Alerts           []Alert      // Alerts that are not dismissed, at WARNING level or above
+	Pools            []PoolHealth // Status of every pool
+	FailedSmartTests []SmartFailure
+	DegradedServices []Service // Services enabled to start on boot that are not running
+}
+
+// PoolHealth is the status of a pool
+type PoolHealth struct {
+	ID           int        `json:"id"`
+	Name         string     `json:"name"`
+	Status       PoolStatus `json:"status"`
+	Healthy      bool       `json:"healthy"`
+	Warning      bool       `json:"warning"`
+	StatusDetail string     `json:"status_detail"`
+}
+
+// SmartFailure is a disk whose most recent SMART test failed
+type SmartFailure struct {
+	Disk string
+	Test SmartTestDetail
+}
+
+// Healthy reports whether the system is ready with no alerts, unhealthy pools,
+// failed SMART tests or degraded services
+func (h *Health) Healthy() bool {
+	return h.Err() == nil
+}
+
+// Err returns an error describing every problem found, or nil if the system is healthy.
+// It suits readiness probes, which can report the error as the reason they failed.
+func (h *Health) Err() error {
+	var errs []error
+	if !h.Ready {
+		errs = append(errs, errors.New("system is not ready"))
+	}
+	for _, pool := range h.Pools {
+		if !pool.Healthy {
+			errs = append(errs, fmt.Errorf("pool %s is %s", pool.Name, pool.Status))
+		}
+	}
+	for _, failure := range h.FailedSmartTests {
+		errs = append(errs, fmt.Errorf("disk %s failed SMART test: %s", failure.Disk, failure.Test.StatusVerbose))
+	}
+	for _, service := range h.DegradedServices {
+		errs = append(errs, fmt.Errorf("service %s is %s", service.Service, service.State))
+	}
+	for _, alert := range h.Alerts {
+		errs = append(errs, fmt.Errorf("%s alert: %s", alert.Level, alert.Formatted))
+	}
+	return errors.Join(errs...)
+}
+
+// Health collects the system's readiness, active alerts, pool statuses, failed SMART tests
+// and degraded services in a single batch of calls. The returned error reports calls that
+// failed, not problems found; use Health.Healthy or Health.Err to check those.
+func (c *Client) Health(ctx context.Context) (*Health, error) {
+	var (
+		health  Health
+		alerts  []Alert
+		results []SmartTestResult
+	)
+	batch := c.Batch()
+	batch.Add("system.ready", nil, &health.Ready)
+	batch.Add("alert.list", nil, &alerts)
+	batch.Add("pool.query", NewQuery().Select("id", "name", "status", "healthy", "warning", "status_detail").Params(), &health.Pools)
+	batch.Add("smart.test.results", nil, &results)
+	batch.Add("service.query", NewQuery(Eq("enable", true), Ne("state", ServiceStateRunning)).Params(), &health.DegradedServices)
+	if err := batch.Do(ctx); err != nil {
+		return nil, fmt.Errorf("health: %w", err)
+	}
+
+	warning := slices.Index(alertLevels, AlertLevelWarning)
+	for _, alert := range alerts {
+		if !alert.Dismissed && slices.Index(alertLevels, AlertLevel(alert.Level)) >= warning {
+			health.Alerts = append(health.Alerts, alert)
+		}
+	}
+	for _, result := range results {
+		if len(result.Tests) > 0 && result.Tests[0].Status == SmartTestStatusFailed {
+			health.FailedSmartTests = append(health.FailedSmartTests, SmartFailure{Disk: result.Disk, Test: result.Tests[0]})
+		}
+	}
+	return &health, nil
+}
@@ -0,0 +1,113 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setHealthyResponses(server *TestServer) {
+	server.SetResponse("system.ready", true)
+	server.SetResponse("alert.list", []Alert{
+		{UUID: "1", Level: "INFO", Formatted: "Update available"},
+		{UUID: "2", Level: "CRITICAL", Formatted: "Dismissed", Dismissed: true},
+	})
+	server.SetResponse("pool.query", []PoolHealth{{ID: 1, Name: "tank", Status: PoolStatusOnline, Healthy: true}})
+	server.SetResponse("smart.test.results", []SmartTestResult{{
+		Disk: "sda",
+		Tests: []SmartTestDetail{
+			{Num: 1, Status: SmartTestStatusSuccess},
+			{Num: 2, Status: SmartTestStatusFailed}, // Superseded by the later test
+		},
+	}})
+	server.SetResponse("service.query", []Service{})
+}
+
+func TestClient_Health(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setHealthyResponses(server)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	health, err := client.Health(ctx)
+	require.NoError(t, err)
+	assert.True(t, health.Ready)
+	assert.Empty(t, health.Alerts)
+	assert.Empty(t, health.FailedSmartTests)
+	assert.Empty(t, health.DegradedServices)
+	require.Len(t, health.Pools, 1)
+	assert.Equal(t, "tank", health.Pools[0].Name)
+	assert.True(t, health.Healthy())
+	assert.NoError(t, health.Err())
+
+	server.AssertCalled(t, "service.query", []any{[]any{"enable", "=", true}, []any{"state", "!=", "RUNNING"}}, map[string]any{})
+}
+
+func TestClient_Health_Problems(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setHealthyResponses(server)
+
+	server.SetResponse("system.ready", false)
+	server.SetResponse("alert.list", []Alert{{UUID: "1", Level: "WARNING", Formatted: "Pool tank is 85% full"}})
+	server.SetResponse("pool.query", []PoolHealth{
+		{ID: 1, Name: "tank", Status: PoolStatusOnline, Healthy: true},
+		{ID: 2, Name: "backup", Status: PoolStatusDegraded, Healthy: false},
+	})
+	server.SetResponse("smart.test.results", []SmartTestResult{{
+		Disk:  "sdb",
+		Tests: []SmartTestDetail{{Num: 1, Status: SmartTestStatusFailed, StatusVerbose: "Completed: read failure"}},
+	}})
+	server.SetResponse("service.query", []Service{{ID: 4, Service: "nfs", Enable: true, State: ServiceStateStopped}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	health, err := client.Health(ctx)
+	require.NoError(t, err)
+	assert.False(t, health.Healthy())
+	require.Len(t, health.Alerts, 1)
+	require.Len(t, health.FailedSmartTests, 1)
+	assert.Equal(t, "sdb", health.FailedSmartTests[0].Disk)
+	require.Len(t, health.DegradedServices, 1)
+
+	err = health.Err()
+	require.Error(t, err)
+	for _, problem := range []string{
+		"system is not ready",
+		"pool backup is DEGRADED",
+		"disk sdb failed SMART test: Completed: read failure",
+		"service nfs is STOPPED",
+		"WARNING alert: Pool tank is 85% full",
+	} {
+		assert.ErrorContains(t, err, problem)
+	}
+}
+
+func TestClient_Health_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setHealthyResponses(server)
+
+	server.SetError("smart.test.results", 500, "smartctl unavailable")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	health, err := client.Health(ctx)
+	require.Error(t, err)
+	assert.Nil(t, health)
+	assert.ErrorContains(t, err, "smart.test.results")
+
+	var apiErr *ErrorMsg
+	assert.ErrorAs(t, err, &apiErr)
+}
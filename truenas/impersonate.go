@@ -0,0 +1,30 @@
+package truenas
+
+// WithAuth returns a client that makes its calls as username instead of with c's credentials,
+// such as to check what a user is permitted to do. The derived client keeps c's options and
+// shares its HTTP connection pool and detected server version. The middleware authenticates
+// each websocket connection, so over websockets it logs in on connections of its own.
+//
+// Close the derived client when done with it; closing it leaves c open.
+func (c *Client) WithAuth(username, password string) (*Client, error) {
+	return c.derive(func(opts *Options) {
+		opts.Username, opts.Password = username, password
+	})
+}
+
+// WithAPIKey returns a client that makes its calls with apiKey instead of with c's credentials.
+// It behaves like a client returned by WithAuth.
+func (c *Client) WithAPIKey(apiKey string) (*Client, error) {
+	return c.derive(func(opts *Options) {
+		opts.APIKey = apiKey
+	})
+}
+
+// derive creates a client for c's endpoint with c's options and the credentials set by auth
+func (c *Client) derive(auth func(*Options)) (*Client, error) {
+	opts := c.opts
+	opts.Username, opts.Password, opts.APIKey, opts.Token = "", "", "", ""
+	opts.OnConnect, opts.OnDisconnect, opts.OnReconnect = nil, nil, nil
+	auth(&opts)
+	return newClient(c.url, opts, c)
+}
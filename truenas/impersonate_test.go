@@ -0,0 +1,66 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_WithAuth(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.10.1")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	alice, err := client.WithAuth("alice", "secret")
+	require.NoError(t, err)
+	server.AssertCalled(t, "auth.login", "alice", "secret")
+
+	// The derived client shares the parent's HTTP client and detected version
+	assert.Same(t, client.httpClient, alice.httpClient)
+	require.NotNil(t, alice.ServerVersion())
+	assert.Equal(t, "TrueNAS-SCALE-24.10.1", alice.ServerVersion().String())
+	assert.Equal(t, 1, server.CallCount("system.version"))
+
+	ctx := NewTestContext(t)
+	require.NoError(t, alice.Call(ctx, "system.info", nil, nil))
+
+	// Closing the derived client leaves the parent connected
+	require.NoError(t, alice.Close())
+	assert.Equal(t, StateConnected, client.State())
+	require.NoError(t, client.Call(ctx, "system.info", nil, nil))
+}
+
+func TestClient_WithAPIKey(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	derived, err := client.WithAPIKey("1-abc")
+	require.NoError(t, err)
+	defer derived.Close()
+	server.AssertCalled(t, "auth.login_with_api_key", "1-abc")
+	assert.Equal(t, 1, server.CallCount("auth.login"))
+}
+
+func TestClient_WithAuth_Rejected(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	server.SetError("auth.login", 401, "Authentication failed")
+	_, err := client.WithAuth("mallory", "wrong")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Authentication failed")
+	assert.Equal(t, StateConnected, client.State())
+}
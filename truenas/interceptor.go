@@ -0,0 +1,32 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// Invoker performs a call and returns its undecoded result
+type Invoker func(ctx context.Context, method string, params []any) (json.RawMessage, error)
+
+// Interceptor wraps every call the client makes, for example to add tracing or metrics.
+// It must call invoke to perform the call and may inspect or replace its result.
+type Interceptor func(ctx context.Context, method string, params []any, invoke Invoker) (json.RawMessage, error)
+
+type jobCallKey struct{}
+
+// IsJobCall reports whether ctx belongs to a call that starts a job, whose result is the job ID
+func IsJobCall(ctx context.Context) bool {
+	job, _ := ctx.Value(jobCallKey{}).(bool)
+	return job
+}
+
+// chainInterceptors wraps invoke in interceptors, the first being outermost
+func chainInterceptors(interceptors []Interceptor, invoke Invoker) Invoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoke
+		invoke = func(ctx context.Context, method string, params []any) (json.RawMessage, error) {
+			return interceptor(ctx, method, params, next)
+		}
+	}
+	return invoke
+}
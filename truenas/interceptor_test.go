@@ -0,0 +1,52 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Interceptors(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetJobResponse("pool.scrub.run", true)
+
+	var mu sync.Mutex
+	var calls []string
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, method string, params []any, invoke Invoker) (json.RawMessage, error) {
+			mu.Lock()
+			calls = append(calls, name+" "+method)
+			if IsJobCall(ctx) {
+				calls = append(calls, name+" job")
+			}
+			mu.Unlock()
+			return invoke(ctx, method, params)
+		}
+	}
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:     "test",
+		Password:     "test",
+		Interceptors: []Interceptor{record("outer"), record("inner")},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.CallJob(ctx, "pool.scrub.run", []any{"tank"}, nil))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{
+		"outer auth.login", "inner auth.login",
+		"outer system.version", "inner system.version",
+		"outer pool.scrub.run", "outer job", "inner pool.scrub.run", "inner job",
+		"outer core.get_jobs", "inner core.get_jobs",
+	}, calls)
+}
@@ -0,0 +1,160 @@
+package truenas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// APICatalog describes the services and methods of the connected middleware, as returned by
+// Client.Introspect
+type APICatalog struct {
+	Services map[string]ServiceInfo // By service name, such as "pool.dataset"
+	Methods  map[string]MethodInfo  // By method name, such as "pool.dataset.query"
+}
+
+// ServiceInfo describes a middleware service
+type ServiceInfo struct {
+	Name   string        `json:"-"`
+	Type   string        `json:"type"` // "service", "config" or "crud"
+	Config ServiceConfig `json:"config"`
+}
+
+// ServiceConfig holds the configuration of a middleware service
+type ServiceConfig struct {
+	Namespace    string `json:"namespace"`
+	Private      bool   `json:"private"`
+	CLINamespace string `json:"cli_namespace"`
+	Datastore    string `json:"datastore"`
+}
+
+// MethodInfo describes a middleware method
+type MethodInfo struct {
+	Name           string        `json:"-"`
+	Description    string        `json:"description"`
+	Job            bool          `json:"job"`
+	Downloadable   bool          `json:"downloadable"`
+	Uploadable     bool          `json:"uploadable"`
+	Filterable     bool          `json:"filterable"`
+	ItemMethod     bool          `json:"item_method"`
+	NoAuthRequired bool          `json:"no_auth_required"`
+	Roles          []string      `json:"roles"`
+	Accepts        []*JSONSchema `json:"accepts"` // One schema per positional param
+	Returns        []*JSONSchema `json:"returns"`
+}
+
+// JSONSchema is the JSON Schema of a method's param or result. Servers before SCALE 24.10
+// describe lists with an array of item schemas and mark required params with _required_;
+// later servers use standard JSON Schema.
+type JSONSchema struct {
+	Name                 string                 `json:"_name_,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 SchemaTypes            `json:"type,omitempty"`
+	Required             bool                   `json:"_required_,omitempty"` // Whether a param is required
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	RequiredProperties   []string               `json:"required,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"`
+	Items                SchemaList             `json:"items,omitempty"`
+	AnyOf                []*JSONSchema          `json:"anyOf,omitempty"`
+	OneOf                []*JSONSchema          `json:"oneOf,omitempty"`
+	Enum                 []any                  `json:"enum,omitempty"`
+	Const                any                    `json:"const,omitempty"`
+	Default              any                    `json:"default,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Defs                 map[string]*JSONSchema `json:"$defs,omitempty"`
+}
+
+// SchemaTypes holds the JSON types a schema allows, written as a string or a list of strings
+type SchemaTypes []string
+
+// UnmarshalJSON implements json.Unmarshaler
+func (t *SchemaTypes) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*t = SchemaTypes{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(t))
+}
+
+// MarshalJSON implements json.Marshaler
+func (t SchemaTypes) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// Has reports whether the schema allows a JSON type, such as "null"
+func (t SchemaTypes) Has(typ string) bool {
+	return slices.Contains(t, typ)
+}
+
+// SchemaList holds the item schemas of a list, written as a schema or a list of schemas
+type SchemaList []*JSONSchema
+
+// UnmarshalJSON implements json.Unmarshaler
+func (l *SchemaList) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var schema JSONSchema
+		if err := unmarshalJSON(data, &schema); err != nil {
+			return err
+		}
+		*l = SchemaList{&schema}
+		return nil
+	}
+	return unmarshalJSON(data, (*[]*JSONSchema)(l))
+}
+
+// Introspect returns the services and methods the connected middleware provides, with the
+// schemas of each method's params and result, such as to check for a method before calling
+// it or to build a form for it
+func (c *Client) Introspect(ctx context.Context) (*APICatalog, error) {
+	var catalog APICatalog
+	batch := c.Batch()
+	batch.Add("core.get_services", nil, &catalog.Services)
+	batch.Add("core.get_methods", nil, &catalog.Methods)
+	if err := batch.Do(ctx); err != nil {
+		return nil, fmt.Errorf("introspect: %w", err)
+	}
+	for name, service := range catalog.Services {
+		service.Name = name
+		catalog.Services[name] = service
+	}
+	for name, method := range catalog.Methods {
+		method.Name = name
+		catalog.Methods[name] = method
+	}
+	return &catalog, nil
+}
+
+// HasMethod reports whether the middleware provides a method
+func (c *APICatalog) HasMethod(name string) bool {
+	_, ok := c.Methods[name]
+	return ok
+}
+
+// ServiceMethods returns the methods of a service sorted by name, such as those of
+// "pool.dataset" but not of "pool.dataset.userprop"
+func (c *APICatalog) ServiceMethods(service string) []MethodInfo {
+	var methods []MethodInfo
+	for _, name := range slices.Sorted(maps.Keys(c.Methods)) {
+		if rest, ok := strings.CutPrefix(name, service+"."); ok && !strings.Contains(rest, ".") {
+			methods = append(methods, c.Methods[name])
+		}
+	}
+	return methods
+}
+
+// ServiceNames returns the names of the services sorted
+func (c *APICatalog) ServiceNames() []string {
+	return slices.Sorted(maps.Keys(c.Services))
+}
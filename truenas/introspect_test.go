@@ -0,0 +1,89 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Introspect(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("core.get_services", json.RawMessage(`{
+		"pool.dataset": {"type": "crud", "config": {"namespace": "pool.dataset", "private": false, "cli_namespace": "storage.dataset", "datastore": null}},
+		"alert": {"type": "service", "config": {"namespace": "alert", "private": false}}
+	}`))
+	server.SetResponse("core.get_methods", json.RawMessage(`{
+		"pool.dataset.query": {
+			"description": "Query datasets.",
+			"filterable": true,
+			"roles": ["DATASET_READ"],
+			"accepts": [
+				{"_name_": "query-filters", "type": "array", "items": [], "_required_": false},
+				{"_name_": "query-options", "type": "object", "properties": {"limit": {"type": "integer", "default": 0}}}
+			],
+			"returns": [{"_name_": "datasets", "type": ["array", "object"], "items": {"type": "object"}}]
+		},
+		"pool.dataset.lock": {"description": "Lock a dataset.", "job": true, "accepts": [{"_name_": "id", "type": "string", "_required_": true}]},
+		"pool.dataset.userprop.query": {"filterable": true}
+	}`))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	catalog, err := client.Introspect(NewTestContext(t))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alert", "pool.dataset"}, catalog.ServiceNames())
+	assert.Equal(t, "crud", catalog.Services["pool.dataset"].Type)
+	assert.Equal(t, "storage.dataset", catalog.Services["pool.dataset"].Config.CLINamespace)
+
+	assert.True(t, catalog.HasMethod("pool.dataset.lock"))
+	assert.False(t, catalog.HasMethod("pool.dataset.frobnicate"))
+
+	methods := catalog.ServiceMethods("pool.dataset")
+	require.Len(t, methods, 2)
+	assert.Equal(t, "pool.dataset.lock", methods[0].Name)
+	assert.True(t, methods[0].Job)
+	assert.True(t, methods[0].Accepts[0].Required)
+
+	query := methods[1]
+	assert.Equal(t, "pool.dataset.query", query.Name)
+	assert.True(t, query.Filterable)
+	assert.Equal(t, []string{"DATASET_READ"}, query.Roles)
+	require.Len(t, query.Accepts, 2)
+	assert.Equal(t, SchemaTypes{"array"}, query.Accepts[0].Type)
+	assert.Empty(t, query.Accepts[0].Items)
+	assert.Equal(t, json.Number("0"), query.Accepts[1].Properties["limit"].Default)
+	require.Len(t, query.Returns, 1)
+	assert.True(t, query.Returns[0].Type.Has("object"))
+	require.Len(t, query.Returns[0].Items, 1)
+	assert.Equal(t, SchemaTypes{"object"}, query.Returns[0].Items[0].Type)
+}
+
+func TestClient_Introspect_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("core.get_services", map[string]any{})
+	server.SetError("core.get_methods", 13, "Not authorized")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	catalog, err := client.Introspect(NewTestContext(t))
+	require.Error(t, err)
+	assert.Nil(t, catalog)
+	assert.ErrorContains(t, err, "core.get_methods")
+}
+
+func TestSchemaTypes_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	data, err := json.Marshal(&JSONSchema{Type: SchemaTypes{"string"}, Items: SchemaList{{Type: SchemaTypes{"string", "null"}}}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "string", "items": [{"type": ["string", "null"]}]}`, string(data))
+}
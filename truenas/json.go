@@ -0,0 +1,23 @@
+package truenas
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// unmarshalJSON is json.Unmarshal, except that numbers decoded into interface values become
+// json.Number instead of float64. Byte counts such as dataset quotas and pool sizes exceed
+// 2^53, beyond which float64 cannot hold every integer.
+func unmarshalJSON(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return errors.New("invalid data after top-level value")
+	}
+	return nil
+}
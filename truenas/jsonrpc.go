@@ -0,0 +1,148 @@
+package truenas
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+// Protocol selects the wire protocol spoken over the WebSocket connection
+type Protocol string
+
+const (
+	ProtocolAuto    Protocol = ""        // Detect from the endpoint path, the default
+	ProtocolDDP     Protocol = "ddp"     // Legacy DDP protocol served at /websocket
+	ProtocolJSONRPC Protocol = "jsonrpc" // JSON-RPC 2.0 served at /api/current on TrueNAS 24.04 and later
+)
+
+// detectProtocol picks JSON-RPC for endpoints below /api/, such as /api/current or /api/v25.04.0,
+// and DDP for everything else
+func detectProtocol(endpoint string) Protocol {
+	u, err := url.Parse(endpoint)
+	if err == nil && strings.HasPrefix(u.Path, "/api/") {
+		return ProtocolJSONRPC
+	}
+	return ProtocolDDP
+}
+
+// rpcRequest is a JSON-RPC 2.0 request
+type rpcRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      string `json:"id"`
+	Method  string `json:"method"`
+	Params  any    `json:"params"`
+}
+
+// rpcMessage is a JSON-RPC 2.0 response or server notification
+type rpcMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError is a JSON-RPC 2.0 error. Middleware errors carry the errno and reason in Data.
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    *struct {
+		Error   int             `json:"error"`
+		Errname string          `json:"errname"`
+		Reason  string          `json:"reason"`
+		Extra   json.RawMessage `json:"extra"`
+	} `json:"data,omitempty"`
+}
+
+// errorMsg converts the error into the ErrorMsg returned by DDP calls
+func (e *rpcError) errorMsg() *ErrorMsg {
+	errMsg := &ErrorMsg{Code: e.Code, Message: e.Message}
+	if e.Data != nil {
+		errMsg.Code = e.Data.Error
+		errMsg.Reason = e.Data.Reason
+		errMsg.Type = e.Data.Errname
+		errMsg.Errname = e.Data.Errname
+		errMsg.Extra = e.Data.Extra
+	}
+	return errMsg
+}
+
+// writeMessage encodes msg in the connection's protocol and writes it
+func (c *Client) writeMessage(conn *websocket.Conn, msg *Message) error {
+	if c.protocol != ProtocolJSONRPC {
+		return conn.WriteJSON(msg)
+	}
+
+	req := rpcRequest{JSONRPC: "2.0", ID: msg.ID, Method: msg.Method, Params: msg.Params}
+	switch msg.Msg {
+	case "sub":
+		// Remember the request so its result can be recorded as the server's subscription ID.
+		c.rpcSubs.Store(msg.ID, "")
+		req.Method = "core.subscribe"
+		req.Params = []any{msg.Name}
+	case "unsub":
+		serverID, ok := c.rpcSubs.LoadAndDelete(msg.ID)
+		if !ok || serverID == "" {
+			return nil
+		}
+		req.Method = "core.unsubscribe"
+		req.Params = []any{serverID}
+	}
+	if req.Params == nil {
+		req.Params = []any{}
+	}
+	return conn.WriteJSON(req)
+}
+
+// readMessage reads the next message and decodes it from the connection's protocol
+func (c *Client) readMessage(conn *websocket.Conn, msg *Message) error {
+	if c.protocol != ProtocolJSONRPC {
+		return conn.ReadJSON(msg)
+	}
+
+	var rpc rpcMessage
+	if err := conn.ReadJSON(&rpc); err != nil {
+		return err
+	}
+
+	switch rpc.Method {
+	case "":
+	case "collection_update":
+		// Collection updates carry DDP-style {msg, collection, id, fields} params.
+		if err := json.Unmarshal(rpc.Params, msg); err != nil {
+			return fmt.Errorf("unmarshal collection update: %w", err)
+		}
+		return nil
+	default:
+		// Other notifications, such as notify_unsubscribed, have no DDP equivalent.
+		return nil
+	}
+
+	var id string
+	if err := json.Unmarshal(rpc.ID, &id); err != nil {
+		id = string(rpc.ID)
+	}
+	msg.ID = id
+	msg.Msg = "result"
+	msg.Result = rpc.Result
+	if rpc.Error != nil {
+		msg.Error = rpc.Error.errorMsg()
+	}
+
+	// Replies to core.subscribe are turned into DDP ready/nosub messages.
+	if _, ok := c.rpcSubs.Load(id); ok {
+		if msg.Error != nil {
+			c.rpcSubs.Delete(id)
+			msg.Msg = "nosub"
+			return nil
+		}
+		var serverID string
+		_ = json.Unmarshal(rpc.Result, &serverID)
+		c.rpcSubs.Store(id, serverID)
+		*msg = Message{Msg: "ready", Subs: []string{id}}
+	}
+	return nil
+}
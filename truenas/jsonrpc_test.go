@@ -0,0 +1,132 @@
+package truenas
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectProtocol(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, ProtocolJSONRPC, detectProtocol("wss://truenas.local/api/current"))
+	assert.Equal(t, ProtocolJSONRPC, detectProtocol("ws://truenas.local/api/v25.04.0"))
+	assert.Equal(t, ProtocolDDP, detectProtocol("wss://truenas.local/websocket"))
+	assert.Equal(t, ProtocolDDP, detectProtocol("ws://truenas.local"))
+}
+
+// newJSONRPCServer starts a JSON-RPC 2.0 server at /api/current. Calls to core.unsubscribe
+// are reported on unsubscribed.
+func newJSONRPCServer(t *testing.T, unsubscribed chan<- []any) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/current" {
+			http.NotFound(w, r)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		var mu sync.Mutex
+		write := func(v any) {
+			mu.Lock()
+			defer mu.Unlock()
+			_ = conn.WriteJSON(v)
+		}
+
+		for {
+			var req struct {
+				JSONRPC string          `json:"jsonrpc"`
+				ID      any             `json:"id"`
+				Method  string          `json:"method"`
+				Params  json.RawMessage `json:"params"`
+			}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			assert.Equal(t, "2.0", req.JSONRPC)
+
+			resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+			switch req.Method {
+			case "auth.login":
+				resp["result"] = true
+			case "system.info":
+				resp["result"] = map[string]any{"hostname": "dragonfish", "version": "TrueNAS-SCALE-24.04.2"}
+			case "pool.create":
+				resp["error"] = map[string]any{
+					"code":    -32001,
+					"message": "Method call error",
+					"data":    map[string]any{"error": 22, "errname": "EINVAL", "reason": "[EINVAL] pool_create.name: Field required"},
+				}
+			case "core.subscribe":
+				resp["result"] = "server-sub-1"
+				write(resp)
+				write(map[string]any{
+					"jsonrpc": "2.0",
+					"method":  "collection_update",
+					"params":  map[string]any{"msg": "added", "collection": "alert.list", "id": 5, "fields": map[string]any{"uuid": "a1"}},
+				})
+				continue
+			case "core.unsubscribe":
+				var params []any
+				_ = json.Unmarshal(req.Params, &params)
+				unsubscribed <- params
+				resp["result"] = nil
+			default:
+				resp["result"] = true
+			}
+			write(resp)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_JSONRPC(t *testing.T) {
+	t.Parallel()
+	unsubscribed := make(chan []any, 1)
+	server := newJSONRPCServer(t, unsubscribed)
+
+	endpoint := strings.Replace(server.URL, "http://", "ws://", 1) + "/api/current"
+	client, err := NewClient(endpoint, Options{Username: "root", Password: "secret"})
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	info, err := client.System.GetInfo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "dragonfish", info.Hostname)
+
+	err = client.Call(ctx, "pool.create", []any{map[string]any{}}, nil)
+	var errMsg *ErrorMsg
+	require.ErrorAs(t, err, &errMsg)
+	assert.Equal(t, 22, errMsg.Code)
+	assert.Equal(t, "EINVAL", errMsg.Type)
+	assert.Contains(t, errMsg.Reason, "Field required")
+
+	sub, err := client.Subscribe.Watch(ctx, "alert.list")
+	require.NoError(t, err)
+	select {
+	case event := <-sub.Events():
+		assert.Equal(t, EventTypeAdded, event.Type)
+		assert.Equal(t, "alert.list", event.Collection)
+		assert.Equal(t, "5", event.ID)
+		assert.JSONEq(t, `{"uuid": "a1"}`, string(event.Fields))
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	require.NoError(t, sub.Unsubscribe(ctx))
+	select {
+	case params := <-unsubscribed:
+		assert.Equal(t, []any{"server-sub-1"}, params)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for core.unsubscribe")
+	}
+}
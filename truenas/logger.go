@@ -1,7 +1,15 @@
 package truenas
 
-import "log"
+import (
+	"context"
+	"log"
+	"log/slog"
+	"time"
+)
 
+// Logger receives the unstructured diagnostics enabled by Options.Debug.
+//
+// Deprecated: use Options.Logger, which receives structured, redacted records.
 type Logger interface {
 	Println(msg string)
 	Printf(format string, v ...any)
@@ -16,3 +24,37 @@ func (l *defaultLogger) Printf(format string, v ...any) {
 func (l *defaultLogger) Println(msg string) {
 	log.Println(msg)
 }
+
+// logCall records a finished call with its method, duration, redacted params and error.
+// Successful calls are logged at debug level and failures at warn level.
+func (c *Client) logCall(ctx context.Context, method string, params []any, start time.Time, err error) {
+	if c.opts.Logger == nil {
+		return
+	}
+	level := slog.LevelDebug
+	if err != nil {
+		level = slog.LevelWarn
+	}
+	if !c.opts.Logger.Enabled(ctx, level) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.Duration("duration", time.Since(start)),
+		slog.Any("params", RedactParams(method, params)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		c.opts.Logger.LogAttrs(ctx, level, "truenas call failed", attrs...)
+		return
+	}
+	c.opts.Logger.LogAttrs(ctx, level, "truenas call", attrs...)
+}
+
+// logConnection records a connection lifecycle event such as a lost connection or reconnect,
+// or a failure in the background, such as a dropped subscription event
+func (c *Client) logConnection(level slog.Level, msg string, args ...any) {
+	if c.opts.Logger != nil {
+		c.opts.Logger.Log(context.Background(), level, msg, args...)
+	}
+}
@@ -0,0 +1,148 @@
+package truenas
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// syncBuffer is a bytes.Buffer safe for use by concurrent log writers
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestClient_Logger(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetError("pool.query", 13, "Not authorized")
+
+	var buf syncBuffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client, err := NewClient(server.GetWebSocketURL(), Options{Username: "root", Password: "hunter2", Logger: logger})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err = client.Pool.List(ctx)
+	require.Error(t, err)
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	require.Len(t, records, 3)
+
+	assert.Equal(t, "DEBUG", records[0]["level"])
+	assert.Equal(t, "truenas call", records[0]["msg"])
+	assert.Equal(t, "auth.login", records[0]["method"])
+	assert.Equal(t, []any{"root", redacted}, records[0]["params"])
+	assert.Contains(t, records[0], "duration")
+	assert.NotContains(t, buf.String(), "hunter2")
+
+	assert.Equal(t, "system.version", records[1]["method"])
+
+	assert.Equal(t, "WARN", records[2]["level"])
+	assert.Equal(t, "truenas call failed", records[2]["msg"])
+	assert.Equal(t, "pool.query", records[2]["method"])
+	assert.Contains(t, records[2]["error"], "Not authorized")
+}
+
+func TestClient_Debug_RedactedUploadsAndResults(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("auth.generate_token", "tok-sekrit")
+	server.SetJobResponse("pool.dataset.change_key", nil)
+
+	logger := &printLogger{}
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:      "root",
+		Password:      "hunter2",
+		Debug:         true,
+		DefaultLogger: logger,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	token, err := client.Token(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "tok-sekrit", token)
+	require.NoError(t, client.Dataset.ChangeKeyWithKeyFile(ctx, "tank/encrypted", DatasetChangeKeyOptions{Key: "c0ffee00"}, strings.NewReader("3d5b6c")))
+	server.SetDownload("config.save", []byte("SQLite format 3\x00"))
+	require.NoError(t, client.CallDownload(ctx, "config.save", nil, "config.db", &bytes.Buffer{}))
+
+	output := logger.buf.String()
+	assert.Contains(t, output, `"result":"[REDACTED]"`)
+	assert.Contains(t, output, `"key":"[REDACTED]"`)
+	assert.Contains(t, output, "auth_token=[REDACTED]")
+	for _, secret := range []string{"tok-sekrit", "c0ffee00", "test-token"} {
+		assert.NotContains(t, output, secret)
+	}
+}
+
+// printLogger collects the output of Options.Debug
+type printLogger struct {
+	buf syncBuffer
+}
+
+func (l *printLogger) Println(msg string) {
+	_, _ = l.buf.Write([]byte(msg + "\n"))
+}
+
+func (l *printLogger) Printf(format string, v ...any) {
+	_, _ = fmt.Fprintf(&l.buf, format, v...)
+}
+
+func TestClient_Debug_Redacted(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("api_key.create", map[string]any{"id": 1, "name": "ci", "key": "1-sekrit"})
+	server.SetResponse("kerberos.keytab.create", map[string]any{"id": 2, "name": "host"})
+
+	logger := &printLogger{}
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:      "root",
+		Password:      "hunter2",
+		Debug:         true,
+		DefaultLogger: logger,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.Call(ctx, "api_key.create", []any{map[string]any{"name": "ci"}}, nil))
+	require.NoError(t, client.Call(ctx, "kerberos.keytab.create", []any{map[string]any{"name": "host", "file": "BQIAAABH"}}, nil))
+
+	output := logger.buf.String()
+	assert.Contains(t, output, `"params":["root","[REDACTED]"]`)
+	assert.Contains(t, output, `"key":"[REDACTED]"`)
+	assert.Contains(t, output, `"file":"[REDACTED]"`)
+	for _, secret := range []string{"hunter2", "1-sekrit", "BQIAAABH"} {
+		assert.NotContains(t, output, secret)
+	}
+}
@@ -0,0 +1,491 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// ACLTemplateAPI is an autogenerated mock type for the ACLTemplateAPI type
+type ACLTemplateAPI struct {
+	mock.Mock
+}
+
+type ACLTemplateAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ACLTemplateAPI) EXPECT() *ACLTemplateAPI_Expecter {
+	return &ACLTemplateAPI_Expecter{mock: &_m.Mock}
+}
+
+// ByPath provides a mock function with given fields: ctx, path, q, opts
+func (_m *ACLTemplateAPI) ByPath(ctx context.Context, path string, q *truenas.Query, opts truenas.ACLTemplateByPathOptions) ([]truenas.ACLTemplate, error) {
+	ret := _m.Called(ctx, path, q, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ByPath")
+	}
+
+	var r0 []truenas.ACLTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.Query, truenas.ACLTemplateByPathOptions) ([]truenas.ACLTemplate, error)); ok {
+		return rf(ctx, path, q, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.Query, truenas.ACLTemplateByPathOptions) []truenas.ACLTemplate); ok {
+		r0 = rf(ctx, path, q, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ACLTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.Query, truenas.ACLTemplateByPathOptions) error); ok {
+		r1 = rf(ctx, path, q, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ACLTemplateAPI_ByPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ByPath'
+type ACLTemplateAPI_ByPath_Call struct {
+	*mock.Call
+}
+
+// ByPath is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - q *truenas.Query
+//   - opts truenas.ACLTemplateByPathOptions
+func (_e *ACLTemplateAPI_Expecter) ByPath(ctx interface{}, path interface{}, q interface{}, opts interface{}) *ACLTemplateAPI_ByPath_Call {
+	return &ACLTemplateAPI_ByPath_Call{Call: _e.mock.On("ByPath", ctx, path, q, opts)}
+}
+
+func (_c *ACLTemplateAPI_ByPath_Call) Run(run func(ctx context.Context, path string, q *truenas.Query, opts truenas.ACLTemplateByPathOptions)) *ACLTemplateAPI_ByPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.Query), args[3].(truenas.ACLTemplateByPathOptions))
+	})
+	return _c
+}
+
+func (_c *ACLTemplateAPI_ByPath_Call) Return(_a0 []truenas.ACLTemplate, _a1 error) *ACLTemplateAPI_ByPath_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ACLTemplateAPI_ByPath_Call) RunAndReturn(run func(context.Context, string, *truenas.Query, truenas.ACLTemplateByPathOptions) ([]truenas.ACLTemplate, error)) *ACLTemplateAPI_ByPath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *ACLTemplateAPI) Create(ctx context.Context, req *truenas.ACLTemplateRequest) (*truenas.ACLTemplate, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.ACLTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ACLTemplateRequest) (*truenas.ACLTemplate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ACLTemplateRequest) *truenas.ACLTemplate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ACLTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.ACLTemplateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ACLTemplateAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ACLTemplateAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.ACLTemplateRequest
+func (_e *ACLTemplateAPI_Expecter) Create(ctx interface{}, req interface{}) *ACLTemplateAPI_Create_Call {
+	return &ACLTemplateAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *ACLTemplateAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.ACLTemplateRequest)) *ACLTemplateAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.ACLTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *ACLTemplateAPI_Create_Call) Return(_a0 *truenas.ACLTemplate, _a1 error) *ACLTemplateAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ACLTemplateAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.ACLTemplateRequest) (*truenas.ACLTemplate, error)) *ACLTemplateAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *ACLTemplateAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ACLTemplateAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ACLTemplateAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *ACLTemplateAPI_Expecter) Delete(ctx interface{}, id interface{}) *ACLTemplateAPI_Delete_Call {
+	return &ACLTemplateAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *ACLTemplateAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *ACLTemplateAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ACLTemplateAPI_Delete_Call) Return(_a0 error) *ACLTemplateAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ACLTemplateAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *ACLTemplateAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ACLTemplateAPI) Get(ctx context.Context, id int) (*truenas.ACLTemplate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.ACLTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.ACLTemplate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.ACLTemplate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ACLTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ACLTemplateAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ACLTemplateAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *ACLTemplateAPI_Expecter) Get(ctx interface{}, id interface{}) *ACLTemplateAPI_Get_Call {
+	return &ACLTemplateAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *ACLTemplateAPI_Get_Call) Run(run func(ctx context.Context, id int)) *ACLTemplateAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ACLTemplateAPI_Get_Call) Return(_a0 *truenas.ACLTemplate, _a1 error) *ACLTemplateAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ACLTemplateAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.ACLTemplate, error)) *ACLTemplateAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *ACLTemplateAPI) List(ctx context.Context) ([]truenas.ACLTemplate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.ACLTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.ACLTemplate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.ACLTemplate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ACLTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ACLTemplateAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ACLTemplateAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ACLTemplateAPI_Expecter) List(ctx interface{}) *ACLTemplateAPI_List_Call {
+	return &ACLTemplateAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *ACLTemplateAPI_List_Call) Run(run func(ctx context.Context)) *ACLTemplateAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ACLTemplateAPI_List_Call) Return(_a0 []truenas.ACLTemplate, _a1 error) *ACLTemplateAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ACLTemplateAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.ACLTemplate, error)) *ACLTemplateAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *ACLTemplateAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.ACLTemplate, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.ACLTemplate, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.ACLTemplate, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.ACLTemplate, error])
+		}
+	}
+
+	return r0
+}
+
+// ACLTemplateAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type ACLTemplateAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *ACLTemplateAPI_Expecter) ListIter(ctx interface{}, q interface{}) *ACLTemplateAPI_ListIter_Call {
+	return &ACLTemplateAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *ACLTemplateAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *ACLTemplateAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *ACLTemplateAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.ACLTemplate, error]) *ACLTemplateAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ACLTemplateAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.ACLTemplate, error]) *ACLTemplateAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *ACLTemplateAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.ACLTemplate, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.ACLTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.ACLTemplate, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.ACLTemplate); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ACLTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ACLTemplateAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type ACLTemplateAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *ACLTemplateAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *ACLTemplateAPI_ListWithQuery_Call {
+	return &ACLTemplateAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *ACLTemplateAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *ACLTemplateAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *ACLTemplateAPI_ListWithQuery_Call) Return(_a0 []truenas.ACLTemplate, _a1 error) *ACLTemplateAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ACLTemplateAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.ACLTemplate, error)) *ACLTemplateAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *ACLTemplateAPI) Update(ctx context.Context, id int, req *truenas.ACLTemplateRequest) (*truenas.ACLTemplate, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.ACLTemplate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.ACLTemplateRequest) (*truenas.ACLTemplate, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.ACLTemplateRequest) *truenas.ACLTemplate); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ACLTemplate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.ACLTemplateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ACLTemplateAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ACLTemplateAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.ACLTemplateRequest
+func (_e *ACLTemplateAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *ACLTemplateAPI_Update_Call {
+	return &ACLTemplateAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *ACLTemplateAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.ACLTemplateRequest)) *ACLTemplateAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.ACLTemplateRequest))
+	})
+	return _c
+}
+
+func (_c *ACLTemplateAPI_Update_Call) Return(_a0 *truenas.ACLTemplate, _a1 error) *ACLTemplateAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ACLTemplateAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.ACLTemplateRequest) (*truenas.ACLTemplate, error)) *ACLTemplateAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewACLTemplateAPI creates a new instance of ACLTemplateAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewACLTemplateAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ACLTemplateAPI {
+	mock := &ACLTemplateAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
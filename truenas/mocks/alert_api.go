@@ -0,0 +1,422 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AlertAPI is an autogenerated mock type for the AlertAPI type
+type AlertAPI struct {
+	mock.Mock
+}
+
+type AlertAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AlertAPI) EXPECT() *AlertAPI_Expecter {
+	return &AlertAPI_Expecter{mock: &_m.Mock}
+}
+
+// Dismiss provides a mock function with given fields: ctx, uuid
+func (_m *AlertAPI) Dismiss(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dismiss")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlertAPI_Dismiss_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dismiss'
+type AlertAPI_Dismiss_Call struct {
+	*mock.Call
+}
+
+// Dismiss is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *AlertAPI_Expecter) Dismiss(ctx interface{}, uuid interface{}) *AlertAPI_Dismiss_Call {
+	return &AlertAPI_Dismiss_Call{Call: _e.mock.On("Dismiss", ctx, uuid)}
+}
+
+func (_c *AlertAPI_Dismiss_Call) Run(run func(ctx context.Context, uuid string)) *AlertAPI_Dismiss_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AlertAPI_Dismiss_Call) Return(_a0 error) *AlertAPI_Dismiss_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlertAPI_Dismiss_Call) RunAndReturn(run func(context.Context, string) error) *AlertAPI_Dismiss_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAlertClassesConfig provides a mock function with given fields: ctx
+func (_m *AlertAPI) GetAlertClassesConfig(ctx context.Context) (map[string]any, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAlertClassesConfig")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]any, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]any); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertAPI_GetAlertClassesConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAlertClassesConfig'
+type AlertAPI_GetAlertClassesConfig_Call struct {
+	*mock.Call
+}
+
+// GetAlertClassesConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AlertAPI_Expecter) GetAlertClassesConfig(ctx interface{}) *AlertAPI_GetAlertClassesConfig_Call {
+	return &AlertAPI_GetAlertClassesConfig_Call{Call: _e.mock.On("GetAlertClassesConfig", ctx)}
+}
+
+func (_c *AlertAPI_GetAlertClassesConfig_Call) Run(run func(ctx context.Context)) *AlertAPI_GetAlertClassesConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AlertAPI_GetAlertClassesConfig_Call) Return(_a0 map[string]any, _a1 error) *AlertAPI_GetAlertClassesConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlertAPI_GetAlertClassesConfig_Call) RunAndReturn(run func(context.Context) (map[string]any, error)) *AlertAPI_GetAlertClassesConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *AlertAPI) List(ctx context.Context) ([]truenas.Alert, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Alert
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Alert, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Alert); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Alert)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type AlertAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AlertAPI_Expecter) List(ctx interface{}) *AlertAPI_List_Call {
+	return &AlertAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *AlertAPI_List_Call) Run(run func(ctx context.Context)) *AlertAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AlertAPI_List_Call) Return(_a0 []truenas.Alert, _a1 error) *AlertAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlertAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Alert, error)) *AlertAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx
+func (_m *AlertAPI) ListCategories(ctx context.Context) ([]truenas.AlertCategory, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []truenas.AlertCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.AlertCategory, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.AlertCategory); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.AlertCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertAPI_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type AlertAPI_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AlertAPI_Expecter) ListCategories(ctx interface{}) *AlertAPI_ListCategories_Call {
+	return &AlertAPI_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+}
+
+func (_c *AlertAPI_ListCategories_Call) Run(run func(ctx context.Context)) *AlertAPI_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AlertAPI_ListCategories_Call) Return(_a0 []truenas.AlertCategory, _a1 error) *AlertAPI_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlertAPI_ListCategories_Call) RunAndReturn(run func(context.Context) ([]truenas.AlertCategory, error)) *AlertAPI_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPolicies provides a mock function with given fields: ctx
+func (_m *AlertAPI) ListPolicies(ctx context.Context) ([]truenas.AlertPolicy, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPolicies")
+	}
+
+	var r0 []truenas.AlertPolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.AlertPolicy, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.AlertPolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.AlertPolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertAPI_ListPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPolicies'
+type AlertAPI_ListPolicies_Call struct {
+	*mock.Call
+}
+
+// ListPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AlertAPI_Expecter) ListPolicies(ctx interface{}) *AlertAPI_ListPolicies_Call {
+	return &AlertAPI_ListPolicies_Call{Call: _e.mock.On("ListPolicies", ctx)}
+}
+
+func (_c *AlertAPI_ListPolicies_Call) Run(run func(ctx context.Context)) *AlertAPI_ListPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AlertAPI_ListPolicies_Call) Return(_a0 []truenas.AlertPolicy, _a1 error) *AlertAPI_ListPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlertAPI_ListPolicies_Call) RunAndReturn(run func(context.Context) ([]truenas.AlertPolicy, error)) *AlertAPI_ListPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function with given fields: ctx, uuid
+func (_m *AlertAPI) Restore(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AlertAPI_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type AlertAPI_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *AlertAPI_Expecter) Restore(ctx interface{}, uuid interface{}) *AlertAPI_Restore_Call {
+	return &AlertAPI_Restore_Call{Call: _e.mock.On("Restore", ctx, uuid)}
+}
+
+func (_c *AlertAPI_Restore_Call) Run(run func(ctx context.Context, uuid string)) *AlertAPI_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AlertAPI_Restore_Call) Return(_a0 error) *AlertAPI_Restore_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AlertAPI_Restore_Call) RunAndReturn(run func(context.Context, string) error) *AlertAPI_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAlertClasses provides a mock function with given fields: ctx, req
+func (_m *AlertAPI) UpdateAlertClasses(ctx context.Context, req *truenas.AlertClassesUpdateRequest) (map[string]any, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAlertClasses")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AlertClassesUpdateRequest) (map[string]any, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AlertClassesUpdateRequest) map[string]any); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.AlertClassesUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AlertAPI_UpdateAlertClasses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAlertClasses'
+type AlertAPI_UpdateAlertClasses_Call struct {
+	*mock.Call
+}
+
+// UpdateAlertClasses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.AlertClassesUpdateRequest
+func (_e *AlertAPI_Expecter) UpdateAlertClasses(ctx interface{}, req interface{}) *AlertAPI_UpdateAlertClasses_Call {
+	return &AlertAPI_UpdateAlertClasses_Call{Call: _e.mock.On("UpdateAlertClasses", ctx, req)}
+}
+
+func (_c *AlertAPI_UpdateAlertClasses_Call) Run(run func(ctx context.Context, req *truenas.AlertClassesUpdateRequest)) *AlertAPI_UpdateAlertClasses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.AlertClassesUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *AlertAPI_UpdateAlertClasses_Call) Return(_a0 map[string]any, _a1 error) *AlertAPI_UpdateAlertClasses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AlertAPI_UpdateAlertClasses_Call) RunAndReturn(run func(context.Context, *truenas.AlertClassesUpdateRequest) (map[string]any, error)) *AlertAPI_UpdateAlertClasses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAlertAPI creates a new instance of AlertAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAlertAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AlertAPI {
+	mock := &AlertAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,608 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// APIKeyAPI is an autogenerated mock type for the APIKeyAPI type
+type APIKeyAPI struct {
+	mock.Mock
+}
+
+type APIKeyAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyAPI) EXPECT() *APIKeyAPI_Expecter {
+	return &APIKeyAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, name
+func (_m *APIKeyAPI) Create(ctx context.Context, name string) (*truenas.APIKey, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.APIKey, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.APIKey); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type APIKeyAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *APIKeyAPI_Expecter) Create(ctx interface{}, name interface{}) *APIKeyAPI_Create_Call {
+	return &APIKeyAPI_Create_Call{Call: _e.mock.On("Create", ctx, name)}
+}
+
+func (_c *APIKeyAPI_Create_Call) Run(run func(ctx context.Context, name string)) *APIKeyAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_Create_Call) Return(_a0 *truenas.APIKey, _a1 error) *APIKeyAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyAPI_Create_Call) RunAndReturn(run func(context.Context, string) (*truenas.APIKey, error)) *APIKeyAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateWithRequest provides a mock function with given fields: ctx, req
+func (_m *APIKeyAPI) CreateWithRequest(ctx context.Context, req *truenas.APIKeyCreateRequest) (*truenas.APIKey, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWithRequest")
+	}
+
+	var r0 *truenas.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.APIKeyCreateRequest) (*truenas.APIKey, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.APIKeyCreateRequest) *truenas.APIKey); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.APIKeyCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyAPI_CreateWithRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWithRequest'
+type APIKeyAPI_CreateWithRequest_Call struct {
+	*mock.Call
+}
+
+// CreateWithRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.APIKeyCreateRequest
+func (_e *APIKeyAPI_Expecter) CreateWithRequest(ctx interface{}, req interface{}) *APIKeyAPI_CreateWithRequest_Call {
+	return &APIKeyAPI_CreateWithRequest_Call{Call: _e.mock.On("CreateWithRequest", ctx, req)}
+}
+
+func (_c *APIKeyAPI_CreateWithRequest_Call) Run(run func(ctx context.Context, req *truenas.APIKeyCreateRequest)) *APIKeyAPI_CreateWithRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.APIKeyCreateRequest))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_CreateWithRequest_Call) Return(_a0 *truenas.APIKey, _a1 error) *APIKeyAPI_CreateWithRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyAPI_CreateWithRequest_Call) RunAndReturn(run func(context.Context, *truenas.APIKeyCreateRequest) (*truenas.APIKey, error)) *APIKeyAPI_CreateWithRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *APIKeyAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// APIKeyAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type APIKeyAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *APIKeyAPI_Expecter) Delete(ctx interface{}, id interface{}) *APIKeyAPI_Delete_Call {
+	return &APIKeyAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *APIKeyAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *APIKeyAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_Delete_Call) Return(_a0 error) *APIKeyAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *APIKeyAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *APIKeyAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *APIKeyAPI) Get(ctx context.Context, id int) (*truenas.APIKey, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.APIKey, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.APIKey); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type APIKeyAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *APIKeyAPI_Expecter) Get(ctx interface{}, id interface{}) *APIKeyAPI_Get_Call {
+	return &APIKeyAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *APIKeyAPI_Get_Call) Run(run func(ctx context.Context, id int)) *APIKeyAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_Get_Call) Return(_a0 *truenas.APIKey, _a1 error) *APIKeyAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.APIKey, error)) *APIKeyAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *APIKeyAPI) List(ctx context.Context) ([]truenas.APIKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.APIKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.APIKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type APIKeyAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *APIKeyAPI_Expecter) List(ctx interface{}) *APIKeyAPI_List_Call {
+	return &APIKeyAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *APIKeyAPI_List_Call) Run(run func(ctx context.Context)) *APIKeyAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_List_Call) Return(_a0 []truenas.APIKey, _a1 error) *APIKeyAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.APIKey, error)) *APIKeyAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *APIKeyAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.APIKey, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.APIKey, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.APIKey, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.APIKey, error])
+		}
+	}
+
+	return r0
+}
+
+// APIKeyAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type APIKeyAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *APIKeyAPI_Expecter) ListIter(ctx interface{}, q interface{}) *APIKeyAPI_ListIter_Call {
+	return &APIKeyAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *APIKeyAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *APIKeyAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.APIKey, error]) *APIKeyAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *APIKeyAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.APIKey, error]) *APIKeyAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *APIKeyAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.APIKey, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.APIKey, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.APIKey); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type APIKeyAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *APIKeyAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *APIKeyAPI_ListWithQuery_Call {
+	return &APIKeyAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *APIKeyAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *APIKeyAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_ListWithQuery_Call) Return(_a0 []truenas.APIKey, _a1 error) *APIKeyAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.APIKey, error)) *APIKeyAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reset provides a mock function with given fields: ctx, id
+func (_m *APIKeyAPI) Reset(ctx context.Context, id int) (*truenas.APIKey, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 *truenas.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.APIKey, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.APIKey); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyAPI_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type APIKeyAPI_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *APIKeyAPI_Expecter) Reset(ctx interface{}, id interface{}) *APIKeyAPI_Reset_Call {
+	return &APIKeyAPI_Reset_Call{Call: _e.mock.On("Reset", ctx, id)}
+}
+
+func (_c *APIKeyAPI_Reset_Call) Run(run func(ctx context.Context, id int)) *APIKeyAPI_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_Reset_Call) Return(_a0 *truenas.APIKey, _a1 error) *APIKeyAPI_Reset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyAPI_Reset_Call) RunAndReturn(run func(context.Context, int) (*truenas.APIKey, error)) *APIKeyAPI_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *APIKeyAPI) Update(ctx context.Context, id int, req *truenas.APIKeyUpdateRequest) (*truenas.APIKey, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.APIKeyUpdateRequest) (*truenas.APIKey, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.APIKeyUpdateRequest) *truenas.APIKey); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.APIKeyUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type APIKeyAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.APIKeyUpdateRequest
+func (_e *APIKeyAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *APIKeyAPI_Update_Call {
+	return &APIKeyAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *APIKeyAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.APIKeyUpdateRequest)) *APIKeyAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.APIKeyUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_Update_Call) Return(_a0 *truenas.APIKey, _a1 error) *APIKeyAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.APIKeyUpdateRequest) (*truenas.APIKey, error)) *APIKeyAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateName provides a mock function with given fields: ctx, id, name
+func (_m *APIKeyAPI) UpdateName(ctx context.Context, id int, name string) (*truenas.APIKey, error) {
+	ret := _m.Called(ctx, id, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateName")
+	}
+
+	var r0 *truenas.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*truenas.APIKey, error)); ok {
+		return rf(ctx, id, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *truenas.APIKey); ok {
+		r0 = rf(ctx, id, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyAPI_UpdateName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateName'
+type APIKeyAPI_UpdateName_Call struct {
+	*mock.Call
+}
+
+// UpdateName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - name string
+func (_e *APIKeyAPI_Expecter) UpdateName(ctx interface{}, id interface{}, name interface{}) *APIKeyAPI_UpdateName_Call {
+	return &APIKeyAPI_UpdateName_Call{Call: _e.mock.On("UpdateName", ctx, id, name)}
+}
+
+func (_c *APIKeyAPI_UpdateName_Call) Run(run func(ctx context.Context, id int, name string)) *APIKeyAPI_UpdateName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyAPI_UpdateName_Call) Return(_a0 *truenas.APIKey, _a1 error) *APIKeyAPI_UpdateName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyAPI_UpdateName_Call) RunAndReturn(run func(context.Context, int, string) (*truenas.APIKey, error)) *APIKeyAPI_UpdateName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPIKeyAPI creates a new instance of APIKeyAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyAPI {
+	mock := &APIKeyAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1173 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// AppAPI is an autogenerated mock type for the AppAPI type
+type AppAPI struct {
+	mock.Mock
+}
+
+type AppAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AppAPI) EXPECT() *AppAPI_Expecter {
+	return &AppAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *AppAPI) Create(ctx context.Context, req *truenas.AppCreateRequest) (*truenas.App, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AppCreateRequest) (*truenas.App, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AppCreateRequest) *truenas.App); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.AppCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type AppAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.AppCreateRequest
+func (_e *AppAPI_Expecter) Create(ctx interface{}, req interface{}) *AppAPI_Create_Call {
+	return &AppAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *AppAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.AppCreateRequest)) *AppAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.AppCreateRequest))
+	})
+	return _c
+}
+
+func (_c *AppAPI_Create_Call) Return(_a0 *truenas.App, _a1 error) *AppAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.AppCreateRequest) (*truenas.App, error)) *AppAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, name, options
+func (_m *AppAPI) Delete(ctx context.Context, name string, options *truenas.AppDeleteOptions) error {
+	ret := _m.Called(ctx, name, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.AppDeleteOptions) error); ok {
+		r0 = rf(ctx, name, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AppAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type AppAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - options *truenas.AppDeleteOptions
+func (_e *AppAPI_Expecter) Delete(ctx interface{}, name interface{}, options interface{}) *AppAPI_Delete_Call {
+	return &AppAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, name, options)}
+}
+
+func (_c *AppAPI_Delete_Call) Run(run func(ctx context.Context, name string, options *truenas.AppDeleteOptions)) *AppAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.AppDeleteOptions))
+	})
+	return _c
+}
+
+func (_c *AppAPI_Delete_Call) Return(_a0 error) *AppAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AppAPI_Delete_Call) RunAndReturn(run func(context.Context, string, *truenas.AppDeleteOptions) error) *AppAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, name, extra
+func (_m *AppAPI) Get(ctx context.Context, name string, extra map[string]any) (*truenas.App, error) {
+	ret := _m.Called(ctx, name, extra)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]any) (*truenas.App, error)); ok {
+		return rf(ctx, name, extra)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]any) *truenas.App); ok {
+		r0 = rf(ctx, name, extra)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]any) error); ok {
+		r1 = rf(ctx, name, extra)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type AppAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - extra map[string]any
+func (_e *AppAPI_Expecter) Get(ctx interface{}, name interface{}, extra interface{}) *AppAPI_Get_Call {
+	return &AppAPI_Get_Call{Call: _e.mock.On("Get", ctx, name, extra)}
+}
+
+func (_c *AppAPI_Get_Call) Run(run func(ctx context.Context, name string, extra map[string]any)) *AppAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *AppAPI_Get_Call) Return(_a0 *truenas.App, _a1 error) *AppAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_Get_Call) RunAndReturn(run func(context.Context, string, map[string]any) (*truenas.App, error)) *AppAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *AppAPI) GetByID(ctx context.Context, id string) (*truenas.App, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.App, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.App); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_GetByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByID'
+type AppAPI_GetByID_Call struct {
+	*mock.Call
+}
+
+// GetByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *AppAPI_Expecter) GetByID(ctx interface{}, id interface{}) *AppAPI_GetByID_Call {
+	return &AppAPI_GetByID_Call{Call: _e.mock.On("GetByID", ctx, id)}
+}
+
+func (_c *AppAPI_GetByID_Call) Run(run func(ctx context.Context, id string)) *AppAPI_GetByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AppAPI_GetByID_Call) Return(_a0 *truenas.App, _a1 error) *AppAPI_GetByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_GetByID_Call) RunAndReturn(run func(context.Context, string) (*truenas.App, error)) *AppAPI_GetByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *AppAPI) List(ctx context.Context) ([]truenas.App, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.App, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.App); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type AppAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AppAPI_Expecter) List(ctx interface{}) *AppAPI_List_Call {
+	return &AppAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *AppAPI_List_Call) Run(run func(ctx context.Context)) *AppAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AppAPI_List_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.App, error)) *AppAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCrashed provides a mock function with given fields: ctx
+func (_m *AppAPI) ListCrashed(ctx context.Context) ([]truenas.App, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCrashed")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.App, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.App); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_ListCrashed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCrashed'
+type AppAPI_ListCrashed_Call struct {
+	*mock.Call
+}
+
+// ListCrashed is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AppAPI_Expecter) ListCrashed(ctx interface{}) *AppAPI_ListCrashed_Call {
+	return &AppAPI_ListCrashed_Call{Call: _e.mock.On("ListCrashed", ctx)}
+}
+
+func (_c *AppAPI_ListCrashed_Call) Run(run func(ctx context.Context)) *AppAPI_ListCrashed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AppAPI_ListCrashed_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_ListCrashed_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_ListCrashed_Call) RunAndReturn(run func(context.Context) ([]truenas.App, error)) *AppAPI_ListCrashed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDeploying provides a mock function with given fields: ctx
+func (_m *AppAPI) ListDeploying(ctx context.Context) ([]truenas.App, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeploying")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.App, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.App); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_ListDeploying_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDeploying'
+type AppAPI_ListDeploying_Call struct {
+	*mock.Call
+}
+
+// ListDeploying is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AppAPI_Expecter) ListDeploying(ctx interface{}) *AppAPI_ListDeploying_Call {
+	return &AppAPI_ListDeploying_Call{Call: _e.mock.On("ListDeploying", ctx)}
+}
+
+func (_c *AppAPI_ListDeploying_Call) Run(run func(ctx context.Context)) *AppAPI_ListDeploying_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AppAPI_ListDeploying_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_ListDeploying_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_ListDeploying_Call) RunAndReturn(run func(context.Context) ([]truenas.App, error)) *AppAPI_ListDeploying_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *AppAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.App, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.App, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.App, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.App, error])
+		}
+	}
+
+	return r0
+}
+
+// AppAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type AppAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *AppAPI_Expecter) ListIter(ctx interface{}, q interface{}) *AppAPI_ListIter_Call {
+	return &AppAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *AppAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *AppAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *AppAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.App, error]) *AppAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AppAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.App, error]) *AppAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRunning provides a mock function with given fields: ctx
+func (_m *AppAPI) ListRunning(ctx context.Context) ([]truenas.App, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRunning")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.App, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.App); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_ListRunning_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRunning'
+type AppAPI_ListRunning_Call struct {
+	*mock.Call
+}
+
+// ListRunning is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AppAPI_Expecter) ListRunning(ctx interface{}) *AppAPI_ListRunning_Call {
+	return &AppAPI_ListRunning_Call{Call: _e.mock.On("ListRunning", ctx)}
+}
+
+func (_c *AppAPI_ListRunning_Call) Run(run func(ctx context.Context)) *AppAPI_ListRunning_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AppAPI_ListRunning_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_ListRunning_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_ListRunning_Call) RunAndReturn(run func(context.Context) ([]truenas.App, error)) *AppAPI_ListRunning_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStopped provides a mock function with given fields: ctx
+func (_m *AppAPI) ListStopped(ctx context.Context) ([]truenas.App, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStopped")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.App, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.App); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_ListStopped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStopped'
+type AppAPI_ListStopped_Call struct {
+	*mock.Call
+}
+
+// ListStopped is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AppAPI_Expecter) ListStopped(ctx interface{}) *AppAPI_ListStopped_Call {
+	return &AppAPI_ListStopped_Call{Call: _e.mock.On("ListStopped", ctx)}
+}
+
+func (_c *AppAPI_ListStopped_Call) Run(run func(ctx context.Context)) *AppAPI_ListStopped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AppAPI_ListStopped_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_ListStopped_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_ListStopped_Call) RunAndReturn(run func(context.Context) ([]truenas.App, error)) *AppAPI_ListStopped_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithOptions provides a mock function with given fields: ctx, options
+func (_m *AppAPI) ListWithOptions(ctx context.Context, options *truenas.AppQueryOptions) ([]truenas.App, error) {
+	ret := _m.Called(ctx, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithOptions")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AppQueryOptions) ([]truenas.App, error)); ok {
+		return rf(ctx, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AppQueryOptions) []truenas.App); ok {
+		r0 = rf(ctx, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.AppQueryOptions) error); ok {
+		r1 = rf(ctx, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_ListWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithOptions'
+type AppAPI_ListWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options *truenas.AppQueryOptions
+func (_e *AppAPI_Expecter) ListWithOptions(ctx interface{}, options interface{}) *AppAPI_ListWithOptions_Call {
+	return &AppAPI_ListWithOptions_Call{Call: _e.mock.On("ListWithOptions", ctx, options)}
+}
+
+func (_c *AppAPI_ListWithOptions_Call) Run(run func(ctx context.Context, options *truenas.AppQueryOptions)) *AppAPI_ListWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.AppQueryOptions))
+	})
+	return _c
+}
+
+func (_c *AppAPI_ListWithOptions_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_ListWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_ListWithOptions_Call) RunAndReturn(run func(context.Context, *truenas.AppQueryOptions) ([]truenas.App, error)) *AppAPI_ListWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *AppAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.App, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.App, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.App); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type AppAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *AppAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *AppAPI_ListWithQuery_Call {
+	return &AppAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *AppAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *AppAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *AppAPI_ListWithQuery_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.App, error)) *AppAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logs provides a mock function with given fields: ctx, name, containerID, follow
+func (_m *AppAPI) Logs(ctx context.Context, name string, containerID string, follow bool) (<-chan truenas.AppLogLine, error) {
+	ret := _m.Called(ctx, name, containerID, follow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logs")
+	}
+
+	var r0 <-chan truenas.AppLogLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) (<-chan truenas.AppLogLine, error)); ok {
+		return rf(ctx, name, containerID, follow)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) <-chan truenas.AppLogLine); ok {
+		r0 = rf(ctx, name, containerID, follow)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan truenas.AppLogLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = rf(ctx, name, containerID, follow)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_Logs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logs'
+type AppAPI_Logs_Call struct {
+	*mock.Call
+}
+
+// Logs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - containerID string
+//   - follow bool
+func (_e *AppAPI_Expecter) Logs(ctx interface{}, name interface{}, containerID interface{}, follow interface{}) *AppAPI_Logs_Call {
+	return &AppAPI_Logs_Call{Call: _e.mock.On("Logs", ctx, name, containerID, follow)}
+}
+
+func (_c *AppAPI_Logs_Call) Run(run func(ctx context.Context, name string, containerID string, follow bool)) *AppAPI_Logs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *AppAPI_Logs_Call) Return(_a0 <-chan truenas.AppLogLine, _a1 error) *AppAPI_Logs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_Logs_Call) RunAndReturn(run func(context.Context, string, string, bool) (<-chan truenas.AppLogLine, error)) *AppAPI_Logs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PodChoices provides a mock function with given fields: ctx, name
+func (_m *AppAPI) PodChoices(ctx context.Context, name string) (map[string]truenas.AppContainerID, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PodChoices")
+	}
+
+	var r0 map[string]truenas.AppContainerID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]truenas.AppContainerID, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]truenas.AppContainerID); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]truenas.AppContainerID)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_PodChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PodChoices'
+type AppAPI_PodChoices_Call struct {
+	*mock.Call
+}
+
+// PodChoices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *AppAPI_Expecter) PodChoices(ctx interface{}, name interface{}) *AppAPI_PodChoices_Call {
+	return &AppAPI_PodChoices_Call{Call: _e.mock.On("PodChoices", ctx, name)}
+}
+
+func (_c *AppAPI_PodChoices_Call) Run(run func(ctx context.Context, name string)) *AppAPI_PodChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AppAPI_PodChoices_Call) Return(_a0 map[string]truenas.AppContainerID, _a1 error) *AppAPI_PodChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_PodChoices_Call) RunAndReturn(run func(context.Context, string) (map[string]truenas.AppContainerID, error)) *AppAPI_PodChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryByCatalog provides a mock function with given fields: ctx, catalog
+func (_m *AppAPI) QueryByCatalog(ctx context.Context, catalog string) ([]truenas.App, error) {
+	ret := _m.Called(ctx, catalog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryByCatalog")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]truenas.App, error)); ok {
+		return rf(ctx, catalog)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []truenas.App); ok {
+		r0 = rf(ctx, catalog)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, catalog)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_QueryByCatalog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryByCatalog'
+type AppAPI_QueryByCatalog_Call struct {
+	*mock.Call
+}
+
+// QueryByCatalog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - catalog string
+func (_e *AppAPI_Expecter) QueryByCatalog(ctx interface{}, catalog interface{}) *AppAPI_QueryByCatalog_Call {
+	return &AppAPI_QueryByCatalog_Call{Call: _e.mock.On("QueryByCatalog", ctx, catalog)}
+}
+
+func (_c *AppAPI_QueryByCatalog_Call) Run(run func(ctx context.Context, catalog string)) *AppAPI_QueryByCatalog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AppAPI_QueryByCatalog_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_QueryByCatalog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_QueryByCatalog_Call) RunAndReturn(run func(context.Context, string) ([]truenas.App, error)) *AppAPI_QueryByCatalog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryByState provides a mock function with given fields: ctx, state
+func (_m *AppAPI) QueryByState(ctx context.Context, state truenas.AppState) ([]truenas.App, error) {
+	ret := _m.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryByState")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.AppState) ([]truenas.App, error)); ok {
+		return rf(ctx, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.AppState) []truenas.App); ok {
+		r0 = rf(ctx, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.AppState) error); ok {
+		r1 = rf(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_QueryByState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryByState'
+type AppAPI_QueryByState_Call struct {
+	*mock.Call
+}
+
+// QueryByState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state truenas.AppState
+func (_e *AppAPI_Expecter) QueryByState(ctx interface{}, state interface{}) *AppAPI_QueryByState_Call {
+	return &AppAPI_QueryByState_Call{Call: _e.mock.On("QueryByState", ctx, state)}
+}
+
+func (_c *AppAPI_QueryByState_Call) Run(run func(ctx context.Context, state truenas.AppState)) *AppAPI_QueryByState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.AppState))
+	})
+	return _c
+}
+
+func (_c *AppAPI_QueryByState_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_QueryByState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_QueryByState_Call) RunAndReturn(run func(context.Context, truenas.AppState) ([]truenas.App, error)) *AppAPI_QueryByState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QueryWithFilters provides a mock function with given fields: ctx, filters, options
+func (_m *AppAPI) QueryWithFilters(ctx context.Context, filters [][]any, options *truenas.AppQueryOptions) ([]truenas.App, error) {
+	ret := _m.Called(ctx, filters, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryWithFilters")
+	}
+
+	var r0 []truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, [][]any, *truenas.AppQueryOptions) ([]truenas.App, error)); ok {
+		return rf(ctx, filters, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, [][]any, *truenas.AppQueryOptions) []truenas.App); ok {
+		r0 = rf(ctx, filters, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, [][]any, *truenas.AppQueryOptions) error); ok {
+		r1 = rf(ctx, filters, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_QueryWithFilters_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryWithFilters'
+type AppAPI_QueryWithFilters_Call struct {
+	*mock.Call
+}
+
+// QueryWithFilters is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters [][]any
+//   - options *truenas.AppQueryOptions
+func (_e *AppAPI_Expecter) QueryWithFilters(ctx interface{}, filters interface{}, options interface{}) *AppAPI_QueryWithFilters_Call {
+	return &AppAPI_QueryWithFilters_Call{Call: _e.mock.On("QueryWithFilters", ctx, filters, options)}
+}
+
+func (_c *AppAPI_QueryWithFilters_Call) Run(run func(ctx context.Context, filters [][]any, options *truenas.AppQueryOptions)) *AppAPI_QueryWithFilters_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([][]any), args[2].(*truenas.AppQueryOptions))
+	})
+	return _c
+}
+
+func (_c *AppAPI_QueryWithFilters_Call) Return(_a0 []truenas.App, _a1 error) *AppAPI_QueryWithFilters_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_QueryWithFilters_Call) RunAndReturn(run func(context.Context, [][]any, *truenas.AppQueryOptions) ([]truenas.App, error)) *AppAPI_QueryWithFilters_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubscribeStats provides a mock function with given fields: ctx, fn
+func (_m *AppAPI) SubscribeStats(ctx context.Context, fn func([]truenas.AppStats) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func([]truenas.AppStats) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AppAPI_SubscribeStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStats'
+type AppAPI_SubscribeStats_Call struct {
+	*mock.Call
+}
+
+// SubscribeStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func([]truenas.AppStats) error
+func (_e *AppAPI_Expecter) SubscribeStats(ctx interface{}, fn interface{}) *AppAPI_SubscribeStats_Call {
+	return &AppAPI_SubscribeStats_Call{Call: _e.mock.On("SubscribeStats", ctx, fn)}
+}
+
+func (_c *AppAPI_SubscribeStats_Call) Run(run func(ctx context.Context, fn func([]truenas.AppStats) error)) *AppAPI_SubscribeStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func([]truenas.AppStats) error))
+	})
+	return _c
+}
+
+func (_c *AppAPI_SubscribeStats_Call) Return(_a0 error) *AppAPI_SubscribeStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AppAPI_SubscribeStats_Call) RunAndReturn(run func(context.Context, func([]truenas.AppStats) error) error) *AppAPI_SubscribeStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnsubscribeStats provides a mock function with given fields: ctx
+func (_m *AppAPI) UnsubscribeStats(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnsubscribeStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AppAPI_UnsubscribeStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsubscribeStats'
+type AppAPI_UnsubscribeStats_Call struct {
+	*mock.Call
+}
+
+// UnsubscribeStats is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AppAPI_Expecter) UnsubscribeStats(ctx interface{}) *AppAPI_UnsubscribeStats_Call {
+	return &AppAPI_UnsubscribeStats_Call{Call: _e.mock.On("UnsubscribeStats", ctx)}
+}
+
+func (_c *AppAPI_UnsubscribeStats_Call) Run(run func(ctx context.Context)) *AppAPI_UnsubscribeStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AppAPI_UnsubscribeStats_Call) Return(_a0 error) *AppAPI_UnsubscribeStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AppAPI_UnsubscribeStats_Call) RunAndReturn(run func(context.Context) error) *AppAPI_UnsubscribeStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upgrade provides a mock function with given fields: ctx, name, options
+func (_m *AppAPI) Upgrade(ctx context.Context, name string, options *truenas.AppUpgradeOptions) (*truenas.App, error) {
+	ret := _m.Called(ctx, name, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upgrade")
+	}
+
+	var r0 *truenas.App
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.AppUpgradeOptions) (*truenas.App, error)); ok {
+		return rf(ctx, name, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.AppUpgradeOptions) *truenas.App); ok {
+		r0 = rf(ctx, name, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.App)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.AppUpgradeOptions) error); ok {
+		r1 = rf(ctx, name, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppAPI_Upgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upgrade'
+type AppAPI_Upgrade_Call struct {
+	*mock.Call
+}
+
+// Upgrade is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - options *truenas.AppUpgradeOptions
+func (_e *AppAPI_Expecter) Upgrade(ctx interface{}, name interface{}, options interface{}) *AppAPI_Upgrade_Call {
+	return &AppAPI_Upgrade_Call{Call: _e.mock.On("Upgrade", ctx, name, options)}
+}
+
+func (_c *AppAPI_Upgrade_Call) Run(run func(ctx context.Context, name string, options *truenas.AppUpgradeOptions)) *AppAPI_Upgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.AppUpgradeOptions))
+	})
+	return _c
+}
+
+func (_c *AppAPI_Upgrade_Call) Return(_a0 *truenas.App, _a1 error) *AppAPI_Upgrade_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AppAPI_Upgrade_Call) RunAndReturn(run func(context.Context, string, *truenas.AppUpgradeOptions) (*truenas.App, error)) *AppAPI_Upgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAppAPI creates a new instance of AppAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAppAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AppAPI {
+	mock := &AppAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,264 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// AuditAPI is an autogenerated mock type for the AuditAPI type
+type AuditAPI struct {
+	mock.Mock
+}
+
+type AuditAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuditAPI) EXPECT() *AuditAPI_Expecter {
+	return &AuditAPI_Expecter{mock: &_m.Mock}
+}
+
+// Export provides a mock function with given fields: ctx, w, filter, format
+func (_m *AuditAPI) Export(ctx context.Context, w io.Writer, filter *truenas.AuditFilter, format truenas.AuditExportFormat) error {
+	ret := _m.Called(ctx, w, filter, format)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Writer, *truenas.AuditFilter, truenas.AuditExportFormat) error); ok {
+		r0 = rf(ctx, w, filter, format)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuditAPI_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type AuditAPI_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - w io.Writer
+//   - filter *truenas.AuditFilter
+//   - format truenas.AuditExportFormat
+func (_e *AuditAPI_Expecter) Export(ctx interface{}, w interface{}, filter interface{}, format interface{}) *AuditAPI_Export_Call {
+	return &AuditAPI_Export_Call{Call: _e.mock.On("Export", ctx, w, filter, format)}
+}
+
+func (_c *AuditAPI_Export_Call) Run(run func(ctx context.Context, w io.Writer, filter *truenas.AuditFilter, format truenas.AuditExportFormat)) *AuditAPI_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Writer), args[2].(*truenas.AuditFilter), args[3].(truenas.AuditExportFormat))
+	})
+	return _c
+}
+
+func (_c *AuditAPI_Export_Call) Return(_a0 error) *AuditAPI_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuditAPI_Export_Call) RunAndReturn(run func(context.Context, io.Writer, *truenas.AuditFilter, truenas.AuditExportFormat) error) *AuditAPI_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *AuditAPI) GetConfig(ctx context.Context) (*truenas.AuditConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.AuditConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.AuditConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.AuditConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.AuditConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type AuditAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuditAPI_Expecter) GetConfig(ctx interface{}) *AuditAPI_GetConfig_Call {
+	return &AuditAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *AuditAPI_GetConfig_Call) Run(run func(ctx context.Context)) *AuditAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuditAPI_GetConfig_Call) Return(_a0 *truenas.AuditConfig, _a1 error) *AuditAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.AuditConfig, error)) *AuditAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: ctx, filter
+func (_m *AuditAPI) Query(ctx context.Context, filter *truenas.AuditFilter) ([]truenas.AuditEntry, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Query")
+	}
+
+	var r0 []truenas.AuditEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AuditFilter) ([]truenas.AuditEntry, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AuditFilter) []truenas.AuditEntry); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.AuditEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.AuditFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditAPI_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type AuditAPI_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter *truenas.AuditFilter
+func (_e *AuditAPI_Expecter) Query(ctx interface{}, filter interface{}) *AuditAPI_Query_Call {
+	return &AuditAPI_Query_Call{Call: _e.mock.On("Query", ctx, filter)}
+}
+
+func (_c *AuditAPI_Query_Call) Run(run func(ctx context.Context, filter *truenas.AuditFilter)) *AuditAPI_Query_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.AuditFilter))
+	})
+	return _c
+}
+
+func (_c *AuditAPI_Query_Call) Return(_a0 []truenas.AuditEntry, _a1 error) *AuditAPI_Query_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditAPI_Query_Call) RunAndReturn(run func(context.Context, *truenas.AuditFilter) ([]truenas.AuditEntry, error)) *AuditAPI_Query_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, req
+func (_m *AuditAPI) UpdateConfig(ctx context.Context, req *truenas.AuditConfigUpdateRequest) (*truenas.AuditConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.AuditConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AuditConfigUpdateRequest) (*truenas.AuditConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AuditConfigUpdateRequest) *truenas.AuditConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.AuditConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.AuditConfigUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuditAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type AuditAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.AuditConfigUpdateRequest
+func (_e *AuditAPI_Expecter) UpdateConfig(ctx interface{}, req interface{}) *AuditAPI_UpdateConfig_Call {
+	return &AuditAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, req)}
+}
+
+func (_c *AuditAPI_UpdateConfig_Call) Run(run func(ctx context.Context, req *truenas.AuditConfigUpdateRequest)) *AuditAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.AuditConfigUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *AuditAPI_UpdateConfig_Call) Return(_a0 *truenas.AuditConfig, _a1 error) *AuditAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuditAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.AuditConfigUpdateRequest) (*truenas.AuditConfig, error)) *AuditAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuditAPI creates a new instance of AuditAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuditAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuditAPI {
+	mock := &AuditAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,372 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AuthAPI is an autogenerated mock type for the AuthAPI type
+type AuthAPI struct {
+	mock.Mock
+}
+
+type AuthAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AuthAPI) EXPECT() *AuthAPI_Expecter {
+	return &AuthAPI_Expecter{mock: &_m.Mock}
+}
+
+// CheckPassword provides a mock function with given fields: ctx, username, password
+func (_m *AuthAPI) CheckPassword(ctx context.Context, username string, password string) (bool, error) {
+	ret := _m.Called(ctx, username, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPassword")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, username, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, username, password)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthAPI_CheckPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPassword'
+type AuthAPI_CheckPassword_Call struct {
+	*mock.Call
+}
+
+// CheckPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - password string
+func (_e *AuthAPI_Expecter) CheckPassword(ctx interface{}, username interface{}, password interface{}) *AuthAPI_CheckPassword_Call {
+	return &AuthAPI_CheckPassword_Call{Call: _e.mock.On("CheckPassword", ctx, username, password)}
+}
+
+func (_c *AuthAPI_CheckPassword_Call) Run(run func(ctx context.Context, username string, password string)) *AuthAPI_CheckPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthAPI_CheckPassword_Call) Return(_a0 bool, _a1 error) *AuthAPI_CheckPassword_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthAPI_CheckPassword_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *AuthAPI_CheckPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateToken provides a mock function with given fields: ctx, req
+func (_m *AuthAPI) GenerateToken(ctx context.Context, req truenas.GenerateTokenRequest) (*truenas.TokenResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateToken")
+	}
+
+	var r0 *truenas.TokenResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.GenerateTokenRequest) (*truenas.TokenResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.GenerateTokenRequest) *truenas.TokenResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.TokenResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.GenerateTokenRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthAPI_GenerateToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateToken'
+type AuthAPI_GenerateToken_Call struct {
+	*mock.Call
+}
+
+// GenerateToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.GenerateTokenRequest
+func (_e *AuthAPI_Expecter) GenerateToken(ctx interface{}, req interface{}) *AuthAPI_GenerateToken_Call {
+	return &AuthAPI_GenerateToken_Call{Call: _e.mock.On("GenerateToken", ctx, req)}
+}
+
+func (_c *AuthAPI_GenerateToken_Call) Run(run func(ctx context.Context, req truenas.GenerateTokenRequest)) *AuthAPI_GenerateToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.GenerateTokenRequest))
+	})
+	return _c
+}
+
+func (_c *AuthAPI_GenerateToken_Call) Return(_a0 *truenas.TokenResponse, _a1 error) *AuthAPI_GenerateToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthAPI_GenerateToken_Call) RunAndReturn(run func(context.Context, truenas.GenerateTokenRequest) (*truenas.TokenResponse, error)) *AuthAPI_GenerateToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: ctx, username, password
+func (_m *AuthAPI) Login(ctx context.Context, username string, password string) (bool, error) {
+	ret := _m.Called(ctx, username, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, username, password)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, username, password)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, username, password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthAPI_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type AuthAPI_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+//   - password string
+func (_e *AuthAPI_Expecter) Login(ctx interface{}, username interface{}, password interface{}) *AuthAPI_Login_Call {
+	return &AuthAPI_Login_Call{Call: _e.mock.On("Login", ctx, username, password)}
+}
+
+func (_c *AuthAPI_Login_Call) Run(run func(ctx context.Context, username string, password string)) *AuthAPI_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AuthAPI_Login_Call) Return(_a0 bool, _a1 error) *AuthAPI_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthAPI_Login_Call) RunAndReturn(run func(context.Context, string, string) (bool, error)) *AuthAPI_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginWithAPIKey provides a mock function with given fields: ctx, apiKey
+func (_m *AuthAPI) LoginWithAPIKey(ctx context.Context, apiKey string) (bool, error) {
+	ret := _m.Called(ctx, apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginWithAPIKey")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, apiKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, apiKey)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, apiKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthAPI_LoginWithAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginWithAPIKey'
+type AuthAPI_LoginWithAPIKey_Call struct {
+	*mock.Call
+}
+
+// LoginWithAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKey string
+func (_e *AuthAPI_Expecter) LoginWithAPIKey(ctx interface{}, apiKey interface{}) *AuthAPI_LoginWithAPIKey_Call {
+	return &AuthAPI_LoginWithAPIKey_Call{Call: _e.mock.On("LoginWithAPIKey", ctx, apiKey)}
+}
+
+func (_c *AuthAPI_LoginWithAPIKey_Call) Run(run func(ctx context.Context, apiKey string)) *AuthAPI_LoginWithAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthAPI_LoginWithAPIKey_Call) Return(_a0 bool, _a1 error) *AuthAPI_LoginWithAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthAPI_LoginWithAPIKey_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *AuthAPI_LoginWithAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginWithToken provides a mock function with given fields: ctx, token
+func (_m *AuthAPI) LoginWithToken(ctx context.Context, token string) (bool, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginWithToken")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthAPI_LoginWithToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginWithToken'
+type AuthAPI_LoginWithToken_Call struct {
+	*mock.Call
+}
+
+// LoginWithToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *AuthAPI_Expecter) LoginWithToken(ctx interface{}, token interface{}) *AuthAPI_LoginWithToken_Call {
+	return &AuthAPI_LoginWithToken_Call{Call: _e.mock.On("LoginWithToken", ctx, token)}
+}
+
+func (_c *AuthAPI_LoginWithToken_Call) Run(run func(ctx context.Context, token string)) *AuthAPI_LoginWithToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AuthAPI_LoginWithToken_Call) Return(_a0 bool, _a1 error) *AuthAPI_LoginWithToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthAPI_LoginWithToken_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *AuthAPI_LoginWithToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx
+func (_m *AuthAPI) Logout(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Logout")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthAPI_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type AuthAPI_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthAPI_Expecter) Logout(ctx interface{}) *AuthAPI_Logout_Call {
+	return &AuthAPI_Logout_Call{Call: _e.mock.On("Logout", ctx)}
+}
+
+func (_c *AuthAPI_Logout_Call) Run(run func(ctx context.Context)) *AuthAPI_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthAPI_Logout_Call) Return(_a0 error) *AuthAPI_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthAPI_Logout_Call) RunAndReturn(run func(context.Context) error) *AuthAPI_Logout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAuthAPI creates a new instance of AuthAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AuthAPI {
+	mock := &AuthAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,445 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BootAPI is an autogenerated mock type for the BootAPI type
+type BootAPI struct {
+	mock.Mock
+}
+
+type BootAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BootAPI) EXPECT() *BootAPI_Expecter {
+	return &BootAPI_Expecter{mock: &_m.Mock}
+}
+
+// Attach provides a mock function with given fields: ctx, device, expand
+func (_m *BootAPI) Attach(ctx context.Context, device string, expand bool) error {
+	ret := _m.Called(ctx, device, expand)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, device, expand)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BootAPI_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type BootAPI_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//   - ctx context.Context
+//   - device string
+//   - expand bool
+func (_e *BootAPI_Expecter) Attach(ctx interface{}, device interface{}, expand interface{}) *BootAPI_Attach_Call {
+	return &BootAPI_Attach_Call{Call: _e.mock.On("Attach", ctx, device, expand)}
+}
+
+func (_c *BootAPI_Attach_Call) Run(run func(ctx context.Context, device string, expand bool)) *BootAPI_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *BootAPI_Attach_Call) Return(_a0 error) *BootAPI_Attach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BootAPI_Attach_Call) RunAndReturn(run func(context.Context, string, bool) error) *BootAPI_Attach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Detach provides a mock function with given fields: ctx, device
+func (_m *BootAPI) Detach(ctx context.Context, device string) error {
+	ret := _m.Called(ctx, device)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Detach")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, device)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BootAPI_Detach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detach'
+type BootAPI_Detach_Call struct {
+	*mock.Call
+}
+
+// Detach is a helper method to define mock.On call
+//   - ctx context.Context
+//   - device string
+func (_e *BootAPI_Expecter) Detach(ctx interface{}, device interface{}) *BootAPI_Detach_Call {
+	return &BootAPI_Detach_Call{Call: _e.mock.On("Detach", ctx, device)}
+}
+
+func (_c *BootAPI_Detach_Call) Run(run func(ctx context.Context, device string)) *BootAPI_Detach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BootAPI_Detach_Call) Return(_a0 error) *BootAPI_Detach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BootAPI_Detach_Call) RunAndReturn(run func(context.Context, string) error) *BootAPI_Detach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDisks provides a mock function with given fields: ctx
+func (_m *BootAPI) GetDisks(ctx context.Context) ([]truenas.BootDisk, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisks")
+	}
+
+	var r0 []truenas.BootDisk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.BootDisk, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.BootDisk); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.BootDisk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BootAPI_GetDisks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisks'
+type BootAPI_GetDisks_Call struct {
+	*mock.Call
+}
+
+// GetDisks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BootAPI_Expecter) GetDisks(ctx interface{}) *BootAPI_GetDisks_Call {
+	return &BootAPI_GetDisks_Call{Call: _e.mock.On("GetDisks", ctx)}
+}
+
+func (_c *BootAPI_GetDisks_Call) Run(run func(ctx context.Context)) *BootAPI_GetDisks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BootAPI_GetDisks_Call) Return(_a0 []truenas.BootDisk, _a1 error) *BootAPI_GetDisks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BootAPI_GetDisks_Call) RunAndReturn(run func(context.Context) ([]truenas.BootDisk, error)) *BootAPI_GetDisks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScrubInterval provides a mock function with given fields: ctx
+func (_m *BootAPI) GetScrubInterval(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScrubInterval")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BootAPI_GetScrubInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScrubInterval'
+type BootAPI_GetScrubInterval_Call struct {
+	*mock.Call
+}
+
+// GetScrubInterval is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BootAPI_Expecter) GetScrubInterval(ctx interface{}) *BootAPI_GetScrubInterval_Call {
+	return &BootAPI_GetScrubInterval_Call{Call: _e.mock.On("GetScrubInterval", ctx)}
+}
+
+func (_c *BootAPI_GetScrubInterval_Call) Run(run func(ctx context.Context)) *BootAPI_GetScrubInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BootAPI_GetScrubInterval_Call) Return(_a0 int, _a1 error) *BootAPI_GetScrubInterval_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BootAPI_GetScrubInterval_Call) RunAndReturn(run func(context.Context) (int, error)) *BootAPI_GetScrubInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetState provides a mock function with given fields: ctx
+func (_m *BootAPI) GetState(ctx context.Context) (*truenas.BootState, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetState")
+	}
+
+	var r0 *truenas.BootState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.BootState, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.BootState); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.BootState)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BootAPI_GetState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetState'
+type BootAPI_GetState_Call struct {
+	*mock.Call
+}
+
+// GetState is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BootAPI_Expecter) GetState(ctx interface{}) *BootAPI_GetState_Call {
+	return &BootAPI_GetState_Call{Call: _e.mock.On("GetState", ctx)}
+}
+
+func (_c *BootAPI_GetState_Call) Run(run func(ctx context.Context)) *BootAPI_GetState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BootAPI_GetState_Call) Return(_a0 *truenas.BootState, _a1 error) *BootAPI_GetState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BootAPI_GetState_Call) RunAndReturn(run func(context.Context) (*truenas.BootState, error)) *BootAPI_GetState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Replace provides a mock function with given fields: ctx, label, device
+func (_m *BootAPI) Replace(ctx context.Context, label string, device string) error {
+	ret := _m.Called(ctx, label, device)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replace")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, label, device)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BootAPI_Replace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Replace'
+type BootAPI_Replace_Call struct {
+	*mock.Call
+}
+
+// Replace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - label string
+//   - device string
+func (_e *BootAPI_Expecter) Replace(ctx interface{}, label interface{}, device interface{}) *BootAPI_Replace_Call {
+	return &BootAPI_Replace_Call{Call: _e.mock.On("Replace", ctx, label, device)}
+}
+
+func (_c *BootAPI_Replace_Call) Run(run func(ctx context.Context, label string, device string)) *BootAPI_Replace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *BootAPI_Replace_Call) Return(_a0 error) *BootAPI_Replace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BootAPI_Replace_Call) RunAndReturn(run func(context.Context, string, string) error) *BootAPI_Replace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Scrub provides a mock function with given fields: ctx
+func (_m *BootAPI) Scrub(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Scrub")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BootAPI_Scrub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Scrub'
+type BootAPI_Scrub_Call struct {
+	*mock.Call
+}
+
+// Scrub is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BootAPI_Expecter) Scrub(ctx interface{}) *BootAPI_Scrub_Call {
+	return &BootAPI_Scrub_Call{Call: _e.mock.On("Scrub", ctx)}
+}
+
+func (_c *BootAPI_Scrub_Call) Run(run func(ctx context.Context)) *BootAPI_Scrub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BootAPI_Scrub_Call) Return(_a0 error) *BootAPI_Scrub_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BootAPI_Scrub_Call) RunAndReturn(run func(context.Context) error) *BootAPI_Scrub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetScrubInterval provides a mock function with given fields: ctx, interval
+func (_m *BootAPI) SetScrubInterval(ctx context.Context, interval int) error {
+	ret := _m.Called(ctx, interval)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetScrubInterval")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, interval)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BootAPI_SetScrubInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetScrubInterval'
+type BootAPI_SetScrubInterval_Call struct {
+	*mock.Call
+}
+
+// SetScrubInterval is a helper method to define mock.On call
+//   - ctx context.Context
+//   - interval int
+func (_e *BootAPI_Expecter) SetScrubInterval(ctx interface{}, interval interface{}) *BootAPI_SetScrubInterval_Call {
+	return &BootAPI_SetScrubInterval_Call{Call: _e.mock.On("SetScrubInterval", ctx, interval)}
+}
+
+func (_c *BootAPI_SetScrubInterval_Call) Run(run func(ctx context.Context, interval int)) *BootAPI_SetScrubInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *BootAPI_SetScrubInterval_Call) Return(_a0 error) *BootAPI_SetScrubInterval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BootAPI_SetScrubInterval_Call) RunAndReturn(run func(context.Context, int) error) *BootAPI_SetScrubInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBootAPI creates a new instance of BootAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBootAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BootAPI {
+	mock := &BootAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,644 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// CatalogAPI is an autogenerated mock type for the CatalogAPI type
+type CatalogAPI struct {
+	mock.Mock
+}
+
+type CatalogAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CatalogAPI) EXPECT() *CatalogAPI_Expecter {
+	return &CatalogAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *CatalogAPI) Create(ctx context.Context, req *truenas.CatalogCreateRequest) (*truenas.Catalog, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Catalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.CatalogCreateRequest) (*truenas.Catalog, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.CatalogCreateRequest) *truenas.Catalog); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Catalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.CatalogCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CatalogAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.CatalogCreateRequest
+func (_e *CatalogAPI_Expecter) Create(ctx interface{}, req interface{}) *CatalogAPI_Create_Call {
+	return &CatalogAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *CatalogAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.CatalogCreateRequest)) *CatalogAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.CatalogCreateRequest))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_Create_Call) Return(_a0 *truenas.Catalog, _a1 error) *CatalogAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.CatalogCreateRequest) (*truenas.Catalog, error)) *CatalogAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, label
+func (_m *CatalogAPI) Delete(ctx context.Context, label string) error {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CatalogAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type CatalogAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - label string
+func (_e *CatalogAPI_Expecter) Delete(ctx interface{}, label interface{}) *CatalogAPI_Delete_Call {
+	return &CatalogAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, label)}
+}
+
+func (_c *CatalogAPI_Delete_Call) Run(run func(ctx context.Context, label string)) *CatalogAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_Delete_Call) Return(_a0 error) *CatalogAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CatalogAPI_Delete_Call) RunAndReturn(run func(context.Context, string) error) *CatalogAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, label
+func (_m *CatalogAPI) Get(ctx context.Context, label string) (*truenas.Catalog, error) {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Catalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Catalog, error)); ok {
+		return rf(ctx, label)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Catalog); ok {
+		r0 = rf(ctx, label)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Catalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, label)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CatalogAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - label string
+func (_e *CatalogAPI_Expecter) Get(ctx interface{}, label interface{}) *CatalogAPI_Get_Call {
+	return &CatalogAPI_Get_Call{Call: _e.mock.On("Get", ctx, label)}
+}
+
+func (_c *CatalogAPI_Get_Call) Run(run func(ctx context.Context, label string)) *CatalogAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_Get_Call) Return(_a0 *truenas.Catalog, _a1 error) *CatalogAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogAPI_Get_Call) RunAndReturn(run func(context.Context, string) (*truenas.Catalog, error)) *CatalogAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetItemDetails provides a mock function with given fields: ctx, item, catalog, train
+func (_m *CatalogAPI) GetItemDetails(ctx context.Context, item string, catalog string, train string) (map[string]any, error) {
+	ret := _m.Called(ctx, item, catalog, train)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetItemDetails")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (map[string]any, error)); ok {
+		return rf(ctx, item, catalog, train)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) map[string]any); ok {
+		r0 = rf(ctx, item, catalog, train)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, item, catalog, train)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogAPI_GetItemDetails_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetItemDetails'
+type CatalogAPI_GetItemDetails_Call struct {
+	*mock.Call
+}
+
+// GetItemDetails is a helper method to define mock.On call
+//   - ctx context.Context
+//   - item string
+//   - catalog string
+//   - train string
+func (_e *CatalogAPI_Expecter) GetItemDetails(ctx interface{}, item interface{}, catalog interface{}, train interface{}) *CatalogAPI_GetItemDetails_Call {
+	return &CatalogAPI_GetItemDetails_Call{Call: _e.mock.On("GetItemDetails", ctx, item, catalog, train)}
+}
+
+func (_c *CatalogAPI_GetItemDetails_Call) Run(run func(ctx context.Context, item string, catalog string, train string)) *CatalogAPI_GetItemDetails_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_GetItemDetails_Call) Return(_a0 map[string]any, _a1 error) *CatalogAPI_GetItemDetails_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogAPI_GetItemDetails_Call) RunAndReturn(run func(context.Context, string, string, string) (map[string]any, error)) *CatalogAPI_GetItemDetails_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Items provides a mock function with given fields: ctx, label, options
+func (_m *CatalogAPI) Items(ctx context.Context, label string, options *truenas.CatalogItemsOptions) (map[string]map[string]truenas.CatalogItem, error) {
+	ret := _m.Called(ctx, label, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Items")
+	}
+
+	var r0 map[string]map[string]truenas.CatalogItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.CatalogItemsOptions) (map[string]map[string]truenas.CatalogItem, error)); ok {
+		return rf(ctx, label, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.CatalogItemsOptions) map[string]map[string]truenas.CatalogItem); ok {
+		r0 = rf(ctx, label, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]map[string]truenas.CatalogItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.CatalogItemsOptions) error); ok {
+		r1 = rf(ctx, label, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogAPI_Items_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Items'
+type CatalogAPI_Items_Call struct {
+	*mock.Call
+}
+
+// Items is a helper method to define mock.On call
+//   - ctx context.Context
+//   - label string
+//   - options *truenas.CatalogItemsOptions
+func (_e *CatalogAPI_Expecter) Items(ctx interface{}, label interface{}, options interface{}) *CatalogAPI_Items_Call {
+	return &CatalogAPI_Items_Call{Call: _e.mock.On("Items", ctx, label, options)}
+}
+
+func (_c *CatalogAPI_Items_Call) Run(run func(ctx context.Context, label string, options *truenas.CatalogItemsOptions)) *CatalogAPI_Items_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.CatalogItemsOptions))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_Items_Call) Return(_a0 map[string]map[string]truenas.CatalogItem, _a1 error) *CatalogAPI_Items_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogAPI_Items_Call) RunAndReturn(run func(context.Context, string, *truenas.CatalogItemsOptions) (map[string]map[string]truenas.CatalogItem, error)) *CatalogAPI_Items_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *CatalogAPI) List(ctx context.Context) ([]truenas.Catalog, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Catalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Catalog, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Catalog); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Catalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type CatalogAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CatalogAPI_Expecter) List(ctx interface{}) *CatalogAPI_List_Call {
+	return &CatalogAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *CatalogAPI_List_Call) Run(run func(ctx context.Context)) *CatalogAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_List_Call) Return(_a0 []truenas.Catalog, _a1 error) *CatalogAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Catalog, error)) *CatalogAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *CatalogAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Catalog, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Catalog, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Catalog, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Catalog, error])
+		}
+	}
+
+	return r0
+}
+
+// CatalogAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type CatalogAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *CatalogAPI_Expecter) ListIter(ctx interface{}, q interface{}) *CatalogAPI_ListIter_Call {
+	return &CatalogAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *CatalogAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *CatalogAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Catalog, error]) *CatalogAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CatalogAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Catalog, error]) *CatalogAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *CatalogAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Catalog, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Catalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Catalog, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Catalog); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Catalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type CatalogAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *CatalogAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *CatalogAPI_ListWithQuery_Call {
+	return &CatalogAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *CatalogAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *CatalogAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_ListWithQuery_Call) Return(_a0 []truenas.Catalog, _a1 error) *CatalogAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Catalog, error)) *CatalogAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Sync provides a mock function with given fields: ctx, label
+func (_m *CatalogAPI) Sync(ctx context.Context, label string) error {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sync")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CatalogAPI_Sync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sync'
+type CatalogAPI_Sync_Call struct {
+	*mock.Call
+}
+
+// Sync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - label string
+func (_e *CatalogAPI_Expecter) Sync(ctx interface{}, label interface{}) *CatalogAPI_Sync_Call {
+	return &CatalogAPI_Sync_Call{Call: _e.mock.On("Sync", ctx, label)}
+}
+
+func (_c *CatalogAPI_Sync_Call) Run(run func(ctx context.Context, label string)) *CatalogAPI_Sync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_Sync_Call) Return(_a0 error) *CatalogAPI_Sync_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CatalogAPI_Sync_Call) RunAndReturn(run func(context.Context, string) error) *CatalogAPI_Sync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SyncAll provides a mock function with given fields: ctx
+func (_m *CatalogAPI) SyncAll(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SyncAll")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CatalogAPI_SyncAll_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SyncAll'
+type CatalogAPI_SyncAll_Call struct {
+	*mock.Call
+}
+
+// SyncAll is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CatalogAPI_Expecter) SyncAll(ctx interface{}) *CatalogAPI_SyncAll_Call {
+	return &CatalogAPI_SyncAll_Call{Call: _e.mock.On("SyncAll", ctx)}
+}
+
+func (_c *CatalogAPI_SyncAll_Call) Run(run func(ctx context.Context)) *CatalogAPI_SyncAll_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_SyncAll_Call) Return(_a0 error) *CatalogAPI_SyncAll_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CatalogAPI_SyncAll_Call) RunAndReturn(run func(context.Context) error) *CatalogAPI_SyncAll_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, label, req
+func (_m *CatalogAPI) Update(ctx context.Context, label string, req *truenas.CatalogUpdateRequest) (*truenas.Catalog, error) {
+	ret := _m.Called(ctx, label, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Catalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.CatalogUpdateRequest) (*truenas.Catalog, error)); ok {
+		return rf(ctx, label, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.CatalogUpdateRequest) *truenas.Catalog); ok {
+		r0 = rf(ctx, label, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Catalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.CatalogUpdateRequest) error); ok {
+		r1 = rf(ctx, label, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CatalogAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type CatalogAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - label string
+//   - req *truenas.CatalogUpdateRequest
+func (_e *CatalogAPI_Expecter) Update(ctx interface{}, label interface{}, req interface{}) *CatalogAPI_Update_Call {
+	return &CatalogAPI_Update_Call{Call: _e.mock.On("Update", ctx, label, req)}
+}
+
+func (_c *CatalogAPI_Update_Call) Run(run func(ctx context.Context, label string, req *truenas.CatalogUpdateRequest)) *CatalogAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.CatalogUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *CatalogAPI_Update_Call) Return(_a0 *truenas.Catalog, _a1 error) *CatalogAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CatalogAPI_Update_Call) RunAndReturn(run func(context.Context, string, *truenas.CatalogUpdateRequest) (*truenas.Catalog, error)) *CatalogAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCatalogAPI creates a new instance of CatalogAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCatalogAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CatalogAPI {
+	mock := &CatalogAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
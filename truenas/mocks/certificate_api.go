@@ -0,0 +1,779 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// CertificateAPI is an autogenerated mock type for the CertificateAPI type
+type CertificateAPI struct {
+	mock.Mock
+}
+
+type CertificateAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CertificateAPI) EXPECT() *CertificateAPI_Expecter {
+	return &CertificateAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *CertificateAPI) Create(ctx context.Context, req *truenas.CertificateCreateRequest) (*truenas.Certificate, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.CertificateCreateRequest) (*truenas.Certificate, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.CertificateCreateRequest) *truenas.Certificate); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.CertificateCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CertificateAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.CertificateCreateRequest
+func (_e *CertificateAPI_Expecter) Create(ctx interface{}, req interface{}) *CertificateAPI_Create_Call {
+	return &CertificateAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *CertificateAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.CertificateCreateRequest)) *CertificateAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.CertificateCreateRequest))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_Create_Call) Return(_a0 *truenas.Certificate, _a1 error) *CertificateAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.CertificateCreateRequest) (*truenas.Certificate, error)) *CertificateAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, force
+func (_m *CertificateAPI) Delete(ctx context.Context, id int, force bool) error {
+	ret := _m.Called(ctx, id, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) error); ok {
+		r0 = rf(ctx, id, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CertificateAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type CertificateAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - force bool
+func (_e *CertificateAPI_Expecter) Delete(ctx interface{}, id interface{}, force interface{}) *CertificateAPI_Delete_Call {
+	return &CertificateAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, force)}
+}
+
+func (_c *CertificateAPI_Delete_Call) Run(run func(ctx context.Context, id int, force bool)) *CertificateAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_Delete_Call) Return(_a0 error) *CertificateAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CertificateAPI_Delete_Call) RunAndReturn(run func(context.Context, int, bool) error) *CertificateAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *CertificateAPI) Get(ctx context.Context, id int) (*truenas.Certificate, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Certificate, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Certificate); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CertificateAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *CertificateAPI_Expecter) Get(ctx interface{}, id interface{}) *CertificateAPI_Get_Call {
+	return &CertificateAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *CertificateAPI_Get_Call) Run(run func(ctx context.Context, id int)) *CertificateAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_Get_Call) Return(_a0 *truenas.Certificate, _a1 error) *CertificateAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.Certificate, error)) *CertificateAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetACMEServerChoices provides a mock function with given fields: ctx
+func (_m *CertificateAPI) GetACMEServerChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetACMEServerChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_GetACMEServerChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetACMEServerChoices'
+type CertificateAPI_GetACMEServerChoices_Call struct {
+	*mock.Call
+}
+
+// GetACMEServerChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CertificateAPI_Expecter) GetACMEServerChoices(ctx interface{}) *CertificateAPI_GetACMEServerChoices_Call {
+	return &CertificateAPI_GetACMEServerChoices_Call{Call: _e.mock.On("GetACMEServerChoices", ctx)}
+}
+
+func (_c *CertificateAPI_GetACMEServerChoices_Call) Run(run func(ctx context.Context)) *CertificateAPI_GetACMEServerChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_GetACMEServerChoices_Call) Return(_a0 map[string]string, _a1 error) *CertificateAPI_GetACMEServerChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_GetACMEServerChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *CertificateAPI_GetACMEServerChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCountryChoices provides a mock function with given fields: ctx
+func (_m *CertificateAPI) GetCountryChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCountryChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_GetCountryChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCountryChoices'
+type CertificateAPI_GetCountryChoices_Call struct {
+	*mock.Call
+}
+
+// GetCountryChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CertificateAPI_Expecter) GetCountryChoices(ctx interface{}) *CertificateAPI_GetCountryChoices_Call {
+	return &CertificateAPI_GetCountryChoices_Call{Call: _e.mock.On("GetCountryChoices", ctx)}
+}
+
+func (_c *CertificateAPI_GetCountryChoices_Call) Run(run func(ctx context.Context)) *CertificateAPI_GetCountryChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_GetCountryChoices_Call) Return(_a0 map[string]string, _a1 error) *CertificateAPI_GetCountryChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_GetCountryChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *CertificateAPI_GetCountryChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetECCurveChoices provides a mock function with given fields: ctx
+func (_m *CertificateAPI) GetECCurveChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetECCurveChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_GetECCurveChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetECCurveChoices'
+type CertificateAPI_GetECCurveChoices_Call struct {
+	*mock.Call
+}
+
+// GetECCurveChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CertificateAPI_Expecter) GetECCurveChoices(ctx interface{}) *CertificateAPI_GetECCurveChoices_Call {
+	return &CertificateAPI_GetECCurveChoices_Call{Call: _e.mock.On("GetECCurveChoices", ctx)}
+}
+
+func (_c *CertificateAPI_GetECCurveChoices_Call) Run(run func(ctx context.Context)) *CertificateAPI_GetECCurveChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_GetECCurveChoices_Call) Return(_a0 map[string]string, _a1 error) *CertificateAPI_GetECCurveChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_GetECCurveChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *CertificateAPI_GetECCurveChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExtendedKeyUsageChoices provides a mock function with given fields: ctx
+func (_m *CertificateAPI) GetExtendedKeyUsageChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExtendedKeyUsageChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_GetExtendedKeyUsageChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExtendedKeyUsageChoices'
+type CertificateAPI_GetExtendedKeyUsageChoices_Call struct {
+	*mock.Call
+}
+
+// GetExtendedKeyUsageChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CertificateAPI_Expecter) GetExtendedKeyUsageChoices(ctx interface{}) *CertificateAPI_GetExtendedKeyUsageChoices_Call {
+	return &CertificateAPI_GetExtendedKeyUsageChoices_Call{Call: _e.mock.On("GetExtendedKeyUsageChoices", ctx)}
+}
+
+func (_c *CertificateAPI_GetExtendedKeyUsageChoices_Call) Run(run func(ctx context.Context)) *CertificateAPI_GetExtendedKeyUsageChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_GetExtendedKeyUsageChoices_Call) Return(_a0 map[string]string, _a1 error) *CertificateAPI_GetExtendedKeyUsageChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_GetExtendedKeyUsageChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *CertificateAPI_GetExtendedKeyUsageChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetKeyTypeChoices provides a mock function with given fields: ctx
+func (_m *CertificateAPI) GetKeyTypeChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetKeyTypeChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_GetKeyTypeChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetKeyTypeChoices'
+type CertificateAPI_GetKeyTypeChoices_Call struct {
+	*mock.Call
+}
+
+// GetKeyTypeChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CertificateAPI_Expecter) GetKeyTypeChoices(ctx interface{}) *CertificateAPI_GetKeyTypeChoices_Call {
+	return &CertificateAPI_GetKeyTypeChoices_Call{Call: _e.mock.On("GetKeyTypeChoices", ctx)}
+}
+
+func (_c *CertificateAPI_GetKeyTypeChoices_Call) Run(run func(ctx context.Context)) *CertificateAPI_GetKeyTypeChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_GetKeyTypeChoices_Call) Return(_a0 map[string]string, _a1 error) *CertificateAPI_GetKeyTypeChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_GetKeyTypeChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *CertificateAPI_GetKeyTypeChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProfiles provides a mock function with given fields: ctx
+func (_m *CertificateAPI) GetProfiles(ctx context.Context) (map[string]any, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProfiles")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]any, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]any); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_GetProfiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProfiles'
+type CertificateAPI_GetProfiles_Call struct {
+	*mock.Call
+}
+
+// GetProfiles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CertificateAPI_Expecter) GetProfiles(ctx interface{}) *CertificateAPI_GetProfiles_Call {
+	return &CertificateAPI_GetProfiles_Call{Call: _e.mock.On("GetProfiles", ctx)}
+}
+
+func (_c *CertificateAPI_GetProfiles_Call) Run(run func(ctx context.Context)) *CertificateAPI_GetProfiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_GetProfiles_Call) Return(_a0 map[string]any, _a1 error) *CertificateAPI_GetProfiles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_GetProfiles_Call) RunAndReturn(run func(context.Context) (map[string]any, error)) *CertificateAPI_GetProfiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *CertificateAPI) List(ctx context.Context) ([]truenas.Certificate, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Certificate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Certificate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type CertificateAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CertificateAPI_Expecter) List(ctx interface{}) *CertificateAPI_List_Call {
+	return &CertificateAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *CertificateAPI_List_Call) Run(run func(ctx context.Context)) *CertificateAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_List_Call) Return(_a0 []truenas.Certificate, _a1 error) *CertificateAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Certificate, error)) *CertificateAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *CertificateAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Certificate, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Certificate, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Certificate, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Certificate, error])
+		}
+	}
+
+	return r0
+}
+
+// CertificateAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type CertificateAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *CertificateAPI_Expecter) ListIter(ctx interface{}, q interface{}) *CertificateAPI_ListIter_Call {
+	return &CertificateAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *CertificateAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *CertificateAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Certificate, error]) *CertificateAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CertificateAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Certificate, error]) *CertificateAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *CertificateAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Certificate, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Certificate, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Certificate); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type CertificateAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *CertificateAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *CertificateAPI_ListWithQuery_Call {
+	return &CertificateAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *CertificateAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *CertificateAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_ListWithQuery_Call) Return(_a0 []truenas.Certificate, _a1 error) *CertificateAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Certificate, error)) *CertificateAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *CertificateAPI) Update(ctx context.Context, id int, req *truenas.CertificateUpdateRequest) (*truenas.Certificate, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Certificate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.CertificateUpdateRequest) (*truenas.Certificate, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.CertificateUpdateRequest) *truenas.Certificate); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Certificate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.CertificateUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CertificateAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type CertificateAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.CertificateUpdateRequest
+func (_e *CertificateAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *CertificateAPI_Update_Call {
+	return &CertificateAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *CertificateAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.CertificateUpdateRequest)) *CertificateAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.CertificateUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *CertificateAPI_Update_Call) Return(_a0 *truenas.Certificate, _a1 error) *CertificateAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CertificateAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.CertificateUpdateRequest) (*truenas.Certificate, error)) *CertificateAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCertificateAPI creates a new instance of CertificateAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCertificateAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CertificateAPI {
+	mock := &CertificateAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
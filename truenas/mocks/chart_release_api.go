@@ -0,0 +1,658 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// ChartReleaseAPI is an autogenerated mock type for the ChartReleaseAPI type
+type ChartReleaseAPI struct {
+	mock.Mock
+}
+
+type ChartReleaseAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ChartReleaseAPI) EXPECT() *ChartReleaseAPI_Expecter {
+	return &ChartReleaseAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *ChartReleaseAPI) Create(ctx context.Context, req *truenas.ChartReleaseCreateRequest) (*truenas.ChartRelease, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.ChartRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ChartReleaseCreateRequest) (*truenas.ChartRelease, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ChartReleaseCreateRequest) *truenas.ChartRelease); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ChartRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.ChartReleaseCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChartReleaseAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ChartReleaseAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.ChartReleaseCreateRequest
+func (_e *ChartReleaseAPI_Expecter) Create(ctx interface{}, req interface{}) *ChartReleaseAPI_Create_Call {
+	return &ChartReleaseAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *ChartReleaseAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.ChartReleaseCreateRequest)) *ChartReleaseAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.ChartReleaseCreateRequest))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Create_Call) Return(_a0 *truenas.ChartRelease, _a1 error) *ChartReleaseAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.ChartReleaseCreateRequest) (*truenas.ChartRelease, error)) *ChartReleaseAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, name, deleteUnusedImages
+func (_m *ChartReleaseAPI) Delete(ctx context.Context, name string, deleteUnusedImages bool) error {
+	ret := _m.Called(ctx, name, deleteUnusedImages)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, name, deleteUnusedImages)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChartReleaseAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ChartReleaseAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - deleteUnusedImages bool
+func (_e *ChartReleaseAPI_Expecter) Delete(ctx interface{}, name interface{}, deleteUnusedImages interface{}) *ChartReleaseAPI_Delete_Call {
+	return &ChartReleaseAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, name, deleteUnusedImages)}
+}
+
+func (_c *ChartReleaseAPI_Delete_Call) Run(run func(ctx context.Context, name string, deleteUnusedImages bool)) *ChartReleaseAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Delete_Call) Return(_a0 error) *ChartReleaseAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Delete_Call) RunAndReturn(run func(context.Context, string, bool) error) *ChartReleaseAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *ChartReleaseAPI) Get(ctx context.Context, name string) (*truenas.ChartRelease, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.ChartRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.ChartRelease, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.ChartRelease); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ChartRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChartReleaseAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ChartReleaseAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ChartReleaseAPI_Expecter) Get(ctx interface{}, name interface{}) *ChartReleaseAPI_Get_Call {
+	return &ChartReleaseAPI_Get_Call{Call: _e.mock.On("Get", ctx, name)}
+}
+
+func (_c *ChartReleaseAPI_Get_Call) Run(run func(ctx context.Context, name string)) *ChartReleaseAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Get_Call) Return(_a0 *truenas.ChartRelease, _a1 error) *ChartReleaseAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Get_Call) RunAndReturn(run func(context.Context, string) (*truenas.ChartRelease, error)) *ChartReleaseAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVersions provides a mock function with given fields: ctx, name
+func (_m *ChartReleaseAPI) GetVersions(ctx context.Context, name string) (map[string]any, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVersions")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string]any, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string]any); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChartReleaseAPI_GetVersions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersions'
+type ChartReleaseAPI_GetVersions_Call struct {
+	*mock.Call
+}
+
+// GetVersions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ChartReleaseAPI_Expecter) GetVersions(ctx interface{}, name interface{}) *ChartReleaseAPI_GetVersions_Call {
+	return &ChartReleaseAPI_GetVersions_Call{Call: _e.mock.On("GetVersions", ctx, name)}
+}
+
+func (_c *ChartReleaseAPI_GetVersions_Call) Run(run func(ctx context.Context, name string)) *ChartReleaseAPI_GetVersions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_GetVersions_Call) Return(_a0 map[string]any, _a1 error) *ChartReleaseAPI_GetVersions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_GetVersions_Call) RunAndReturn(run func(context.Context, string) (map[string]any, error)) *ChartReleaseAPI_GetVersions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *ChartReleaseAPI) List(ctx context.Context) ([]truenas.ChartRelease, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.ChartRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.ChartRelease, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.ChartRelease); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ChartRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChartReleaseAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ChartReleaseAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ChartReleaseAPI_Expecter) List(ctx interface{}) *ChartReleaseAPI_List_Call {
+	return &ChartReleaseAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *ChartReleaseAPI_List_Call) Run(run func(ctx context.Context)) *ChartReleaseAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_List_Call) Return(_a0 []truenas.ChartRelease, _a1 error) *ChartReleaseAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.ChartRelease, error)) *ChartReleaseAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *ChartReleaseAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.ChartRelease, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.ChartRelease, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.ChartRelease, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.ChartRelease, error])
+		}
+	}
+
+	return r0
+}
+
+// ChartReleaseAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type ChartReleaseAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *ChartReleaseAPI_Expecter) ListIter(ctx interface{}, q interface{}) *ChartReleaseAPI_ListIter_Call {
+	return &ChartReleaseAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *ChartReleaseAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *ChartReleaseAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.ChartRelease, error]) *ChartReleaseAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.ChartRelease, error]) *ChartReleaseAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *ChartReleaseAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.ChartRelease, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.ChartRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.ChartRelease, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.ChartRelease); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ChartRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChartReleaseAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type ChartReleaseAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *ChartReleaseAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *ChartReleaseAPI_ListWithQuery_Call {
+	return &ChartReleaseAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *ChartReleaseAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *ChartReleaseAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_ListWithQuery_Call) Return(_a0 []truenas.ChartRelease, _a1 error) *ChartReleaseAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.ChartRelease, error)) *ChartReleaseAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: ctx, name, options
+func (_m *ChartReleaseAPI) Rollback(ctx context.Context, name string, options *truenas.ChartReleaseRollbackOptions) (*truenas.ChartRelease, error) {
+	ret := _m.Called(ctx, name, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 *truenas.ChartRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.ChartReleaseRollbackOptions) (*truenas.ChartRelease, error)); ok {
+		return rf(ctx, name, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.ChartReleaseRollbackOptions) *truenas.ChartRelease); ok {
+		r0 = rf(ctx, name, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ChartRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.ChartReleaseRollbackOptions) error); ok {
+		r1 = rf(ctx, name, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChartReleaseAPI_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type ChartReleaseAPI_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - options *truenas.ChartReleaseRollbackOptions
+func (_e *ChartReleaseAPI_Expecter) Rollback(ctx interface{}, name interface{}, options interface{}) *ChartReleaseAPI_Rollback_Call {
+	return &ChartReleaseAPI_Rollback_Call{Call: _e.mock.On("Rollback", ctx, name, options)}
+}
+
+func (_c *ChartReleaseAPI_Rollback_Call) Run(run func(ctx context.Context, name string, options *truenas.ChartReleaseRollbackOptions)) *ChartReleaseAPI_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.ChartReleaseRollbackOptions))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Rollback_Call) Return(_a0 *truenas.ChartRelease, _a1 error) *ChartReleaseAPI_Rollback_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Rollback_Call) RunAndReturn(run func(context.Context, string, *truenas.ChartReleaseRollbackOptions) (*truenas.ChartRelease, error)) *ChartReleaseAPI_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Scale provides a mock function with given fields: ctx, name, replicaCount
+func (_m *ChartReleaseAPI) Scale(ctx context.Context, name string, replicaCount int) error {
+	ret := _m.Called(ctx, name, replicaCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Scale")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, name, replicaCount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChartReleaseAPI_Scale_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Scale'
+type ChartReleaseAPI_Scale_Call struct {
+	*mock.Call
+}
+
+// Scale is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - replicaCount int
+func (_e *ChartReleaseAPI_Expecter) Scale(ctx interface{}, name interface{}, replicaCount interface{}) *ChartReleaseAPI_Scale_Call {
+	return &ChartReleaseAPI_Scale_Call{Call: _e.mock.On("Scale", ctx, name, replicaCount)}
+}
+
+func (_c *ChartReleaseAPI_Scale_Call) Run(run func(ctx context.Context, name string, replicaCount int)) *ChartReleaseAPI_Scale_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Scale_Call) Return(_a0 error) *ChartReleaseAPI_Scale_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Scale_Call) RunAndReturn(run func(context.Context, string, int) error) *ChartReleaseAPI_Scale_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, name, req
+func (_m *ChartReleaseAPI) Update(ctx context.Context, name string, req *truenas.ChartReleaseUpdateRequest) (*truenas.ChartRelease, error) {
+	ret := _m.Called(ctx, name, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.ChartRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.ChartReleaseUpdateRequest) (*truenas.ChartRelease, error)); ok {
+		return rf(ctx, name, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.ChartReleaseUpdateRequest) *truenas.ChartRelease); ok {
+		r0 = rf(ctx, name, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ChartRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.ChartReleaseUpdateRequest) error); ok {
+		r1 = rf(ctx, name, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChartReleaseAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ChartReleaseAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - req *truenas.ChartReleaseUpdateRequest
+func (_e *ChartReleaseAPI_Expecter) Update(ctx interface{}, name interface{}, req interface{}) *ChartReleaseAPI_Update_Call {
+	return &ChartReleaseAPI_Update_Call{Call: _e.mock.On("Update", ctx, name, req)}
+}
+
+func (_c *ChartReleaseAPI_Update_Call) Run(run func(ctx context.Context, name string, req *truenas.ChartReleaseUpdateRequest)) *ChartReleaseAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.ChartReleaseUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Update_Call) Return(_a0 *truenas.ChartRelease, _a1 error) *ChartReleaseAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Update_Call) RunAndReturn(run func(context.Context, string, *truenas.ChartReleaseUpdateRequest) (*truenas.ChartRelease, error)) *ChartReleaseAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Upgrade provides a mock function with given fields: ctx, name, options
+func (_m *ChartReleaseAPI) Upgrade(ctx context.Context, name string, options *truenas.ChartReleaseUpgradeOptions) (*truenas.ChartRelease, error) {
+	ret := _m.Called(ctx, name, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upgrade")
+	}
+
+	var r0 *truenas.ChartRelease
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.ChartReleaseUpgradeOptions) (*truenas.ChartRelease, error)); ok {
+		return rf(ctx, name, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.ChartReleaseUpgradeOptions) *truenas.ChartRelease); ok {
+		r0 = rf(ctx, name, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ChartRelease)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.ChartReleaseUpgradeOptions) error); ok {
+		r1 = rf(ctx, name, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChartReleaseAPI_Upgrade_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Upgrade'
+type ChartReleaseAPI_Upgrade_Call struct {
+	*mock.Call
+}
+
+// Upgrade is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - options *truenas.ChartReleaseUpgradeOptions
+func (_e *ChartReleaseAPI_Expecter) Upgrade(ctx interface{}, name interface{}, options interface{}) *ChartReleaseAPI_Upgrade_Call {
+	return &ChartReleaseAPI_Upgrade_Call{Call: _e.mock.On("Upgrade", ctx, name, options)}
+}
+
+func (_c *ChartReleaseAPI_Upgrade_Call) Run(run func(ctx context.Context, name string, options *truenas.ChartReleaseUpgradeOptions)) *ChartReleaseAPI_Upgrade_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.ChartReleaseUpgradeOptions))
+	})
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Upgrade_Call) Return(_a0 *truenas.ChartRelease, _a1 error) *ChartReleaseAPI_Upgrade_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChartReleaseAPI_Upgrade_Call) RunAndReturn(run func(context.Context, string, *truenas.ChartReleaseUpgradeOptions) (*truenas.ChartRelease, error)) *ChartReleaseAPI_Upgrade_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewChartReleaseAPI creates a new instance of ChartReleaseAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChartReleaseAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChartReleaseAPI {
+	mock := &ChartReleaseAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,380 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CloudCredentialAPI is an autogenerated mock type for the CloudCredentialAPI type
+type CloudCredentialAPI struct {
+	mock.Mock
+}
+
+type CloudCredentialAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CloudCredentialAPI) EXPECT() *CloudCredentialAPI_Expecter {
+	return &CloudCredentialAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *CloudCredentialAPI) Create(ctx context.Context, req *truenas.CloudCredentialRequest) (*truenas.CloudCredential, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.CloudCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.CloudCredentialRequest) (*truenas.CloudCredential, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.CloudCredentialRequest) *truenas.CloudCredential); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.CloudCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.CloudCredentialRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloudCredentialAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CloudCredentialAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.CloudCredentialRequest
+func (_e *CloudCredentialAPI_Expecter) Create(ctx interface{}, req interface{}) *CloudCredentialAPI_Create_Call {
+	return &CloudCredentialAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *CloudCredentialAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.CloudCredentialRequest)) *CloudCredentialAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.CloudCredentialRequest))
+	})
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Create_Call) Return(_a0 *truenas.CloudCredential, _a1 error) *CloudCredentialAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.CloudCredentialRequest) (*truenas.CloudCredential, error)) *CloudCredentialAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *CloudCredentialAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloudCredentialAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type CloudCredentialAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *CloudCredentialAPI_Expecter) Delete(ctx interface{}, id interface{}) *CloudCredentialAPI_Delete_Call {
+	return &CloudCredentialAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *CloudCredentialAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *CloudCredentialAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Delete_Call) Return(_a0 error) *CloudCredentialAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *CloudCredentialAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *CloudCredentialAPI) Get(ctx context.Context, id int) (*truenas.CloudCredential, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.CloudCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.CloudCredential, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.CloudCredential); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.CloudCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloudCredentialAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CloudCredentialAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *CloudCredentialAPI_Expecter) Get(ctx interface{}, id interface{}) *CloudCredentialAPI_Get_Call {
+	return &CloudCredentialAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *CloudCredentialAPI_Get_Call) Run(run func(ctx context.Context, id int)) *CloudCredentialAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Get_Call) Return(_a0 *truenas.CloudCredential, _a1 error) *CloudCredentialAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.CloudCredential, error)) *CloudCredentialAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *CloudCredentialAPI) List(ctx context.Context) ([]truenas.CloudCredential, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.CloudCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.CloudCredential, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.CloudCredential); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.CloudCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloudCredentialAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type CloudCredentialAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CloudCredentialAPI_Expecter) List(ctx interface{}) *CloudCredentialAPI_List_Call {
+	return &CloudCredentialAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *CloudCredentialAPI_List_Call) Run(run func(ctx context.Context)) *CloudCredentialAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CloudCredentialAPI_List_Call) Return(_a0 []truenas.CloudCredential, _a1 error) *CloudCredentialAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CloudCredentialAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.CloudCredential, error)) *CloudCredentialAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *CloudCredentialAPI) Update(ctx context.Context, id int, req *truenas.CloudCredentialRequest) (*truenas.CloudCredential, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.CloudCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.CloudCredentialRequest) (*truenas.CloudCredential, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.CloudCredentialRequest) *truenas.CloudCredential); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.CloudCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.CloudCredentialRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloudCredentialAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type CloudCredentialAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.CloudCredentialRequest
+func (_e *CloudCredentialAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *CloudCredentialAPI_Update_Call {
+	return &CloudCredentialAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *CloudCredentialAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.CloudCredentialRequest)) *CloudCredentialAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.CloudCredentialRequest))
+	})
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Update_Call) Return(_a0 *truenas.CloudCredential, _a1 error) *CloudCredentialAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.CloudCredentialRequest) (*truenas.CloudCredential, error)) *CloudCredentialAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Verify provides a mock function with given fields: ctx, provider, attributes
+func (_m *CloudCredentialAPI) Verify(ctx context.Context, provider truenas.CloudProvider, attributes any) (*truenas.CloudCredentialVerifyResult, error) {
+	ret := _m.Called(ctx, provider, attributes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Verify")
+	}
+
+	var r0 *truenas.CloudCredentialVerifyResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.CloudProvider, any) (*truenas.CloudCredentialVerifyResult, error)); ok {
+		return rf(ctx, provider, attributes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.CloudProvider, any) *truenas.CloudCredentialVerifyResult); ok {
+		r0 = rf(ctx, provider, attributes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.CloudCredentialVerifyResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.CloudProvider, any) error); ok {
+		r1 = rf(ctx, provider, attributes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CloudCredentialAPI_Verify_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Verify'
+type CloudCredentialAPI_Verify_Call struct {
+	*mock.Call
+}
+
+// Verify is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider truenas.CloudProvider
+//   - attributes any
+func (_e *CloudCredentialAPI_Expecter) Verify(ctx interface{}, provider interface{}, attributes interface{}) *CloudCredentialAPI_Verify_Call {
+	return &CloudCredentialAPI_Verify_Call{Call: _e.mock.On("Verify", ctx, provider, attributes)}
+}
+
+func (_c *CloudCredentialAPI_Verify_Call) Run(run func(ctx context.Context, provider truenas.CloudProvider, attributes any)) *CloudCredentialAPI_Verify_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.CloudProvider), args[2].(any))
+	})
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Verify_Call) Return(_a0 *truenas.CloudCredentialVerifyResult, _a1 error) *CloudCredentialAPI_Verify_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CloudCredentialAPI_Verify_Call) RunAndReturn(run func(context.Context, truenas.CloudProvider, any) (*truenas.CloudCredentialVerifyResult, error)) *CloudCredentialAPI_Verify_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCloudCredentialAPI creates a new instance of CloudCredentialAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCloudCredentialAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CloudCredentialAPI {
+	mock := &CloudCredentialAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,524 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// CronjobAPI is an autogenerated mock type for the CronjobAPI type
+type CronjobAPI struct {
+	mock.Mock
+}
+
+type CronjobAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CronjobAPI) EXPECT() *CronjobAPI_Expecter {
+	return &CronjobAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *CronjobAPI) Create(ctx context.Context, req *truenas.CronjobCreateRequest) (*truenas.Cronjob, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Cronjob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.CronjobCreateRequest) (*truenas.Cronjob, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.CronjobCreateRequest) *truenas.Cronjob); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Cronjob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.CronjobCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CronjobAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CronjobAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.CronjobCreateRequest
+func (_e *CronjobAPI_Expecter) Create(ctx interface{}, req interface{}) *CronjobAPI_Create_Call {
+	return &CronjobAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *CronjobAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.CronjobCreateRequest)) *CronjobAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.CronjobCreateRequest))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_Create_Call) Return(_a0 *truenas.Cronjob, _a1 error) *CronjobAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CronjobAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.CronjobCreateRequest) (*truenas.Cronjob, error)) *CronjobAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *CronjobAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CronjobAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type CronjobAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *CronjobAPI_Expecter) Delete(ctx interface{}, id interface{}) *CronjobAPI_Delete_Call {
+	return &CronjobAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *CronjobAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *CronjobAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_Delete_Call) Return(_a0 error) *CronjobAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CronjobAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *CronjobAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *CronjobAPI) Get(ctx context.Context, id int) (*truenas.Cronjob, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Cronjob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Cronjob, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Cronjob); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Cronjob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CronjobAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CronjobAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *CronjobAPI_Expecter) Get(ctx interface{}, id interface{}) *CronjobAPI_Get_Call {
+	return &CronjobAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *CronjobAPI_Get_Call) Run(run func(ctx context.Context, id int)) *CronjobAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_Get_Call) Return(_a0 *truenas.Cronjob, _a1 error) *CronjobAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CronjobAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.Cronjob, error)) *CronjobAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *CronjobAPI) List(ctx context.Context) ([]truenas.Cronjob, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Cronjob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Cronjob, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Cronjob); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Cronjob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CronjobAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type CronjobAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CronjobAPI_Expecter) List(ctx interface{}) *CronjobAPI_List_Call {
+	return &CronjobAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *CronjobAPI_List_Call) Run(run func(ctx context.Context)) *CronjobAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_List_Call) Return(_a0 []truenas.Cronjob, _a1 error) *CronjobAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CronjobAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Cronjob, error)) *CronjobAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *CronjobAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Cronjob, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Cronjob, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Cronjob, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Cronjob, error])
+		}
+	}
+
+	return r0
+}
+
+// CronjobAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type CronjobAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *CronjobAPI_Expecter) ListIter(ctx interface{}, q interface{}) *CronjobAPI_ListIter_Call {
+	return &CronjobAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *CronjobAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *CronjobAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Cronjob, error]) *CronjobAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CronjobAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Cronjob, error]) *CronjobAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *CronjobAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Cronjob, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Cronjob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Cronjob, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Cronjob); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Cronjob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CronjobAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type CronjobAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *CronjobAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *CronjobAPI_ListWithQuery_Call {
+	return &CronjobAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *CronjobAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *CronjobAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_ListWithQuery_Call) Return(_a0 []truenas.Cronjob, _a1 error) *CronjobAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CronjobAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Cronjob, error)) *CronjobAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx, id, skipDisabled
+func (_m *CronjobAPI) Run(ctx context.Context, id int, skipDisabled bool) error {
+	ret := _m.Called(ctx, id, skipDisabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) error); ok {
+		r0 = rf(ctx, id, skipDisabled)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CronjobAPI_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type CronjobAPI_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - skipDisabled bool
+func (_e *CronjobAPI_Expecter) Run(ctx interface{}, id interface{}, skipDisabled interface{}) *CronjobAPI_Run_Call {
+	return &CronjobAPI_Run_Call{Call: _e.mock.On("Run", ctx, id, skipDisabled)}
+}
+
+func (_c *CronjobAPI_Run_Call) Run(run func(ctx context.Context, id int, skipDisabled bool)) *CronjobAPI_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_Run_Call) Return(_a0 error) *CronjobAPI_Run_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CronjobAPI_Run_Call) RunAndReturn(run func(context.Context, int, bool) error) *CronjobAPI_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *CronjobAPI) Update(ctx context.Context, id int, req *truenas.CronjobUpdateRequest) (*truenas.Cronjob, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Cronjob
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.CronjobUpdateRequest) (*truenas.Cronjob, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.CronjobUpdateRequest) *truenas.Cronjob); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Cronjob)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.CronjobUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CronjobAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type CronjobAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.CronjobUpdateRequest
+func (_e *CronjobAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *CronjobAPI_Update_Call {
+	return &CronjobAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *CronjobAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.CronjobUpdateRequest)) *CronjobAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.CronjobUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_Update_Call) Return(_a0 *truenas.Cronjob, _a1 error) *CronjobAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CronjobAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.CronjobUpdateRequest) (*truenas.Cronjob, error)) *CronjobAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Validate provides a mock function with given fields: req
+func (_m *CronjobAPI) Validate(req *truenas.CronjobCreateRequest) error {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*truenas.CronjobCreateRequest) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CronjobAPI_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type CronjobAPI_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - req *truenas.CronjobCreateRequest
+func (_e *CronjobAPI_Expecter) Validate(req interface{}) *CronjobAPI_Validate_Call {
+	return &CronjobAPI_Validate_Call{Call: _e.mock.On("Validate", req)}
+}
+
+func (_c *CronjobAPI_Validate_Call) Run(run func(req *truenas.CronjobCreateRequest)) *CronjobAPI_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*truenas.CronjobCreateRequest))
+	})
+	return _c
+}
+
+func (_c *CronjobAPI_Validate_Call) Return(_a0 error) *CronjobAPI_Validate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CronjobAPI_Validate_Call) RunAndReturn(run func(*truenas.CronjobCreateRequest) error) *CronjobAPI_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCronjobAPI creates a new instance of CronjobAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCronjobAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CronjobAPI {
+	mock := &CronjobAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
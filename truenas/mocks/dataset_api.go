@@ -0,0 +1,1543 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// DatasetAPI is an autogenerated mock type for the DatasetAPI type
+type DatasetAPI struct {
+	mock.Mock
+}
+
+type DatasetAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DatasetAPI) EXPECT() *DatasetAPI_Expecter {
+	return &DatasetAPI_Expecter{mock: &_m.Mock}
+}
+
+// ChangeKey provides a mock function with given fields: ctx, id, options
+func (_m *DatasetAPI) ChangeKey(ctx context.Context, id string, options *truenas.DatasetChangeKeyOptions) error {
+	ret := _m.Called(ctx, id, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.DatasetChangeKeyOptions) error); ok {
+		r0 = rf(ctx, id, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DatasetAPI_ChangeKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeKey'
+type DatasetAPI_ChangeKey_Call struct {
+	*mock.Call
+}
+
+// ChangeKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - options *truenas.DatasetChangeKeyOptions
+func (_e *DatasetAPI_Expecter) ChangeKey(ctx interface{}, id interface{}, options interface{}) *DatasetAPI_ChangeKey_Call {
+	return &DatasetAPI_ChangeKey_Call{Call: _e.mock.On("ChangeKey", ctx, id, options)}
+}
+
+func (_c *DatasetAPI_ChangeKey_Call) Run(run func(ctx context.Context, id string, options *truenas.DatasetChangeKeyOptions)) *DatasetAPI_ChangeKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.DatasetChangeKeyOptions))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_ChangeKey_Call) Return(_a0 error) *DatasetAPI_ChangeKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_ChangeKey_Call) RunAndReturn(run func(context.Context, string, *truenas.DatasetChangeKeyOptions) error) *DatasetAPI_ChangeKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeKeyWithKeyFile provides a mock function with given fields: ctx, id, options, keyFile
+func (_m *DatasetAPI) ChangeKeyWithKeyFile(ctx context.Context, id string, options truenas.DatasetChangeKeyOptions, keyFile io.Reader) error {
+	ret := _m.Called(ctx, id, options, keyFile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeKeyWithKeyFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetChangeKeyOptions, io.Reader) error); ok {
+		r0 = rf(ctx, id, options, keyFile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DatasetAPI_ChangeKeyWithKeyFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeKeyWithKeyFile'
+type DatasetAPI_ChangeKeyWithKeyFile_Call struct {
+	*mock.Call
+}
+
+// ChangeKeyWithKeyFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - options truenas.DatasetChangeKeyOptions
+//   - keyFile io.Reader
+func (_e *DatasetAPI_Expecter) ChangeKeyWithKeyFile(ctx interface{}, id interface{}, options interface{}, keyFile interface{}) *DatasetAPI_ChangeKeyWithKeyFile_Call {
+	return &DatasetAPI_ChangeKeyWithKeyFile_Call{Call: _e.mock.On("ChangeKeyWithKeyFile", ctx, id, options, keyFile)}
+}
+
+func (_c *DatasetAPI_ChangeKeyWithKeyFile_Call) Run(run func(ctx context.Context, id string, options truenas.DatasetChangeKeyOptions, keyFile io.Reader)) *DatasetAPI_ChangeKeyWithKeyFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.DatasetChangeKeyOptions), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_ChangeKeyWithKeyFile_Call) Return(_a0 error) *DatasetAPI_ChangeKeyWithKeyFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_ChangeKeyWithKeyFile_Call) RunAndReturn(run func(context.Context, string, truenas.DatasetChangeKeyOptions, io.Reader) error) *DatasetAPI_ChangeKeyWithKeyFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloneFromSnapshot provides a mock function with given fields: ctx, source, snapName, target, opts
+func (_m *DatasetAPI) CloneFromSnapshot(ctx context.Context, source string, snapName string, target string, opts *truenas.CloneOptions) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, source, snapName, target, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneFromSnapshot")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *truenas.CloneOptions) (*truenas.Dataset, error)); ok {
+		return rf(ctx, source, snapName, target, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *truenas.CloneOptions) *truenas.Dataset); ok {
+		r0 = rf(ctx, source, snapName, target, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *truenas.CloneOptions) error); ok {
+		r1 = rf(ctx, source, snapName, target, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_CloneFromSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneFromSnapshot'
+type DatasetAPI_CloneFromSnapshot_Call struct {
+	*mock.Call
+}
+
+// CloneFromSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - source string
+//   - snapName string
+//   - target string
+//   - opts *truenas.CloneOptions
+func (_e *DatasetAPI_Expecter) CloneFromSnapshot(ctx interface{}, source interface{}, snapName interface{}, target interface{}, opts interface{}) *DatasetAPI_CloneFromSnapshot_Call {
+	return &DatasetAPI_CloneFromSnapshot_Call{Call: _e.mock.On("CloneFromSnapshot", ctx, source, snapName, target, opts)}
+}
+
+func (_c *DatasetAPI_CloneFromSnapshot_Call) Run(run func(ctx context.Context, source string, snapName string, target string, opts *truenas.CloneOptions)) *DatasetAPI_CloneFromSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(*truenas.CloneOptions))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_CloneFromSnapshot_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_CloneFromSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_CloneFromSnapshot_Call) RunAndReturn(run func(context.Context, string, string, string, *truenas.CloneOptions) (*truenas.Dataset, error)) *DatasetAPI_CloneFromSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *DatasetAPI) Create(ctx context.Context, req *truenas.DatasetCreateRequest) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DatasetCreateRequest) (*truenas.Dataset, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DatasetCreateRequest) *truenas.Dataset); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.DatasetCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type DatasetAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.DatasetCreateRequest
+func (_e *DatasetAPI_Expecter) Create(ctx interface{}, req interface{}) *DatasetAPI_Create_Call {
+	return &DatasetAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *DatasetAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.DatasetCreateRequest)) *DatasetAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.DatasetCreateRequest))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Create_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.DatasetCreateRequest) (*truenas.Dataset, error)) *DatasetAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, req
+func (_m *DatasetAPI) Delete(ctx context.Context, id string, req truenas.DatasetDeleteRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetDeleteRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DatasetAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type DatasetAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req truenas.DatasetDeleteRequest
+func (_e *DatasetAPI_Expecter) Delete(ctx interface{}, id interface{}, req interface{}) *DatasetAPI_Delete_Call {
+	return &DatasetAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, req)}
+}
+
+func (_c *DatasetAPI_Delete_Call) Run(run func(ctx context.Context, id string, req truenas.DatasetDeleteRequest)) *DatasetAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.DatasetDeleteRequest))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Delete_Call) Return(_a0 error) *DatasetAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_Delete_Call) RunAndReturn(run func(context.Context, string, truenas.DatasetDeleteRequest) error) *DatasetAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EncryptionSummary provides a mock function with given fields: ctx, id, options
+func (_m *DatasetAPI) EncryptionSummary(ctx context.Context, id string, options *truenas.DatasetEncryptionSummaryOptions) ([]truenas.DatasetEncryptionSummary, error) {
+	ret := _m.Called(ctx, id, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EncryptionSummary")
+	}
+
+	var r0 []truenas.DatasetEncryptionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.DatasetEncryptionSummaryOptions) ([]truenas.DatasetEncryptionSummary, error)); ok {
+		return rf(ctx, id, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.DatasetEncryptionSummaryOptions) []truenas.DatasetEncryptionSummary); ok {
+		r0 = rf(ctx, id, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.DatasetEncryptionSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.DatasetEncryptionSummaryOptions) error); ok {
+		r1 = rf(ctx, id, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_EncryptionSummary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EncryptionSummary'
+type DatasetAPI_EncryptionSummary_Call struct {
+	*mock.Call
+}
+
+// EncryptionSummary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - options *truenas.DatasetEncryptionSummaryOptions
+func (_e *DatasetAPI_Expecter) EncryptionSummary(ctx interface{}, id interface{}, options interface{}) *DatasetAPI_EncryptionSummary_Call {
+	return &DatasetAPI_EncryptionSummary_Call{Call: _e.mock.On("EncryptionSummary", ctx, id, options)}
+}
+
+func (_c *DatasetAPI_EncryptionSummary_Call) Run(run func(ctx context.Context, id string, options *truenas.DatasetEncryptionSummaryOptions)) *DatasetAPI_EncryptionSummary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.DatasetEncryptionSummaryOptions))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_EncryptionSummary_Call) Return(_a0 []truenas.DatasetEncryptionSummary, _a1 error) *DatasetAPI_EncryptionSummary_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_EncryptionSummary_Call) RunAndReturn(run func(context.Context, string, *truenas.DatasetEncryptionSummaryOptions) ([]truenas.DatasetEncryptionSummary, error)) *DatasetAPI_EncryptionSummary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EncryptionSummaryWithKeyFile provides a mock function with given fields: ctx, id, options, keyFile
+func (_m *DatasetAPI) EncryptionSummaryWithKeyFile(ctx context.Context, id string, options truenas.DatasetEncryptionSummaryOptions, keyFile io.Reader) ([]truenas.DatasetEncryptionSummary, error) {
+	ret := _m.Called(ctx, id, options, keyFile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EncryptionSummaryWithKeyFile")
+	}
+
+	var r0 []truenas.DatasetEncryptionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetEncryptionSummaryOptions, io.Reader) ([]truenas.DatasetEncryptionSummary, error)); ok {
+		return rf(ctx, id, options, keyFile)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetEncryptionSummaryOptions, io.Reader) []truenas.DatasetEncryptionSummary); ok {
+		r0 = rf(ctx, id, options, keyFile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.DatasetEncryptionSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, truenas.DatasetEncryptionSummaryOptions, io.Reader) error); ok {
+		r1 = rf(ctx, id, options, keyFile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_EncryptionSummaryWithKeyFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EncryptionSummaryWithKeyFile'
+type DatasetAPI_EncryptionSummaryWithKeyFile_Call struct {
+	*mock.Call
+}
+
+// EncryptionSummaryWithKeyFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - options truenas.DatasetEncryptionSummaryOptions
+//   - keyFile io.Reader
+func (_e *DatasetAPI_Expecter) EncryptionSummaryWithKeyFile(ctx interface{}, id interface{}, options interface{}, keyFile interface{}) *DatasetAPI_EncryptionSummaryWithKeyFile_Call {
+	return &DatasetAPI_EncryptionSummaryWithKeyFile_Call{Call: _e.mock.On("EncryptionSummaryWithKeyFile", ctx, id, options, keyFile)}
+}
+
+func (_c *DatasetAPI_EncryptionSummaryWithKeyFile_Call) Run(run func(ctx context.Context, id string, options truenas.DatasetEncryptionSummaryOptions, keyFile io.Reader)) *DatasetAPI_EncryptionSummaryWithKeyFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.DatasetEncryptionSummaryOptions), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_EncryptionSummaryWithKeyFile_Call) Return(_a0 []truenas.DatasetEncryptionSummary, _a1 error) *DatasetAPI_EncryptionSummaryWithKeyFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_EncryptionSummaryWithKeyFile_Call) RunAndReturn(run func(context.Context, string, truenas.DatasetEncryptionSummaryOptions, io.Reader) ([]truenas.DatasetEncryptionSummary, error)) *DatasetAPI_EncryptionSummaryWithKeyFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportKey provides a mock function with given fields: ctx, id
+func (_m *DatasetAPI) ExportKey(ctx context.Context, id string) (string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportKey")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_ExportKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportKey'
+type DatasetAPI_ExportKey_Call struct {
+	*mock.Call
+}
+
+// ExportKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DatasetAPI_Expecter) ExportKey(ctx interface{}, id interface{}) *DatasetAPI_ExportKey_Call {
+	return &DatasetAPI_ExportKey_Call{Call: _e.mock.On("ExportKey", ctx, id)}
+}
+
+func (_c *DatasetAPI_ExportKey_Call) Run(run func(ctx context.Context, id string)) *DatasetAPI_ExportKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_ExportKey_Call) Return(_a0 string, _a1 error) *DatasetAPI_ExportKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_ExportKey_Call) RunAndReturn(run func(context.Context, string) (string, error)) *DatasetAPI_ExportKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *DatasetAPI) Get(ctx context.Context, id string) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Dataset, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Dataset); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type DatasetAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DatasetAPI_Expecter) Get(ctx interface{}, id interface{}) *DatasetAPI_Get_Call {
+	return &DatasetAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *DatasetAPI_Get_Call) Run(run func(ctx context.Context, id string)) *DatasetAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Get_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_Get_Call) RunAndReturn(run func(context.Context, string) (*truenas.Dataset, error)) *DatasetAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function with given fields: ctx, name
+func (_m *DatasetAPI) GetByName(ctx context.Context, name string) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Dataset, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Dataset); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type DatasetAPI_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *DatasetAPI_Expecter) GetByName(ctx interface{}, name interface{}) *DatasetAPI_GetByName_Call {
+	return &DatasetAPI_GetByName_Call{Call: _e.mock.On("GetByName", ctx, name)}
+}
+
+func (_c *DatasetAPI_GetByName_Call) Run(run func(ctx context.Context, name string)) *DatasetAPI_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_GetByName_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_GetByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_GetByName_Call) RunAndReturn(run func(context.Context, string) (*truenas.Dataset, error)) *DatasetAPI_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProcesses provides a mock function with given fields: ctx, id
+func (_m *DatasetAPI) GetProcesses(ctx context.Context, id string) (any, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProcesses")
+	}
+
+	var r0 any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (any, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) any); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_GetProcesses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcesses'
+type DatasetAPI_GetProcesses_Call struct {
+	*mock.Call
+}
+
+// GetProcesses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DatasetAPI_Expecter) GetProcesses(ctx interface{}, id interface{}) *DatasetAPI_GetProcesses_Call {
+	return &DatasetAPI_GetProcesses_Call{Call: _e.mock.On("GetProcesses", ctx, id)}
+}
+
+func (_c *DatasetAPI_GetProcesses_Call) Run(run func(ctx context.Context, id string)) *DatasetAPI_GetProcesses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_GetProcesses_Call) Return(_a0 any, _a1 error) *DatasetAPI_GetProcesses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_GetProcesses_Call) RunAndReturn(run func(context.Context, string) (any, error)) *DatasetAPI_GetProcesses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSnapshots provides a mock function with given fields: ctx, datasetName
+func (_m *DatasetAPI) GetSnapshots(ctx context.Context, datasetName string) ([]any, error) {
+	ret := _m.Called(ctx, datasetName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSnapshots")
+	}
+
+	var r0 []any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]any, error)); ok {
+		return rf(ctx, datasetName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []any); ok {
+		r0 = rf(ctx, datasetName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, datasetName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_GetSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSnapshots'
+type DatasetAPI_GetSnapshots_Call struct {
+	*mock.Call
+}
+
+// GetSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+//   - datasetName string
+func (_e *DatasetAPI_Expecter) GetSnapshots(ctx interface{}, datasetName interface{}) *DatasetAPI_GetSnapshots_Call {
+	return &DatasetAPI_GetSnapshots_Call{Call: _e.mock.On("GetSnapshots", ctx, datasetName)}
+}
+
+func (_c *DatasetAPI_GetSnapshots_Call) Run(run func(ctx context.Context, datasetName string)) *DatasetAPI_GetSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_GetSnapshots_Call) Return(_a0 []any, _a1 error) *DatasetAPI_GetSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_GetSnapshots_Call) RunAndReturn(run func(context.Context, string) ([]any, error)) *DatasetAPI_GetSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InheritProperty provides a mock function with given fields: ctx, id, name
+func (_m *DatasetAPI) InheritProperty(ctx context.Context, id string, name truenas.DatasetPropertyName) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, id, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InheritProperty")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetPropertyName) (*truenas.Dataset, error)); ok {
+		return rf(ctx, id, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetPropertyName) *truenas.Dataset); ok {
+		r0 = rf(ctx, id, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, truenas.DatasetPropertyName) error); ok {
+		r1 = rf(ctx, id, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_InheritProperty_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InheritProperty'
+type DatasetAPI_InheritProperty_Call struct {
+	*mock.Call
+}
+
+// InheritProperty is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - name truenas.DatasetPropertyName
+func (_e *DatasetAPI_Expecter) InheritProperty(ctx interface{}, id interface{}, name interface{}) *DatasetAPI_InheritProperty_Call {
+	return &DatasetAPI_InheritProperty_Call{Call: _e.mock.On("InheritProperty", ctx, id, name)}
+}
+
+func (_c *DatasetAPI_InheritProperty_Call) Run(run func(ctx context.Context, id string, name truenas.DatasetPropertyName)) *DatasetAPI_InheritProperty_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.DatasetPropertyName))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_InheritProperty_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_InheritProperty_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_InheritProperty_Call) RunAndReturn(run func(context.Context, string, truenas.DatasetPropertyName) (*truenas.Dataset, error)) *DatasetAPI_InheritProperty_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *DatasetAPI) List(ctx context.Context) ([]truenas.Dataset, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Dataset, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Dataset); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type DatasetAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DatasetAPI_Expecter) List(ctx interface{}) *DatasetAPI_List_Call {
+	return &DatasetAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *DatasetAPI_List_Call) Run(run func(ctx context.Context)) *DatasetAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_List_Call) Return(_a0 []truenas.Dataset, _a1 error) *DatasetAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Dataset, error)) *DatasetAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *DatasetAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Dataset, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Dataset, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Dataset, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Dataset, error])
+		}
+	}
+
+	return r0
+}
+
+// DatasetAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type DatasetAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *DatasetAPI_Expecter) ListIter(ctx interface{}, q interface{}) *DatasetAPI_ListIter_Call {
+	return &DatasetAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *DatasetAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *DatasetAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Dataset, error]) *DatasetAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Dataset, error]) *DatasetAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *DatasetAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Dataset, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Dataset, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Dataset); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type DatasetAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *DatasetAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *DatasetAPI_ListWithQuery_Call {
+	return &DatasetAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *DatasetAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *DatasetAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_ListWithQuery_Call) Return(_a0 []truenas.Dataset, _a1 error) *DatasetAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Dataset, error)) *DatasetAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lock provides a mock function with given fields: ctx, id, req
+func (_m *DatasetAPI) Lock(ctx context.Context, id string, req truenas.DatasetLockRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetLockRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DatasetAPI_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type DatasetAPI_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req truenas.DatasetLockRequest
+func (_e *DatasetAPI_Expecter) Lock(ctx interface{}, id interface{}, req interface{}) *DatasetAPI_Lock_Call {
+	return &DatasetAPI_Lock_Call{Call: _e.mock.On("Lock", ctx, id, req)}
+}
+
+func (_c *DatasetAPI_Lock_Call) Run(run func(ctx context.Context, id string, req truenas.DatasetLockRequest)) *DatasetAPI_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.DatasetLockRequest))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Lock_Call) Return(_a0 error) *DatasetAPI_Lock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_Lock_Call) RunAndReturn(run func(context.Context, string, truenas.DatasetLockRequest) error) *DatasetAPI_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mount provides a mock function with given fields: ctx, id
+func (_m *DatasetAPI) Mount(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DatasetAPI_Mount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mount'
+type DatasetAPI_Mount_Call struct {
+	*mock.Call
+}
+
+// Mount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DatasetAPI_Expecter) Mount(ctx interface{}, id interface{}) *DatasetAPI_Mount_Call {
+	return &DatasetAPI_Mount_Call{Call: _e.mock.On("Mount", ctx, id)}
+}
+
+func (_c *DatasetAPI_Mount_Call) Run(run func(ctx context.Context, id string)) *DatasetAPI_Mount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Mount_Call) Return(_a0 error) *DatasetAPI_Mount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_Mount_Call) RunAndReturn(run func(context.Context, string) error) *DatasetAPI_Mount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Promote provides a mock function with given fields: ctx, id
+func (_m *DatasetAPI) Promote(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Promote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DatasetAPI_Promote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Promote'
+type DatasetAPI_Promote_Call struct {
+	*mock.Call
+}
+
+// Promote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DatasetAPI_Expecter) Promote(ctx interface{}, id interface{}) *DatasetAPI_Promote_Call {
+	return &DatasetAPI_Promote_Call{Call: _e.mock.On("Promote", ctx, id)}
+}
+
+func (_c *DatasetAPI_Promote_Call) Run(run func(ctx context.Context, id string)) *DatasetAPI_Promote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Promote_Call) Return(_a0 error) *DatasetAPI_Promote_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_Promote_Call) RunAndReturn(run func(context.Context, string) error) *DatasetAPI_Promote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteClone provides a mock function with given fields: ctx, id
+func (_m *DatasetAPI) PromoteClone(ctx context.Context, id string) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteClone")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Dataset, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Dataset); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_PromoteClone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteClone'
+type DatasetAPI_PromoteClone_Call struct {
+	*mock.Call
+}
+
+// PromoteClone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DatasetAPI_Expecter) PromoteClone(ctx interface{}, id interface{}) *DatasetAPI_PromoteClone_Call {
+	return &DatasetAPI_PromoteClone_Call{Call: _e.mock.On("PromoteClone", ctx, id)}
+}
+
+func (_c *DatasetAPI_PromoteClone_Call) Run(run func(ctx context.Context, id string)) *DatasetAPI_PromoteClone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_PromoteClone_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_PromoteClone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_PromoteClone_Call) RunAndReturn(run func(context.Context, string) (*truenas.Dataset, error)) *DatasetAPI_PromoteClone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Snapshot provides a mock function with given fields: ctx, req
+func (_m *DatasetAPI) Snapshot(ctx context.Context, req truenas.DatasetSnapshotRequest) (any, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Snapshot")
+	}
+
+	var r0 any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.DatasetSnapshotRequest) (any, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.DatasetSnapshotRequest) any); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.DatasetSnapshotRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_Snapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Snapshot'
+type DatasetAPI_Snapshot_Call struct {
+	*mock.Call
+}
+
+// Snapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.DatasetSnapshotRequest
+func (_e *DatasetAPI_Expecter) Snapshot(ctx interface{}, req interface{}) *DatasetAPI_Snapshot_Call {
+	return &DatasetAPI_Snapshot_Call{Call: _e.mock.On("Snapshot", ctx, req)}
+}
+
+func (_c *DatasetAPI_Snapshot_Call) Run(run func(ctx context.Context, req truenas.DatasetSnapshotRequest)) *DatasetAPI_Snapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.DatasetSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Snapshot_Call) Return(_a0 any, _a1 error) *DatasetAPI_Snapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_Snapshot_Call) RunAndReturn(run func(context.Context, truenas.DatasetSnapshotRequest) (any, error)) *DatasetAPI_Snapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Tree provides a mock function with given fields: ctx, root
+func (_m *DatasetAPI) Tree(ctx context.Context, root string) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, root)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Tree")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Dataset, error)); ok {
+		return rf(ctx, root)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Dataset); ok {
+		r0 = rf(ctx, root)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, root)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_Tree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Tree'
+type DatasetAPI_Tree_Call struct {
+	*mock.Call
+}
+
+// Tree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - root string
+func (_e *DatasetAPI_Expecter) Tree(ctx interface{}, root interface{}) *DatasetAPI_Tree_Call {
+	return &DatasetAPI_Tree_Call{Call: _e.mock.On("Tree", ctx, root)}
+}
+
+func (_c *DatasetAPI_Tree_Call) Run(run func(ctx context.Context, root string)) *DatasetAPI_Tree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Tree_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_Tree_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_Tree_Call) RunAndReturn(run func(context.Context, string) (*truenas.Dataset, error)) *DatasetAPI_Tree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unlock provides a mock function with given fields: ctx, id, req
+func (_m *DatasetAPI) Unlock(ctx context.Context, id string, req truenas.DatasetUnlockRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetUnlockRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DatasetAPI_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type DatasetAPI_Unlock_Call struct {
+	*mock.Call
+}
+
+// Unlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req truenas.DatasetUnlockRequest
+func (_e *DatasetAPI_Expecter) Unlock(ctx interface{}, id interface{}, req interface{}) *DatasetAPI_Unlock_Call {
+	return &DatasetAPI_Unlock_Call{Call: _e.mock.On("Unlock", ctx, id, req)}
+}
+
+func (_c *DatasetAPI_Unlock_Call) Run(run func(ctx context.Context, id string, req truenas.DatasetUnlockRequest)) *DatasetAPI_Unlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.DatasetUnlockRequest))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Unlock_Call) Return(_a0 error) *DatasetAPI_Unlock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_Unlock_Call) RunAndReturn(run func(context.Context, string, truenas.DatasetUnlockRequest) error) *DatasetAPI_Unlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlockWithKeyFile provides a mock function with given fields: ctx, id, req, keyFile
+func (_m *DatasetAPI) UnlockWithKeyFile(ctx context.Context, id string, req truenas.DatasetUnlockRequest, keyFile io.Reader) (*truenas.DatasetUnlockResult, error) {
+	ret := _m.Called(ctx, id, req, keyFile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlockWithKeyFile")
+	}
+
+	var r0 *truenas.DatasetUnlockResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetUnlockRequest, io.Reader) (*truenas.DatasetUnlockResult, error)); ok {
+		return rf(ctx, id, req, keyFile)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetUnlockRequest, io.Reader) *truenas.DatasetUnlockResult); ok {
+		r0 = rf(ctx, id, req, keyFile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.DatasetUnlockResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, truenas.DatasetUnlockRequest, io.Reader) error); ok {
+		r1 = rf(ctx, id, req, keyFile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_UnlockWithKeyFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockWithKeyFile'
+type DatasetAPI_UnlockWithKeyFile_Call struct {
+	*mock.Call
+}
+
+// UnlockWithKeyFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req truenas.DatasetUnlockRequest
+//   - keyFile io.Reader
+func (_e *DatasetAPI_Expecter) UnlockWithKeyFile(ctx interface{}, id interface{}, req interface{}, keyFile interface{}) *DatasetAPI_UnlockWithKeyFile_Call {
+	return &DatasetAPI_UnlockWithKeyFile_Call{Call: _e.mock.On("UnlockWithKeyFile", ctx, id, req, keyFile)}
+}
+
+func (_c *DatasetAPI_UnlockWithKeyFile_Call) Run(run func(ctx context.Context, id string, req truenas.DatasetUnlockRequest, keyFile io.Reader)) *DatasetAPI_UnlockWithKeyFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.DatasetUnlockRequest), args[3].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_UnlockWithKeyFile_Call) Return(_a0 *truenas.DatasetUnlockResult, _a1 error) *DatasetAPI_UnlockWithKeyFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_UnlockWithKeyFile_Call) RunAndReturn(run func(context.Context, string, truenas.DatasetUnlockRequest, io.Reader) (*truenas.DatasetUnlockResult, error)) *DatasetAPI_UnlockWithKeyFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmount provides a mock function with given fields: ctx, id, force
+func (_m *DatasetAPI) Unmount(ctx context.Context, id string, force bool) error {
+	ret := _m.Called(ctx, id, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, id, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DatasetAPI_Unmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmount'
+type DatasetAPI_Unmount_Call struct {
+	*mock.Call
+}
+
+// Unmount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - force bool
+func (_e *DatasetAPI_Expecter) Unmount(ctx interface{}, id interface{}, force interface{}) *DatasetAPI_Unmount_Call {
+	return &DatasetAPI_Unmount_Call{Call: _e.mock.On("Unmount", ctx, id, force)}
+}
+
+func (_c *DatasetAPI_Unmount_Call) Run(run func(ctx context.Context, id string, force bool)) *DatasetAPI_Unmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Unmount_Call) Return(_a0 error) *DatasetAPI_Unmount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DatasetAPI_Unmount_Call) RunAndReturn(run func(context.Context, string, bool) error) *DatasetAPI_Unmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *DatasetAPI) Update(ctx context.Context, id string, req truenas.DatasetUpdateRequest) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetUpdateRequest) (*truenas.Dataset, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.DatasetUpdateRequest) *truenas.Dataset); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, truenas.DatasetUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type DatasetAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req truenas.DatasetUpdateRequest
+func (_e *DatasetAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *DatasetAPI_Update_Call {
+	return &DatasetAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *DatasetAPI_Update_Call) Run(run func(ctx context.Context, id string, req truenas.DatasetUpdateRequest)) *DatasetAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.DatasetUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_Update_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_Update_Call) RunAndReturn(run func(context.Context, string, truenas.DatasetUpdateRequest) (*truenas.Dataset, error)) *DatasetAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProperties provides a mock function with given fields: ctx, id, props
+func (_m *DatasetAPI) UpdateProperties(ctx context.Context, id string, props map[truenas.DatasetPropertyName]any) (*truenas.Dataset, error) {
+	ret := _m.Called(ctx, id, props)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProperties")
+	}
+
+	var r0 *truenas.Dataset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[truenas.DatasetPropertyName]any) (*truenas.Dataset, error)); ok {
+		return rf(ctx, id, props)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[truenas.DatasetPropertyName]any) *truenas.Dataset); ok {
+		r0 = rf(ctx, id, props)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Dataset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[truenas.DatasetPropertyName]any) error); ok {
+		r1 = rf(ctx, id, props)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DatasetAPI_UpdateProperties_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProperties'
+type DatasetAPI_UpdateProperties_Call struct {
+	*mock.Call
+}
+
+// UpdateProperties is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - props map[truenas.DatasetPropertyName]any
+func (_e *DatasetAPI_Expecter) UpdateProperties(ctx interface{}, id interface{}, props interface{}) *DatasetAPI_UpdateProperties_Call {
+	return &DatasetAPI_UpdateProperties_Call{Call: _e.mock.On("UpdateProperties", ctx, id, props)}
+}
+
+func (_c *DatasetAPI_UpdateProperties_Call) Run(run func(ctx context.Context, id string, props map[truenas.DatasetPropertyName]any)) *DatasetAPI_UpdateProperties_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[truenas.DatasetPropertyName]any))
+	})
+	return _c
+}
+
+func (_c *DatasetAPI_UpdateProperties_Call) Return(_a0 *truenas.Dataset, _a1 error) *DatasetAPI_UpdateProperties_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DatasetAPI_UpdateProperties_Call) RunAndReturn(run func(context.Context, string, map[truenas.DatasetPropertyName]any) (*truenas.Dataset, error)) *DatasetAPI_UpdateProperties_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDatasetAPI creates a new instance of DatasetAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDatasetAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DatasetAPI {
+	mock := &DatasetAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
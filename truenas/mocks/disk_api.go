@@ -0,0 +1,1151 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// DiskAPI is an autogenerated mock type for the DiskAPI type
+type DiskAPI struct {
+	mock.Mock
+}
+
+type DiskAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DiskAPI) EXPECT() *DiskAPI_Expecter {
+	return &DiskAPI_Expecter{mock: &_m.Mock}
+}
+
+// Decrypt provides a mock function with given fields: ctx, req
+func (_m *DiskAPI) Decrypt(ctx context.Context, req *truenas.DecryptRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Decrypt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DecryptRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiskAPI_Decrypt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Decrypt'
+type DiskAPI_Decrypt_Call struct {
+	*mock.Call
+}
+
+// Decrypt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.DecryptRequest
+func (_e *DiskAPI_Expecter) Decrypt(ctx interface{}, req interface{}) *DiskAPI_Decrypt_Call {
+	return &DiskAPI_Decrypt_Call{Call: _e.mock.On("Decrypt", ctx, req)}
+}
+
+func (_c *DiskAPI_Decrypt_Call) Run(run func(ctx context.Context, req *truenas.DecryptRequest)) *DiskAPI_Decrypt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.DecryptRequest))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_Decrypt_Call) Return(_a0 error) *DiskAPI_Decrypt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiskAPI_Decrypt_Call) RunAndReturn(run func(context.Context, *truenas.DecryptRequest) error) *DiskAPI_Decrypt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *DiskAPI) Get(ctx context.Context, id string) (*truenas.Disk, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Disk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Disk, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Disk); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Disk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type DiskAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *DiskAPI_Expecter) Get(ctx interface{}, id interface{}) *DiskAPI_Get_Call {
+	return &DiskAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *DiskAPI_Get_Call) Run(run func(ctx context.Context, id string)) *DiskAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_Get_Call) Return(_a0 *truenas.Disk, _a1 error) *DiskAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_Get_Call) RunAndReturn(run func(context.Context, string) (*truenas.Disk, error)) *DiskAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEncrypted provides a mock function with given fields: ctx, includeUnused
+func (_m *DiskAPI) GetEncrypted(ctx context.Context, includeUnused bool) ([]truenas.EncryptedDevice, error) {
+	ret := _m.Called(ctx, includeUnused)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEncrypted")
+	}
+
+	var r0 []truenas.EncryptedDevice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]truenas.EncryptedDevice, error)); ok {
+		return rf(ctx, includeUnused)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []truenas.EncryptedDevice); ok {
+		r0 = rf(ctx, includeUnused)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.EncryptedDevice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, includeUnused)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_GetEncrypted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEncrypted'
+type DiskAPI_GetEncrypted_Call struct {
+	*mock.Call
+}
+
+// GetEncrypted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeUnused bool
+func (_e *DiskAPI_Expecter) GetEncrypted(ctx interface{}, includeUnused interface{}) *DiskAPI_GetEncrypted_Call {
+	return &DiskAPI_GetEncrypted_Call{Call: _e.mock.On("GetEncrypted", ctx, includeUnused)}
+}
+
+func (_c *DiskAPI_GetEncrypted_Call) Run(run func(ctx context.Context, includeUnused bool)) *DiskAPI_GetEncrypted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_GetEncrypted_Call) Return(_a0 []truenas.EncryptedDevice, _a1 error) *DiskAPI_GetEncrypted_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_GetEncrypted_Call) RunAndReturn(run func(context.Context, bool) ([]truenas.EncryptedDevice, error)) *DiskAPI_GetEncrypted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSedDevName provides a mock function with given fields: ctx, deviceName
+func (_m *DiskAPI) GetSedDevName(ctx context.Context, deviceName string) (string, error) {
+	ret := _m.Called(ctx, deviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSedDevName")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, deviceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, deviceName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deviceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_GetSedDevName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSedDevName'
+type DiskAPI_GetSedDevName_Call struct {
+	*mock.Call
+}
+
+// GetSedDevName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceName string
+func (_e *DiskAPI_Expecter) GetSedDevName(ctx interface{}, deviceName interface{}) *DiskAPI_GetSedDevName_Call {
+	return &DiskAPI_GetSedDevName_Call{Call: _e.mock.On("GetSedDevName", ctx, deviceName)}
+}
+
+func (_c *DiskAPI_GetSedDevName_Call) Run(run func(ctx context.Context, deviceName string)) *DiskAPI_GetSedDevName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_GetSedDevName_Call) Return(_a0 string, _a1 error) *DiskAPI_GetSedDevName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_GetSedDevName_Call) RunAndReturn(run func(context.Context, string) (string, error)) *DiskAPI_GetSedDevName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSmartAttributes provides a mock function with given fields: ctx, deviceName
+func (_m *DiskAPI) GetSmartAttributes(ctx context.Context, deviceName string) ([]truenas.SmartAttribute, error) {
+	ret := _m.Called(ctx, deviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSmartAttributes")
+	}
+
+	var r0 []truenas.SmartAttribute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]truenas.SmartAttribute, error)); ok {
+		return rf(ctx, deviceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []truenas.SmartAttribute); ok {
+		r0 = rf(ctx, deviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SmartAttribute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, deviceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_GetSmartAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSmartAttributes'
+type DiskAPI_GetSmartAttributes_Call struct {
+	*mock.Call
+}
+
+// GetSmartAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceName string
+func (_e *DiskAPI_Expecter) GetSmartAttributes(ctx interface{}, deviceName interface{}) *DiskAPI_GetSmartAttributes_Call {
+	return &DiskAPI_GetSmartAttributes_Call{Call: _e.mock.On("GetSmartAttributes", ctx, deviceName)}
+}
+
+func (_c *DiskAPI_GetSmartAttributes_Call) Run(run func(ctx context.Context, deviceName string)) *DiskAPI_GetSmartAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_GetSmartAttributes_Call) Return(_a0 []truenas.SmartAttribute, _a1 error) *DiskAPI_GetSmartAttributes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_GetSmartAttributes_Call) RunAndReturn(run func(context.Context, string) ([]truenas.SmartAttribute, error)) *DiskAPI_GetSmartAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemperature provides a mock function with given fields: ctx, deviceName, powerMode
+func (_m *DiskAPI) GetTemperature(ctx context.Context, deviceName string, powerMode truenas.PowerMode) (*truenas.DiskTemperature, error) {
+	ret := _m.Called(ctx, deviceName, powerMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemperature")
+	}
+
+	var r0 *truenas.DiskTemperature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.PowerMode) (*truenas.DiskTemperature, error)); ok {
+		return rf(ctx, deviceName, powerMode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.PowerMode) *truenas.DiskTemperature); ok {
+		r0 = rf(ctx, deviceName, powerMode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.DiskTemperature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, truenas.PowerMode) error); ok {
+		r1 = rf(ctx, deviceName, powerMode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_GetTemperature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemperature'
+type DiskAPI_GetTemperature_Call struct {
+	*mock.Call
+}
+
+// GetTemperature is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceName string
+//   - powerMode truenas.PowerMode
+func (_e *DiskAPI_Expecter) GetTemperature(ctx interface{}, deviceName interface{}, powerMode interface{}) *DiskAPI_GetTemperature_Call {
+	return &DiskAPI_GetTemperature_Call{Call: _e.mock.On("GetTemperature", ctx, deviceName, powerMode)}
+}
+
+func (_c *DiskAPI_GetTemperature_Call) Run(run func(ctx context.Context, deviceName string, powerMode truenas.PowerMode)) *DiskAPI_GetTemperature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.PowerMode))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_GetTemperature_Call) Return(_a0 *truenas.DiskTemperature, _a1 error) *DiskAPI_GetTemperature_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_GetTemperature_Call) RunAndReturn(run func(context.Context, string, truenas.PowerMode) (*truenas.DiskTemperature, error)) *DiskAPI_GetTemperature_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTemperatures provides a mock function with given fields: ctx, deviceNames, powerMode
+func (_m *DiskAPI) GetTemperatures(ctx context.Context, deviceNames []string, powerMode truenas.PowerMode) ([]truenas.DiskTemperature, error) {
+	ret := _m.Called(ctx, deviceNames, powerMode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTemperatures")
+	}
+
+	var r0 []truenas.DiskTemperature
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, truenas.PowerMode) ([]truenas.DiskTemperature, error)); ok {
+		return rf(ctx, deviceNames, powerMode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, truenas.PowerMode) []truenas.DiskTemperature); ok {
+		r0 = rf(ctx, deviceNames, powerMode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.DiskTemperature)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, truenas.PowerMode) error); ok {
+		r1 = rf(ctx, deviceNames, powerMode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_GetTemperatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTemperatures'
+type DiskAPI_GetTemperatures_Call struct {
+	*mock.Call
+}
+
+// GetTemperatures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceNames []string
+//   - powerMode truenas.PowerMode
+func (_e *DiskAPI_Expecter) GetTemperatures(ctx interface{}, deviceNames interface{}, powerMode interface{}) *DiskAPI_GetTemperatures_Call {
+	return &DiskAPI_GetTemperatures_Call{Call: _e.mock.On("GetTemperatures", ctx, deviceNames, powerMode)}
+}
+
+func (_c *DiskAPI_GetTemperatures_Call) Run(run func(ctx context.Context, deviceNames []string, powerMode truenas.PowerMode)) *DiskAPI_GetTemperatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(truenas.PowerMode))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_GetTemperatures_Call) Return(_a0 []truenas.DiskTemperature, _a1 error) *DiskAPI_GetTemperatures_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_GetTemperatures_Call) RunAndReturn(run func(context.Context, []string, truenas.PowerMode) ([]truenas.DiskTemperature, error)) *DiskAPI_GetTemperatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnused provides a mock function with given fields: ctx, joinPartitions
+func (_m *DiskAPI) GetUnused(ctx context.Context, joinPartitions bool) ([]truenas.UnusedDisk, error) {
+	ret := _m.Called(ctx, joinPartitions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnused")
+	}
+
+	var r0 []truenas.UnusedDisk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]truenas.UnusedDisk, error)); ok {
+		return rf(ctx, joinPartitions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []truenas.UnusedDisk); ok {
+		r0 = rf(ctx, joinPartitions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.UnusedDisk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, joinPartitions)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_GetUnused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnused'
+type DiskAPI_GetUnused_Call struct {
+	*mock.Call
+}
+
+// GetUnused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - joinPartitions bool
+func (_e *DiskAPI_Expecter) GetUnused(ctx interface{}, joinPartitions interface{}) *DiskAPI_GetUnused_Call {
+	return &DiskAPI_GetUnused_Call{Call: _e.mock.On("GetUnused", ctx, joinPartitions)}
+}
+
+func (_c *DiskAPI_GetUnused_Call) Run(run func(ctx context.Context, joinPartitions bool)) *DiskAPI_GetUnused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_GetUnused_Call) Return(_a0 []truenas.UnusedDisk, _a1 error) *DiskAPI_GetUnused_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_GetUnused_Call) RunAndReturn(run func(context.Context, bool) ([]truenas.UnusedDisk, error)) *DiskAPI_GetUnused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LabelToDev provides a mock function with given fields: ctx, label
+func (_m *DiskAPI) LabelToDev(ctx context.Context, label string) (string, error) {
+	ret := _m.Called(ctx, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LabelToDev")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, label)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, label)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, label)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_LabelToDev_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LabelToDev'
+type DiskAPI_LabelToDev_Call struct {
+	*mock.Call
+}
+
+// LabelToDev is a helper method to define mock.On call
+//   - ctx context.Context
+//   - label string
+func (_e *DiskAPI_Expecter) LabelToDev(ctx interface{}, label interface{}) *DiskAPI_LabelToDev_Call {
+	return &DiskAPI_LabelToDev_Call{Call: _e.mock.On("LabelToDev", ctx, label)}
+}
+
+func (_c *DiskAPI_LabelToDev_Call) Run(run func(ctx context.Context, label string)) *DiskAPI_LabelToDev_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_LabelToDev_Call) Return(_a0 string, _a1 error) *DiskAPI_LabelToDev_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_LabelToDev_Call) RunAndReturn(run func(context.Context, string) (string, error)) *DiskAPI_LabelToDev_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *DiskAPI) List(ctx context.Context) ([]truenas.Disk, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Disk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Disk, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Disk); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Disk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type DiskAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DiskAPI_Expecter) List(ctx interface{}) *DiskAPI_List_Call {
+	return &DiskAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *DiskAPI_List_Call) Run(run func(ctx context.Context)) *DiskAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_List_Call) Return(_a0 []truenas.Disk, _a1 error) *DiskAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Disk, error)) *DiskAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *DiskAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Disk, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Disk, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Disk, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Disk, error])
+		}
+	}
+
+	return r0
+}
+
+// DiskAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type DiskAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *DiskAPI_Expecter) ListIter(ctx interface{}, q interface{}) *DiskAPI_ListIter_Call {
+	return &DiskAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *DiskAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *DiskAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Disk, error]) *DiskAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiskAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Disk, error]) *DiskAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUnused provides a mock function with given fields: ctx, join
+func (_m *DiskAPI) ListUnused(ctx context.Context, join bool) ([]truenas.UnusedDisk, error) {
+	ret := _m.Called(ctx, join)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnused")
+	}
+
+	var r0 []truenas.UnusedDisk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]truenas.UnusedDisk, error)); ok {
+		return rf(ctx, join)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []truenas.UnusedDisk); ok {
+		r0 = rf(ctx, join)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.UnusedDisk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, join)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_ListUnused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnused'
+type DiskAPI_ListUnused_Call struct {
+	*mock.Call
+}
+
+// ListUnused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - join bool
+func (_e *DiskAPI_Expecter) ListUnused(ctx interface{}, join interface{}) *DiskAPI_ListUnused_Call {
+	return &DiskAPI_ListUnused_Call{Call: _e.mock.On("ListUnused", ctx, join)}
+}
+
+func (_c *DiskAPI_ListUnused_Call) Run(run func(ctx context.Context, join bool)) *DiskAPI_ListUnused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_ListUnused_Call) Return(_a0 []truenas.UnusedDisk, _a1 error) *DiskAPI_ListUnused_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_ListUnused_Call) RunAndReturn(run func(context.Context, bool) ([]truenas.UnusedDisk, error)) *DiskAPI_ListUnused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithOptions provides a mock function with given fields: ctx, opts
+func (_m *DiskAPI) ListWithOptions(ctx context.Context, opts *truenas.DiskQueryOptions) ([]truenas.Disk, error) {
+	ret := _m.Called(ctx, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithOptions")
+	}
+
+	var r0 []truenas.Disk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DiskQueryOptions) ([]truenas.Disk, error)); ok {
+		return rf(ctx, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DiskQueryOptions) []truenas.Disk); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Disk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.DiskQueryOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_ListWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithOptions'
+type DiskAPI_ListWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - opts *truenas.DiskQueryOptions
+func (_e *DiskAPI_Expecter) ListWithOptions(ctx interface{}, opts interface{}) *DiskAPI_ListWithOptions_Call {
+	return &DiskAPI_ListWithOptions_Call{Call: _e.mock.On("ListWithOptions", ctx, opts)}
+}
+
+func (_c *DiskAPI_ListWithOptions_Call) Run(run func(ctx context.Context, opts *truenas.DiskQueryOptions)) *DiskAPI_ListWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.DiskQueryOptions))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_ListWithOptions_Call) Return(_a0 []truenas.Disk, _a1 error) *DiskAPI_ListWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_ListWithOptions_Call) RunAndReturn(run func(context.Context, *truenas.DiskQueryOptions) ([]truenas.Disk, error)) *DiskAPI_ListWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *DiskAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Disk, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Disk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Disk, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Disk); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Disk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type DiskAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *DiskAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *DiskAPI_ListWithQuery_Call {
+	return &DiskAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *DiskAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *DiskAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_ListWithQuery_Call) Return(_a0 []truenas.Disk, _a1 error) *DiskAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Disk, error)) *DiskAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Overprovision provides a mock function with given fields: ctx, deviceName, size
+func (_m *DiskAPI) Overprovision(ctx context.Context, deviceName string, size int64) error {
+	ret := _m.Called(ctx, deviceName, size)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Overprovision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, deviceName, size)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiskAPI_Overprovision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Overprovision'
+type DiskAPI_Overprovision_Call struct {
+	*mock.Call
+}
+
+// Overprovision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceName string
+//   - size int64
+func (_e *DiskAPI_Expecter) Overprovision(ctx interface{}, deviceName interface{}, size interface{}) *DiskAPI_Overprovision_Call {
+	return &DiskAPI_Overprovision_Call{Call: _e.mock.On("Overprovision", ctx, deviceName, size)}
+}
+
+func (_c *DiskAPI_Overprovision_Call) Run(run func(ctx context.Context, deviceName string, size int64)) *DiskAPI_Overprovision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_Overprovision_Call) Return(_a0 error) *DiskAPI_Overprovision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiskAPI_Overprovision_Call) RunAndReturn(run func(context.Context, string, int64) error) *DiskAPI_Overprovision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Spindown provides a mock function with given fields: ctx, deviceName
+func (_m *DiskAPI) Spindown(ctx context.Context, deviceName string) error {
+	ret := _m.Called(ctx, deviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Spindown")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, deviceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiskAPI_Spindown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Spindown'
+type DiskAPI_Spindown_Call struct {
+	*mock.Call
+}
+
+// Spindown is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceName string
+func (_e *DiskAPI_Expecter) Spindown(ctx interface{}, deviceName interface{}) *DiskAPI_Spindown_Call {
+	return &DiskAPI_Spindown_Call{Call: _e.mock.On("Spindown", ctx, deviceName)}
+}
+
+func (_c *DiskAPI_Spindown_Call) Run(run func(ctx context.Context, deviceName string)) *DiskAPI_Spindown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_Spindown_Call) Return(_a0 error) *DiskAPI_Spindown_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiskAPI_Spindown_Call) RunAndReturn(run func(context.Context, string) error) *DiskAPI_Spindown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unoverprovision provides a mock function with given fields: ctx, deviceName
+func (_m *DiskAPI) Unoverprovision(ctx context.Context, deviceName string) error {
+	ret := _m.Called(ctx, deviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unoverprovision")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, deviceName)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiskAPI_Unoverprovision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unoverprovision'
+type DiskAPI_Unoverprovision_Call struct {
+	*mock.Call
+}
+
+// Unoverprovision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - deviceName string
+func (_e *DiskAPI_Expecter) Unoverprovision(ctx interface{}, deviceName interface{}) *DiskAPI_Unoverprovision_Call {
+	return &DiskAPI_Unoverprovision_Call{Call: _e.mock.On("Unoverprovision", ctx, deviceName)}
+}
+
+func (_c *DiskAPI_Unoverprovision_Call) Run(run func(ctx context.Context, deviceName string)) *DiskAPI_Unoverprovision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_Unoverprovision_Call) Return(_a0 error) *DiskAPI_Unoverprovision_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiskAPI_Unoverprovision_Call) RunAndReturn(run func(context.Context, string) error) *DiskAPI_Unoverprovision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *DiskAPI) Update(ctx context.Context, id string, req *truenas.DiskUpdateRequest) (*truenas.Disk, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Disk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.DiskUpdateRequest) (*truenas.Disk, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.DiskUpdateRequest) *truenas.Disk); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Disk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.DiskUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type DiskAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - req *truenas.DiskUpdateRequest
+func (_e *DiskAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *DiskAPI_Update_Call {
+	return &DiskAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *DiskAPI_Update_Call) Run(run func(ctx context.Context, id string, req *truenas.DiskUpdateRequest)) *DiskAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.DiskUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_Update_Call) Return(_a0 *truenas.Disk, _a1 error) *DiskAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_Update_Call) RunAndReturn(run func(context.Context, string, *truenas.DiskUpdateRequest) (*truenas.Disk, error)) *DiskAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForUnused provides a mock function with given fields: ctx, n, timeout
+func (_m *DiskAPI) WaitForUnused(ctx context.Context, n int, timeout time.Duration) ([]truenas.UnusedDisk, error) {
+	ret := _m.Called(ctx, n, timeout)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForUnused")
+	}
+
+	var r0 []truenas.UnusedDisk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) ([]truenas.UnusedDisk, error)); ok {
+		return rf(ctx, n, timeout)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Duration) []truenas.UnusedDisk); ok {
+		r0 = rf(ctx, n, timeout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.UnusedDisk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Duration) error); ok {
+		r1 = rf(ctx, n, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DiskAPI_WaitForUnused_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForUnused'
+type DiskAPI_WaitForUnused_Call struct {
+	*mock.Call
+}
+
+// WaitForUnused is a helper method to define mock.On call
+//   - ctx context.Context
+//   - n int
+//   - timeout time.Duration
+func (_e *DiskAPI_Expecter) WaitForUnused(ctx interface{}, n interface{}, timeout interface{}) *DiskAPI_WaitForUnused_Call {
+	return &DiskAPI_WaitForUnused_Call{Call: _e.mock.On("WaitForUnused", ctx, n, timeout)}
+}
+
+func (_c *DiskAPI_WaitForUnused_Call) Run(run func(ctx context.Context, n int, timeout time.Duration)) *DiskAPI_WaitForUnused_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_WaitForUnused_Call) Return(_a0 []truenas.UnusedDisk, _a1 error) *DiskAPI_WaitForUnused_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DiskAPI_WaitForUnused_Call) RunAndReturn(run func(context.Context, int, time.Duration) ([]truenas.UnusedDisk, error)) *DiskAPI_WaitForUnused_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wipe provides a mock function with given fields: ctx, req
+func (_m *DiskAPI) Wipe(ctx context.Context, req *truenas.WipeRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wipe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.WipeRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DiskAPI_Wipe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wipe'
+type DiskAPI_Wipe_Call struct {
+	*mock.Call
+}
+
+// Wipe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.WipeRequest
+func (_e *DiskAPI_Expecter) Wipe(ctx interface{}, req interface{}) *DiskAPI_Wipe_Call {
+	return &DiskAPI_Wipe_Call{Call: _e.mock.On("Wipe", ctx, req)}
+}
+
+func (_c *DiskAPI_Wipe_Call) Run(run func(ctx context.Context, req *truenas.WipeRequest)) *DiskAPI_Wipe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.WipeRequest))
+	})
+	return _c
+}
+
+func (_c *DiskAPI_Wipe_Call) Return(_a0 error) *DiskAPI_Wipe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DiskAPI_Wipe_Call) RunAndReturn(run func(context.Context, *truenas.WipeRequest) error) *DiskAPI_Wipe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDiskAPI creates a new instance of DiskAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDiskAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DiskAPI {
+	mock := &DiskAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
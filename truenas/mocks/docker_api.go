@@ -0,0 +1,212 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DockerAPI is an autogenerated mock type for the DockerAPI type
+type DockerAPI struct {
+	mock.Mock
+}
+
+type DockerAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DockerAPI) EXPECT() *DockerAPI_Expecter {
+	return &DockerAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *DockerAPI) GetConfig(ctx context.Context) (*truenas.DockerConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.DockerConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.DockerConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.DockerConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.DockerConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DockerAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type DockerAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DockerAPI_Expecter) GetConfig(ctx interface{}) *DockerAPI_GetConfig_Call {
+	return &DockerAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *DockerAPI_GetConfig_Call) Run(run func(ctx context.Context)) *DockerAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DockerAPI_GetConfig_Call) Return(_a0 *truenas.DockerConfig, _a1 error) *DockerAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DockerAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.DockerConfig, error)) *DockerAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with given fields: ctx
+func (_m *DockerAPI) Status(ctx context.Context) (*truenas.DockerStatus, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 *truenas.DockerStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.DockerStatus, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.DockerStatus); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.DockerStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DockerAPI_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type DockerAPI_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DockerAPI_Expecter) Status(ctx interface{}) *DockerAPI_Status_Call {
+	return &DockerAPI_Status_Call{Call: _e.mock.On("Status", ctx)}
+}
+
+func (_c *DockerAPI_Status_Call) Run(run func(ctx context.Context)) *DockerAPI_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DockerAPI_Status_Call) Return(_a0 *truenas.DockerStatus, _a1 error) *DockerAPI_Status_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DockerAPI_Status_Call) RunAndReturn(run func(context.Context) (*truenas.DockerStatus, error)) *DockerAPI_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, req
+func (_m *DockerAPI) UpdateConfig(ctx context.Context, req *truenas.DockerUpdateRequest) (*truenas.DockerConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.DockerConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DockerUpdateRequest) (*truenas.DockerConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DockerUpdateRequest) *truenas.DockerConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.DockerConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.DockerUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DockerAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type DockerAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.DockerUpdateRequest
+func (_e *DockerAPI_Expecter) UpdateConfig(ctx interface{}, req interface{}) *DockerAPI_UpdateConfig_Call {
+	return &DockerAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, req)}
+}
+
+func (_c *DockerAPI_UpdateConfig_Call) Run(run func(ctx context.Context, req *truenas.DockerUpdateRequest)) *DockerAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.DockerUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *DockerAPI_UpdateConfig_Call) Return(_a0 *truenas.DockerConfig, _a1 error) *DockerAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DockerAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.DockerUpdateRequest) (*truenas.DockerConfig, error)) *DockerAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDockerAPI creates a new instance of DockerAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDockerAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DockerAPI {
+	mock := &DockerAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,270 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DynDNSAPI is an autogenerated mock type for the DynDNSAPI type
+type DynDNSAPI struct {
+	mock.Mock
+}
+
+type DynDNSAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *DynDNSAPI) EXPECT() *DynDNSAPI_Expecter {
+	return &DynDNSAPI_Expecter{mock: &_m.Mock}
+}
+
+// CheckIPChoices provides a mock function with given fields: ctx
+func (_m *DynDNSAPI) CheckIPChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckIPChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DynDNSAPI_CheckIPChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckIPChoices'
+type DynDNSAPI_CheckIPChoices_Call struct {
+	*mock.Call
+}
+
+// CheckIPChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DynDNSAPI_Expecter) CheckIPChoices(ctx interface{}) *DynDNSAPI_CheckIPChoices_Call {
+	return &DynDNSAPI_CheckIPChoices_Call{Call: _e.mock.On("CheckIPChoices", ctx)}
+}
+
+func (_c *DynDNSAPI_CheckIPChoices_Call) Run(run func(ctx context.Context)) *DynDNSAPI_CheckIPChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DynDNSAPI_CheckIPChoices_Call) Return(_a0 map[string]string, _a1 error) *DynDNSAPI_CheckIPChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DynDNSAPI_CheckIPChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *DynDNSAPI_CheckIPChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *DynDNSAPI) GetConfig(ctx context.Context) (*truenas.DynDNSConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.DynDNSConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.DynDNSConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.DynDNSConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.DynDNSConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DynDNSAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type DynDNSAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DynDNSAPI_Expecter) GetConfig(ctx interface{}) *DynDNSAPI_GetConfig_Call {
+	return &DynDNSAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *DynDNSAPI_GetConfig_Call) Run(run func(ctx context.Context)) *DynDNSAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DynDNSAPI_GetConfig_Call) Return(_a0 *truenas.DynDNSConfig, _a1 error) *DynDNSAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DynDNSAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.DynDNSConfig, error)) *DynDNSAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderChoices provides a mock function with given fields: ctx
+func (_m *DynDNSAPI) ProviderChoices(ctx context.Context) (map[truenas.DynDNSProvider]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProviderChoices")
+	}
+
+	var r0 map[truenas.DynDNSProvider]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[truenas.DynDNSProvider]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[truenas.DynDNSProvider]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[truenas.DynDNSProvider]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DynDNSAPI_ProviderChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderChoices'
+type DynDNSAPI_ProviderChoices_Call struct {
+	*mock.Call
+}
+
+// ProviderChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DynDNSAPI_Expecter) ProviderChoices(ctx interface{}) *DynDNSAPI_ProviderChoices_Call {
+	return &DynDNSAPI_ProviderChoices_Call{Call: _e.mock.On("ProviderChoices", ctx)}
+}
+
+func (_c *DynDNSAPI_ProviderChoices_Call) Run(run func(ctx context.Context)) *DynDNSAPI_ProviderChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DynDNSAPI_ProviderChoices_Call) Return(_a0 map[truenas.DynDNSProvider]string, _a1 error) *DynDNSAPI_ProviderChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DynDNSAPI_ProviderChoices_Call) RunAndReturn(run func(context.Context) (map[truenas.DynDNSProvider]string, error)) *DynDNSAPI_ProviderChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, config
+func (_m *DynDNSAPI) UpdateConfig(ctx context.Context, config *truenas.DynDNSConfig) (*truenas.DynDNSConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.DynDNSConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DynDNSConfig) (*truenas.DynDNSConfig, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.DynDNSConfig) *truenas.DynDNSConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.DynDNSConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.DynDNSConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DynDNSAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type DynDNSAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *truenas.DynDNSConfig
+func (_e *DynDNSAPI_Expecter) UpdateConfig(ctx interface{}, config interface{}) *DynDNSAPI_UpdateConfig_Call {
+	return &DynDNSAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, config)}
+}
+
+func (_c *DynDNSAPI_UpdateConfig_Call) Run(run func(ctx context.Context, config *truenas.DynDNSConfig)) *DynDNSAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.DynDNSConfig))
+	})
+	return _c
+}
+
+func (_c *DynDNSAPI_UpdateConfig_Call) Return(_a0 *truenas.DynDNSConfig, _a1 error) *DynDNSAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DynDNSAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.DynDNSConfig) (*truenas.DynDNSConfig, error)) *DynDNSAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewDynDNSAPI creates a new instance of DynDNSAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDynDNSAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DynDNSAPI {
+	mock := &DynDNSAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,40 @@
+package mocks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/715d/go-truenas/truenas"
+	"github.com/715d/go-truenas/truenas/mocks"
+)
+
+// shareHome is code under test that depends on interfaces rather than the concrete clients
+func shareHome(ctx context.Context, fs truenas.FilesystemAPI, smb truenas.SharingSMBAPI, user string) error {
+	dir := "/mnt/tank/home/" + user
+	if _, err := fs.Mkdir(ctx, dir, "700"); err != nil {
+		return fmt.Errorf("create home: %w", err)
+	}
+	_, err := smb.Create(ctx, &truenas.SMBShareRequest{Path: dir, Name: user})
+	return err
+}
+
+func TestMocks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	fs := mocks.NewFilesystemAPI(t)
+	smb := mocks.NewSharingSMBAPI(t)
+
+	fs.EXPECT().Mkdir(ctx, "/mnt/tank/home/alice", "700").Return(&truenas.DirEntry{Name: "alice"}, nil)
+	smb.EXPECT().Create(ctx, mock.MatchedBy(func(req *truenas.SMBShareRequest) bool {
+		return req.Path == "/mnt/tank/home/alice"
+	})).Return(&truenas.SMBShare{ID: 1, Name: "alice"}, nil)
+	assert.NoError(t, shareHome(ctx, fs, smb, "alice"))
+
+	fs.EXPECT().Mkdir(ctx, "/mnt/tank/home/bob", "700").Return(nil, errors.New("permission denied"))
+	assert.ErrorContains(t, shareHome(ctx, fs, smb, "bob"), "permission denied")
+}
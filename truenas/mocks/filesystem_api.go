@@ -0,0 +1,1281 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	fs "io/fs"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// FilesystemAPI is an autogenerated mock type for the FilesystemAPI type
+type FilesystemAPI struct {
+	mock.Mock
+}
+
+type FilesystemAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FilesystemAPI) EXPECT() *FilesystemAPI_Expecter {
+	return &FilesystemAPI_Expecter{mock: &_m.Mock}
+}
+
+// ChangeOwner provides a mock function with given fields: ctx, req
+func (_m *FilesystemAPI) ChangeOwner(ctx context.Context, req *truenas.ChownRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeOwner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ChownRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_ChangeOwner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeOwner'
+type FilesystemAPI_ChangeOwner_Call struct {
+	*mock.Call
+}
+
+// ChangeOwner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.ChownRequest
+func (_e *FilesystemAPI_Expecter) ChangeOwner(ctx interface{}, req interface{}) *FilesystemAPI_ChangeOwner_Call {
+	return &FilesystemAPI_ChangeOwner_Call{Call: _e.mock.On("ChangeOwner", ctx, req)}
+}
+
+func (_c *FilesystemAPI_ChangeOwner_Call) Run(run func(ctx context.Context, req *truenas.ChownRequest)) *FilesystemAPI_ChangeOwner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.ChownRequest))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_ChangeOwner_Call) Return(_a0 error) *FilesystemAPI_ChangeOwner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_ChangeOwner_Call) RunAndReturn(run func(context.Context, *truenas.ChownRequest) error) *FilesystemAPI_ChangeOwner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateDefaultACL provides a mock function with given fields: ctx, aclType
+func (_m *FilesystemAPI) CreateDefaultACL(ctx context.Context, aclType truenas.DefaultACLType) (*truenas.ACL, error) {
+	ret := _m.Called(ctx, aclType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDefaultACL")
+	}
+
+	var r0 *truenas.ACL
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.DefaultACLType) (*truenas.ACL, error)); ok {
+		return rf(ctx, aclType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.DefaultACLType) *truenas.ACL); ok {
+		r0 = rf(ctx, aclType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ACL)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.DefaultACLType) error); ok {
+		r1 = rf(ctx, aclType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_CreateDefaultACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDefaultACL'
+type FilesystemAPI_CreateDefaultACL_Call struct {
+	*mock.Call
+}
+
+// CreateDefaultACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - aclType truenas.DefaultACLType
+func (_e *FilesystemAPI_Expecter) CreateDefaultACL(ctx interface{}, aclType interface{}) *FilesystemAPI_CreateDefaultACL_Call {
+	return &FilesystemAPI_CreateDefaultACL_Call{Call: _e.mock.On("CreateDefaultACL", ctx, aclType)}
+}
+
+func (_c *FilesystemAPI_CreateDefaultACL_Call) Run(run func(ctx context.Context, aclType truenas.DefaultACLType)) *FilesystemAPI_CreateDefaultACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.DefaultACLType))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_CreateDefaultACL_Call) Return(_a0 *truenas.ACL, _a1 error) *FilesystemAPI_CreateDefaultACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_CreateDefaultACL_Call) RunAndReturn(run func(context.Context, truenas.DefaultACLType) (*truenas.ACL, error)) *FilesystemAPI_CreateDefaultACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateShareACL provides a mock function with given fields: ctx, shareType
+func (_m *FilesystemAPI) CreateShareACL(ctx context.Context, shareType truenas.ShareType) (*truenas.ACL, error) {
+	ret := _m.Called(ctx, shareType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShareACL")
+	}
+
+	var r0 *truenas.ACL
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.ShareType) (*truenas.ACL, error)); ok {
+		return rf(ctx, shareType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.ShareType) *truenas.ACL); ok {
+		r0 = rf(ctx, shareType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ACL)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.ShareType) error); ok {
+		r1 = rf(ctx, shareType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_CreateShareACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShareACL'
+type FilesystemAPI_CreateShareACL_Call struct {
+	*mock.Call
+}
+
+// CreateShareACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - shareType truenas.ShareType
+func (_e *FilesystemAPI_Expecter) CreateShareACL(ctx interface{}, shareType interface{}) *FilesystemAPI_CreateShareACL_Call {
+	return &FilesystemAPI_CreateShareACL_Call{Call: _e.mock.On("CreateShareACL", ctx, shareType)}
+}
+
+func (_c *FilesystemAPI_CreateShareACL_Call) Run(run func(ctx context.Context, shareType truenas.ShareType)) *FilesystemAPI_CreateShareACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.ShareType))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_CreateShareACL_Call) Return(_a0 *truenas.ACL, _a1 error) *FilesystemAPI_CreateShareACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_CreateShareACL_Call) RunAndReturn(run func(context.Context, truenas.ShareType) (*truenas.ACL, error)) *FilesystemAPI_CreateShareACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Download provides a mock function with given fields: ctx, filePath, w
+func (_m *FilesystemAPI) Download(ctx context.Context, filePath string, w io.Writer) error {
+	ret := _m.Called(ctx, filePath, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Download")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Writer) error); ok {
+		r0 = rf(ctx, filePath, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
+type FilesystemAPI_Download_Call struct {
+	*mock.Call
+}
+
+// Download is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filePath string
+//   - w io.Writer
+func (_e *FilesystemAPI_Expecter) Download(ctx interface{}, filePath interface{}, w interface{}) *FilesystemAPI_Download_Call {
+	return &FilesystemAPI_Download_Call{Call: _e.mock.On("Download", ctx, filePath, w)}
+}
+
+func (_c *FilesystemAPI_Download_Call) Run(run func(ctx context.Context, filePath string, w io.Writer)) *FilesystemAPI_Download_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_Download_Call) Return(_a0 error) *FilesystemAPI_Download_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_Download_Call) RunAndReturn(run func(context.Context, string, io.Writer) error) *FilesystemAPI_Download_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnsureACL provides a mock function with given fields: ctx, req
+func (_m *FilesystemAPI) EnsureACL(ctx context.Context, req *truenas.SetACLRequest) (*truenas.ACLDiff, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnsureACL")
+	}
+
+	var r0 *truenas.ACLDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SetACLRequest) (*truenas.ACLDiff, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SetACLRequest) *truenas.ACLDiff); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ACLDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SetACLRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_EnsureACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnsureACL'
+type FilesystemAPI_EnsureACL_Call struct {
+	*mock.Call
+}
+
+// EnsureACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SetACLRequest
+func (_e *FilesystemAPI_Expecter) EnsureACL(ctx interface{}, req interface{}) *FilesystemAPI_EnsureACL_Call {
+	return &FilesystemAPI_EnsureACL_Call{Call: _e.mock.On("EnsureACL", ctx, req)}
+}
+
+func (_c *FilesystemAPI_EnsureACL_Call) Run(run func(ctx context.Context, req *truenas.SetACLRequest)) *FilesystemAPI_EnsureACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SetACLRequest))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_EnsureACL_Call) Return(_a0 *truenas.ACLDiff, _a1 error) *FilesystemAPI_EnsureACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_EnsureACL_Call) RunAndReturn(run func(context.Context, *truenas.SetACLRequest) (*truenas.ACLDiff, error)) *FilesystemAPI_EnsureACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FS provides a mock function with given fields: ctx, root
+func (_m *FilesystemAPI) FS(ctx context.Context, root string) fs.FS {
+	ret := _m.Called(ctx, root)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FS")
+	}
+
+	var r0 fs.FS
+	if rf, ok := ret.Get(0).(func(context.Context, string) fs.FS); ok {
+		r0 = rf(ctx, root)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(fs.FS)
+		}
+	}
+
+	return r0
+}
+
+// FilesystemAPI_FS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FS'
+type FilesystemAPI_FS_Call struct {
+	*mock.Call
+}
+
+// FS is a helper method to define mock.On call
+//   - ctx context.Context
+//   - root string
+func (_e *FilesystemAPI_Expecter) FS(ctx interface{}, root interface{}) *FilesystemAPI_FS_Call {
+	return &FilesystemAPI_FS_Call{Call: _e.mock.On("FS", ctx, root)}
+}
+
+func (_c *FilesystemAPI_FS_Call) Run(run func(ctx context.Context, root string)) *FilesystemAPI_FS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_FS_Call) Return(_a0 fs.FS) *FilesystemAPI_FS_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_FS_Call) RunAndReturn(run func(context.Context, string) fs.FS) *FilesystemAPI_FS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetACL provides a mock function with given fields: ctx, path, simplified
+func (_m *FilesystemAPI) GetACL(ctx context.Context, path string, simplified bool) (*truenas.ACL, error) {
+	ret := _m.Called(ctx, path, simplified)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetACL")
+	}
+
+	var r0 *truenas.ACL
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (*truenas.ACL, error)); ok {
+		return rf(ctx, path, simplified)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *truenas.ACL); ok {
+		r0 = rf(ctx, path, simplified)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ACL)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, path, simplified)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_GetACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetACL'
+type FilesystemAPI_GetACL_Call struct {
+	*mock.Call
+}
+
+// GetACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - simplified bool
+func (_e *FilesystemAPI_Expecter) GetACL(ctx interface{}, path interface{}, simplified interface{}) *FilesystemAPI_GetACL_Call {
+	return &FilesystemAPI_GetACL_Call{Call: _e.mock.On("GetACL", ctx, path, simplified)}
+}
+
+func (_c *FilesystemAPI_GetACL_Call) Run(run func(ctx context.Context, path string, simplified bool)) *FilesystemAPI_GetACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_GetACL_Call) Return(_a0 *truenas.ACL, _a1 error) *FilesystemAPI_GetACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_GetACL_Call) RunAndReturn(run func(context.Context, string, bool) (*truenas.ACL, error)) *FilesystemAPI_GetACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultACL provides a mock function with given fields: ctx, aclType, shareType
+func (_m *FilesystemAPI) GetDefaultACL(ctx context.Context, aclType truenas.DefaultACLType, shareType truenas.ShareType) (*truenas.ACL, error) {
+	ret := _m.Called(ctx, aclType, shareType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultACL")
+	}
+
+	var r0 *truenas.ACL
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.DefaultACLType, truenas.ShareType) (*truenas.ACL, error)); ok {
+		return rf(ctx, aclType, shareType)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.DefaultACLType, truenas.ShareType) *truenas.ACL); ok {
+		r0 = rf(ctx, aclType, shareType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ACL)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.DefaultACLType, truenas.ShareType) error); ok {
+		r1 = rf(ctx, aclType, shareType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_GetDefaultACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultACL'
+type FilesystemAPI_GetDefaultACL_Call struct {
+	*mock.Call
+}
+
+// GetDefaultACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - aclType truenas.DefaultACLType
+//   - shareType truenas.ShareType
+func (_e *FilesystemAPI_Expecter) GetDefaultACL(ctx interface{}, aclType interface{}, shareType interface{}) *FilesystemAPI_GetDefaultACL_Call {
+	return &FilesystemAPI_GetDefaultACL_Call{Call: _e.mock.On("GetDefaultACL", ctx, aclType, shareType)}
+}
+
+func (_c *FilesystemAPI_GetDefaultACL_Call) Run(run func(ctx context.Context, aclType truenas.DefaultACLType, shareType truenas.ShareType)) *FilesystemAPI_GetDefaultACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.DefaultACLType), args[2].(truenas.ShareType))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_GetDefaultACL_Call) Return(_a0 *truenas.ACL, _a1 error) *FilesystemAPI_GetDefaultACL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_GetDefaultACL_Call) RunAndReturn(run func(context.Context, truenas.DefaultACLType, truenas.ShareType) (*truenas.ACL, error)) *FilesystemAPI_GetDefaultACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultACLChoices provides a mock function with given fields: ctx
+func (_m *FilesystemAPI) GetDefaultACLChoices(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultACLChoices")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_GetDefaultACLChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultACLChoices'
+type FilesystemAPI_GetDefaultACLChoices_Call struct {
+	*mock.Call
+}
+
+// GetDefaultACLChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *FilesystemAPI_Expecter) GetDefaultACLChoices(ctx interface{}) *FilesystemAPI_GetDefaultACLChoices_Call {
+	return &FilesystemAPI_GetDefaultACLChoices_Call{Call: _e.mock.On("GetDefaultACLChoices", ctx)}
+}
+
+func (_c *FilesystemAPI_GetDefaultACLChoices_Call) Run(run func(ctx context.Context)) *FilesystemAPI_GetDefaultACLChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_GetDefaultACLChoices_Call) Return(_a0 []string, _a1 error) *FilesystemAPI_GetDefaultACLChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_GetDefaultACLChoices_Call) RunAndReturn(run func(context.Context) ([]string, error)) *FilesystemAPI_GetDefaultACLChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFile provides a mock function with given fields: ctx, path
+func (_m *FilesystemAPI) GetFile(ctx context.Context, path string) error {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_GetFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFile'
+type FilesystemAPI_GetFile_Call struct {
+	*mock.Call
+}
+
+// GetFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *FilesystemAPI_Expecter) GetFile(ctx interface{}, path interface{}) *FilesystemAPI_GetFile_Call {
+	return &FilesystemAPI_GetFile_Call{Call: _e.mock.On("GetFile", ctx, path)}
+}
+
+func (_c *FilesystemAPI_GetFile_Call) Run(run func(ctx context.Context, path string)) *FilesystemAPI_GetFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_GetFile_Call) Return(_a0 error) *FilesystemAPI_GetFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_GetFile_Call) RunAndReturn(run func(context.Context, string) error) *FilesystemAPI_GetFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsACLTrivial provides a mock function with given fields: ctx, path
+func (_m *FilesystemAPI) IsACLTrivial(ctx context.Context, path string) (bool, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsACLTrivial")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, path)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_IsACLTrivial_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsACLTrivial'
+type FilesystemAPI_IsACLTrivial_Call struct {
+	*mock.Call
+}
+
+// IsACLTrivial is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *FilesystemAPI_Expecter) IsACLTrivial(ctx interface{}, path interface{}) *FilesystemAPI_IsACLTrivial_Call {
+	return &FilesystemAPI_IsACLTrivial_Call{Call: _e.mock.On("IsACLTrivial", ctx, path)}
+}
+
+func (_c *FilesystemAPI_IsACLTrivial_Call) Run(run func(ctx context.Context, path string)) *FilesystemAPI_IsACLTrivial_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_IsACLTrivial_Call) Return(_a0 bool, _a1 error) *FilesystemAPI_IsACLTrivial_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_IsACLTrivial_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *FilesystemAPI_IsACLTrivial_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDir provides a mock function with given fields: ctx, path
+func (_m *FilesystemAPI) ListDir(ctx context.Context, path string) ([]truenas.DirEntry, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDir")
+	}
+
+	var r0 []truenas.DirEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]truenas.DirEntry, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []truenas.DirEntry); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.DirEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_ListDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDir'
+type FilesystemAPI_ListDir_Call struct {
+	*mock.Call
+}
+
+// ListDir is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *FilesystemAPI_Expecter) ListDir(ctx interface{}, path interface{}) *FilesystemAPI_ListDir_Call {
+	return &FilesystemAPI_ListDir_Call{Call: _e.mock.On("ListDir", ctx, path)}
+}
+
+func (_c *FilesystemAPI_ListDir_Call) Run(run func(ctx context.Context, path string)) *FilesystemAPI_ListDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_ListDir_Call) Return(_a0 []truenas.DirEntry, _a1 error) *FilesystemAPI_ListDir_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_ListDir_Call) RunAndReturn(run func(context.Context, string) ([]truenas.DirEntry, error)) *FilesystemAPI_ListDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDirWithOptions provides a mock function with given fields: ctx, dir, opts
+func (_m *FilesystemAPI) ListDirWithOptions(ctx context.Context, dir string, opts truenas.ListDirOptions) ([]truenas.DirEntry, error) {
+	ret := _m.Called(ctx, dir, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDirWithOptions")
+	}
+
+	var r0 []truenas.DirEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.ListDirOptions) ([]truenas.DirEntry, error)); ok {
+		return rf(ctx, dir, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.ListDirOptions) []truenas.DirEntry); ok {
+		r0 = rf(ctx, dir, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.DirEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, truenas.ListDirOptions) error); ok {
+		r1 = rf(ctx, dir, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_ListDirWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDirWithOptions'
+type FilesystemAPI_ListDirWithOptions_Call struct {
+	*mock.Call
+}
+
+// ListDirWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dir string
+//   - opts truenas.ListDirOptions
+func (_e *FilesystemAPI_Expecter) ListDirWithOptions(ctx interface{}, dir interface{}, opts interface{}) *FilesystemAPI_ListDirWithOptions_Call {
+	return &FilesystemAPI_ListDirWithOptions_Call{Call: _e.mock.On("ListDirWithOptions", ctx, dir, opts)}
+}
+
+func (_c *FilesystemAPI_ListDirWithOptions_Call) Run(run func(ctx context.Context, dir string, opts truenas.ListDirOptions)) *FilesystemAPI_ListDirWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.ListDirOptions))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_ListDirWithOptions_Call) Return(_a0 []truenas.DirEntry, _a1 error) *FilesystemAPI_ListDirWithOptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_ListDirWithOptions_Call) RunAndReturn(run func(context.Context, string, truenas.ListDirOptions) ([]truenas.DirEntry, error)) *FilesystemAPI_ListDirWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Mkdir provides a mock function with given fields: ctx, path, mode
+func (_m *FilesystemAPI) Mkdir(ctx context.Context, path string, mode string) (*truenas.DirEntry, error) {
+	ret := _m.Called(ctx, path, mode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Mkdir")
+	}
+
+	var r0 *truenas.DirEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*truenas.DirEntry, error)); ok {
+		return rf(ctx, path, mode)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *truenas.DirEntry); ok {
+		r0 = rf(ctx, path, mode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.DirEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, path, mode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_Mkdir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Mkdir'
+type FilesystemAPI_Mkdir_Call struct {
+	*mock.Call
+}
+
+// Mkdir is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - mode string
+func (_e *FilesystemAPI_Expecter) Mkdir(ctx interface{}, path interface{}, mode interface{}) *FilesystemAPI_Mkdir_Call {
+	return &FilesystemAPI_Mkdir_Call{Call: _e.mock.On("Mkdir", ctx, path, mode)}
+}
+
+func (_c *FilesystemAPI_Mkdir_Call) Run(run func(ctx context.Context, path string, mode string)) *FilesystemAPI_Mkdir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_Mkdir_Call) Return(_a0 *truenas.DirEntry, _a1 error) *FilesystemAPI_Mkdir_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_Mkdir_Call) RunAndReturn(run func(context.Context, string, string) (*truenas.DirEntry, error)) *FilesystemAPI_Mkdir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutFile provides a mock function with given fields: ctx, path, r, options
+func (_m *FilesystemAPI) PutFile(ctx context.Context, path string, r io.Reader, options *truenas.PutFileOptions) error {
+	ret := _m.Called(ctx, path, r, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, *truenas.PutFileOptions) error); ok {
+		r0 = rf(ctx, path, r, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_PutFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutFile'
+type FilesystemAPI_PutFile_Call struct {
+	*mock.Call
+}
+
+// PutFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - r io.Reader
+//   - options *truenas.PutFileOptions
+func (_e *FilesystemAPI_Expecter) PutFile(ctx interface{}, path interface{}, r interface{}, options interface{}) *FilesystemAPI_PutFile_Call {
+	return &FilesystemAPI_PutFile_Call{Call: _e.mock.On("PutFile", ctx, path, r, options)}
+}
+
+func (_c *FilesystemAPI_PutFile_Call) Run(run func(ctx context.Context, path string, r io.Reader, options *truenas.PutFileOptions)) *FilesystemAPI_PutFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), args[3].(*truenas.PutFileOptions))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_PutFile_Call) Return(_a0 error) *FilesystemAPI_PutFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_PutFile_Call) RunAndReturn(run func(context.Context, string, io.Reader, *truenas.PutFileOptions) error) *FilesystemAPI_PutFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PutFileWithProgress provides a mock function with given fields: ctx, path, r, options, fn
+func (_m *FilesystemAPI) PutFileWithProgress(ctx context.Context, path string, r io.Reader, options *truenas.PutFileOptions, fn truenas.UploadProgressFunc) error {
+	ret := _m.Called(ctx, path, r, options, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PutFileWithProgress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, *truenas.PutFileOptions, truenas.UploadProgressFunc) error); ok {
+		r0 = rf(ctx, path, r, options, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_PutFileWithProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PutFileWithProgress'
+type FilesystemAPI_PutFileWithProgress_Call struct {
+	*mock.Call
+}
+
+// PutFileWithProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - r io.Reader
+//   - options *truenas.PutFileOptions
+//   - fn truenas.UploadProgressFunc
+func (_e *FilesystemAPI_Expecter) PutFileWithProgress(ctx interface{}, path interface{}, r interface{}, options interface{}, fn interface{}) *FilesystemAPI_PutFileWithProgress_Call {
+	return &FilesystemAPI_PutFileWithProgress_Call{Call: _e.mock.On("PutFileWithProgress", ctx, path, r, options, fn)}
+}
+
+func (_c *FilesystemAPI_PutFileWithProgress_Call) Run(run func(ctx context.Context, path string, r io.Reader, options *truenas.PutFileOptions, fn truenas.UploadProgressFunc)) *FilesystemAPI_PutFileWithProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(io.Reader), args[3].(*truenas.PutFileOptions), args[4].(truenas.UploadProgressFunc))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_PutFileWithProgress_Call) Return(_a0 error) *FilesystemAPI_PutFileWithProgress_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_PutFileWithProgress_Call) RunAndReturn(run func(context.Context, string, io.Reader, *truenas.PutFileOptions, truenas.UploadProgressFunc) error) *FilesystemAPI_PutFileWithProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetACL provides a mock function with given fields: ctx, req
+func (_m *FilesystemAPI) SetACL(ctx context.Context, req *truenas.SetACLRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetACL")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SetACLRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_SetACL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetACL'
+type FilesystemAPI_SetACL_Call struct {
+	*mock.Call
+}
+
+// SetACL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SetACLRequest
+func (_e *FilesystemAPI_Expecter) SetACL(ctx interface{}, req interface{}) *FilesystemAPI_SetACL_Call {
+	return &FilesystemAPI_SetACL_Call{Call: _e.mock.On("SetACL", ctx, req)}
+}
+
+func (_c *FilesystemAPI_SetACL_Call) Run(run func(ctx context.Context, req *truenas.SetACLRequest)) *FilesystemAPI_SetACL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SetACLRequest))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_SetACL_Call) Return(_a0 error) *FilesystemAPI_SetACL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_SetACL_Call) RunAndReturn(run func(context.Context, *truenas.SetACLRequest) error) *FilesystemAPI_SetACL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetOwnership provides a mock function with given fields: ctx, path, uid, gid, recursive
+func (_m *FilesystemAPI) SetOwnership(ctx context.Context, path string, uid *int, gid *int, recursive bool) error {
+	ret := _m.Called(ctx, path, uid, gid, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetOwnership")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *int, *int, bool) error); ok {
+		r0 = rf(ctx, path, uid, gid, recursive)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_SetOwnership_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetOwnership'
+type FilesystemAPI_SetOwnership_Call struct {
+	*mock.Call
+}
+
+// SetOwnership is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - uid *int
+//   - gid *int
+//   - recursive bool
+func (_e *FilesystemAPI_Expecter) SetOwnership(ctx interface{}, path interface{}, uid interface{}, gid interface{}, recursive interface{}) *FilesystemAPI_SetOwnership_Call {
+	return &FilesystemAPI_SetOwnership_Call{Call: _e.mock.On("SetOwnership", ctx, path, uid, gid, recursive)}
+}
+
+func (_c *FilesystemAPI_SetOwnership_Call) Run(run func(ctx context.Context, path string, uid *int, gid *int, recursive bool)) *FilesystemAPI_SetOwnership_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*int), args[3].(*int), args[4].(bool))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_SetOwnership_Call) Return(_a0 error) *FilesystemAPI_SetOwnership_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_SetOwnership_Call) RunAndReturn(run func(context.Context, string, *int, *int, bool) error) *FilesystemAPI_SetOwnership_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPermissions provides a mock function with given fields: ctx, req
+func (_m *FilesystemAPI) SetPermissions(ctx context.Context, req *truenas.SetPermRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPermissions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SetPermRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_SetPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPermissions'
+type FilesystemAPI_SetPermissions_Call struct {
+	*mock.Call
+}
+
+// SetPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SetPermRequest
+func (_e *FilesystemAPI_Expecter) SetPermissions(ctx interface{}, req interface{}) *FilesystemAPI_SetPermissions_Call {
+	return &FilesystemAPI_SetPermissions_Call{Call: _e.mock.On("SetPermissions", ctx, req)}
+}
+
+func (_c *FilesystemAPI_SetPermissions_Call) Run(run func(ctx context.Context, req *truenas.SetPermRequest)) *FilesystemAPI_SetPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SetPermRequest))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_SetPermissions_Call) Return(_a0 error) *FilesystemAPI_SetPermissions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_SetPermissions_Call) RunAndReturn(run func(context.Context, *truenas.SetPermRequest) error) *FilesystemAPI_SetPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSimplePermissions provides a mock function with given fields: ctx, path, mode, recursive
+func (_m *FilesystemAPI) SetSimplePermissions(ctx context.Context, path string, mode string, recursive bool) error {
+	ret := _m.Called(ctx, path, mode, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSimplePermissions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = rf(ctx, path, mode, recursive)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_SetSimplePermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSimplePermissions'
+type FilesystemAPI_SetSimplePermissions_Call struct {
+	*mock.Call
+}
+
+// SetSimplePermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - mode string
+//   - recursive bool
+func (_e *FilesystemAPI_Expecter) SetSimplePermissions(ctx interface{}, path interface{}, mode interface{}, recursive interface{}) *FilesystemAPI_SetSimplePermissions_Call {
+	return &FilesystemAPI_SetSimplePermissions_Call{Call: _e.mock.On("SetSimplePermissions", ctx, path, mode, recursive)}
+}
+
+func (_c *FilesystemAPI_SetSimplePermissions_Call) Run(run func(ctx context.Context, path string, mode string, recursive bool)) *FilesystemAPI_SetSimplePermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_SetSimplePermissions_Call) Return(_a0 error) *FilesystemAPI_SetSimplePermissions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_SetSimplePermissions_Call) RunAndReturn(run func(context.Context, string, string, bool) error) *FilesystemAPI_SetSimplePermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stat provides a mock function with given fields: ctx, path
+func (_m *FilesystemAPI) Stat(ctx context.Context, path string) (*truenas.FilesystemStat, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stat")
+	}
+
+	var r0 *truenas.FilesystemStat
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.FilesystemStat, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.FilesystemStat); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.FilesystemStat)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_Stat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stat'
+type FilesystemAPI_Stat_Call struct {
+	*mock.Call
+}
+
+// Stat is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *FilesystemAPI_Expecter) Stat(ctx interface{}, path interface{}) *FilesystemAPI_Stat_Call {
+	return &FilesystemAPI_Stat_Call{Call: _e.mock.On("Stat", ctx, path)}
+}
+
+func (_c *FilesystemAPI_Stat_Call) Run(run func(ctx context.Context, path string)) *FilesystemAPI_Stat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_Stat_Call) Return(_a0 *truenas.FilesystemStat, _a1 error) *FilesystemAPI_Stat_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_Stat_Call) RunAndReturn(run func(context.Context, string) (*truenas.FilesystemStat, error)) *FilesystemAPI_Stat_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Statfs provides a mock function with given fields: ctx, path
+func (_m *FilesystemAPI) Statfs(ctx context.Context, path string) (*truenas.FilesystemStatfs, error) {
+	ret := _m.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Statfs")
+	}
+
+	var r0 *truenas.FilesystemStatfs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.FilesystemStatfs, error)); ok {
+		return rf(ctx, path)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.FilesystemStatfs); ok {
+		r0 = rf(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.FilesystemStatfs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FilesystemAPI_Statfs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Statfs'
+type FilesystemAPI_Statfs_Call struct {
+	*mock.Call
+}
+
+// Statfs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *FilesystemAPI_Expecter) Statfs(ctx interface{}, path interface{}) *FilesystemAPI_Statfs_Call {
+	return &FilesystemAPI_Statfs_Call{Call: _e.mock.On("Statfs", ctx, path)}
+}
+
+func (_c *FilesystemAPI_Statfs_Call) Run(run func(ctx context.Context, path string)) *FilesystemAPI_Statfs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_Statfs_Call) Return(_a0 *truenas.FilesystemStatfs, _a1 error) *FilesystemAPI_Statfs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FilesystemAPI_Statfs_Call) RunAndReturn(run func(context.Context, string) (*truenas.FilesystemStatfs, error)) *FilesystemAPI_Statfs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Walk provides a mock function with given fields: ctx, root, fn
+func (_m *FilesystemAPI) Walk(ctx context.Context, root string, fn truenas.WalkFunc) error {
+	ret := _m.Called(ctx, root, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Walk")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.WalkFunc) error); ok {
+		r0 = rf(ctx, root, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FilesystemAPI_Walk_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Walk'
+type FilesystemAPI_Walk_Call struct {
+	*mock.Call
+}
+
+// Walk is a helper method to define mock.On call
+//   - ctx context.Context
+//   - root string
+//   - fn truenas.WalkFunc
+func (_e *FilesystemAPI_Expecter) Walk(ctx interface{}, root interface{}, fn interface{}) *FilesystemAPI_Walk_Call {
+	return &FilesystemAPI_Walk_Call{Call: _e.mock.On("Walk", ctx, root, fn)}
+}
+
+func (_c *FilesystemAPI_Walk_Call) Run(run func(ctx context.Context, root string, fn truenas.WalkFunc)) *FilesystemAPI_Walk_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.WalkFunc))
+	})
+	return _c
+}
+
+func (_c *FilesystemAPI_Walk_Call) Return(_a0 error) *FilesystemAPI_Walk_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FilesystemAPI_Walk_Call) RunAndReturn(run func(context.Context, string, truenas.WalkFunc) error) *FilesystemAPI_Walk_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFilesystemAPI creates a new instance of FilesystemAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFilesystemAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FilesystemAPI {
+	mock := &FilesystemAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
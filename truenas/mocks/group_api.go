@@ -0,0 +1,722 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// GroupAPI is an autogenerated mock type for the GroupAPI type
+type GroupAPI struct {
+	mock.Mock
+}
+
+type GroupAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *GroupAPI) EXPECT() *GroupAPI_Expecter {
+	return &GroupAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *GroupAPI) Create(ctx context.Context, req *truenas.GroupCreateRequest) (*truenas.Group, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.GroupCreateRequest) (*truenas.Group, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.GroupCreateRequest) *truenas.Group); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.GroupCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type GroupAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.GroupCreateRequest
+func (_e *GroupAPI_Expecter) Create(ctx interface{}, req interface{}) *GroupAPI_Create_Call {
+	return &GroupAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *GroupAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.GroupCreateRequest)) *GroupAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.GroupCreateRequest))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_Create_Call) Return(_a0 *truenas.Group, _a1 error) *GroupAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.GroupCreateRequest) (*truenas.Group, error)) *GroupAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, req
+func (_m *GroupAPI) Delete(ctx context.Context, id int, req *truenas.GroupDeleteRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.GroupDeleteRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GroupAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type GroupAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.GroupDeleteRequest
+func (_e *GroupAPI_Expecter) Delete(ctx interface{}, id interface{}, req interface{}) *GroupAPI_Delete_Call {
+	return &GroupAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, req)}
+}
+
+func (_c *GroupAPI_Delete_Call) Run(run func(ctx context.Context, id int, req *truenas.GroupDeleteRequest)) *GroupAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.GroupDeleteRequest))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_Delete_Call) Return(_a0 error) *GroupAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupAPI_Delete_Call) RunAndReturn(run func(context.Context, int, *truenas.GroupDeleteRequest) error) *GroupAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *GroupAPI) Get(ctx context.Context, id int) (*truenas.Group, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Group, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Group); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type GroupAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *GroupAPI_Expecter) Get(ctx interface{}, id interface{}) *GroupAPI_Get_Call {
+	return &GroupAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *GroupAPI_Get_Call) Run(run func(ctx context.Context, id int)) *GroupAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_Get_Call) Return(_a0 *truenas.Group, _a1 error) *GroupAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.Group, error)) *GroupAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByGID provides a mock function with given fields: ctx, gid
+func (_m *GroupAPI) GetByGID(ctx context.Context, gid int) (*truenas.Group, error) {
+	ret := _m.Called(ctx, gid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByGID")
+	}
+
+	var r0 *truenas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Group, error)); ok {
+		return rf(ctx, gid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Group); ok {
+		r0 = rf(ctx, gid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, gid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_GetByGID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByGID'
+type GroupAPI_GetByGID_Call struct {
+	*mock.Call
+}
+
+// GetByGID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - gid int
+func (_e *GroupAPI_Expecter) GetByGID(ctx interface{}, gid interface{}) *GroupAPI_GetByGID_Call {
+	return &GroupAPI_GetByGID_Call{Call: _e.mock.On("GetByGID", ctx, gid)}
+}
+
+func (_c *GroupAPI_GetByGID_Call) Run(run func(ctx context.Context, gid int)) *GroupAPI_GetByGID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_GetByGID_Call) Return(_a0 *truenas.Group, _a1 error) *GroupAPI_GetByGID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_GetByGID_Call) RunAndReturn(run func(context.Context, int) (*truenas.Group, error)) *GroupAPI_GetByGID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function with given fields: ctx, name
+func (_m *GroupAPI) GetByName(ctx context.Context, name string) (*truenas.Group, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 *truenas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Group, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Group); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type GroupAPI_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *GroupAPI_Expecter) GetByName(ctx interface{}, name interface{}) *GroupAPI_GetByName_Call {
+	return &GroupAPI_GetByName_Call{Call: _e.mock.On("GetByName", ctx, name)}
+}
+
+func (_c *GroupAPI_GetByName_Call) Run(run func(ctx context.Context, name string)) *GroupAPI_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_GetByName_Call) Return(_a0 *truenas.Group, _a1 error) *GroupAPI_GetByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_GetByName_Call) RunAndReturn(run func(context.Context, string) (*truenas.Group, error)) *GroupAPI_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGroupObj provides a mock function with given fields: ctx, req
+func (_m *GroupAPI) GetGroupObj(ctx context.Context, req truenas.GroupGetRequest) (map[string]any, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGroupObj")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.GroupGetRequest) (map[string]any, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.GroupGetRequest) map[string]any); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.GroupGetRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_GetGroupObj_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGroupObj'
+type GroupAPI_GetGroupObj_Call struct {
+	*mock.Call
+}
+
+// GetGroupObj is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.GroupGetRequest
+func (_e *GroupAPI_Expecter) GetGroupObj(ctx interface{}, req interface{}) *GroupAPI_GetGroupObj_Call {
+	return &GroupAPI_GetGroupObj_Call{Call: _e.mock.On("GetGroupObj", ctx, req)}
+}
+
+func (_c *GroupAPI_GetGroupObj_Call) Run(run func(ctx context.Context, req truenas.GroupGetRequest)) *GroupAPI_GetGroupObj_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.GroupGetRequest))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_GetGroupObj_Call) Return(_a0 map[string]any, _a1 error) *GroupAPI_GetGroupObj_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_GetGroupObj_Call) RunAndReturn(run func(context.Context, truenas.GroupGetRequest) (map[string]any, error)) *GroupAPI_GetGroupObj_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextGID provides a mock function with given fields: ctx
+func (_m *GroupAPI) GetNextGID(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextGID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_GetNextGID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextGID'
+type GroupAPI_GetNextGID_Call struct {
+	*mock.Call
+}
+
+// GetNextGID is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *GroupAPI_Expecter) GetNextGID(ctx interface{}) *GroupAPI_GetNextGID_Call {
+	return &GroupAPI_GetNextGID_Call{Call: _e.mock.On("GetNextGID", ctx)}
+}
+
+func (_c *GroupAPI_GetNextGID_Call) Run(run func(ctx context.Context)) *GroupAPI_GetNextGID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_GetNextGID_Call) Return(_a0 int, _a1 error) *GroupAPI_GetNextGID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_GetNextGID_Call) RunAndReturn(run func(context.Context) (int, error)) *GroupAPI_GetNextGID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *GroupAPI) List(ctx context.Context) ([]truenas.Group, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Group, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Group); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type GroupAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *GroupAPI_Expecter) List(ctx interface{}) *GroupAPI_List_Call {
+	return &GroupAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *GroupAPI_List_Call) Run(run func(ctx context.Context)) *GroupAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_List_Call) Return(_a0 []truenas.Group, _a1 error) *GroupAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Group, error)) *GroupAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *GroupAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Group, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Group, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Group, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Group, error])
+		}
+	}
+
+	return r0
+}
+
+// GroupAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type GroupAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *GroupAPI_Expecter) ListIter(ctx interface{}, q interface{}) *GroupAPI_ListIter_Call {
+	return &GroupAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *GroupAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *GroupAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Group, error]) *GroupAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GroupAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Group, error]) *GroupAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithDSCache provides a mock function with given fields: ctx
+func (_m *GroupAPI) ListWithDSCache(ctx context.Context) ([]truenas.Group, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithDSCache")
+	}
+
+	var r0 []truenas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Group, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Group); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_ListWithDSCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithDSCache'
+type GroupAPI_ListWithDSCache_Call struct {
+	*mock.Call
+}
+
+// ListWithDSCache is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *GroupAPI_Expecter) ListWithDSCache(ctx interface{}) *GroupAPI_ListWithDSCache_Call {
+	return &GroupAPI_ListWithDSCache_Call{Call: _e.mock.On("ListWithDSCache", ctx)}
+}
+
+func (_c *GroupAPI_ListWithDSCache_Call) Run(run func(ctx context.Context)) *GroupAPI_ListWithDSCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_ListWithDSCache_Call) Return(_a0 []truenas.Group, _a1 error) *GroupAPI_ListWithDSCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_ListWithDSCache_Call) RunAndReturn(run func(context.Context) ([]truenas.Group, error)) *GroupAPI_ListWithDSCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *GroupAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Group, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Group, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Group); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type GroupAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *GroupAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *GroupAPI_ListWithQuery_Call {
+	return &GroupAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *GroupAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *GroupAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_ListWithQuery_Call) Return(_a0 []truenas.Group, _a1 error) *GroupAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Group, error)) *GroupAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *GroupAPI) Update(ctx context.Context, id int, req *truenas.GroupUpdateRequest) (*truenas.Group, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Group
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.GroupUpdateRequest) (*truenas.Group, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.GroupUpdateRequest) *truenas.Group); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Group)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.GroupUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GroupAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type GroupAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.GroupUpdateRequest
+func (_e *GroupAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *GroupAPI_Update_Call {
+	return &GroupAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *GroupAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.GroupUpdateRequest)) *GroupAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.GroupUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *GroupAPI_Update_Call) Return(_a0 *truenas.Group, _a1 error) *GroupAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *GroupAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.GroupUpdateRequest) (*truenas.Group, error)) *GroupAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewGroupAPI creates a new instance of GroupAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewGroupAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *GroupAPI {
+	mock := &GroupAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
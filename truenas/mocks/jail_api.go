@@ -0,0 +1,525 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// JailAPI is an autogenerated mock type for the JailAPI type
+type JailAPI struct {
+	mock.Mock
+}
+
+type JailAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JailAPI) EXPECT() *JailAPI_Expecter {
+	return &JailAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *JailAPI) Create(ctx context.Context, req *truenas.JailCreateRequest) (*truenas.Jail, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Jail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.JailCreateRequest) (*truenas.Jail, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.JailCreateRequest) *truenas.Jail); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Jail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.JailCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JailAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type JailAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.JailCreateRequest
+func (_e *JailAPI_Expecter) Create(ctx interface{}, req interface{}) *JailAPI_Create_Call {
+	return &JailAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *JailAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.JailCreateRequest)) *JailAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.JailCreateRequest))
+	})
+	return _c
+}
+
+func (_c *JailAPI_Create_Call) Return(_a0 *truenas.Jail, _a1 error) *JailAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JailAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.JailCreateRequest) (*truenas.Jail, error)) *JailAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, name, force
+func (_m *JailAPI) Delete(ctx context.Context, name string, force bool) error {
+	ret := _m.Called(ctx, name, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, name, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JailAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type JailAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - force bool
+func (_e *JailAPI_Expecter) Delete(ctx interface{}, name interface{}, force interface{}) *JailAPI_Delete_Call {
+	return &JailAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, name, force)}
+}
+
+func (_c *JailAPI_Delete_Call) Run(run func(ctx context.Context, name string, force bool)) *JailAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *JailAPI_Delete_Call) Return(_a0 error) *JailAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JailAPI_Delete_Call) RunAndReturn(run func(context.Context, string, bool) error) *JailAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Exec provides a mock function with given fields: ctx, name, command, opts
+func (_m *JailAPI) Exec(ctx context.Context, name string, command []string, opts *truenas.JailExecOptions) (string, error) {
+	ret := _m.Called(ctx, name, command, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exec")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *truenas.JailExecOptions) (string, error)); ok {
+		return rf(ctx, name, command, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, *truenas.JailExecOptions) string); ok {
+		r0 = rf(ctx, name, command, opts)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string, *truenas.JailExecOptions) error); ok {
+		r1 = rf(ctx, name, command, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JailAPI_Exec_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exec'
+type JailAPI_Exec_Call struct {
+	*mock.Call
+}
+
+// Exec is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - command []string
+//   - opts *truenas.JailExecOptions
+func (_e *JailAPI_Expecter) Exec(ctx interface{}, name interface{}, command interface{}, opts interface{}) *JailAPI_Exec_Call {
+	return &JailAPI_Exec_Call{Call: _e.mock.On("Exec", ctx, name, command, opts)}
+}
+
+func (_c *JailAPI_Exec_Call) Run(run func(ctx context.Context, name string, command []string, opts *truenas.JailExecOptions)) *JailAPI_Exec_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(*truenas.JailExecOptions))
+	})
+	return _c
+}
+
+func (_c *JailAPI_Exec_Call) Return(_a0 string, _a1 error) *JailAPI_Exec_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JailAPI_Exec_Call) RunAndReturn(run func(context.Context, string, []string, *truenas.JailExecOptions) (string, error)) *JailAPI_Exec_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *JailAPI) Get(ctx context.Context, name string) (*truenas.Jail, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Jail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Jail, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Jail); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Jail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JailAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type JailAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *JailAPI_Expecter) Get(ctx interface{}, name interface{}) *JailAPI_Get_Call {
+	return &JailAPI_Get_Call{Call: _e.mock.On("Get", ctx, name)}
+}
+
+func (_c *JailAPI_Get_Call) Run(run func(ctx context.Context, name string)) *JailAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JailAPI_Get_Call) Return(_a0 *truenas.Jail, _a1 error) *JailAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JailAPI_Get_Call) RunAndReturn(run func(context.Context, string) (*truenas.Jail, error)) *JailAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *JailAPI) List(ctx context.Context) ([]truenas.Jail, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Jail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Jail, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Jail); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Jail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JailAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type JailAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *JailAPI_Expecter) List(ctx interface{}) *JailAPI_List_Call {
+	return &JailAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *JailAPI_List_Call) Run(run func(ctx context.Context)) *JailAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JailAPI_List_Call) Return(_a0 []truenas.Jail, _a1 error) *JailAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JailAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Jail, error)) *JailAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *JailAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Jail, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Jail, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Jail, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Jail, error])
+		}
+	}
+
+	return r0
+}
+
+// JailAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type JailAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *JailAPI_Expecter) ListIter(ctx interface{}, q interface{}) *JailAPI_ListIter_Call {
+	return &JailAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *JailAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *JailAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *JailAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Jail, error]) *JailAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JailAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Jail, error]) *JailAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *JailAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Jail, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Jail
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Jail, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Jail); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Jail)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JailAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type JailAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *JailAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *JailAPI_ListWithQuery_Call {
+	return &JailAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *JailAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *JailAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *JailAPI_ListWithQuery_Call) Return(_a0 []truenas.Jail, _a1 error) *JailAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JailAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Jail, error)) *JailAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, name
+func (_m *JailAPI) Start(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JailAPI_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type JailAPI_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *JailAPI_Expecter) Start(ctx interface{}, name interface{}) *JailAPI_Start_Call {
+	return &JailAPI_Start_Call{Call: _e.mock.On("Start", ctx, name)}
+}
+
+func (_c *JailAPI_Start_Call) Run(run func(ctx context.Context, name string)) *JailAPI_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JailAPI_Start_Call) Return(_a0 error) *JailAPI_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JailAPI_Start_Call) RunAndReturn(run func(context.Context, string) error) *JailAPI_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx, name, force
+func (_m *JailAPI) Stop(ctx context.Context, name string, force bool) error {
+	ret := _m.Called(ctx, name, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, name, force)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JailAPI_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type JailAPI_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - force bool
+func (_e *JailAPI_Expecter) Stop(ctx interface{}, name interface{}, force interface{}) *JailAPI_Stop_Call {
+	return &JailAPI_Stop_Call{Call: _e.mock.On("Stop", ctx, name, force)}
+}
+
+func (_c *JailAPI_Stop_Call) Run(run func(ctx context.Context, name string, force bool)) *JailAPI_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *JailAPI_Stop_Call) Return(_a0 error) *JailAPI_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JailAPI_Stop_Call) RunAndReturn(run func(context.Context, string, bool) error) *JailAPI_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJailAPI creates a new instance of JailAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJailAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JailAPI {
+	mock := &JailAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,383 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// JobAPI is an autogenerated mock type for the JobAPI type
+type JobAPI struct {
+	mock.Mock
+}
+
+type JobAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobAPI) EXPECT() *JobAPI_Expecter {
+	return &JobAPI_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *JobAPI) Get(ctx context.Context, id int) (*truenas.Job, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Job, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Job); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type JobAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *JobAPI_Expecter) Get(ctx interface{}, id interface{}) *JobAPI_Get_Call {
+	return &JobAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *JobAPI_Get_Call) Run(run func(ctx context.Context, id int)) *JobAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *JobAPI_Get_Call) Return(_a0 *truenas.Job, _a1 error) *JobAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.Job, error)) *JobAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *JobAPI) List(ctx context.Context) ([]truenas.Job, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Job, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Job); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type JobAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *JobAPI_Expecter) List(ctx interface{}) *JobAPI_List_Call {
+	return &JobAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *JobAPI_List_Call) Run(run func(ctx context.Context)) *JobAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JobAPI_List_Call) Return(_a0 []truenas.Job, _a1 error) *JobAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Job, error)) *JobAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *JobAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Job, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Job, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Job, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Job, error])
+		}
+	}
+
+	return r0
+}
+
+// JobAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type JobAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *JobAPI_Expecter) ListIter(ctx interface{}, q interface{}) *JobAPI_ListIter_Call {
+	return &JobAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *JobAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *JobAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *JobAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Job, error]) *JobAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JobAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Job, error]) *JobAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *JobAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Job, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Job, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Job); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type JobAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *JobAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *JobAPI_ListWithQuery_Call {
+	return &JobAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *JobAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *JobAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *JobAPI_ListWithQuery_Call) Return(_a0 []truenas.Job, _a1 error) *JobAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Job, error)) *JobAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Wait provides a mock function with given fields: ctx, jobID
+func (_m *JobAPI) Wait(ctx context.Context, jobID int) (*truenas.Job, error) {
+	ret := _m.Called(ctx, jobID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Wait")
+	}
+
+	var r0 *truenas.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Job, error)); ok {
+		return rf(ctx, jobID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Job); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobAPI_Wait_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Wait'
+type JobAPI_Wait_Call struct {
+	*mock.Call
+}
+
+// Wait is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int
+func (_e *JobAPI_Expecter) Wait(ctx interface{}, jobID interface{}) *JobAPI_Wait_Call {
+	return &JobAPI_Wait_Call{Call: _e.mock.On("Wait", ctx, jobID)}
+}
+
+func (_c *JobAPI_Wait_Call) Run(run func(ctx context.Context, jobID int)) *JobAPI_Wait_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *JobAPI_Wait_Call) Return(_a0 *truenas.Job, _a1 error) *JobAPI_Wait_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobAPI_Wait_Call) RunAndReturn(run func(context.Context, int) (*truenas.Job, error)) *JobAPI_Wait_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitWithProgress provides a mock function with given fields: ctx, jobID, fn
+func (_m *JobAPI) WaitWithProgress(ctx context.Context, jobID int, fn truenas.JobProgressFunc) (*truenas.Job, error) {
+	ret := _m.Called(ctx, jobID, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitWithProgress")
+	}
+
+	var r0 *truenas.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.JobProgressFunc) (*truenas.Job, error)); ok {
+		return rf(ctx, jobID, fn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.JobProgressFunc) *truenas.Job); ok {
+		r0 = rf(ctx, jobID, fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, truenas.JobProgressFunc) error); ok {
+		r1 = rf(ctx, jobID, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobAPI_WaitWithProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitWithProgress'
+type JobAPI_WaitWithProgress_Call struct {
+	*mock.Call
+}
+
+// WaitWithProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jobID int
+//   - fn truenas.JobProgressFunc
+func (_e *JobAPI_Expecter) WaitWithProgress(ctx interface{}, jobID interface{}, fn interface{}) *JobAPI_WaitWithProgress_Call {
+	return &JobAPI_WaitWithProgress_Call{Call: _e.mock.On("WaitWithProgress", ctx, jobID, fn)}
+}
+
+func (_c *JobAPI_WaitWithProgress_Call) Run(run func(ctx context.Context, jobID int, fn truenas.JobProgressFunc)) *JobAPI_WaitWithProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.JobProgressFunc))
+	})
+	return _c
+}
+
+func (_c *JobAPI_WaitWithProgress_Call) Return(_a0 *truenas.Job, _a1 error) *JobAPI_WaitWithProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobAPI_WaitWithProgress_Call) RunAndReturn(run func(context.Context, int, truenas.JobProgressFunc) (*truenas.Job, error)) *JobAPI_WaitWithProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobAPI creates a new instance of JobAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobAPI {
+	mock := &JobAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,215 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JobsAPI is an autogenerated mock type for the JobsAPI type
+type JobsAPI struct {
+	mock.Mock
+}
+
+type JobsAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JobsAPI) EXPECT() *JobsAPI_Expecter {
+	return &JobsAPI_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *JobsAPI) Get(ctx context.Context, id int) (*truenas.JobHandle, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.JobHandle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.JobHandle, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.JobHandle); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.JobHandle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobsAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type JobsAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *JobsAPI_Expecter) Get(ctx interface{}, id interface{}) *JobsAPI_Get_Call {
+	return &JobsAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *JobsAPI_Get_Call) Run(run func(ctx context.Context, id int)) *JobsAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *JobsAPI_Get_Call) Return(_a0 *truenas.JobHandle, _a1 error) *JobsAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobsAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.JobHandle, error)) *JobsAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx, q
+func (_m *JobsAPI) List(ctx context.Context, q *truenas.Query) ([]*truenas.JobHandle, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*truenas.JobHandle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]*truenas.JobHandle, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []*truenas.JobHandle); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*truenas.JobHandle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobsAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type JobsAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *JobsAPI_Expecter) List(ctx interface{}, q interface{}) *JobsAPI_List_Call {
+	return &JobsAPI_List_Call{Call: _e.mock.On("List", ctx, q)}
+}
+
+func (_c *JobsAPI_List_Call) Run(run func(ctx context.Context, q *truenas.Query)) *JobsAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *JobsAPI_List_Call) Return(_a0 []*truenas.JobHandle, _a1 error) *JobsAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobsAPI_List_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]*truenas.JobHandle, error)) *JobsAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Submit provides a mock function with given fields: ctx, method, params
+func (_m *JobsAPI) Submit(ctx context.Context, method string, params []any) (*truenas.JobHandle, error) {
+	ret := _m.Called(ctx, method, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Submit")
+	}
+
+	var r0 *truenas.JobHandle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []any) (*truenas.JobHandle, error)); ok {
+		return rf(ctx, method, params)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []any) *truenas.JobHandle); ok {
+		r0 = rf(ctx, method, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.JobHandle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []any) error); ok {
+		r1 = rf(ctx, method, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JobsAPI_Submit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Submit'
+type JobsAPI_Submit_Call struct {
+	*mock.Call
+}
+
+// Submit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - method string
+//   - params []any
+func (_e *JobsAPI_Expecter) Submit(ctx interface{}, method interface{}, params interface{}) *JobsAPI_Submit_Call {
+	return &JobsAPI_Submit_Call{Call: _e.mock.On("Submit", ctx, method, params)}
+}
+
+func (_c *JobsAPI_Submit_Call) Run(run func(ctx context.Context, method string, params []any)) *JobsAPI_Submit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]any))
+	})
+	return _c
+}
+
+func (_c *JobsAPI_Submit_Call) Return(_a0 *truenas.JobHandle, _a1 error) *JobsAPI_Submit_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JobsAPI_Submit_Call) RunAndReturn(run func(context.Context, string, []any) (*truenas.JobHandle, error)) *JobsAPI_Submit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJobsAPI creates a new instance of JobsAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobsAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobsAPI {
+	mock := &JobsAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
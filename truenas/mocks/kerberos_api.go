@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// KerberosAPI is an autogenerated mock type for the KerberosAPI type
+type KerberosAPI struct {
+	mock.Mock
+}
+
+type KerberosAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KerberosAPI) EXPECT() *KerberosAPI_Expecter {
+	return &KerberosAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *KerberosAPI) GetConfig(ctx context.Context) (*truenas.KerberosConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.KerberosConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.KerberosConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.KerberosConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KerberosConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type KerberosAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KerberosAPI_Expecter) GetConfig(ctx interface{}) *KerberosAPI_GetConfig_Call {
+	return &KerberosAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *KerberosAPI_GetConfig_Call) Run(run func(ctx context.Context)) *KerberosAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KerberosAPI_GetConfig_Call) Return(_a0 *truenas.KerberosConfig, _a1 error) *KerberosAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.KerberosConfig, error)) *KerberosAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, req
+func (_m *KerberosAPI) UpdateConfig(ctx context.Context, req *truenas.KerberosConfigUpdateRequest) (*truenas.KerberosConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.KerberosConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KerberosConfigUpdateRequest) (*truenas.KerberosConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KerberosConfigUpdateRequest) *truenas.KerberosConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KerberosConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.KerberosConfigUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type KerberosAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.KerberosConfigUpdateRequest
+func (_e *KerberosAPI_Expecter) UpdateConfig(ctx interface{}, req interface{}) *KerberosAPI_UpdateConfig_Call {
+	return &KerberosAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, req)}
+}
+
+func (_c *KerberosAPI_UpdateConfig_Call) Run(run func(ctx context.Context, req *truenas.KerberosConfigUpdateRequest)) *KerberosAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.KerberosConfigUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *KerberosAPI_UpdateConfig_Call) Return(_a0 *truenas.KerberosConfig, _a1 error) *KerberosAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.KerberosConfigUpdateRequest) (*truenas.KerberosConfig, error)) *KerberosAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKerberosAPI creates a new instance of KerberosAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKerberosAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KerberosAPI {
+	mock := &KerberosAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
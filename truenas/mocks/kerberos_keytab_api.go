@@ -0,0 +1,488 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// KerberosKeytabAPI is an autogenerated mock type for the KerberosKeytabAPI type
+type KerberosKeytabAPI struct {
+	mock.Mock
+}
+
+type KerberosKeytabAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KerberosKeytabAPI) EXPECT() *KerberosKeytabAPI_Expecter {
+	return &KerberosKeytabAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *KerberosKeytabAPI) Create(ctx context.Context, req *truenas.KerberosKeytabRequest) (*truenas.KerberosKeytab, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.KerberosKeytab
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KerberosKeytabRequest) (*truenas.KerberosKeytab, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KerberosKeytabRequest) *truenas.KerberosKeytab); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KerberosKeytab)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.KerberosKeytabRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosKeytabAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type KerberosKeytabAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.KerberosKeytabRequest
+func (_e *KerberosKeytabAPI_Expecter) Create(ctx interface{}, req interface{}) *KerberosKeytabAPI_Create_Call {
+	return &KerberosKeytabAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *KerberosKeytabAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.KerberosKeytabRequest)) *KerberosKeytabAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.KerberosKeytabRequest))
+	})
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_Create_Call) Return(_a0 *truenas.KerberosKeytab, _a1 error) *KerberosKeytabAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.KerberosKeytabRequest) (*truenas.KerberosKeytab, error)) *KerberosKeytabAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *KerberosKeytabAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KerberosKeytabAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type KerberosKeytabAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *KerberosKeytabAPI_Expecter) Delete(ctx interface{}, id interface{}) *KerberosKeytabAPI_Delete_Call {
+	return &KerberosKeytabAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *KerberosKeytabAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *KerberosKeytabAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_Delete_Call) Return(_a0 error) *KerberosKeytabAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *KerberosKeytabAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *KerberosKeytabAPI) Get(ctx context.Context, id int) (*truenas.KerberosKeytab, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.KerberosKeytab
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.KerberosKeytab, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.KerberosKeytab); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KerberosKeytab)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosKeytabAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type KerberosKeytabAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *KerberosKeytabAPI_Expecter) Get(ctx interface{}, id interface{}) *KerberosKeytabAPI_Get_Call {
+	return &KerberosKeytabAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *KerberosKeytabAPI_Get_Call) Run(run func(ctx context.Context, id int)) *KerberosKeytabAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_Get_Call) Return(_a0 *truenas.KerberosKeytab, _a1 error) *KerberosKeytabAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.KerberosKeytab, error)) *KerberosKeytabAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *KerberosKeytabAPI) List(ctx context.Context) ([]truenas.KerberosKeytab, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.KerberosKeytab
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.KerberosKeytab, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.KerberosKeytab); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.KerberosKeytab)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosKeytabAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type KerberosKeytabAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KerberosKeytabAPI_Expecter) List(ctx interface{}) *KerberosKeytabAPI_List_Call {
+	return &KerberosKeytabAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *KerberosKeytabAPI_List_Call) Run(run func(ctx context.Context)) *KerberosKeytabAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_List_Call) Return(_a0 []truenas.KerberosKeytab, _a1 error) *KerberosKeytabAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.KerberosKeytab, error)) *KerberosKeytabAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *KerberosKeytabAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.KerberosKeytab, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.KerberosKeytab, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.KerberosKeytab, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.KerberosKeytab, error])
+		}
+	}
+
+	return r0
+}
+
+// KerberosKeytabAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type KerberosKeytabAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *KerberosKeytabAPI_Expecter) ListIter(ctx interface{}, q interface{}) *KerberosKeytabAPI_ListIter_Call {
+	return &KerberosKeytabAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *KerberosKeytabAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *KerberosKeytabAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.KerberosKeytab, error]) *KerberosKeytabAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.KerberosKeytab, error]) *KerberosKeytabAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *KerberosKeytabAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.KerberosKeytab, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.KerberosKeytab
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.KerberosKeytab, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.KerberosKeytab); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.KerberosKeytab)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosKeytabAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type KerberosKeytabAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *KerberosKeytabAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *KerberosKeytabAPI_ListWithQuery_Call {
+	return &KerberosKeytabAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *KerberosKeytabAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *KerberosKeytabAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_ListWithQuery_Call) Return(_a0 []truenas.KerberosKeytab, _a1 error) *KerberosKeytabAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.KerberosKeytab, error)) *KerberosKeytabAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SystemKeytabList provides a mock function with given fields: ctx
+func (_m *KerberosKeytabAPI) SystemKeytabList(ctx context.Context) ([]truenas.KerberosKeytabEntry, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SystemKeytabList")
+	}
+
+	var r0 []truenas.KerberosKeytabEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.KerberosKeytabEntry, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.KerberosKeytabEntry); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.KerberosKeytabEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosKeytabAPI_SystemKeytabList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SystemKeytabList'
+type KerberosKeytabAPI_SystemKeytabList_Call struct {
+	*mock.Call
+}
+
+// SystemKeytabList is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KerberosKeytabAPI_Expecter) SystemKeytabList(ctx interface{}) *KerberosKeytabAPI_SystemKeytabList_Call {
+	return &KerberosKeytabAPI_SystemKeytabList_Call{Call: _e.mock.On("SystemKeytabList", ctx)}
+}
+
+func (_c *KerberosKeytabAPI_SystemKeytabList_Call) Run(run func(ctx context.Context)) *KerberosKeytabAPI_SystemKeytabList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_SystemKeytabList_Call) Return(_a0 []truenas.KerberosKeytabEntry, _a1 error) *KerberosKeytabAPI_SystemKeytabList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_SystemKeytabList_Call) RunAndReturn(run func(context.Context) ([]truenas.KerberosKeytabEntry, error)) *KerberosKeytabAPI_SystemKeytabList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *KerberosKeytabAPI) Update(ctx context.Context, id int, req *truenas.KerberosKeytabRequest) (*truenas.KerberosKeytab, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.KerberosKeytab
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.KerberosKeytabRequest) (*truenas.KerberosKeytab, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.KerberosKeytabRequest) *truenas.KerberosKeytab); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KerberosKeytab)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.KerberosKeytabRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosKeytabAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type KerberosKeytabAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.KerberosKeytabRequest
+func (_e *KerberosKeytabAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *KerberosKeytabAPI_Update_Call {
+	return &KerberosKeytabAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *KerberosKeytabAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.KerberosKeytabRequest)) *KerberosKeytabAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.KerberosKeytabRequest))
+	})
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_Update_Call) Return(_a0 *truenas.KerberosKeytab, _a1 error) *KerberosKeytabAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosKeytabAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.KerberosKeytabRequest) (*truenas.KerberosKeytab, error)) *KerberosKeytabAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKerberosKeytabAPI creates a new instance of KerberosKeytabAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKerberosKeytabAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KerberosKeytabAPI {
+	mock := &KerberosKeytabAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,430 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// KerberosRealmAPI is an autogenerated mock type for the KerberosRealmAPI type
+type KerberosRealmAPI struct {
+	mock.Mock
+}
+
+type KerberosRealmAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KerberosRealmAPI) EXPECT() *KerberosRealmAPI_Expecter {
+	return &KerberosRealmAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *KerberosRealmAPI) Create(ctx context.Context, req *truenas.KerberosRealmRequest) (*truenas.KerberosRealm, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.KerberosRealm
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KerberosRealmRequest) (*truenas.KerberosRealm, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KerberosRealmRequest) *truenas.KerberosRealm); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KerberosRealm)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.KerberosRealmRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosRealmAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type KerberosRealmAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.KerberosRealmRequest
+func (_e *KerberosRealmAPI_Expecter) Create(ctx interface{}, req interface{}) *KerberosRealmAPI_Create_Call {
+	return &KerberosRealmAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *KerberosRealmAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.KerberosRealmRequest)) *KerberosRealmAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.KerberosRealmRequest))
+	})
+	return _c
+}
+
+func (_c *KerberosRealmAPI_Create_Call) Return(_a0 *truenas.KerberosRealm, _a1 error) *KerberosRealmAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosRealmAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.KerberosRealmRequest) (*truenas.KerberosRealm, error)) *KerberosRealmAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *KerberosRealmAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KerberosRealmAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type KerberosRealmAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *KerberosRealmAPI_Expecter) Delete(ctx interface{}, id interface{}) *KerberosRealmAPI_Delete_Call {
+	return &KerberosRealmAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *KerberosRealmAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *KerberosRealmAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *KerberosRealmAPI_Delete_Call) Return(_a0 error) *KerberosRealmAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KerberosRealmAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *KerberosRealmAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *KerberosRealmAPI) Get(ctx context.Context, id int) (*truenas.KerberosRealm, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.KerberosRealm
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.KerberosRealm, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.KerberosRealm); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KerberosRealm)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosRealmAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type KerberosRealmAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *KerberosRealmAPI_Expecter) Get(ctx interface{}, id interface{}) *KerberosRealmAPI_Get_Call {
+	return &KerberosRealmAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *KerberosRealmAPI_Get_Call) Run(run func(ctx context.Context, id int)) *KerberosRealmAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *KerberosRealmAPI_Get_Call) Return(_a0 *truenas.KerberosRealm, _a1 error) *KerberosRealmAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosRealmAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.KerberosRealm, error)) *KerberosRealmAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *KerberosRealmAPI) List(ctx context.Context) ([]truenas.KerberosRealm, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.KerberosRealm
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.KerberosRealm, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.KerberosRealm); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.KerberosRealm)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosRealmAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type KerberosRealmAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KerberosRealmAPI_Expecter) List(ctx interface{}) *KerberosRealmAPI_List_Call {
+	return &KerberosRealmAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *KerberosRealmAPI_List_Call) Run(run func(ctx context.Context)) *KerberosRealmAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KerberosRealmAPI_List_Call) Return(_a0 []truenas.KerberosRealm, _a1 error) *KerberosRealmAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosRealmAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.KerberosRealm, error)) *KerberosRealmAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *KerberosRealmAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.KerberosRealm, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.KerberosRealm, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.KerberosRealm, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.KerberosRealm, error])
+		}
+	}
+
+	return r0
+}
+
+// KerberosRealmAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type KerberosRealmAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *KerberosRealmAPI_Expecter) ListIter(ctx interface{}, q interface{}) *KerberosRealmAPI_ListIter_Call {
+	return &KerberosRealmAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *KerberosRealmAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *KerberosRealmAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *KerberosRealmAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.KerberosRealm, error]) *KerberosRealmAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KerberosRealmAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.KerberosRealm, error]) *KerberosRealmAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *KerberosRealmAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.KerberosRealm, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.KerberosRealm
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.KerberosRealm, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.KerberosRealm); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.KerberosRealm)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosRealmAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type KerberosRealmAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *KerberosRealmAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *KerberosRealmAPI_ListWithQuery_Call {
+	return &KerberosRealmAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *KerberosRealmAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *KerberosRealmAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *KerberosRealmAPI_ListWithQuery_Call) Return(_a0 []truenas.KerberosRealm, _a1 error) *KerberosRealmAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosRealmAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.KerberosRealm, error)) *KerberosRealmAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *KerberosRealmAPI) Update(ctx context.Context, id int, req *truenas.KerberosRealmRequest) (*truenas.KerberosRealm, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.KerberosRealm
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.KerberosRealmRequest) (*truenas.KerberosRealm, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.KerberosRealmRequest) *truenas.KerberosRealm); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KerberosRealm)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.KerberosRealmRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KerberosRealmAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type KerberosRealmAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.KerberosRealmRequest
+func (_e *KerberosRealmAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *KerberosRealmAPI_Update_Call {
+	return &KerberosRealmAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *KerberosRealmAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.KerberosRealmRequest)) *KerberosRealmAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.KerberosRealmRequest))
+	})
+	return _c
+}
+
+func (_c *KerberosRealmAPI_Update_Call) Return(_a0 *truenas.KerberosRealm, _a1 error) *KerberosRealmAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KerberosRealmAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.KerberosRealmRequest) (*truenas.KerberosRealm, error)) *KerberosRealmAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKerberosRealmAPI creates a new instance of KerberosRealmAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKerberosRealmAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KerberosRealmAPI {
+	mock := &KerberosRealmAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
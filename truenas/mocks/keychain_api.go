@@ -0,0 +1,605 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// KeychainAPI is an autogenerated mock type for the KeychainAPI type
+type KeychainAPI struct {
+	mock.Mock
+}
+
+type KeychainAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KeychainAPI) EXPECT() *KeychainAPI_Expecter {
+	return &KeychainAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *KeychainAPI) Create(ctx context.Context, req *truenas.KeychainCredentialRequest) (*truenas.KeychainCredential, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.KeychainCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KeychainCredentialRequest) (*truenas.KeychainCredential, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KeychainCredentialRequest) *truenas.KeychainCredential); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KeychainCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.KeychainCredentialRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeychainAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type KeychainAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.KeychainCredentialRequest
+func (_e *KeychainAPI_Expecter) Create(ctx interface{}, req interface{}) *KeychainAPI_Create_Call {
+	return &KeychainAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *KeychainAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.KeychainCredentialRequest)) *KeychainAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.KeychainCredentialRequest))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_Create_Call) Return(_a0 *truenas.KeychainCredential, _a1 error) *KeychainAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeychainAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.KeychainCredentialRequest) (*truenas.KeychainCredential, error)) *KeychainAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, options
+func (_m *KeychainAPI) Delete(ctx context.Context, id int, options *truenas.KeychainCredentialDeleteOptions) error {
+	ret := _m.Called(ctx, id, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.KeychainCredentialDeleteOptions) error); ok {
+		r0 = rf(ctx, id, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KeychainAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type KeychainAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - options *truenas.KeychainCredentialDeleteOptions
+func (_e *KeychainAPI_Expecter) Delete(ctx interface{}, id interface{}, options interface{}) *KeychainAPI_Delete_Call {
+	return &KeychainAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, options)}
+}
+
+func (_c *KeychainAPI_Delete_Call) Run(run func(ctx context.Context, id int, options *truenas.KeychainCredentialDeleteOptions)) *KeychainAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.KeychainCredentialDeleteOptions))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_Delete_Call) Return(_a0 error) *KeychainAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KeychainAPI_Delete_Call) RunAndReturn(run func(context.Context, int, *truenas.KeychainCredentialDeleteOptions) error) *KeychainAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateSSHKeyPair provides a mock function with given fields: ctx
+func (_m *KeychainAPI) GenerateSSHKeyPair(ctx context.Context) (*truenas.SSHKeyPair, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateSSHKeyPair")
+	}
+
+	var r0 *truenas.SSHKeyPair
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SSHKeyPair, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SSHKeyPair); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SSHKeyPair)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeychainAPI_GenerateSSHKeyPair_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateSSHKeyPair'
+type KeychainAPI_GenerateSSHKeyPair_Call struct {
+	*mock.Call
+}
+
+// GenerateSSHKeyPair is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KeychainAPI_Expecter) GenerateSSHKeyPair(ctx interface{}) *KeychainAPI_GenerateSSHKeyPair_Call {
+	return &KeychainAPI_GenerateSSHKeyPair_Call{Call: _e.mock.On("GenerateSSHKeyPair", ctx)}
+}
+
+func (_c *KeychainAPI_GenerateSSHKeyPair_Call) Run(run func(ctx context.Context)) *KeychainAPI_GenerateSSHKeyPair_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_GenerateSSHKeyPair_Call) Return(_a0 *truenas.SSHKeyPair, _a1 error) *KeychainAPI_GenerateSSHKeyPair_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeychainAPI_GenerateSSHKeyPair_Call) RunAndReturn(run func(context.Context) (*truenas.SSHKeyPair, error)) *KeychainAPI_GenerateSSHKeyPair_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *KeychainAPI) Get(ctx context.Context, id int) (*truenas.KeychainCredential, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.KeychainCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.KeychainCredential, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.KeychainCredential); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KeychainCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeychainAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type KeychainAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *KeychainAPI_Expecter) Get(ctx interface{}, id interface{}) *KeychainAPI_Get_Call {
+	return &KeychainAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *KeychainAPI_Get_Call) Run(run func(ctx context.Context, id int)) *KeychainAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_Get_Call) Return(_a0 *truenas.KeychainCredential, _a1 error) *KeychainAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeychainAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.KeychainCredential, error)) *KeychainAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *KeychainAPI) List(ctx context.Context) ([]truenas.KeychainCredential, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.KeychainCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.KeychainCredential, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.KeychainCredential); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.KeychainCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeychainAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type KeychainAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KeychainAPI_Expecter) List(ctx interface{}) *KeychainAPI_List_Call {
+	return &KeychainAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *KeychainAPI_List_Call) Run(run func(ctx context.Context)) *KeychainAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_List_Call) Return(_a0 []truenas.KeychainCredential, _a1 error) *KeychainAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeychainAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.KeychainCredential, error)) *KeychainAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *KeychainAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.KeychainCredential, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.KeychainCredential, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.KeychainCredential, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.KeychainCredential, error])
+		}
+	}
+
+	return r0
+}
+
+// KeychainAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type KeychainAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *KeychainAPI_Expecter) ListIter(ctx interface{}, q interface{}) *KeychainAPI_ListIter_Call {
+	return &KeychainAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *KeychainAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *KeychainAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.KeychainCredential, error]) *KeychainAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KeychainAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.KeychainCredential, error]) *KeychainAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *KeychainAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.KeychainCredential, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.KeychainCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.KeychainCredential, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.KeychainCredential); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.KeychainCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeychainAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type KeychainAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *KeychainAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *KeychainAPI_ListWithQuery_Call {
+	return &KeychainAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *KeychainAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *KeychainAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_ListWithQuery_Call) Return(_a0 []truenas.KeychainCredential, _a1 error) *KeychainAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeychainAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.KeychainCredential, error)) *KeychainAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoteSSHHostKeyScan provides a mock function with given fields: ctx, req
+func (_m *KeychainAPI) RemoteSSHHostKeyScan(ctx context.Context, req truenas.SSHHostKeyScanRequest) (string, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoteSSHHostKeyScan")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SSHHostKeyScanRequest) (string, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SSHHostKeyScanRequest) string); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.SSHHostKeyScanRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeychainAPI_RemoteSSHHostKeyScan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoteSSHHostKeyScan'
+type KeychainAPI_RemoteSSHHostKeyScan_Call struct {
+	*mock.Call
+}
+
+// RemoteSSHHostKeyScan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.SSHHostKeyScanRequest
+func (_e *KeychainAPI_Expecter) RemoteSSHHostKeyScan(ctx interface{}, req interface{}) *KeychainAPI_RemoteSSHHostKeyScan_Call {
+	return &KeychainAPI_RemoteSSHHostKeyScan_Call{Call: _e.mock.On("RemoteSSHHostKeyScan", ctx, req)}
+}
+
+func (_c *KeychainAPI_RemoteSSHHostKeyScan_Call) Run(run func(ctx context.Context, req truenas.SSHHostKeyScanRequest)) *KeychainAPI_RemoteSSHHostKeyScan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.SSHHostKeyScanRequest))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_RemoteSSHHostKeyScan_Call) Return(_a0 string, _a1 error) *KeychainAPI_RemoteSSHHostKeyScan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeychainAPI_RemoteSSHHostKeyScan_Call) RunAndReturn(run func(context.Context, truenas.SSHHostKeyScanRequest) (string, error)) *KeychainAPI_RemoteSSHHostKeyScan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetupSSHConnection provides a mock function with given fields: ctx, req
+func (_m *KeychainAPI) SetupSSHConnection(ctx context.Context, req *truenas.SSHConnectionSetup) (*truenas.KeychainCredential, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetupSSHConnection")
+	}
+
+	var r0 *truenas.KeychainCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SSHConnectionSetup) (*truenas.KeychainCredential, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SSHConnectionSetup) *truenas.KeychainCredential); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KeychainCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SSHConnectionSetup) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeychainAPI_SetupSSHConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetupSSHConnection'
+type KeychainAPI_SetupSSHConnection_Call struct {
+	*mock.Call
+}
+
+// SetupSSHConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SSHConnectionSetup
+func (_e *KeychainAPI_Expecter) SetupSSHConnection(ctx interface{}, req interface{}) *KeychainAPI_SetupSSHConnection_Call {
+	return &KeychainAPI_SetupSSHConnection_Call{Call: _e.mock.On("SetupSSHConnection", ctx, req)}
+}
+
+func (_c *KeychainAPI_SetupSSHConnection_Call) Run(run func(ctx context.Context, req *truenas.SSHConnectionSetup)) *KeychainAPI_SetupSSHConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SSHConnectionSetup))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_SetupSSHConnection_Call) Return(_a0 *truenas.KeychainCredential, _a1 error) *KeychainAPI_SetupSSHConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeychainAPI_SetupSSHConnection_Call) RunAndReturn(run func(context.Context, *truenas.SSHConnectionSetup) (*truenas.KeychainCredential, error)) *KeychainAPI_SetupSSHConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *KeychainAPI) Update(ctx context.Context, id int, req *truenas.KeychainCredentialRequest) (*truenas.KeychainCredential, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.KeychainCredential
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.KeychainCredentialRequest) (*truenas.KeychainCredential, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.KeychainCredentialRequest) *truenas.KeychainCredential); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KeychainCredential)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.KeychainCredentialRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KeychainAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type KeychainAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.KeychainCredentialRequest
+func (_e *KeychainAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *KeychainAPI_Update_Call {
+	return &KeychainAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *KeychainAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.KeychainCredentialRequest)) *KeychainAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.KeychainCredentialRequest))
+	})
+	return _c
+}
+
+func (_c *KeychainAPI_Update_Call) Return(_a0 *truenas.KeychainCredential, _a1 error) *KeychainAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KeychainAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.KeychainCredentialRequest) (*truenas.KeychainCredential, error)) *KeychainAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKeychainAPI creates a new instance of KeychainAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKeychainAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KeychainAPI {
+	mock := &KeychainAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
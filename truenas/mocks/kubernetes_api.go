@@ -0,0 +1,536 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// KubernetesAPI is an autogenerated mock type for the KubernetesAPI type
+type KubernetesAPI struct {
+	mock.Mock
+}
+
+type KubernetesAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *KubernetesAPI) EXPECT() *KubernetesAPI_Expecter {
+	return &KubernetesAPI_Expecter{mock: &_m.Mock}
+}
+
+// BackupChartReleases provides a mock function with given fields: ctx, name
+func (_m *KubernetesAPI) BackupChartReleases(ctx context.Context, name string) (string, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BackupChartReleases")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubernetesAPI_BackupChartReleases_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BackupChartReleases'
+type KubernetesAPI_BackupChartReleases_Call struct {
+	*mock.Call
+}
+
+// BackupChartReleases is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *KubernetesAPI_Expecter) BackupChartReleases(ctx interface{}, name interface{}) *KubernetesAPI_BackupChartReleases_Call {
+	return &KubernetesAPI_BackupChartReleases_Call{Call: _e.mock.On("BackupChartReleases", ctx, name)}
+}
+
+func (_c *KubernetesAPI_BackupChartReleases_Call) Run(run func(ctx context.Context, name string)) *KubernetesAPI_BackupChartReleases_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_BackupChartReleases_Call) Return(_a0 string, _a1 error) *KubernetesAPI_BackupChartReleases_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubernetesAPI_BackupChartReleases_Call) RunAndReturn(run func(context.Context, string) (string, error)) *KubernetesAPI_BackupChartReleases_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BindIPChoices provides a mock function with given fields: ctx
+func (_m *KubernetesAPI) BindIPChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindIPChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubernetesAPI_BindIPChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindIPChoices'
+type KubernetesAPI_BindIPChoices_Call struct {
+	*mock.Call
+}
+
+// BindIPChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KubernetesAPI_Expecter) BindIPChoices(ctx interface{}) *KubernetesAPI_BindIPChoices_Call {
+	return &KubernetesAPI_BindIPChoices_Call{Call: _e.mock.On("BindIPChoices", ctx)}
+}
+
+func (_c *KubernetesAPI_BindIPChoices_Call) Run(run func(ctx context.Context)) *KubernetesAPI_BindIPChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_BindIPChoices_Call) Return(_a0 map[string]string, _a1 error) *KubernetesAPI_BindIPChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubernetesAPI_BindIPChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *KubernetesAPI_BindIPChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBackup provides a mock function with given fields: ctx, name
+func (_m *KubernetesAPI) DeleteBackup(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBackup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KubernetesAPI_DeleteBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBackup'
+type KubernetesAPI_DeleteBackup_Call struct {
+	*mock.Call
+}
+
+// DeleteBackup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *KubernetesAPI_Expecter) DeleteBackup(ctx interface{}, name interface{}) *KubernetesAPI_DeleteBackup_Call {
+	return &KubernetesAPI_DeleteBackup_Call{Call: _e.mock.On("DeleteBackup", ctx, name)}
+}
+
+func (_c *KubernetesAPI_DeleteBackup_Call) Run(run func(ctx context.Context, name string)) *KubernetesAPI_DeleteBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_DeleteBackup_Call) Return(_a0 error) *KubernetesAPI_DeleteBackup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KubernetesAPI_DeleteBackup_Call) RunAndReturn(run func(context.Context, string) error) *KubernetesAPI_DeleteBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *KubernetesAPI) GetConfig(ctx context.Context) (*truenas.KubernetesConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.KubernetesConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.KubernetesConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.KubernetesConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KubernetesConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubernetesAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type KubernetesAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KubernetesAPI_Expecter) GetConfig(ctx interface{}) *KubernetesAPI_GetConfig_Call {
+	return &KubernetesAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *KubernetesAPI_GetConfig_Call) Run(run func(ctx context.Context)) *KubernetesAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_GetConfig_Call) Return(_a0 *truenas.KubernetesConfig, _a1 error) *KubernetesAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubernetesAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.KubernetesConfig, error)) *KubernetesAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBackups provides a mock function with given fields: ctx
+func (_m *KubernetesAPI) ListBackups(ctx context.Context) (map[string]any, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBackups")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]any, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]any); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubernetesAPI_ListBackups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackups'
+type KubernetesAPI_ListBackups_Call struct {
+	*mock.Call
+}
+
+// ListBackups is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KubernetesAPI_Expecter) ListBackups(ctx interface{}) *KubernetesAPI_ListBackups_Call {
+	return &KubernetesAPI_ListBackups_Call{Call: _e.mock.On("ListBackups", ctx)}
+}
+
+func (_c *KubernetesAPI_ListBackups_Call) Run(run func(ctx context.Context)) *KubernetesAPI_ListBackups_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_ListBackups_Call) Return(_a0 map[string]any, _a1 error) *KubernetesAPI_ListBackups_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubernetesAPI_ListBackups_Call) RunAndReturn(run func(context.Context) (map[string]any, error)) *KubernetesAPI_ListBackups_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NodeIP provides a mock function with given fields: ctx
+func (_m *KubernetesAPI) NodeIP(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NodeIP")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubernetesAPI_NodeIP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NodeIP'
+type KubernetesAPI_NodeIP_Call struct {
+	*mock.Call
+}
+
+// NodeIP is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KubernetesAPI_Expecter) NodeIP(ctx interface{}) *KubernetesAPI_NodeIP_Call {
+	return &KubernetesAPI_NodeIP_Call{Call: _e.mock.On("NodeIP", ctx)}
+}
+
+func (_c *KubernetesAPI_NodeIP_Call) Run(run func(ctx context.Context)) *KubernetesAPI_NodeIP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_NodeIP_Call) Return(_a0 string, _a1 error) *KubernetesAPI_NodeIP_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubernetesAPI_NodeIP_Call) RunAndReturn(run func(context.Context) (string, error)) *KubernetesAPI_NodeIP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreBackup provides a mock function with given fields: ctx, name, options
+func (_m *KubernetesAPI) RestoreBackup(ctx context.Context, name string, options *truenas.KubernetesRestoreOptions) error {
+	ret := _m.Called(ctx, name, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreBackup")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.KubernetesRestoreOptions) error); ok {
+		r0 = rf(ctx, name, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// KubernetesAPI_RestoreBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBackup'
+type KubernetesAPI_RestoreBackup_Call struct {
+	*mock.Call
+}
+
+// RestoreBackup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - options *truenas.KubernetesRestoreOptions
+func (_e *KubernetesAPI_Expecter) RestoreBackup(ctx interface{}, name interface{}, options interface{}) *KubernetesAPI_RestoreBackup_Call {
+	return &KubernetesAPI_RestoreBackup_Call{Call: _e.mock.On("RestoreBackup", ctx, name, options)}
+}
+
+func (_c *KubernetesAPI_RestoreBackup_Call) Run(run func(ctx context.Context, name string, options *truenas.KubernetesRestoreOptions)) *KubernetesAPI_RestoreBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.KubernetesRestoreOptions))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_RestoreBackup_Call) Return(_a0 error) *KubernetesAPI_RestoreBackup_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *KubernetesAPI_RestoreBackup_Call) RunAndReturn(run func(context.Context, string, *truenas.KubernetesRestoreOptions) error) *KubernetesAPI_RestoreBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Status provides a mock function with given fields: ctx
+func (_m *KubernetesAPI) Status(ctx context.Context) (*truenas.KubernetesStatusResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Status")
+	}
+
+	var r0 *truenas.KubernetesStatusResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.KubernetesStatusResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.KubernetesStatusResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KubernetesStatusResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubernetesAPI_Status_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Status'
+type KubernetesAPI_Status_Call struct {
+	*mock.Call
+}
+
+// Status is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *KubernetesAPI_Expecter) Status(ctx interface{}) *KubernetesAPI_Status_Call {
+	return &KubernetesAPI_Status_Call{Call: _e.mock.On("Status", ctx)}
+}
+
+func (_c *KubernetesAPI_Status_Call) Run(run func(ctx context.Context)) *KubernetesAPI_Status_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_Status_Call) Return(_a0 *truenas.KubernetesStatusResult, _a1 error) *KubernetesAPI_Status_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubernetesAPI_Status_Call) RunAndReturn(run func(context.Context) (*truenas.KubernetesStatusResult, error)) *KubernetesAPI_Status_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, req
+func (_m *KubernetesAPI) UpdateConfig(ctx context.Context, req *truenas.KubernetesUpdateRequest) (*truenas.KubernetesConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.KubernetesConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KubernetesUpdateRequest) (*truenas.KubernetesConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.KubernetesUpdateRequest) *truenas.KubernetesConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.KubernetesConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.KubernetesUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// KubernetesAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type KubernetesAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.KubernetesUpdateRequest
+func (_e *KubernetesAPI_Expecter) UpdateConfig(ctx interface{}, req interface{}) *KubernetesAPI_UpdateConfig_Call {
+	return &KubernetesAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, req)}
+}
+
+func (_c *KubernetesAPI_UpdateConfig_Call) Run(run func(ctx context.Context, req *truenas.KubernetesUpdateRequest)) *KubernetesAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.KubernetesUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *KubernetesAPI_UpdateConfig_Call) Return(_a0 *truenas.KubernetesConfig, _a1 error) *KubernetesAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *KubernetesAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.KubernetesUpdateRequest) (*truenas.KubernetesConfig, error)) *KubernetesAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewKubernetesAPI creates a new instance of KubernetesAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKubernetesAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KubernetesAPI {
+	mock := &KubernetesAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
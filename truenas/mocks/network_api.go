@@ -0,0 +1,1252 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NetworkAPI is an autogenerated mock type for the NetworkAPI type
+type NetworkAPI struct {
+	mock.Mock
+}
+
+type NetworkAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NetworkAPI) EXPECT() *NetworkAPI_Expecter {
+	return &NetworkAPI_Expecter{mock: &_m.Mock}
+}
+
+// Checkin provides a mock function with given fields: ctx
+func (_m *NetworkAPI) Checkin(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Checkin")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NetworkAPI_Checkin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Checkin'
+type NetworkAPI_Checkin_Call struct {
+	*mock.Call
+}
+
+// Checkin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NetworkAPI_Expecter) Checkin(ctx interface{}) *NetworkAPI_Checkin_Call {
+	return &NetworkAPI_Checkin_Call{Call: _e.mock.On("Checkin", ctx)}
+}
+
+func (_c *NetworkAPI_Checkin_Call) Run(run func(ctx context.Context)) *NetworkAPI_Checkin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_Checkin_Call) Return(_a0 error) *NetworkAPI_Checkin_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_Checkin_Call) RunAndReturn(run func(context.Context) error) *NetworkAPI_Checkin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckinWaiting provides a mock function with given fields: ctx
+func (_m *NetworkAPI) CheckinWaiting(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckinWaiting")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_CheckinWaiting_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckinWaiting'
+type NetworkAPI_CheckinWaiting_Call struct {
+	*mock.Call
+}
+
+// CheckinWaiting is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NetworkAPI_Expecter) CheckinWaiting(ctx interface{}) *NetworkAPI_CheckinWaiting_Call {
+	return &NetworkAPI_CheckinWaiting_Call{Call: _e.mock.On("CheckinWaiting", ctx)}
+}
+
+func (_c *NetworkAPI_CheckinWaiting_Call) Run(run func(ctx context.Context)) *NetworkAPI_CheckinWaiting_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_CheckinWaiting_Call) Return(_a0 bool, _a1 error) *NetworkAPI_CheckinWaiting_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_CheckinWaiting_Call) RunAndReturn(run func(context.Context) (bool, error)) *NetworkAPI_CheckinWaiting_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CommitPendingChanges provides a mock function with given fields: ctx, rollback
+func (_m *NetworkAPI) CommitPendingChanges(ctx context.Context, rollback bool) error {
+	ret := _m.Called(ctx, rollback)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CommitPendingChanges")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) error); ok {
+		r0 = rf(ctx, rollback)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NetworkAPI_CommitPendingChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitPendingChanges'
+type NetworkAPI_CommitPendingChanges_Call struct {
+	*mock.Call
+}
+
+// CommitPendingChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rollback bool
+func (_e *NetworkAPI_Expecter) CommitPendingChanges(ctx interface{}, rollback interface{}) *NetworkAPI_CommitPendingChanges_Call {
+	return &NetworkAPI_CommitPendingChanges_Call{Call: _e.mock.On("CommitPendingChanges", ctx, rollback)}
+}
+
+func (_c *NetworkAPI_CommitPendingChanges_Call) Run(run func(ctx context.Context, rollback bool)) *NetworkAPI_CommitPendingChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_CommitPendingChanges_Call) Return(_a0 error) *NetworkAPI_CommitPendingChanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_CommitPendingChanges_Call) RunAndReturn(run func(context.Context, bool) error) *NetworkAPI_CommitPendingChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateInterface provides a mock function with given fields: ctx, req
+func (_m *NetworkAPI) CreateInterface(ctx context.Context, req *truenas.NetworkInterfaceCreateRequest) (*truenas.NetworkInterface, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInterface")
+	}
+
+	var r0 *truenas.NetworkInterface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NetworkInterfaceCreateRequest) (*truenas.NetworkInterface, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NetworkInterfaceCreateRequest) *truenas.NetworkInterface); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NetworkInterface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.NetworkInterfaceCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_CreateInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateInterface'
+type NetworkAPI_CreateInterface_Call struct {
+	*mock.Call
+}
+
+// CreateInterface is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.NetworkInterfaceCreateRequest
+func (_e *NetworkAPI_Expecter) CreateInterface(ctx interface{}, req interface{}) *NetworkAPI_CreateInterface_Call {
+	return &NetworkAPI_CreateInterface_Call{Call: _e.mock.On("CreateInterface", ctx, req)}
+}
+
+func (_c *NetworkAPI_CreateInterface_Call) Run(run func(ctx context.Context, req *truenas.NetworkInterfaceCreateRequest)) *NetworkAPI_CreateInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.NetworkInterfaceCreateRequest))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_CreateInterface_Call) Return(_a0 *truenas.NetworkInterface, _a1 error) *NetworkAPI_CreateInterface_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_CreateInterface_Call) RunAndReturn(run func(context.Context, *truenas.NetworkInterfaceCreateRequest) (*truenas.NetworkInterface, error)) *NetworkAPI_CreateInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateStaticRoute provides a mock function with given fields: ctx, req
+func (_m *NetworkAPI) CreateStaticRoute(ctx context.Context, req truenas.StaticRouteCreateRequest) (*truenas.StaticRoute, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStaticRoute")
+	}
+
+	var r0 *truenas.StaticRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.StaticRouteCreateRequest) (*truenas.StaticRoute, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.StaticRouteCreateRequest) *truenas.StaticRoute); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.StaticRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.StaticRouteCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_CreateStaticRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateStaticRoute'
+type NetworkAPI_CreateStaticRoute_Call struct {
+	*mock.Call
+}
+
+// CreateStaticRoute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.StaticRouteCreateRequest
+func (_e *NetworkAPI_Expecter) CreateStaticRoute(ctx interface{}, req interface{}) *NetworkAPI_CreateStaticRoute_Call {
+	return &NetworkAPI_CreateStaticRoute_Call{Call: _e.mock.On("CreateStaticRoute", ctx, req)}
+}
+
+func (_c *NetworkAPI_CreateStaticRoute_Call) Run(run func(ctx context.Context, req truenas.StaticRouteCreateRequest)) *NetworkAPI_CreateStaticRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.StaticRouteCreateRequest))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_CreateStaticRoute_Call) Return(_a0 *truenas.StaticRoute, _a1 error) *NetworkAPI_CreateStaticRoute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_CreateStaticRoute_Call) RunAndReturn(run func(context.Context, truenas.StaticRouteCreateRequest) (*truenas.StaticRoute, error)) *NetworkAPI_CreateStaticRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteInterface provides a mock function with given fields: ctx, id
+func (_m *NetworkAPI) DeleteInterface(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteInterface")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NetworkAPI_DeleteInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteInterface'
+type NetworkAPI_DeleteInterface_Call struct {
+	*mock.Call
+}
+
+// DeleteInterface is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *NetworkAPI_Expecter) DeleteInterface(ctx interface{}, id interface{}) *NetworkAPI_DeleteInterface_Call {
+	return &NetworkAPI_DeleteInterface_Call{Call: _e.mock.On("DeleteInterface", ctx, id)}
+}
+
+func (_c *NetworkAPI_DeleteInterface_Call) Run(run func(ctx context.Context, id int)) *NetworkAPI_DeleteInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_DeleteInterface_Call) Return(_a0 error) *NetworkAPI_DeleteInterface_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_DeleteInterface_Call) RunAndReturn(run func(context.Context, int) error) *NetworkAPI_DeleteInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteStaticRoute provides a mock function with given fields: ctx, id
+func (_m *NetworkAPI) DeleteStaticRoute(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteStaticRoute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NetworkAPI_DeleteStaticRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteStaticRoute'
+type NetworkAPI_DeleteStaticRoute_Call struct {
+	*mock.Call
+}
+
+// DeleteStaticRoute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *NetworkAPI_Expecter) DeleteStaticRoute(ctx interface{}, id interface{}) *NetworkAPI_DeleteStaticRoute_Call {
+	return &NetworkAPI_DeleteStaticRoute_Call{Call: _e.mock.On("DeleteStaticRoute", ctx, id)}
+}
+
+func (_c *NetworkAPI_DeleteStaticRoute_Call) Run(run func(ctx context.Context, id int)) *NetworkAPI_DeleteStaticRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_DeleteStaticRoute_Call) Return(_a0 error) *NetworkAPI_DeleteStaticRoute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_DeleteStaticRoute_Call) RunAndReturn(run func(context.Context, int) error) *NetworkAPI_DeleteStaticRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfiguration provides a mock function with given fields: ctx
+func (_m *NetworkAPI) GetConfiguration(ctx context.Context) (*truenas.NetworkConfiguration, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfiguration")
+	}
+
+	var r0 *truenas.NetworkConfiguration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.NetworkConfiguration, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.NetworkConfiguration); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NetworkConfiguration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_GetConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfiguration'
+type NetworkAPI_GetConfiguration_Call struct {
+	*mock.Call
+}
+
+// GetConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NetworkAPI_Expecter) GetConfiguration(ctx interface{}) *NetworkAPI_GetConfiguration_Call {
+	return &NetworkAPI_GetConfiguration_Call{Call: _e.mock.On("GetConfiguration", ctx)}
+}
+
+func (_c *NetworkAPI_GetConfiguration_Call) Run(run func(ctx context.Context)) *NetworkAPI_GetConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_GetConfiguration_Call) Return(_a0 *truenas.NetworkConfiguration, _a1 error) *NetworkAPI_GetConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_GetConfiguration_Call) RunAndReturn(run func(context.Context) (*truenas.NetworkConfiguration, error)) *NetworkAPI_GetConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInterface provides a mock function with given fields: ctx, id
+func (_m *NetworkAPI) GetInterface(ctx context.Context, id int) (*truenas.NetworkInterface, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInterface")
+	}
+
+	var r0 *truenas.NetworkInterface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.NetworkInterface, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.NetworkInterface); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NetworkInterface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_GetInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInterface'
+type NetworkAPI_GetInterface_Call struct {
+	*mock.Call
+}
+
+// GetInterface is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *NetworkAPI_Expecter) GetInterface(ctx interface{}, id interface{}) *NetworkAPI_GetInterface_Call {
+	return &NetworkAPI_GetInterface_Call{Call: _e.mock.On("GetInterface", ctx, id)}
+}
+
+func (_c *NetworkAPI_GetInterface_Call) Run(run func(ctx context.Context, id int)) *NetworkAPI_GetInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_GetInterface_Call) Return(_a0 *truenas.NetworkInterface, _a1 error) *NetworkAPI_GetInterface_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_GetInterface_Call) RunAndReturn(run func(context.Context, int) (*truenas.NetworkInterface, error)) *NetworkAPI_GetInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInterfaceByName provides a mock function with given fields: ctx, name
+func (_m *NetworkAPI) GetInterfaceByName(ctx context.Context, name string) (*truenas.NetworkInterface, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInterfaceByName")
+	}
+
+	var r0 *truenas.NetworkInterface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.NetworkInterface, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.NetworkInterface); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NetworkInterface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_GetInterfaceByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInterfaceByName'
+type NetworkAPI_GetInterfaceByName_Call struct {
+	*mock.Call
+}
+
+// GetInterfaceByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *NetworkAPI_Expecter) GetInterfaceByName(ctx interface{}, name interface{}) *NetworkAPI_GetInterfaceByName_Call {
+	return &NetworkAPI_GetInterfaceByName_Call{Call: _e.mock.On("GetInterfaceByName", ctx, name)}
+}
+
+func (_c *NetworkAPI_GetInterfaceByName_Call) Run(run func(ctx context.Context, name string)) *NetworkAPI_GetInterfaceByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_GetInterfaceByName_Call) Return(_a0 *truenas.NetworkInterface, _a1 error) *NetworkAPI_GetInterfaceByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_GetInterfaceByName_Call) RunAndReturn(run func(context.Context, string) (*truenas.NetworkInterface, error)) *NetworkAPI_GetInterfaceByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInterfaceChoices provides a mock function with given fields: ctx
+func (_m *NetworkAPI) GetInterfaceChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInterfaceChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_GetInterfaceChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInterfaceChoices'
+type NetworkAPI_GetInterfaceChoices_Call struct {
+	*mock.Call
+}
+
+// GetInterfaceChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NetworkAPI_Expecter) GetInterfaceChoices(ctx interface{}) *NetworkAPI_GetInterfaceChoices_Call {
+	return &NetworkAPI_GetInterfaceChoices_Call{Call: _e.mock.On("GetInterfaceChoices", ctx)}
+}
+
+func (_c *NetworkAPI_GetInterfaceChoices_Call) Run(run func(ctx context.Context)) *NetworkAPI_GetInterfaceChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_GetInterfaceChoices_Call) Return(_a0 map[string]string, _a1 error) *NetworkAPI_GetInterfaceChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_GetInterfaceChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *NetworkAPI_GetInterfaceChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStaticRoute provides a mock function with given fields: ctx, id
+func (_m *NetworkAPI) GetStaticRoute(ctx context.Context, id int) (*truenas.StaticRoute, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStaticRoute")
+	}
+
+	var r0 *truenas.StaticRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.StaticRoute, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.StaticRoute); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.StaticRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_GetStaticRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStaticRoute'
+type NetworkAPI_GetStaticRoute_Call struct {
+	*mock.Call
+}
+
+// GetStaticRoute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *NetworkAPI_Expecter) GetStaticRoute(ctx interface{}, id interface{}) *NetworkAPI_GetStaticRoute_Call {
+	return &NetworkAPI_GetStaticRoute_Call{Call: _e.mock.On("GetStaticRoute", ctx, id)}
+}
+
+func (_c *NetworkAPI_GetStaticRoute_Call) Run(run func(ctx context.Context, id int)) *NetworkAPI_GetStaticRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_GetStaticRoute_Call) Return(_a0 *truenas.StaticRoute, _a1 error) *NetworkAPI_GetStaticRoute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_GetStaticRoute_Call) RunAndReturn(run func(context.Context, int) (*truenas.StaticRoute, error)) *NetworkAPI_GetStaticRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasPendingChanges provides a mock function with given fields: ctx
+func (_m *NetworkAPI) HasPendingChanges(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasPendingChanges")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_HasPendingChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPendingChanges'
+type NetworkAPI_HasPendingChanges_Call struct {
+	*mock.Call
+}
+
+// HasPendingChanges is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NetworkAPI_Expecter) HasPendingChanges(ctx interface{}) *NetworkAPI_HasPendingChanges_Call {
+	return &NetworkAPI_HasPendingChanges_Call{Call: _e.mock.On("HasPendingChanges", ctx)}
+}
+
+func (_c *NetworkAPI_HasPendingChanges_Call) Run(run func(ctx context.Context)) *NetworkAPI_HasPendingChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_HasPendingChanges_Call) Return(_a0 bool, _a1 error) *NetworkAPI_HasPendingChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_HasPendingChanges_Call) RunAndReturn(run func(context.Context) (bool, error)) *NetworkAPI_HasPendingChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInterfaces provides a mock function with given fields: ctx
+func (_m *NetworkAPI) ListInterfaces(ctx context.Context) ([]truenas.NetworkInterface, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInterfaces")
+	}
+
+	var r0 []truenas.NetworkInterface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.NetworkInterface, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.NetworkInterface); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.NetworkInterface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_ListInterfaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInterfaces'
+type NetworkAPI_ListInterfaces_Call struct {
+	*mock.Call
+}
+
+// ListInterfaces is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NetworkAPI_Expecter) ListInterfaces(ctx interface{}) *NetworkAPI_ListInterfaces_Call {
+	return &NetworkAPI_ListInterfaces_Call{Call: _e.mock.On("ListInterfaces", ctx)}
+}
+
+func (_c *NetworkAPI_ListInterfaces_Call) Run(run func(ctx context.Context)) *NetworkAPI_ListInterfaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_ListInterfaces_Call) Return(_a0 []truenas.NetworkInterface, _a1 error) *NetworkAPI_ListInterfaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_ListInterfaces_Call) RunAndReturn(run func(context.Context) ([]truenas.NetworkInterface, error)) *NetworkAPI_ListInterfaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListInterfacesWithQuery provides a mock function with given fields: ctx, q
+func (_m *NetworkAPI) ListInterfacesWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.NetworkInterface, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListInterfacesWithQuery")
+	}
+
+	var r0 []truenas.NetworkInterface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.NetworkInterface, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.NetworkInterface); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.NetworkInterface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_ListInterfacesWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListInterfacesWithQuery'
+type NetworkAPI_ListInterfacesWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListInterfacesWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *NetworkAPI_Expecter) ListInterfacesWithQuery(ctx interface{}, q interface{}) *NetworkAPI_ListInterfacesWithQuery_Call {
+	return &NetworkAPI_ListInterfacesWithQuery_Call{Call: _e.mock.On("ListInterfacesWithQuery", ctx, q)}
+}
+
+func (_c *NetworkAPI_ListInterfacesWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *NetworkAPI_ListInterfacesWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_ListInterfacesWithQuery_Call) Return(_a0 []truenas.NetworkInterface, _a1 error) *NetworkAPI_ListInterfacesWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_ListInterfacesWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.NetworkInterface, error)) *NetworkAPI_ListInterfacesWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStaticRoutes provides a mock function with given fields: ctx
+func (_m *NetworkAPI) ListStaticRoutes(ctx context.Context) ([]truenas.StaticRoute, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStaticRoutes")
+	}
+
+	var r0 []truenas.StaticRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.StaticRoute, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.StaticRoute); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.StaticRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_ListStaticRoutes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStaticRoutes'
+type NetworkAPI_ListStaticRoutes_Call struct {
+	*mock.Call
+}
+
+// ListStaticRoutes is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NetworkAPI_Expecter) ListStaticRoutes(ctx interface{}) *NetworkAPI_ListStaticRoutes_Call {
+	return &NetworkAPI_ListStaticRoutes_Call{Call: _e.mock.On("ListStaticRoutes", ctx)}
+}
+
+func (_c *NetworkAPI_ListStaticRoutes_Call) Run(run func(ctx context.Context)) *NetworkAPI_ListStaticRoutes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_ListStaticRoutes_Call) Return(_a0 []truenas.StaticRoute, _a1 error) *NetworkAPI_ListStaticRoutes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_ListStaticRoutes_Call) RunAndReturn(run func(context.Context) ([]truenas.StaticRoute, error)) *NetworkAPI_ListStaticRoutes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStaticRoutesWithQuery provides a mock function with given fields: ctx, q
+func (_m *NetworkAPI) ListStaticRoutesWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.StaticRoute, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStaticRoutesWithQuery")
+	}
+
+	var r0 []truenas.StaticRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.StaticRoute, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.StaticRoute); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.StaticRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_ListStaticRoutesWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStaticRoutesWithQuery'
+type NetworkAPI_ListStaticRoutesWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListStaticRoutesWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *NetworkAPI_Expecter) ListStaticRoutesWithQuery(ctx interface{}, q interface{}) *NetworkAPI_ListStaticRoutesWithQuery_Call {
+	return &NetworkAPI_ListStaticRoutesWithQuery_Call{Call: _e.mock.On("ListStaticRoutesWithQuery", ctx, q)}
+}
+
+func (_c *NetworkAPI_ListStaticRoutesWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *NetworkAPI_ListStaticRoutesWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_ListStaticRoutesWithQuery_Call) Return(_a0 []truenas.StaticRoute, _a1 error) *NetworkAPI_ListStaticRoutesWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_ListStaticRoutesWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.StaticRoute, error)) *NetworkAPI_ListStaticRoutesWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackPendingChanges provides a mock function with given fields: ctx
+func (_m *NetworkAPI) RollbackPendingChanges(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackPendingChanges")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NetworkAPI_RollbackPendingChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackPendingChanges'
+type NetworkAPI_RollbackPendingChanges_Call struct {
+	*mock.Call
+}
+
+// RollbackPendingChanges is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NetworkAPI_Expecter) RollbackPendingChanges(ctx interface{}) *NetworkAPI_RollbackPendingChanges_Call {
+	return &NetworkAPI_RollbackPendingChanges_Call{Call: _e.mock.On("RollbackPendingChanges", ctx)}
+}
+
+func (_c *NetworkAPI_RollbackPendingChanges_Call) Run(run func(ctx context.Context)) *NetworkAPI_RollbackPendingChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_RollbackPendingChanges_Call) Return(_a0 error) *NetworkAPI_RollbackPendingChanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_RollbackPendingChanges_Call) RunAndReturn(run func(context.Context) error) *NetworkAPI_RollbackPendingChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfiguration provides a mock function with given fields: ctx, config
+func (_m *NetworkAPI) UpdateConfiguration(ctx context.Context, config *truenas.NetworkConfiguration) (*truenas.NetworkConfiguration, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfiguration")
+	}
+
+	var r0 *truenas.NetworkConfiguration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NetworkConfiguration) (*truenas.NetworkConfiguration, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NetworkConfiguration) *truenas.NetworkConfiguration); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NetworkConfiguration)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.NetworkConfiguration) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_UpdateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfiguration'
+type NetworkAPI_UpdateConfiguration_Call struct {
+	*mock.Call
+}
+
+// UpdateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *truenas.NetworkConfiguration
+func (_e *NetworkAPI_Expecter) UpdateConfiguration(ctx interface{}, config interface{}) *NetworkAPI_UpdateConfiguration_Call {
+	return &NetworkAPI_UpdateConfiguration_Call{Call: _e.mock.On("UpdateConfiguration", ctx, config)}
+}
+
+func (_c *NetworkAPI_UpdateConfiguration_Call) Run(run func(ctx context.Context, config *truenas.NetworkConfiguration)) *NetworkAPI_UpdateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.NetworkConfiguration))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_UpdateConfiguration_Call) Return(_a0 *truenas.NetworkConfiguration, _a1 error) *NetworkAPI_UpdateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_UpdateConfiguration_Call) RunAndReturn(run func(context.Context, *truenas.NetworkConfiguration) (*truenas.NetworkConfiguration, error)) *NetworkAPI_UpdateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateInterface provides a mock function with given fields: ctx, id, req
+func (_m *NetworkAPI) UpdateInterface(ctx context.Context, id int, req *truenas.NetworkInterfaceUpdateRequest) (*truenas.NetworkInterface, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateInterface")
+	}
+
+	var r0 *truenas.NetworkInterface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.NetworkInterfaceUpdateRequest) (*truenas.NetworkInterface, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.NetworkInterfaceUpdateRequest) *truenas.NetworkInterface); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NetworkInterface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.NetworkInterfaceUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_UpdateInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateInterface'
+type NetworkAPI_UpdateInterface_Call struct {
+	*mock.Call
+}
+
+// UpdateInterface is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.NetworkInterfaceUpdateRequest
+func (_e *NetworkAPI_Expecter) UpdateInterface(ctx interface{}, id interface{}, req interface{}) *NetworkAPI_UpdateInterface_Call {
+	return &NetworkAPI_UpdateInterface_Call{Call: _e.mock.On("UpdateInterface", ctx, id, req)}
+}
+
+func (_c *NetworkAPI_UpdateInterface_Call) Run(run func(ctx context.Context, id int, req *truenas.NetworkInterfaceUpdateRequest)) *NetworkAPI_UpdateInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.NetworkInterfaceUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_UpdateInterface_Call) Return(_a0 *truenas.NetworkInterface, _a1 error) *NetworkAPI_UpdateInterface_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_UpdateInterface_Call) RunAndReturn(run func(context.Context, int, *truenas.NetworkInterfaceUpdateRequest) (*truenas.NetworkInterface, error)) *NetworkAPI_UpdateInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStaticRoute provides a mock function with given fields: ctx, id, req
+func (_m *NetworkAPI) UpdateStaticRoute(ctx context.Context, id int, req truenas.StaticRouteCreateRequest) (*truenas.StaticRoute, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStaticRoute")
+	}
+
+	var r0 *truenas.StaticRoute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.StaticRouteCreateRequest) (*truenas.StaticRoute, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.StaticRouteCreateRequest) *truenas.StaticRoute); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.StaticRoute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, truenas.StaticRouteCreateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NetworkAPI_UpdateStaticRoute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStaticRoute'
+type NetworkAPI_UpdateStaticRoute_Call struct {
+	*mock.Call
+}
+
+// UpdateStaticRoute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req truenas.StaticRouteCreateRequest
+func (_e *NetworkAPI_Expecter) UpdateStaticRoute(ctx interface{}, id interface{}, req interface{}) *NetworkAPI_UpdateStaticRoute_Call {
+	return &NetworkAPI_UpdateStaticRoute_Call{Call: _e.mock.On("UpdateStaticRoute", ctx, id, req)}
+}
+
+func (_c *NetworkAPI_UpdateStaticRoute_Call) Run(run func(ctx context.Context, id int, req truenas.StaticRouteCreateRequest)) *NetworkAPI_UpdateStaticRoute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.StaticRouteCreateRequest))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_UpdateStaticRoute_Call) Return(_a0 *truenas.StaticRoute, _a1 error) *NetworkAPI_UpdateStaticRoute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NetworkAPI_UpdateStaticRoute_Call) RunAndReturn(run func(context.Context, int, truenas.StaticRouteCreateRequest) (*truenas.StaticRoute, error)) *NetworkAPI_UpdateStaticRoute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateInterface provides a mock function with given fields: req
+func (_m *NetworkAPI) ValidateInterface(req *truenas.NetworkInterfaceCreateRequest) error {
+	ret := _m.Called(req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateInterface")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*truenas.NetworkInterfaceCreateRequest) error); ok {
+		r0 = rf(req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NetworkAPI_ValidateInterface_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateInterface'
+type NetworkAPI_ValidateInterface_Call struct {
+	*mock.Call
+}
+
+// ValidateInterface is a helper method to define mock.On call
+//   - req *truenas.NetworkInterfaceCreateRequest
+func (_e *NetworkAPI_Expecter) ValidateInterface(req interface{}) *NetworkAPI_ValidateInterface_Call {
+	return &NetworkAPI_ValidateInterface_Call{Call: _e.mock.On("ValidateInterface", req)}
+}
+
+func (_c *NetworkAPI_ValidateInterface_Call) Run(run func(req *truenas.NetworkInterfaceCreateRequest)) *NetworkAPI_ValidateInterface_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*truenas.NetworkInterfaceCreateRequest))
+	})
+	return _c
+}
+
+func (_c *NetworkAPI_ValidateInterface_Call) Return(_a0 error) *NetworkAPI_ValidateInterface_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *NetworkAPI_ValidateInterface_Call) RunAndReturn(run func(*truenas.NetworkInterfaceCreateRequest) error) *NetworkAPI_ValidateInterface_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNetworkAPI creates a new instance of NetworkAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNetworkAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NetworkAPI {
+	mock := &NetworkAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,212 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NFSAPI is an autogenerated mock type for the NFSAPI type
+type NFSAPI struct {
+	mock.Mock
+}
+
+type NFSAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *NFSAPI) EXPECT() *NFSAPI_Expecter {
+	return &NFSAPI_Expecter{mock: &_m.Mock}
+}
+
+// BindIPChoices provides a mock function with given fields: ctx
+func (_m *NFSAPI) BindIPChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindIPChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NFSAPI_BindIPChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindIPChoices'
+type NFSAPI_BindIPChoices_Call struct {
+	*mock.Call
+}
+
+// BindIPChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NFSAPI_Expecter) BindIPChoices(ctx interface{}) *NFSAPI_BindIPChoices_Call {
+	return &NFSAPI_BindIPChoices_Call{Call: _e.mock.On("BindIPChoices", ctx)}
+}
+
+func (_c *NFSAPI_BindIPChoices_Call) Run(run func(ctx context.Context)) *NFSAPI_BindIPChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NFSAPI_BindIPChoices_Call) Return(_a0 map[string]string, _a1 error) *NFSAPI_BindIPChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NFSAPI_BindIPChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *NFSAPI_BindIPChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *NFSAPI) GetConfig(ctx context.Context) (*truenas.NFSConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.NFSConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.NFSConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.NFSConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NFSConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NFSAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type NFSAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *NFSAPI_Expecter) GetConfig(ctx interface{}) *NFSAPI_GetConfig_Call {
+	return &NFSAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *NFSAPI_GetConfig_Call) Run(run func(ctx context.Context)) *NFSAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *NFSAPI_GetConfig_Call) Return(_a0 *truenas.NFSConfig, _a1 error) *NFSAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NFSAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.NFSConfig, error)) *NFSAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, config
+func (_m *NFSAPI) UpdateConfig(ctx context.Context, config *truenas.NFSConfig) (*truenas.NFSConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.NFSConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NFSConfig) (*truenas.NFSConfig, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NFSConfig) *truenas.NFSConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NFSConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.NFSConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NFSAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type NFSAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *truenas.NFSConfig
+func (_e *NFSAPI_Expecter) UpdateConfig(ctx interface{}, config interface{}) *NFSAPI_UpdateConfig_Call {
+	return &NFSAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, config)}
+}
+
+func (_c *NFSAPI_UpdateConfig_Call) Run(run func(ctx context.Context, config *truenas.NFSConfig)) *NFSAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.NFSConfig))
+	})
+	return _c
+}
+
+func (_c *NFSAPI_UpdateConfig_Call) Return(_a0 *truenas.NFSConfig, _a1 error) *NFSAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *NFSAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.NFSConfig) (*truenas.NFSConfig, error)) *NFSAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewNFSAPI creates a new instance of NFSAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNFSAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NFSAPI {
+	mock := &NFSAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,430 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// PluginAPI is an autogenerated mock type for the PluginAPI type
+type PluginAPI struct {
+	mock.Mock
+}
+
+type PluginAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PluginAPI) EXPECT() *PluginAPI_Expecter {
+	return &PluginAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *PluginAPI) Create(ctx context.Context, req *truenas.PluginCreateRequest) (*truenas.Plugin, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Plugin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.PluginCreateRequest) (*truenas.Plugin, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.PluginCreateRequest) *truenas.Plugin); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Plugin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.PluginCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PluginAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.PluginCreateRequest
+func (_e *PluginAPI_Expecter) Create(ctx interface{}, req interface{}) *PluginAPI_Create_Call {
+	return &PluginAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *PluginAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.PluginCreateRequest)) *PluginAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.PluginCreateRequest))
+	})
+	return _c
+}
+
+func (_c *PluginAPI_Create_Call) Return(_a0 *truenas.Plugin, _a1 error) *PluginAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.PluginCreateRequest) (*truenas.Plugin, error)) *PluginAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, name
+func (_m *PluginAPI) Delete(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PluginAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PluginAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *PluginAPI_Expecter) Delete(ctx interface{}, name interface{}) *PluginAPI_Delete_Call {
+	return &PluginAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, name)}
+}
+
+func (_c *PluginAPI_Delete_Call) Run(run func(ctx context.Context, name string)) *PluginAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PluginAPI_Delete_Call) Return(_a0 error) *PluginAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PluginAPI_Delete_Call) RunAndReturn(run func(context.Context, string) error) *PluginAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, name
+func (_m *PluginAPI) Get(ctx context.Context, name string) (*truenas.Plugin, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Plugin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Plugin, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Plugin); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Plugin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type PluginAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *PluginAPI_Expecter) Get(ctx interface{}, name interface{}) *PluginAPI_Get_Call {
+	return &PluginAPI_Get_Call{Call: _e.mock.On("Get", ctx, name)}
+}
+
+func (_c *PluginAPI_Get_Call) Run(run func(ctx context.Context, name string)) *PluginAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PluginAPI_Get_Call) Return(_a0 *truenas.Plugin, _a1 error) *PluginAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginAPI_Get_Call) RunAndReturn(run func(context.Context, string) (*truenas.Plugin, error)) *PluginAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *PluginAPI) List(ctx context.Context) ([]truenas.Plugin, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Plugin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Plugin, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Plugin); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Plugin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type PluginAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PluginAPI_Expecter) List(ctx interface{}) *PluginAPI_List_Call {
+	return &PluginAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *PluginAPI_List_Call) Run(run func(ctx context.Context)) *PluginAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PluginAPI_List_Call) Return(_a0 []truenas.Plugin, _a1 error) *PluginAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Plugin, error)) *PluginAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *PluginAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Plugin, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Plugin, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Plugin, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Plugin, error])
+		}
+	}
+
+	return r0
+}
+
+// PluginAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type PluginAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *PluginAPI_Expecter) ListIter(ctx interface{}, q interface{}) *PluginAPI_ListIter_Call {
+	return &PluginAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *PluginAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *PluginAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *PluginAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Plugin, error]) *PluginAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PluginAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Plugin, error]) *PluginAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *PluginAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Plugin, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Plugin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Plugin, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Plugin); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Plugin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type PluginAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *PluginAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *PluginAPI_ListWithQuery_Call {
+	return &PluginAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *PluginAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *PluginAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *PluginAPI_ListWithQuery_Call) Return(_a0 []truenas.Plugin, _a1 error) *PluginAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Plugin, error)) *PluginAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, name, req
+func (_m *PluginAPI) Update(ctx context.Context, name string, req *truenas.PluginUpdateRequest) (*truenas.Plugin, error) {
+	ret := _m.Called(ctx, name, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Plugin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.PluginUpdateRequest) (*truenas.Plugin, error)); ok {
+		return rf(ctx, name, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.PluginUpdateRequest) *truenas.Plugin); ok {
+		r0 = rf(ctx, name, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Plugin)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *truenas.PluginUpdateRequest) error); ok {
+		r1 = rf(ctx, name, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PluginAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type PluginAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - req *truenas.PluginUpdateRequest
+func (_e *PluginAPI_Expecter) Update(ctx interface{}, name interface{}, req interface{}) *PluginAPI_Update_Call {
+	return &PluginAPI_Update_Call{Call: _e.mock.On("Update", ctx, name, req)}
+}
+
+func (_c *PluginAPI_Update_Call) Run(run func(ctx context.Context, name string, req *truenas.PluginUpdateRequest)) *PluginAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.PluginUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *PluginAPI_Update_Call) Return(_a0 *truenas.Plugin, _a1 error) *PluginAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PluginAPI_Update_Call) RunAndReturn(run func(context.Context, string, *truenas.PluginUpdateRequest) (*truenas.Plugin, error)) *PluginAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPluginAPI creates a new instance of PluginAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPluginAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PluginAPI {
+	mock := &PluginAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
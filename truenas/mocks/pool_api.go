@@ -0,0 +1,1674 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// PoolAPI is an autogenerated mock type for the PoolAPI type
+type PoolAPI struct {
+	mock.Mock
+}
+
+type PoolAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PoolAPI) EXPECT() *PoolAPI_Expecter {
+	return &PoolAPI_Expecter{mock: &_m.Mock}
+}
+
+// Attach provides a mock function with given fields: ctx, id, req
+func (_m *PoolAPI) Attach(ctx context.Context, id int, req truenas.PoolAttachRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Attach")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.PoolAttachRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Attach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Attach'
+type PoolAPI_Attach_Call struct {
+	*mock.Call
+}
+
+// Attach is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req truenas.PoolAttachRequest
+func (_e *PoolAPI_Expecter) Attach(ctx interface{}, id interface{}, req interface{}) *PoolAPI_Attach_Call {
+	return &PoolAPI_Attach_Call{Call: _e.mock.On("Attach", ctx, id, req)}
+}
+
+func (_c *PoolAPI_Attach_Call) Run(run func(ctx context.Context, id int, req truenas.PoolAttachRequest)) *PoolAPI_Attach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.PoolAttachRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Attach_Call) Return(_a0 error) *PoolAPI_Attach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Attach_Call) RunAndReturn(run func(context.Context, int, truenas.PoolAttachRequest) error) *PoolAPI_Attach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *PoolAPI) Create(ctx context.Context, req truenas.PoolCreateRequest) (*truenas.Pool, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Pool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.PoolCreateRequest) (*truenas.Pool, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.PoolCreateRequest) *truenas.Pool); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Pool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.PoolCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PoolAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.PoolCreateRequest
+func (_e *PoolAPI_Expecter) Create(ctx interface{}, req interface{}) *PoolAPI_Create_Call {
+	return &PoolAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *PoolAPI_Create_Call) Run(run func(ctx context.Context, req truenas.PoolCreateRequest)) *PoolAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.PoolCreateRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Create_Call) Return(_a0 *truenas.Pool, _a1 error) *PoolAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_Create_Call) RunAndReturn(run func(context.Context, truenas.PoolCreateRequest) (*truenas.Pool, error)) *PoolAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateScrubTask provides a mock function with given fields: ctx, req
+func (_m *PoolAPI) CreateScrubTask(ctx context.Context, req truenas.PoolScrubTaskRequest) (*truenas.PoolScrubTask, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateScrubTask")
+	}
+
+	var r0 *truenas.PoolScrubTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.PoolScrubTaskRequest) (*truenas.PoolScrubTask, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.PoolScrubTaskRequest) *truenas.PoolScrubTask); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.PoolScrubTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.PoolScrubTaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_CreateScrubTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateScrubTask'
+type PoolAPI_CreateScrubTask_Call struct {
+	*mock.Call
+}
+
+// CreateScrubTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.PoolScrubTaskRequest
+func (_e *PoolAPI_Expecter) CreateScrubTask(ctx interface{}, req interface{}) *PoolAPI_CreateScrubTask_Call {
+	return &PoolAPI_CreateScrubTask_Call{Call: _e.mock.On("CreateScrubTask", ctx, req)}
+}
+
+func (_c *PoolAPI_CreateScrubTask_Call) Run(run func(ctx context.Context, req truenas.PoolScrubTaskRequest)) *PoolAPI_CreateScrubTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.PoolScrubTaskRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_CreateScrubTask_Call) Return(_a0 *truenas.PoolScrubTask, _a1 error) *PoolAPI_CreateScrubTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_CreateScrubTask_Call) RunAndReturn(run func(context.Context, truenas.PoolScrubTaskRequest) (*truenas.PoolScrubTask, error)) *PoolAPI_CreateScrubTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, cascade
+func (_m *PoolAPI) Delete(ctx context.Context, id int, cascade bool) error {
+	ret := _m.Called(ctx, id, cascade)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bool) error); ok {
+		r0 = rf(ctx, id, cascade)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PoolAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - cascade bool
+func (_e *PoolAPI_Expecter) Delete(ctx interface{}, id interface{}, cascade interface{}) *PoolAPI_Delete_Call {
+	return &PoolAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, cascade)}
+}
+
+func (_c *PoolAPI_Delete_Call) Run(run func(ctx context.Context, id int, cascade bool)) *PoolAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Delete_Call) Return(_a0 error) *PoolAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Delete_Call) RunAndReturn(run func(context.Context, int, bool) error) *PoolAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteScrubTask provides a mock function with given fields: ctx, id
+func (_m *PoolAPI) DeleteScrubTask(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteScrubTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_DeleteScrubTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteScrubTask'
+type PoolAPI_DeleteScrubTask_Call struct {
+	*mock.Call
+}
+
+// DeleteScrubTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *PoolAPI_Expecter) DeleteScrubTask(ctx interface{}, id interface{}) *PoolAPI_DeleteScrubTask_Call {
+	return &PoolAPI_DeleteScrubTask_Call{Call: _e.mock.On("DeleteScrubTask", ctx, id)}
+}
+
+func (_c *PoolAPI_DeleteScrubTask_Call) Run(run func(ctx context.Context, id int)) *PoolAPI_DeleteScrubTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_DeleteScrubTask_Call) Return(_a0 error) *PoolAPI_DeleteScrubTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_DeleteScrubTask_Call) RunAndReturn(run func(context.Context, int) error) *PoolAPI_DeleteScrubTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Detach provides a mock function with given fields: ctx, id, label, wipe
+func (_m *PoolAPI) Detach(ctx context.Context, id int, label string, wipe bool) error {
+	ret := _m.Called(ctx, id, label, wipe)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Detach")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, bool) error); ok {
+		r0 = rf(ctx, id, label, wipe)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Detach_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Detach'
+type PoolAPI_Detach_Call struct {
+	*mock.Call
+}
+
+// Detach is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - label string
+//   - wipe bool
+func (_e *PoolAPI_Expecter) Detach(ctx interface{}, id interface{}, label interface{}, wipe interface{}) *PoolAPI_Detach_Call {
+	return &PoolAPI_Detach_Call{Call: _e.mock.On("Detach", ctx, id, label, wipe)}
+}
+
+func (_c *PoolAPI_Detach_Call) Run(run func(ctx context.Context, id int, label string, wipe bool)) *PoolAPI_Detach_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Detach_Call) Return(_a0 error) *PoolAPI_Detach_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Detach_Call) RunAndReturn(run func(context.Context, int, string, bool) error) *PoolAPI_Detach_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Expand provides a mock function with given fields: ctx, id
+func (_m *PoolAPI) Expand(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expand")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Expand_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Expand'
+type PoolAPI_Expand_Call struct {
+	*mock.Call
+}
+
+// Expand is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *PoolAPI_Expecter) Expand(ctx interface{}, id interface{}) *PoolAPI_Expand_Call {
+	return &PoolAPI_Expand_Call{Call: _e.mock.On("Expand", ctx, id)}
+}
+
+func (_c *PoolAPI_Expand_Call) Run(run func(ctx context.Context, id int)) *PoolAPI_Expand_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Expand_Call) Return(_a0 error) *PoolAPI_Expand_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Expand_Call) RunAndReturn(run func(context.Context, int) error) *PoolAPI_Expand_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Export provides a mock function with given fields: ctx, id, req
+func (_m *PoolAPI) Export(ctx context.Context, id int, req truenas.PoolExportRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Export")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.PoolExportRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Export_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Export'
+type PoolAPI_Export_Call struct {
+	*mock.Call
+}
+
+// Export is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req truenas.PoolExportRequest
+func (_e *PoolAPI_Expecter) Export(ctx interface{}, id interface{}, req interface{}) *PoolAPI_Export_Call {
+	return &PoolAPI_Export_Call{Call: _e.mock.On("Export", ctx, id, req)}
+}
+
+func (_c *PoolAPI_Export_Call) Run(run func(ctx context.Context, id int, req truenas.PoolExportRequest)) *PoolAPI_Export_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.PoolExportRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Export_Call) Return(_a0 error) *PoolAPI_Export_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Export_Call) RunAndReturn(run func(context.Context, int, truenas.PoolExportRequest) error) *PoolAPI_Export_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindImportablePools provides a mock function with given fields: ctx
+func (_m *PoolAPI) FindImportablePools(ctx context.Context) ([]truenas.PoolImportFindResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindImportablePools")
+	}
+
+	var r0 []truenas.PoolImportFindResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.PoolImportFindResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.PoolImportFindResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.PoolImportFindResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_FindImportablePools_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindImportablePools'
+type PoolAPI_FindImportablePools_Call struct {
+	*mock.Call
+}
+
+// FindImportablePools is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PoolAPI_Expecter) FindImportablePools(ctx interface{}) *PoolAPI_FindImportablePools_Call {
+	return &PoolAPI_FindImportablePools_Call{Call: _e.mock.On("FindImportablePools", ctx)}
+}
+
+func (_c *PoolAPI_FindImportablePools_Call) Run(run func(ctx context.Context)) *PoolAPI_FindImportablePools_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_FindImportablePools_Call) Return(_a0 []truenas.PoolImportFindResult, _a1 error) *PoolAPI_FindImportablePools_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_FindImportablePools_Call) RunAndReturn(run func(context.Context) ([]truenas.PoolImportFindResult, error)) *PoolAPI_FindImportablePools_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *PoolAPI) Get(ctx context.Context, id int) (*truenas.Pool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Pool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Pool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Pool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Pool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type PoolAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *PoolAPI_Expecter) Get(ctx interface{}, id interface{}) *PoolAPI_Get_Call {
+	return &PoolAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *PoolAPI_Get_Call) Run(run func(ctx context.Context, id int)) *PoolAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Get_Call) Return(_a0 *truenas.Pool, _a1 error) *PoolAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.Pool, error)) *PoolAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function with given fields: ctx, name
+func (_m *PoolAPI) GetByName(ctx context.Context, name string) (*truenas.Pool, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 *truenas.Pool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Pool, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Pool); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Pool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type PoolAPI_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *PoolAPI_Expecter) GetByName(ctx interface{}, name interface{}) *PoolAPI_GetByName_Call {
+	return &PoolAPI_GetByName_Call{Call: _e.mock.On("GetByName", ctx, name)}
+}
+
+func (_c *PoolAPI_GetByName_Call) Run(run func(ctx context.Context, name string)) *PoolAPI_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_GetByName_Call) Return(_a0 *truenas.Pool, _a1 error) *PoolAPI_GetByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_GetByName_Call) RunAndReturn(run func(context.Context, string) (*truenas.Pool, error)) *PoolAPI_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProcesses provides a mock function with given fields: ctx, id
+func (_m *PoolAPI) GetProcesses(ctx context.Context, id int) ([]truenas.PoolProcess, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProcesses")
+	}
+
+	var r0 []truenas.PoolProcess
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]truenas.PoolProcess, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []truenas.PoolProcess); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.PoolProcess)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_GetProcesses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcesses'
+type PoolAPI_GetProcesses_Call struct {
+	*mock.Call
+}
+
+// GetProcesses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *PoolAPI_Expecter) GetProcesses(ctx interface{}, id interface{}) *PoolAPI_GetProcesses_Call {
+	return &PoolAPI_GetProcesses_Call{Call: _e.mock.On("GetProcesses", ctx, id)}
+}
+
+func (_c *PoolAPI_GetProcesses_Call) Run(run func(ctx context.Context, id int)) *PoolAPI_GetProcesses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_GetProcesses_Call) Return(_a0 []truenas.PoolProcess, _a1 error) *PoolAPI_GetProcesses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_GetProcesses_Call) RunAndReturn(run func(context.Context, int) ([]truenas.PoolProcess, error)) *PoolAPI_GetProcesses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResilverConfig provides a mock function with given fields: ctx
+func (_m *PoolAPI) GetResilverConfig(ctx context.Context) (*truenas.PoolResilverConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResilverConfig")
+	}
+
+	var r0 *truenas.PoolResilverConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.PoolResilverConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.PoolResilverConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.PoolResilverConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_GetResilverConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResilverConfig'
+type PoolAPI_GetResilverConfig_Call struct {
+	*mock.Call
+}
+
+// GetResilverConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PoolAPI_Expecter) GetResilverConfig(ctx interface{}) *PoolAPI_GetResilverConfig_Call {
+	return &PoolAPI_GetResilverConfig_Call{Call: _e.mock.On("GetResilverConfig", ctx)}
+}
+
+func (_c *PoolAPI_GetResilverConfig_Call) Run(run func(ctx context.Context)) *PoolAPI_GetResilverConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_GetResilverConfig_Call) Return(_a0 *truenas.PoolResilverConfig, _a1 error) *PoolAPI_GetResilverConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_GetResilverConfig_Call) RunAndReturn(run func(context.Context) (*truenas.PoolResilverConfig, error)) *PoolAPI_GetResilverConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScrubTask provides a mock function with given fields: ctx, id
+func (_m *PoolAPI) GetScrubTask(ctx context.Context, id int) (*truenas.PoolScrubTask, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScrubTask")
+	}
+
+	var r0 *truenas.PoolScrubTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.PoolScrubTask, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.PoolScrubTask); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.PoolScrubTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_GetScrubTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScrubTask'
+type PoolAPI_GetScrubTask_Call struct {
+	*mock.Call
+}
+
+// GetScrubTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *PoolAPI_Expecter) GetScrubTask(ctx interface{}, id interface{}) *PoolAPI_GetScrubTask_Call {
+	return &PoolAPI_GetScrubTask_Call{Call: _e.mock.On("GetScrubTask", ctx, id)}
+}
+
+func (_c *PoolAPI_GetScrubTask_Call) Run(run func(ctx context.Context, id int)) *PoolAPI_GetScrubTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_GetScrubTask_Call) Return(_a0 *truenas.PoolScrubTask, _a1 error) *PoolAPI_GetScrubTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_GetScrubTask_Call) RunAndReturn(run func(context.Context, int) (*truenas.PoolScrubTask, error)) *PoolAPI_GetScrubTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScrubTasksByPool provides a mock function with given fields: ctx, poolID
+func (_m *PoolAPI) GetScrubTasksByPool(ctx context.Context, poolID int) ([]truenas.PoolScrubTask, error) {
+	ret := _m.Called(ctx, poolID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetScrubTasksByPool")
+	}
+
+	var r0 []truenas.PoolScrubTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]truenas.PoolScrubTask, error)); ok {
+		return rf(ctx, poolID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []truenas.PoolScrubTask); ok {
+		r0 = rf(ctx, poolID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.PoolScrubTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, poolID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_GetScrubTasksByPool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScrubTasksByPool'
+type PoolAPI_GetScrubTasksByPool_Call struct {
+	*mock.Call
+}
+
+// GetScrubTasksByPool is a helper method to define mock.On call
+//   - ctx context.Context
+//   - poolID int
+func (_e *PoolAPI_Expecter) GetScrubTasksByPool(ctx interface{}, poolID interface{}) *PoolAPI_GetScrubTasksByPool_Call {
+	return &PoolAPI_GetScrubTasksByPool_Call{Call: _e.mock.On("GetScrubTasksByPool", ctx, poolID)}
+}
+
+func (_c *PoolAPI_GetScrubTasksByPool_Call) Run(run func(ctx context.Context, poolID int)) *PoolAPI_GetScrubTasksByPool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_GetScrubTasksByPool_Call) Return(_a0 []truenas.PoolScrubTask, _a1 error) *PoolAPI_GetScrubTasksByPool_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_GetScrubTasksByPool_Call) RunAndReturn(run func(context.Context, int) ([]truenas.PoolScrubTask, error)) *PoolAPI_GetScrubTasksByPool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Import provides a mock function with given fields: ctx, req
+func (_m *PoolAPI) Import(ctx context.Context, req truenas.PoolImportRequest) (*truenas.Pool, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Import")
+	}
+
+	var r0 *truenas.Pool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.PoolImportRequest) (*truenas.Pool, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.PoolImportRequest) *truenas.Pool); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Pool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.PoolImportRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_Import_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Import'
+type PoolAPI_Import_Call struct {
+	*mock.Call
+}
+
+// Import is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.PoolImportRequest
+func (_e *PoolAPI_Expecter) Import(ctx interface{}, req interface{}) *PoolAPI_Import_Call {
+	return &PoolAPI_Import_Call{Call: _e.mock.On("Import", ctx, req)}
+}
+
+func (_c *PoolAPI_Import_Call) Run(run func(ctx context.Context, req truenas.PoolImportRequest)) *PoolAPI_Import_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.PoolImportRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Import_Call) Return(_a0 *truenas.Pool, _a1 error) *PoolAPI_Import_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_Import_Call) RunAndReturn(run func(context.Context, truenas.PoolImportRequest) (*truenas.Pool, error)) *PoolAPI_Import_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *PoolAPI) List(ctx context.Context) ([]truenas.Pool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Pool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Pool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Pool); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Pool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type PoolAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PoolAPI_Expecter) List(ctx interface{}) *PoolAPI_List_Call {
+	return &PoolAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *PoolAPI_List_Call) Run(run func(ctx context.Context)) *PoolAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_List_Call) Return(_a0 []truenas.Pool, _a1 error) *PoolAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Pool, error)) *PoolAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *PoolAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Pool, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Pool, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Pool, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Pool, error])
+		}
+	}
+
+	return r0
+}
+
+// PoolAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type PoolAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *PoolAPI_Expecter) ListIter(ctx interface{}, q interface{}) *PoolAPI_ListIter_Call {
+	return &PoolAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *PoolAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *PoolAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Pool, error]) *PoolAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Pool, error]) *PoolAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScrubTasks provides a mock function with given fields: ctx
+func (_m *PoolAPI) ListScrubTasks(ctx context.Context) ([]truenas.PoolScrubTask, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScrubTasks")
+	}
+
+	var r0 []truenas.PoolScrubTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.PoolScrubTask, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.PoolScrubTask); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.PoolScrubTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_ListScrubTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScrubTasks'
+type PoolAPI_ListScrubTasks_Call struct {
+	*mock.Call
+}
+
+// ListScrubTasks is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PoolAPI_Expecter) ListScrubTasks(ctx interface{}) *PoolAPI_ListScrubTasks_Call {
+	return &PoolAPI_ListScrubTasks_Call{Call: _e.mock.On("ListScrubTasks", ctx)}
+}
+
+func (_c *PoolAPI_ListScrubTasks_Call) Run(run func(ctx context.Context)) *PoolAPI_ListScrubTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_ListScrubTasks_Call) Return(_a0 []truenas.PoolScrubTask, _a1 error) *PoolAPI_ListScrubTasks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_ListScrubTasks_Call) RunAndReturn(run func(context.Context) ([]truenas.PoolScrubTask, error)) *PoolAPI_ListScrubTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScrubTasksWithQuery provides a mock function with given fields: ctx, q
+func (_m *PoolAPI) ListScrubTasksWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.PoolScrubTask, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScrubTasksWithQuery")
+	}
+
+	var r0 []truenas.PoolScrubTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.PoolScrubTask, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.PoolScrubTask); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.PoolScrubTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_ListScrubTasksWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScrubTasksWithQuery'
+type PoolAPI_ListScrubTasksWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListScrubTasksWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *PoolAPI_Expecter) ListScrubTasksWithQuery(ctx interface{}, q interface{}) *PoolAPI_ListScrubTasksWithQuery_Call {
+	return &PoolAPI_ListScrubTasksWithQuery_Call{Call: _e.mock.On("ListScrubTasksWithQuery", ctx, q)}
+}
+
+func (_c *PoolAPI_ListScrubTasksWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *PoolAPI_ListScrubTasksWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_ListScrubTasksWithQuery_Call) Return(_a0 []truenas.PoolScrubTask, _a1 error) *PoolAPI_ListScrubTasksWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_ListScrubTasksWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.PoolScrubTask, error)) *PoolAPI_ListScrubTasksWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *PoolAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Pool, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Pool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Pool, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Pool); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Pool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type PoolAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *PoolAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *PoolAPI_ListWithQuery_Call {
+	return &PoolAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *PoolAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *PoolAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_ListWithQuery_Call) Return(_a0 []truenas.Pool, _a1 error) *PoolAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Pool, error)) *PoolAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Offline provides a mock function with given fields: ctx, id, label
+func (_m *PoolAPI) Offline(ctx context.Context, id int, label string) error {
+	ret := _m.Called(ctx, id, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Offline")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Offline_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Offline'
+type PoolAPI_Offline_Call struct {
+	*mock.Call
+}
+
+// Offline is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - label string
+func (_e *PoolAPI_Expecter) Offline(ctx interface{}, id interface{}, label interface{}) *PoolAPI_Offline_Call {
+	return &PoolAPI_Offline_Call{Call: _e.mock.On("Offline", ctx, id, label)}
+}
+
+func (_c *PoolAPI_Offline_Call) Run(run func(ctx context.Context, id int, label string)) *PoolAPI_Offline_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Offline_Call) Return(_a0 error) *PoolAPI_Offline_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Offline_Call) RunAndReturn(run func(context.Context, int, string) error) *PoolAPI_Offline_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Online provides a mock function with given fields: ctx, id, label
+func (_m *PoolAPI) Online(ctx context.Context, id int, label string) error {
+	ret := _m.Called(ctx, id, label)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Online")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, label)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Online_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Online'
+type PoolAPI_Online_Call struct {
+	*mock.Call
+}
+
+// Online is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - label string
+func (_e *PoolAPI_Expecter) Online(ctx interface{}, id interface{}, label interface{}) *PoolAPI_Online_Call {
+	return &PoolAPI_Online_Call{Call: _e.mock.On("Online", ctx, id, label)}
+}
+
+func (_c *PoolAPI_Online_Call) Run(run func(ctx context.Context, id int, label string)) *PoolAPI_Online_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Online_Call) Return(_a0 error) *PoolAPI_Online_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Online_Call) RunAndReturn(run func(context.Context, int, string) error) *PoolAPI_Online_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Replace provides a mock function with given fields: ctx, id, req
+func (_m *PoolAPI) Replace(ctx context.Context, id int, req truenas.PoolReplaceRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Replace")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.PoolReplaceRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Replace_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Replace'
+type PoolAPI_Replace_Call struct {
+	*mock.Call
+}
+
+// Replace is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req truenas.PoolReplaceRequest
+func (_e *PoolAPI_Expecter) Replace(ctx interface{}, id interface{}, req interface{}) *PoolAPI_Replace_Call {
+	return &PoolAPI_Replace_Call{Call: _e.mock.On("Replace", ctx, id, req)}
+}
+
+func (_c *PoolAPI_Replace_Call) Run(run func(ctx context.Context, id int, req truenas.PoolReplaceRequest)) *PoolAPI_Replace_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.PoolReplaceRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Replace_Call) Return(_a0 error) *PoolAPI_Replace_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Replace_Call) RunAndReturn(run func(context.Context, int, truenas.PoolReplaceRequest) error) *PoolAPI_Replace_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunScrub provides a mock function with given fields: ctx, poolName, action
+func (_m *PoolAPI) RunScrub(ctx context.Context, poolName string, action string) error {
+	ret := _m.Called(ctx, poolName, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunScrub")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, poolName, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_RunScrub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunScrub'
+type PoolAPI_RunScrub_Call struct {
+	*mock.Call
+}
+
+// RunScrub is a helper method to define mock.On call
+//   - ctx context.Context
+//   - poolName string
+//   - action string
+func (_e *PoolAPI_Expecter) RunScrub(ctx interface{}, poolName interface{}, action interface{}) *PoolAPI_RunScrub_Call {
+	return &PoolAPI_RunScrub_Call{Call: _e.mock.On("RunScrub", ctx, poolName, action)}
+}
+
+func (_c *PoolAPI_RunScrub_Call) Run(run func(ctx context.Context, poolName string, action string)) *PoolAPI_RunScrub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_RunScrub_Call) Return(_a0 error) *PoolAPI_RunScrub_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_RunScrub_Call) RunAndReturn(run func(context.Context, string, string) error) *PoolAPI_RunScrub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunScrubAsync provides a mock function with given fields: ctx, poolName, action
+func (_m *PoolAPI) RunScrubAsync(ctx context.Context, poolName string, action string) (int, error) {
+	ret := _m.Called(ctx, poolName, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunScrubAsync")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (int, error)); ok {
+		return rf(ctx, poolName, action)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int); ok {
+		r0 = rf(ctx, poolName, action)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, poolName, action)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_RunScrubAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunScrubAsync'
+type PoolAPI_RunScrubAsync_Call struct {
+	*mock.Call
+}
+
+// RunScrubAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - poolName string
+//   - action string
+func (_e *PoolAPI_Expecter) RunScrubAsync(ctx interface{}, poolName interface{}, action interface{}) *PoolAPI_RunScrubAsync_Call {
+	return &PoolAPI_RunScrubAsync_Call{Call: _e.mock.On("RunScrubAsync", ctx, poolName, action)}
+}
+
+func (_c *PoolAPI_RunScrubAsync_Call) Run(run func(ctx context.Context, poolName string, action string)) *PoolAPI_RunScrubAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_RunScrubAsync_Call) Return(_a0 int, _a1 error) *PoolAPI_RunScrubAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_RunScrubAsync_Call) RunAndReturn(run func(context.Context, string, string) (int, error)) *PoolAPI_RunScrubAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Scrub provides a mock function with given fields: ctx, id, action
+func (_m *PoolAPI) Scrub(ctx context.Context, id int, action truenas.PoolScrubAction) error {
+	ret := _m.Called(ctx, id, action)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Scrub")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.PoolScrubAction) error); ok {
+		r0 = rf(ctx, id, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PoolAPI_Scrub_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Scrub'
+type PoolAPI_Scrub_Call struct {
+	*mock.Call
+}
+
+// Scrub is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - action truenas.PoolScrubAction
+func (_e *PoolAPI_Expecter) Scrub(ctx interface{}, id interface{}, action interface{}) *PoolAPI_Scrub_Call {
+	return &PoolAPI_Scrub_Call{Call: _e.mock.On("Scrub", ctx, id, action)}
+}
+
+func (_c *PoolAPI_Scrub_Call) Run(run func(ctx context.Context, id int, action truenas.PoolScrubAction)) *PoolAPI_Scrub_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.PoolScrubAction))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Scrub_Call) Return(_a0 error) *PoolAPI_Scrub_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PoolAPI_Scrub_Call) RunAndReturn(run func(context.Context, int, truenas.PoolScrubAction) error) *PoolAPI_Scrub_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *PoolAPI) Update(ctx context.Context, id int, req truenas.PoolUpdateRequest) (*truenas.Pool, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Pool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.PoolUpdateRequest) (*truenas.Pool, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.PoolUpdateRequest) *truenas.Pool); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Pool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, truenas.PoolUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type PoolAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req truenas.PoolUpdateRequest
+func (_e *PoolAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *PoolAPI_Update_Call {
+	return &PoolAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *PoolAPI_Update_Call) Run(run func(ctx context.Context, id int, req truenas.PoolUpdateRequest)) *PoolAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.PoolUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_Update_Call) Return(_a0 *truenas.Pool, _a1 error) *PoolAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_Update_Call) RunAndReturn(run func(context.Context, int, truenas.PoolUpdateRequest) (*truenas.Pool, error)) *PoolAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateResilverConfig provides a mock function with given fields: ctx, req
+func (_m *PoolAPI) UpdateResilverConfig(ctx context.Context, req *truenas.PoolResilverUpdateRequest) (*truenas.PoolResilverConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateResilverConfig")
+	}
+
+	var r0 *truenas.PoolResilverConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.PoolResilverUpdateRequest) (*truenas.PoolResilverConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.PoolResilverUpdateRequest) *truenas.PoolResilverConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.PoolResilverConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.PoolResilverUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_UpdateResilverConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateResilverConfig'
+type PoolAPI_UpdateResilverConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateResilverConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.PoolResilverUpdateRequest
+func (_e *PoolAPI_Expecter) UpdateResilverConfig(ctx interface{}, req interface{}) *PoolAPI_UpdateResilverConfig_Call {
+	return &PoolAPI_UpdateResilverConfig_Call{Call: _e.mock.On("UpdateResilverConfig", ctx, req)}
+}
+
+func (_c *PoolAPI_UpdateResilverConfig_Call) Run(run func(ctx context.Context, req *truenas.PoolResilverUpdateRequest)) *PoolAPI_UpdateResilverConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.PoolResilverUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_UpdateResilverConfig_Call) Return(_a0 *truenas.PoolResilverConfig, _a1 error) *PoolAPI_UpdateResilverConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_UpdateResilverConfig_Call) RunAndReturn(run func(context.Context, *truenas.PoolResilverUpdateRequest) (*truenas.PoolResilverConfig, error)) *PoolAPI_UpdateResilverConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateScrubTask provides a mock function with given fields: ctx, id, req
+func (_m *PoolAPI) UpdateScrubTask(ctx context.Context, id int, req truenas.PoolScrubTaskRequest) (*truenas.PoolScrubTask, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateScrubTask")
+	}
+
+	var r0 *truenas.PoolScrubTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.PoolScrubTaskRequest) (*truenas.PoolScrubTask, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.PoolScrubTaskRequest) *truenas.PoolScrubTask); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.PoolScrubTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, truenas.PoolScrubTaskRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PoolAPI_UpdateScrubTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateScrubTask'
+type PoolAPI_UpdateScrubTask_Call struct {
+	*mock.Call
+}
+
+// UpdateScrubTask is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req truenas.PoolScrubTaskRequest
+func (_e *PoolAPI_Expecter) UpdateScrubTask(ctx interface{}, id interface{}, req interface{}) *PoolAPI_UpdateScrubTask_Call {
+	return &PoolAPI_UpdateScrubTask_Call{Call: _e.mock.On("UpdateScrubTask", ctx, id, req)}
+}
+
+func (_c *PoolAPI_UpdateScrubTask_Call) Run(run func(ctx context.Context, id int, req truenas.PoolScrubTaskRequest)) *PoolAPI_UpdateScrubTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.PoolScrubTaskRequest))
+	})
+	return _c
+}
+
+func (_c *PoolAPI_UpdateScrubTask_Call) Return(_a0 *truenas.PoolScrubTask, _a1 error) *PoolAPI_UpdateScrubTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PoolAPI_UpdateScrubTask_Call) RunAndReturn(run func(context.Context, int, truenas.PoolScrubTaskRequest) (*truenas.PoolScrubTask, error)) *PoolAPI_UpdateScrubTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPoolAPI creates a new instance of PoolAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPoolAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PoolAPI {
+	mock := &PoolAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
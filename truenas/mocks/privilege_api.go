@@ -0,0 +1,488 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// PrivilegeAPI is an autogenerated mock type for the PrivilegeAPI type
+type PrivilegeAPI struct {
+	mock.Mock
+}
+
+type PrivilegeAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PrivilegeAPI) EXPECT() *PrivilegeAPI_Expecter {
+	return &PrivilegeAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *PrivilegeAPI) Create(ctx context.Context, req *truenas.PrivilegeCreateRequest) (*truenas.Privilege, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.PrivilegeCreateRequest) (*truenas.Privilege, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.PrivilegeCreateRequest) *truenas.Privilege); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.PrivilegeCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivilegeAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type PrivilegeAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.PrivilegeCreateRequest
+func (_e *PrivilegeAPI_Expecter) Create(ctx interface{}, req interface{}) *PrivilegeAPI_Create_Call {
+	return &PrivilegeAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *PrivilegeAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.PrivilegeCreateRequest)) *PrivilegeAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.PrivilegeCreateRequest))
+	})
+	return _c
+}
+
+func (_c *PrivilegeAPI_Create_Call) Return(_a0 *truenas.Privilege, _a1 error) *PrivilegeAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivilegeAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.PrivilegeCreateRequest) (*truenas.Privilege, error)) *PrivilegeAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *PrivilegeAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PrivilegeAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type PrivilegeAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *PrivilegeAPI_Expecter) Delete(ctx interface{}, id interface{}) *PrivilegeAPI_Delete_Call {
+	return &PrivilegeAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *PrivilegeAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *PrivilegeAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PrivilegeAPI_Delete_Call) Return(_a0 error) *PrivilegeAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PrivilegeAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *PrivilegeAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *PrivilegeAPI) Get(ctx context.Context, id int) (*truenas.Privilege, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Privilege, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Privilege); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivilegeAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type PrivilegeAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *PrivilegeAPI_Expecter) Get(ctx interface{}, id interface{}) *PrivilegeAPI_Get_Call {
+	return &PrivilegeAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *PrivilegeAPI_Get_Call) Run(run func(ctx context.Context, id int)) *PrivilegeAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *PrivilegeAPI_Get_Call) Return(_a0 *truenas.Privilege, _a1 error) *PrivilegeAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivilegeAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.Privilege, error)) *PrivilegeAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *PrivilegeAPI) List(ctx context.Context) ([]truenas.Privilege, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Privilege, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Privilege); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivilegeAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type PrivilegeAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PrivilegeAPI_Expecter) List(ctx interface{}) *PrivilegeAPI_List_Call {
+	return &PrivilegeAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *PrivilegeAPI_List_Call) Run(run func(ctx context.Context)) *PrivilegeAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PrivilegeAPI_List_Call) Return(_a0 []truenas.Privilege, _a1 error) *PrivilegeAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivilegeAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Privilege, error)) *PrivilegeAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *PrivilegeAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Privilege, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Privilege, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Privilege, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Privilege, error])
+		}
+	}
+
+	return r0
+}
+
+// PrivilegeAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type PrivilegeAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *PrivilegeAPI_Expecter) ListIter(ctx interface{}, q interface{}) *PrivilegeAPI_ListIter_Call {
+	return &PrivilegeAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *PrivilegeAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *PrivilegeAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *PrivilegeAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Privilege, error]) *PrivilegeAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PrivilegeAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Privilege, error]) *PrivilegeAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *PrivilegeAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Privilege, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Privilege, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Privilege); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivilegeAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type PrivilegeAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *PrivilegeAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *PrivilegeAPI_ListWithQuery_Call {
+	return &PrivilegeAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *PrivilegeAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *PrivilegeAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *PrivilegeAPI_ListWithQuery_Call) Return(_a0 []truenas.Privilege, _a1 error) *PrivilegeAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivilegeAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Privilege, error)) *PrivilegeAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Roles provides a mock function with given fields: ctx
+func (_m *PrivilegeAPI) Roles(ctx context.Context) ([]truenas.Role, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Roles")
+	}
+
+	var r0 []truenas.Role
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Role, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Role); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Role)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivilegeAPI_Roles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Roles'
+type PrivilegeAPI_Roles_Call struct {
+	*mock.Call
+}
+
+// Roles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PrivilegeAPI_Expecter) Roles(ctx interface{}) *PrivilegeAPI_Roles_Call {
+	return &PrivilegeAPI_Roles_Call{Call: _e.mock.On("Roles", ctx)}
+}
+
+func (_c *PrivilegeAPI_Roles_Call) Run(run func(ctx context.Context)) *PrivilegeAPI_Roles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PrivilegeAPI_Roles_Call) Return(_a0 []truenas.Role, _a1 error) *PrivilegeAPI_Roles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivilegeAPI_Roles_Call) RunAndReturn(run func(context.Context) ([]truenas.Role, error)) *PrivilegeAPI_Roles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *PrivilegeAPI) Update(ctx context.Context, id int, req *truenas.PrivilegeUpdateRequest) (*truenas.Privilege, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Privilege
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.PrivilegeUpdateRequest) (*truenas.Privilege, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.PrivilegeUpdateRequest) *truenas.Privilege); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Privilege)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.PrivilegeUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrivilegeAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type PrivilegeAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.PrivilegeUpdateRequest
+func (_e *PrivilegeAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *PrivilegeAPI_Update_Call {
+	return &PrivilegeAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *PrivilegeAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.PrivilegeUpdateRequest)) *PrivilegeAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.PrivilegeUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *PrivilegeAPI_Update_Call) Return(_a0 *truenas.Privilege, _a1 error) *PrivilegeAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PrivilegeAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.PrivilegeUpdateRequest) (*truenas.Privilege, error)) *PrivilegeAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPrivilegeAPI creates a new instance of PrivilegeAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPrivilegeAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PrivilegeAPI {
+	mock := &PrivilegeAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,601 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// ReplicationAPI is an autogenerated mock type for the ReplicationAPI type
+type ReplicationAPI struct {
+	mock.Mock
+}
+
+type ReplicationAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReplicationAPI) EXPECT() *ReplicationAPI_Expecter {
+	return &ReplicationAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *ReplicationAPI) Create(ctx context.Context, req *truenas.ReplicationTaskRequest) (*truenas.ReplicationTask, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.ReplicationTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ReplicationTaskRequest) (*truenas.ReplicationTask, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ReplicationTaskRequest) *truenas.ReplicationTask); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ReplicationTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.ReplicationTaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicationAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ReplicationAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.ReplicationTaskRequest
+func (_e *ReplicationAPI_Expecter) Create(ctx interface{}, req interface{}) *ReplicationAPI_Create_Call {
+	return &ReplicationAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *ReplicationAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.ReplicationTaskRequest)) *ReplicationAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.ReplicationTaskRequest))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_Create_Call) Return(_a0 *truenas.ReplicationTask, _a1 error) *ReplicationAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReplicationAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.ReplicationTaskRequest) (*truenas.ReplicationTask, error)) *ReplicationAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *ReplicationAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicationAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type ReplicationAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *ReplicationAPI_Expecter) Delete(ctx interface{}, id interface{}) *ReplicationAPI_Delete_Call {
+	return &ReplicationAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *ReplicationAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *ReplicationAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_Delete_Call) Return(_a0 error) *ReplicationAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReplicationAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *ReplicationAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ReplicationAPI) Get(ctx context.Context, id int) (*truenas.ReplicationTask, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.ReplicationTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.ReplicationTask, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.ReplicationTask); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ReplicationTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicationAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ReplicationAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *ReplicationAPI_Expecter) Get(ctx interface{}, id interface{}) *ReplicationAPI_Get_Call {
+	return &ReplicationAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *ReplicationAPI_Get_Call) Run(run func(ctx context.Context, id int)) *ReplicationAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_Get_Call) Return(_a0 *truenas.ReplicationTask, _a1 error) *ReplicationAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReplicationAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.ReplicationTask, error)) *ReplicationAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *ReplicationAPI) List(ctx context.Context) ([]truenas.ReplicationTask, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.ReplicationTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.ReplicationTask, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.ReplicationTask); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ReplicationTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicationAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ReplicationAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReplicationAPI_Expecter) List(ctx interface{}) *ReplicationAPI_List_Call {
+	return &ReplicationAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *ReplicationAPI_List_Call) Run(run func(ctx context.Context)) *ReplicationAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_List_Call) Return(_a0 []truenas.ReplicationTask, _a1 error) *ReplicationAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReplicationAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.ReplicationTask, error)) *ReplicationAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *ReplicationAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.ReplicationTask, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.ReplicationTask, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.ReplicationTask, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.ReplicationTask, error])
+		}
+	}
+
+	return r0
+}
+
+// ReplicationAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type ReplicationAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *ReplicationAPI_Expecter) ListIter(ctx interface{}, q interface{}) *ReplicationAPI_ListIter_Call {
+	return &ReplicationAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *ReplicationAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *ReplicationAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.ReplicationTask, error]) *ReplicationAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReplicationAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.ReplicationTask, error]) *ReplicationAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *ReplicationAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.ReplicationTask, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.ReplicationTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.ReplicationTask, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.ReplicationTask); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ReplicationTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicationAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type ReplicationAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *ReplicationAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *ReplicationAPI_ListWithQuery_Call {
+	return &ReplicationAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *ReplicationAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *ReplicationAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_ListWithQuery_Call) Return(_a0 []truenas.ReplicationTask, _a1 error) *ReplicationAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReplicationAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.ReplicationTask, error)) *ReplicationAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuickSetup provides a mock function with given fields: ctx, sourceDataset, targetSystem, targetDataset, schedule
+func (_m *ReplicationAPI) QuickSetup(ctx context.Context, sourceDataset string, targetSystem truenas.SSHCredentialID, targetDataset string, schedule truenas.Schedule) (*truenas.ReplicationSetup, error) {
+	ret := _m.Called(ctx, sourceDataset, targetSystem, targetDataset, schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuickSetup")
+	}
+
+	var r0 *truenas.ReplicationSetup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.SSHCredentialID, string, truenas.Schedule) (*truenas.ReplicationSetup, error)); ok {
+		return rf(ctx, sourceDataset, targetSystem, targetDataset, schedule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.SSHCredentialID, string, truenas.Schedule) *truenas.ReplicationSetup); ok {
+		r0 = rf(ctx, sourceDataset, targetSystem, targetDataset, schedule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ReplicationSetup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, truenas.SSHCredentialID, string, truenas.Schedule) error); ok {
+		r1 = rf(ctx, sourceDataset, targetSystem, targetDataset, schedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicationAPI_QuickSetup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuickSetup'
+type ReplicationAPI_QuickSetup_Call struct {
+	*mock.Call
+}
+
+// QuickSetup is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceDataset string
+//   - targetSystem truenas.SSHCredentialID
+//   - targetDataset string
+//   - schedule truenas.Schedule
+func (_e *ReplicationAPI_Expecter) QuickSetup(ctx interface{}, sourceDataset interface{}, targetSystem interface{}, targetDataset interface{}, schedule interface{}) *ReplicationAPI_QuickSetup_Call {
+	return &ReplicationAPI_QuickSetup_Call{Call: _e.mock.On("QuickSetup", ctx, sourceDataset, targetSystem, targetDataset, schedule)}
+}
+
+func (_c *ReplicationAPI_QuickSetup_Call) Run(run func(ctx context.Context, sourceDataset string, targetSystem truenas.SSHCredentialID, targetDataset string, schedule truenas.Schedule)) *ReplicationAPI_QuickSetup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.SSHCredentialID), args[3].(string), args[4].(truenas.Schedule))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_QuickSetup_Call) Return(_a0 *truenas.ReplicationSetup, _a1 error) *ReplicationAPI_QuickSetup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReplicationAPI_QuickSetup_Call) RunAndReturn(run func(context.Context, string, truenas.SSHCredentialID, string, truenas.Schedule) (*truenas.ReplicationSetup, error)) *ReplicationAPI_QuickSetup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// QuickSetupWithConnection provides a mock function with given fields: ctx, sourceDataset, conn, targetDataset, schedule
+func (_m *ReplicationAPI) QuickSetupWithConnection(ctx context.Context, sourceDataset string, conn truenas.SSHConnectionSetup, targetDataset string, schedule truenas.Schedule) (*truenas.ReplicationSetup, error) {
+	ret := _m.Called(ctx, sourceDataset, conn, targetDataset, schedule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QuickSetupWithConnection")
+	}
+
+	var r0 *truenas.ReplicationSetup
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.SSHConnectionSetup, string, truenas.Schedule) (*truenas.ReplicationSetup, error)); ok {
+		return rf(ctx, sourceDataset, conn, targetDataset, schedule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, truenas.SSHConnectionSetup, string, truenas.Schedule) *truenas.ReplicationSetup); ok {
+		r0 = rf(ctx, sourceDataset, conn, targetDataset, schedule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ReplicationSetup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, truenas.SSHConnectionSetup, string, truenas.Schedule) error); ok {
+		r1 = rf(ctx, sourceDataset, conn, targetDataset, schedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicationAPI_QuickSetupWithConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QuickSetupWithConnection'
+type ReplicationAPI_QuickSetupWithConnection_Call struct {
+	*mock.Call
+}
+
+// QuickSetupWithConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceDataset string
+//   - conn truenas.SSHConnectionSetup
+//   - targetDataset string
+//   - schedule truenas.Schedule
+func (_e *ReplicationAPI_Expecter) QuickSetupWithConnection(ctx interface{}, sourceDataset interface{}, conn interface{}, targetDataset interface{}, schedule interface{}) *ReplicationAPI_QuickSetupWithConnection_Call {
+	return &ReplicationAPI_QuickSetupWithConnection_Call{Call: _e.mock.On("QuickSetupWithConnection", ctx, sourceDataset, conn, targetDataset, schedule)}
+}
+
+func (_c *ReplicationAPI_QuickSetupWithConnection_Call) Run(run func(ctx context.Context, sourceDataset string, conn truenas.SSHConnectionSetup, targetDataset string, schedule truenas.Schedule)) *ReplicationAPI_QuickSetupWithConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(truenas.SSHConnectionSetup), args[3].(string), args[4].(truenas.Schedule))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_QuickSetupWithConnection_Call) Return(_a0 *truenas.ReplicationSetup, _a1 error) *ReplicationAPI_QuickSetupWithConnection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReplicationAPI_QuickSetupWithConnection_Call) RunAndReturn(run func(context.Context, string, truenas.SSHConnectionSetup, string, truenas.Schedule) (*truenas.ReplicationSetup, error)) *ReplicationAPI_QuickSetupWithConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx, id
+func (_m *ReplicationAPI) Run(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicationAPI_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type ReplicationAPI_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *ReplicationAPI_Expecter) Run(ctx interface{}, id interface{}) *ReplicationAPI_Run_Call {
+	return &ReplicationAPI_Run_Call{Call: _e.mock.On("Run", ctx, id)}
+}
+
+func (_c *ReplicationAPI_Run_Call) Run(run func(ctx context.Context, id int)) *ReplicationAPI_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_Run_Call) Return(_a0 error) *ReplicationAPI_Run_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReplicationAPI_Run_Call) RunAndReturn(run func(context.Context, int) error) *ReplicationAPI_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *ReplicationAPI) Update(ctx context.Context, id int, req *truenas.ReplicationTaskRequest) (*truenas.ReplicationTask, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.ReplicationTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.ReplicationTaskRequest) (*truenas.ReplicationTask, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.ReplicationTaskRequest) *truenas.ReplicationTask); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ReplicationTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.ReplicationTaskRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplicationAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ReplicationAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.ReplicationTaskRequest
+func (_e *ReplicationAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *ReplicationAPI_Update_Call {
+	return &ReplicationAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *ReplicationAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.ReplicationTaskRequest)) *ReplicationAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.ReplicationTaskRequest))
+	})
+	return _c
+}
+
+func (_c *ReplicationAPI_Update_Call) Return(_a0 *truenas.ReplicationTask, _a1 error) *ReplicationAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReplicationAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.ReplicationTaskRequest) (*truenas.ReplicationTask, error)) *ReplicationAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReplicationAPI creates a new instance of ReplicationAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReplicationAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReplicationAPI {
+	mock := &ReplicationAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
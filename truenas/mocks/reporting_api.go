@@ -0,0 +1,330 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReportingAPI is an autogenerated mock type for the ReportingAPI type
+type ReportingAPI struct {
+	mock.Mock
+}
+
+type ReportingAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReportingAPI) EXPECT() *ReportingAPI_Expecter {
+	return &ReportingAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *ReportingAPI) GetConfig(ctx context.Context) (*truenas.ReportingConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.ReportingConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.ReportingConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.ReportingConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ReportingConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportingAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type ReportingAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReportingAPI_Expecter) GetConfig(ctx interface{}) *ReportingAPI_GetConfig_Call {
+	return &ReportingAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *ReportingAPI_GetConfig_Call) Run(run func(ctx context.Context)) *ReportingAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReportingAPI_GetConfig_Call) Return(_a0 *truenas.ReportingConfig, _a1 error) *ReportingAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportingAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.ReportingConfig, error)) *ReportingAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetData provides a mock function with given fields: ctx, graphs, q
+func (_m *ReportingAPI) GetData(ctx context.Context, graphs []truenas.ReportingGraphRequest, q *truenas.ReportingQuery) ([]truenas.ReportingData, error) {
+	ret := _m.Called(ctx, graphs, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetData")
+	}
+
+	var r0 []truenas.ReportingData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []truenas.ReportingGraphRequest, *truenas.ReportingQuery) ([]truenas.ReportingData, error)); ok {
+		return rf(ctx, graphs, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []truenas.ReportingGraphRequest, *truenas.ReportingQuery) []truenas.ReportingData); ok {
+		r0 = rf(ctx, graphs, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ReportingData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []truenas.ReportingGraphRequest, *truenas.ReportingQuery) error); ok {
+		r1 = rf(ctx, graphs, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportingAPI_GetData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetData'
+type ReportingAPI_GetData_Call struct {
+	*mock.Call
+}
+
+// GetData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - graphs []truenas.ReportingGraphRequest
+//   - q *truenas.ReportingQuery
+func (_e *ReportingAPI_Expecter) GetData(ctx interface{}, graphs interface{}, q interface{}) *ReportingAPI_GetData_Call {
+	return &ReportingAPI_GetData_Call{Call: _e.mock.On("GetData", ctx, graphs, q)}
+}
+
+func (_c *ReportingAPI_GetData_Call) Run(run func(ctx context.Context, graphs []truenas.ReportingGraphRequest, q *truenas.ReportingQuery)) *ReportingAPI_GetData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]truenas.ReportingGraphRequest), args[2].(*truenas.ReportingQuery))
+	})
+	return _c
+}
+
+func (_c *ReportingAPI_GetData_Call) Return(_a0 []truenas.ReportingData, _a1 error) *ReportingAPI_GetData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportingAPI_GetData_Call) RunAndReturn(run func(context.Context, []truenas.ReportingGraphRequest, *truenas.ReportingQuery) ([]truenas.ReportingData, error)) *ReportingAPI_GetData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Graphs provides a mock function with given fields: ctx
+func (_m *ReportingAPI) Graphs(ctx context.Context) ([]truenas.ReportingGraph, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Graphs")
+	}
+
+	var r0 []truenas.ReportingGraph
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.ReportingGraph, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.ReportingGraph); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.ReportingGraph)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportingAPI_Graphs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Graphs'
+type ReportingAPI_Graphs_Call struct {
+	*mock.Call
+}
+
+// Graphs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReportingAPI_Expecter) Graphs(ctx interface{}) *ReportingAPI_Graphs_Call {
+	return &ReportingAPI_Graphs_Call{Call: _e.mock.On("Graphs", ctx)}
+}
+
+func (_c *ReportingAPI_Graphs_Call) Run(run func(ctx context.Context)) *ReportingAPI_Graphs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReportingAPI_Graphs_Call) Return(_a0 []truenas.ReportingGraph, _a1 error) *ReportingAPI_Graphs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportingAPI_Graphs_Call) RunAndReturn(run func(context.Context) ([]truenas.ReportingGraph, error)) *ReportingAPI_Graphs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamRealtime provides a mock function with given fields: ctx
+func (_m *ReportingAPI) StreamRealtime(ctx context.Context) (<-chan truenas.RealtimeSample, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamRealtime")
+	}
+
+	var r0 <-chan truenas.RealtimeSample
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (<-chan truenas.RealtimeSample, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan truenas.RealtimeSample); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan truenas.RealtimeSample)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportingAPI_StreamRealtime_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamRealtime'
+type ReportingAPI_StreamRealtime_Call struct {
+	*mock.Call
+}
+
+// StreamRealtime is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ReportingAPI_Expecter) StreamRealtime(ctx interface{}) *ReportingAPI_StreamRealtime_Call {
+	return &ReportingAPI_StreamRealtime_Call{Call: _e.mock.On("StreamRealtime", ctx)}
+}
+
+func (_c *ReportingAPI_StreamRealtime_Call) Run(run func(ctx context.Context)) *ReportingAPI_StreamRealtime_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ReportingAPI_StreamRealtime_Call) Return(_a0 <-chan truenas.RealtimeSample, _a1 error) *ReportingAPI_StreamRealtime_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportingAPI_StreamRealtime_Call) RunAndReturn(run func(context.Context) (<-chan truenas.RealtimeSample, error)) *ReportingAPI_StreamRealtime_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, req
+func (_m *ReportingAPI) UpdateConfig(ctx context.Context, req *truenas.ReportingConfigUpdateRequest) (*truenas.ReportingConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.ReportingConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ReportingConfigUpdateRequest) (*truenas.ReportingConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.ReportingConfigUpdateRequest) *truenas.ReportingConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.ReportingConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.ReportingConfigUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReportingAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type ReportingAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.ReportingConfigUpdateRequest
+func (_e *ReportingAPI_Expecter) UpdateConfig(ctx interface{}, req interface{}) *ReportingAPI_UpdateConfig_Call {
+	return &ReportingAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, req)}
+}
+
+func (_c *ReportingAPI_UpdateConfig_Call) Run(run func(ctx context.Context, req *truenas.ReportingConfigUpdateRequest)) *ReportingAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.ReportingConfigUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *ReportingAPI_UpdateConfig_Call) Return(_a0 *truenas.ReportingConfig, _a1 error) *ReportingAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReportingAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.ReportingConfigUpdateRequest) (*truenas.ReportingConfig, error)) *ReportingAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReportingAPI creates a new instance of ReportingAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportingAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportingAPI {
+	mock := &ReportingAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,868 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// ServiceAPI is an autogenerated mock type for the ServiceAPI type
+type ServiceAPI struct {
+	mock.Mock
+}
+
+type ServiceAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAPI) EXPECT() *ServiceAPI_Expecter {
+	return &ServiceAPI_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *ServiceAPI) Get(ctx context.Context, id int) (*truenas.Service, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.Service, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.Service); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ServiceAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *ServiceAPI_Expecter) Get(ctx interface{}, id interface{}) *ServiceAPI_Get_Call {
+	return &ServiceAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *ServiceAPI_Get_Call) Run(run func(ctx context.Context, id int)) *ServiceAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_Get_Call) Return(_a0 *truenas.Service, _a1 error) *ServiceAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.Service, error)) *ServiceAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByName provides a mock function with given fields: ctx, name
+func (_m *ServiceAPI) GetByName(ctx context.Context, name string) (*truenas.Service, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByName")
+	}
+
+	var r0 *truenas.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Service, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Service); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_GetByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByName'
+type ServiceAPI_GetByName_Call struct {
+	*mock.Call
+}
+
+// GetByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ServiceAPI_Expecter) GetByName(ctx interface{}, name interface{}) *ServiceAPI_GetByName_Call {
+	return &ServiceAPI_GetByName_Call{Call: _e.mock.On("GetByName", ctx, name)}
+}
+
+func (_c *ServiceAPI_GetByName_Call) Run(run func(ctx context.Context, name string)) *ServiceAPI_GetByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_GetByName_Call) Return(_a0 *truenas.Service, _a1 error) *ServiceAPI_GetByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_GetByName_Call) RunAndReturn(run func(context.Context, string) (*truenas.Service, error)) *ServiceAPI_GetByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *ServiceAPI) List(ctx context.Context) ([]truenas.Service, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Service, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Service); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type ServiceAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ServiceAPI_Expecter) List(ctx interface{}) *ServiceAPI_List_Call {
+	return &ServiceAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *ServiceAPI_List_Call) Run(run func(ctx context.Context)) *ServiceAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_List_Call) Return(_a0 []truenas.Service, _a1 error) *ServiceAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Service, error)) *ServiceAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *ServiceAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Service, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Service, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Service, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Service, error])
+		}
+	}
+
+	return r0
+}
+
+// ServiceAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type ServiceAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *ServiceAPI_Expecter) ListIter(ctx interface{}, q interface{}) *ServiceAPI_ListIter_Call {
+	return &ServiceAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *ServiceAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *ServiceAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Service, error]) *ServiceAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Service, error]) *ServiceAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *ServiceAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Service, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Service, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Service); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type ServiceAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *ServiceAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *ServiceAPI_ListWithQuery_Call {
+	return &ServiceAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *ServiceAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *ServiceAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_ListWithQuery_Call) Return(_a0 []truenas.Service, _a1 error) *ServiceAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Service, error)) *ServiceAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reload provides a mock function with given fields: ctx, serviceName, options
+func (_m *ServiceAPI) Reload(ctx context.Context, serviceName string, options ...map[string]any) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...map[string]any) error); ok {
+		r0 = rf(ctx, serviceName, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAPI_Reload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reload'
+type ServiceAPI_Reload_Call struct {
+	*mock.Call
+}
+
+// Reload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceName string
+//   - options ...map[string]any
+func (_e *ServiceAPI_Expecter) Reload(ctx interface{}, serviceName interface{}, options ...interface{}) *ServiceAPI_Reload_Call {
+	return &ServiceAPI_Reload_Call{Call: _e.mock.On("Reload",
+		append([]interface{}{ctx, serviceName}, options...)...)}
+}
+
+func (_c *ServiceAPI_Reload_Call) Run(run func(ctx context.Context, serviceName string, options ...map[string]any)) *ServiceAPI_Reload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]any, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]any)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_Reload_Call) Return(_a0 error) *ServiceAPI_Reload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAPI_Reload_Call) RunAndReturn(run func(context.Context, string, ...map[string]any) error) *ServiceAPI_Reload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restart provides a mock function with given fields: ctx, serviceName, options
+func (_m *ServiceAPI) Restart(ctx context.Context, serviceName string, options ...map[string]any) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...map[string]any) error); ok {
+		r0 = rf(ctx, serviceName, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAPI_Restart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restart'
+type ServiceAPI_Restart_Call struct {
+	*mock.Call
+}
+
+// Restart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceName string
+//   - options ...map[string]any
+func (_e *ServiceAPI_Expecter) Restart(ctx interface{}, serviceName interface{}, options ...interface{}) *ServiceAPI_Restart_Call {
+	return &ServiceAPI_Restart_Call{Call: _e.mock.On("Restart",
+		append([]interface{}{ctx, serviceName}, options...)...)}
+}
+
+func (_c *ServiceAPI_Restart_Call) Run(run func(ctx context.Context, serviceName string, options ...map[string]any)) *ServiceAPI_Restart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]any, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]any)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_Restart_Call) Return(_a0 error) *ServiceAPI_Restart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAPI_Restart_Call) RunAndReturn(run func(context.Context, string, ...map[string]any) error) *ServiceAPI_Restart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestartMany provides a mock function with given fields: ctx, names, opts
+func (_m *ServiceAPI) RestartMany(ctx context.Context, names []string, opts *truenas.RestartManyOptions) (truenas.ServiceRestartResults, error) {
+	ret := _m.Called(ctx, names, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestartMany")
+	}
+
+	var r0 truenas.ServiceRestartResults
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, *truenas.RestartManyOptions) (truenas.ServiceRestartResults, error)); ok {
+		return rf(ctx, names, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, *truenas.RestartManyOptions) truenas.ServiceRestartResults); ok {
+		r0 = rf(ctx, names, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(truenas.ServiceRestartResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, *truenas.RestartManyOptions) error); ok {
+		r1 = rf(ctx, names, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_RestartMany_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestartMany'
+type ServiceAPI_RestartMany_Call struct {
+	*mock.Call
+}
+
+// RestartMany is a helper method to define mock.On call
+//   - ctx context.Context
+//   - names []string
+//   - opts *truenas.RestartManyOptions
+func (_e *ServiceAPI_Expecter) RestartMany(ctx interface{}, names interface{}, opts interface{}) *ServiceAPI_RestartMany_Call {
+	return &ServiceAPI_RestartMany_Call{Call: _e.mock.On("RestartMany", ctx, names, opts)}
+}
+
+func (_c *ServiceAPI_RestartMany_Call) Run(run func(ctx context.Context, names []string, opts *truenas.RestartManyOptions)) *ServiceAPI_RestartMany_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(*truenas.RestartManyOptions))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_RestartMany_Call) Return(_a0 truenas.ServiceRestartResults, _a1 error) *ServiceAPI_RestartMany_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_RestartMany_Call) RunAndReturn(run func(context.Context, []string, *truenas.RestartManyOptions) (truenas.ServiceRestartResults, error)) *ServiceAPI_RestartMany_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetEnabled provides a mock function with given fields: ctx, serviceName, enable
+func (_m *ServiceAPI) SetEnabled(ctx context.Context, serviceName string, enable bool) (*truenas.Service, error) {
+	ret := _m.Called(ctx, serviceName, enable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetEnabled")
+	}
+
+	var r0 *truenas.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (*truenas.Service, error)); ok {
+		return rf(ctx, serviceName, enable)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *truenas.Service); ok {
+		r0 = rf(ctx, serviceName, enable)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, serviceName, enable)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_SetEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetEnabled'
+type ServiceAPI_SetEnabled_Call struct {
+	*mock.Call
+}
+
+// SetEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceName string
+//   - enable bool
+func (_e *ServiceAPI_Expecter) SetEnabled(ctx interface{}, serviceName interface{}, enable interface{}) *ServiceAPI_SetEnabled_Call {
+	return &ServiceAPI_SetEnabled_Call{Call: _e.mock.On("SetEnabled", ctx, serviceName, enable)}
+}
+
+func (_c *ServiceAPI_SetEnabled_Call) Run(run func(ctx context.Context, serviceName string, enable bool)) *ServiceAPI_SetEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_SetEnabled_Call) Return(_a0 *truenas.Service, _a1 error) *ServiceAPI_SetEnabled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_SetEnabled_Call) RunAndReturn(run func(context.Context, string, bool) (*truenas.Service, error)) *ServiceAPI_SetEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, serviceName, options
+func (_m *ServiceAPI) Start(ctx context.Context, serviceName string, options ...map[string]any) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...map[string]any) error); ok {
+		r0 = rf(ctx, serviceName, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAPI_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type ServiceAPI_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceName string
+//   - options ...map[string]any
+func (_e *ServiceAPI_Expecter) Start(ctx interface{}, serviceName interface{}, options ...interface{}) *ServiceAPI_Start_Call {
+	return &ServiceAPI_Start_Call{Call: _e.mock.On("Start",
+		append([]interface{}{ctx, serviceName}, options...)...)}
+}
+
+func (_c *ServiceAPI_Start_Call) Run(run func(ctx context.Context, serviceName string, options ...map[string]any)) *ServiceAPI_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]any, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]any)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_Start_Call) Return(_a0 error) *ServiceAPI_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAPI_Start_Call) RunAndReturn(run func(context.Context, string, ...map[string]any) error) *ServiceAPI_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Started provides a mock function with given fields: ctx, serviceName
+func (_m *ServiceAPI) Started(ctx context.Context, serviceName string) (bool, error) {
+	ret := _m.Called(ctx, serviceName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Started")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, serviceName)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, serviceName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_Started_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Started'
+type ServiceAPI_Started_Call struct {
+	*mock.Call
+}
+
+// Started is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceName string
+func (_e *ServiceAPI_Expecter) Started(ctx interface{}, serviceName interface{}) *ServiceAPI_Started_Call {
+	return &ServiceAPI_Started_Call{Call: _e.mock.On("Started", ctx, serviceName)}
+}
+
+func (_c *ServiceAPI_Started_Call) Run(run func(ctx context.Context, serviceName string)) *ServiceAPI_Started_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_Started_Call) Return(_a0 bool, _a1 error) *ServiceAPI_Started_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_Started_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *ServiceAPI_Started_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx, serviceName, options
+func (_m *ServiceAPI) Stop(ctx context.Context, serviceName string, options ...map[string]any) error {
+	_va := make([]interface{}, len(options))
+	for _i := range options {
+		_va[_i] = options[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, serviceName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...map[string]any) error); ok {
+		r0 = rf(ctx, serviceName, options...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ServiceAPI_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type ServiceAPI_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceName string
+//   - options ...map[string]any
+func (_e *ServiceAPI_Expecter) Stop(ctx interface{}, serviceName interface{}, options ...interface{}) *ServiceAPI_Stop_Call {
+	return &ServiceAPI_Stop_Call{Call: _e.mock.On("Stop",
+		append([]interface{}{ctx, serviceName}, options...)...)}
+}
+
+func (_c *ServiceAPI_Stop_Call) Run(run func(ctx context.Context, serviceName string, options ...map[string]any)) *ServiceAPI_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]map[string]any, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(map[string]any)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_Stop_Call) Return(_a0 error) *ServiceAPI_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ServiceAPI_Stop_Call) RunAndReturn(run func(context.Context, string, ...map[string]any) error) *ServiceAPI_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *ServiceAPI) Update(ctx context.Context, id int, req truenas.ServiceUpdateRequest) (*truenas.Service, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.ServiceUpdateRequest) (*truenas.Service, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, truenas.ServiceUpdateRequest) *truenas.Service); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, truenas.ServiceUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type ServiceAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req truenas.ServiceUpdateRequest
+func (_e *ServiceAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *ServiceAPI_Update_Call {
+	return &ServiceAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *ServiceAPI_Update_Call) Run(run func(ctx context.Context, id int, req truenas.ServiceUpdateRequest)) *ServiceAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(truenas.ServiceUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_Update_Call) Return(_a0 *truenas.Service, _a1 error) *ServiceAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_Update_Call) RunAndReturn(run func(context.Context, int, truenas.ServiceUpdateRequest) (*truenas.Service, error)) *ServiceAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForState provides a mock function with given fields: ctx, serviceName, state
+func (_m *ServiceAPI) WaitForState(ctx context.Context, serviceName string, state string) (*truenas.Service, error) {
+	ret := _m.Called(ctx, serviceName, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForState")
+	}
+
+	var r0 *truenas.Service
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*truenas.Service, error)); ok {
+		return rf(ctx, serviceName, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *truenas.Service); ok {
+		r0 = rf(ctx, serviceName, state)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Service)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, serviceName, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAPI_WaitForState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForState'
+type ServiceAPI_WaitForState_Call struct {
+	*mock.Call
+}
+
+// WaitForState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceName string
+//   - state string
+func (_e *ServiceAPI_Expecter) WaitForState(ctx interface{}, serviceName interface{}, state interface{}) *ServiceAPI_WaitForState_Call {
+	return &ServiceAPI_WaitForState_Call{Call: _e.mock.On("WaitForState", ctx, serviceName, state)}
+}
+
+func (_c *ServiceAPI_WaitForState_Call) Run(run func(ctx context.Context, serviceName string, state string)) *ServiceAPI_WaitForState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ServiceAPI_WaitForState_Call) Return(_a0 *truenas.Service, _a1 error) *ServiceAPI_WaitForState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAPI_WaitForState_Call) RunAndReturn(run func(context.Context, string, string) (*truenas.Service, error)) *ServiceAPI_WaitForState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAPI creates a new instance of ServiceAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAPI {
+	mock := &ServiceAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,430 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// SharingAFPAPI is an autogenerated mock type for the SharingAFPAPI type
+type SharingAFPAPI struct {
+	mock.Mock
+}
+
+type SharingAFPAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SharingAFPAPI) EXPECT() *SharingAFPAPI_Expecter {
+	return &SharingAFPAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *SharingAFPAPI) Create(ctx context.Context, req *truenas.AFPShareRequest) (*truenas.AFPShare, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.AFPShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AFPShareRequest) (*truenas.AFPShare, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.AFPShareRequest) *truenas.AFPShare); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.AFPShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.AFPShareRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingAFPAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SharingAFPAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.AFPShareRequest
+func (_e *SharingAFPAPI_Expecter) Create(ctx interface{}, req interface{}) *SharingAFPAPI_Create_Call {
+	return &SharingAFPAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *SharingAFPAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.AFPShareRequest)) *SharingAFPAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.AFPShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingAFPAPI_Create_Call) Return(_a0 *truenas.AFPShare, _a1 error) *SharingAFPAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingAFPAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.AFPShareRequest) (*truenas.AFPShare, error)) *SharingAFPAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *SharingAFPAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SharingAFPAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SharingAFPAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingAFPAPI_Expecter) Delete(ctx interface{}, id interface{}) *SharingAFPAPI_Delete_Call {
+	return &SharingAFPAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *SharingAFPAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *SharingAFPAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingAFPAPI_Delete_Call) Return(_a0 error) *SharingAFPAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingAFPAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *SharingAFPAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *SharingAFPAPI) Get(ctx context.Context, id int) (*truenas.AFPShare, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.AFPShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.AFPShare, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.AFPShare); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.AFPShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingAFPAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type SharingAFPAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingAFPAPI_Expecter) Get(ctx interface{}, id interface{}) *SharingAFPAPI_Get_Call {
+	return &SharingAFPAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *SharingAFPAPI_Get_Call) Run(run func(ctx context.Context, id int)) *SharingAFPAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingAFPAPI_Get_Call) Return(_a0 *truenas.AFPShare, _a1 error) *SharingAFPAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingAFPAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.AFPShare, error)) *SharingAFPAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *SharingAFPAPI) List(ctx context.Context) ([]truenas.AFPShare, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.AFPShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.AFPShare, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.AFPShare); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.AFPShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingAFPAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type SharingAFPAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SharingAFPAPI_Expecter) List(ctx interface{}) *SharingAFPAPI_List_Call {
+	return &SharingAFPAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *SharingAFPAPI_List_Call) Run(run func(ctx context.Context)) *SharingAFPAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SharingAFPAPI_List_Call) Return(_a0 []truenas.AFPShare, _a1 error) *SharingAFPAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingAFPAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.AFPShare, error)) *SharingAFPAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *SharingAFPAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.AFPShare, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.AFPShare, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.AFPShare, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.AFPShare, error])
+		}
+	}
+
+	return r0
+}
+
+// SharingAFPAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type SharingAFPAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SharingAFPAPI_Expecter) ListIter(ctx interface{}, q interface{}) *SharingAFPAPI_ListIter_Call {
+	return &SharingAFPAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *SharingAFPAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SharingAFPAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SharingAFPAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.AFPShare, error]) *SharingAFPAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingAFPAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.AFPShare, error]) *SharingAFPAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *SharingAFPAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.AFPShare, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.AFPShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.AFPShare, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.AFPShare); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.AFPShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingAFPAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type SharingAFPAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SharingAFPAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *SharingAFPAPI_ListWithQuery_Call {
+	return &SharingAFPAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *SharingAFPAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SharingAFPAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SharingAFPAPI_ListWithQuery_Call) Return(_a0 []truenas.AFPShare, _a1 error) *SharingAFPAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingAFPAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.AFPShare, error)) *SharingAFPAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *SharingAFPAPI) Update(ctx context.Context, id int, req *truenas.AFPShareRequest) (*truenas.AFPShare, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.AFPShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.AFPShareRequest) (*truenas.AFPShare, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.AFPShareRequest) *truenas.AFPShare); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.AFPShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.AFPShareRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingAFPAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SharingAFPAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.AFPShareRequest
+func (_e *SharingAFPAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *SharingAFPAPI_Update_Call {
+	return &SharingAFPAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *SharingAFPAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.AFPShareRequest)) *SharingAFPAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.AFPShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingAFPAPI_Update_Call) Return(_a0 *truenas.AFPShare, _a1 error) *SharingAFPAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingAFPAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.AFPShareRequest) (*truenas.AFPShare, error)) *SharingAFPAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSharingAFPAPI creates a new instance of SharingAFPAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSharingAFPAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SharingAFPAPI {
+	mock := &SharingAFPAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,819 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// SharingNFSAPI is an autogenerated mock type for the SharingNFSAPI type
+type SharingNFSAPI struct {
+	mock.Mock
+}
+
+type SharingNFSAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SharingNFSAPI) EXPECT() *SharingNFSAPI_Expecter {
+	return &SharingNFSAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *SharingNFSAPI) Create(ctx context.Context, req *truenas.NFSShareRequest) (*truenas.NFSShare, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.NFSShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NFSShareRequest) (*truenas.NFSShare, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NFSShareRequest) *truenas.NFSShare); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NFSShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.NFSShareRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SharingNFSAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.NFSShareRequest
+func (_e *SharingNFSAPI_Expecter) Create(ctx interface{}, req interface{}) *SharingNFSAPI_Create_Call {
+	return &SharingNFSAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *SharingNFSAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.NFSShareRequest)) *SharingNFSAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.NFSShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_Create_Call) Return(_a0 *truenas.NFSShare, _a1 error) *SharingNFSAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.NFSShareRequest) (*truenas.NFSShare, error)) *SharingNFSAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *SharingNFSAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SharingNFSAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SharingNFSAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingNFSAPI_Expecter) Delete(ctx interface{}, id interface{}) *SharingNFSAPI_Delete_Call {
+	return &SharingNFSAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *SharingNFSAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *SharingNFSAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_Delete_Call) Return(_a0 error) *SharingNFSAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingNFSAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *SharingNFSAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Diff provides a mock function with given fields: ctx, id, desired
+func (_m *SharingNFSAPI) Diff(ctx context.Context, id int, desired *truenas.NFSShareRequest) ([]truenas.FieldDiff, error) {
+	ret := _m.Called(ctx, id, desired)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Diff")
+	}
+
+	var r0 []truenas.FieldDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.NFSShareRequest) ([]truenas.FieldDiff, error)); ok {
+		return rf(ctx, id, desired)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.NFSShareRequest) []truenas.FieldDiff); ok {
+		r0 = rf(ctx, id, desired)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.FieldDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.NFSShareRequest) error); ok {
+		r1 = rf(ctx, id, desired)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type SharingNFSAPI_Diff_Call struct {
+	*mock.Call
+}
+
+// Diff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - desired *truenas.NFSShareRequest
+func (_e *SharingNFSAPI_Expecter) Diff(ctx interface{}, id interface{}, desired interface{}) *SharingNFSAPI_Diff_Call {
+	return &SharingNFSAPI_Diff_Call{Call: _e.mock.On("Diff", ctx, id, desired)}
+}
+
+func (_c *SharingNFSAPI_Diff_Call) Run(run func(ctx context.Context, id int, desired *truenas.NFSShareRequest)) *SharingNFSAPI_Diff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.NFSShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_Diff_Call) Return(_a0 []truenas.FieldDiff, _a1 error) *SharingNFSAPI_Diff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_Diff_Call) RunAndReturn(run func(context.Context, int, *truenas.NFSShareRequest) ([]truenas.FieldDiff, error)) *SharingNFSAPI_Diff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *SharingNFSAPI) Get(ctx context.Context, id int) (*truenas.NFSShare, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.NFSShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.NFSShare, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.NFSShare); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NFSShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type SharingNFSAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingNFSAPI_Expecter) Get(ctx interface{}, id interface{}) *SharingNFSAPI_Get_Call {
+	return &SharingNFSAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *SharingNFSAPI_Get_Call) Run(run func(ctx context.Context, id int)) *SharingNFSAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_Get_Call) Return(_a0 *truenas.NFSShare, _a1 error) *SharingNFSAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.NFSShare, error)) *SharingNFSAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClients provides a mock function with given fields: ctx
+func (_m *SharingNFSAPI) GetClients(ctx context.Context) ([]truenas.NFSConnectedClient, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClients")
+	}
+
+	var r0 []truenas.NFSConnectedClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.NFSConnectedClient, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.NFSConnectedClient); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.NFSConnectedClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_GetClients_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClients'
+type SharingNFSAPI_GetClients_Call struct {
+	*mock.Call
+}
+
+// GetClients is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SharingNFSAPI_Expecter) GetClients(ctx interface{}) *SharingNFSAPI_GetClients_Call {
+	return &SharingNFSAPI_GetClients_Call{Call: _e.mock.On("GetClients", ctx)}
+}
+
+func (_c *SharingNFSAPI_GetClients_Call) Run(run func(ctx context.Context)) *SharingNFSAPI_GetClients_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_GetClients_Call) Return(_a0 []truenas.NFSConnectedClient, _a1 error) *SharingNFSAPI_GetClients_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_GetClients_Call) RunAndReturn(run func(context.Context) ([]truenas.NFSConnectedClient, error)) *SharingNFSAPI_GetClients_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHumanIdentifier provides a mock function with given fields: ctx, id
+func (_m *SharingNFSAPI) GetHumanIdentifier(ctx context.Context, id int) (string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHumanIdentifier")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_GetHumanIdentifier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHumanIdentifier'
+type SharingNFSAPI_GetHumanIdentifier_Call struct {
+	*mock.Call
+}
+
+// GetHumanIdentifier is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingNFSAPI_Expecter) GetHumanIdentifier(ctx interface{}, id interface{}) *SharingNFSAPI_GetHumanIdentifier_Call {
+	return &SharingNFSAPI_GetHumanIdentifier_Call{Call: _e.mock.On("GetHumanIdentifier", ctx, id)}
+}
+
+func (_c *SharingNFSAPI_GetHumanIdentifier_Call) Run(run func(ctx context.Context, id int)) *SharingNFSAPI_GetHumanIdentifier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_GetHumanIdentifier_Call) Return(_a0 string, _a1 error) *SharingNFSAPI_GetHumanIdentifier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_GetHumanIdentifier_Call) RunAndReturn(run func(context.Context, int) (string, error)) *SharingNFSAPI_GetHumanIdentifier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *SharingNFSAPI) List(ctx context.Context) ([]truenas.NFSShare, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.NFSShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.NFSShare, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.NFSShare); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.NFSShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type SharingNFSAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SharingNFSAPI_Expecter) List(ctx interface{}) *SharingNFSAPI_List_Call {
+	return &SharingNFSAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *SharingNFSAPI_List_Call) Run(run func(ctx context.Context)) *SharingNFSAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_List_Call) Return(_a0 []truenas.NFSShare, _a1 error) *SharingNFSAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.NFSShare, error)) *SharingNFSAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *SharingNFSAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.NFSShare, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.NFSShare, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.NFSShare, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.NFSShare, error])
+		}
+	}
+
+	return r0
+}
+
+// SharingNFSAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type SharingNFSAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SharingNFSAPI_Expecter) ListIter(ctx interface{}, q interface{}) *SharingNFSAPI_ListIter_Call {
+	return &SharingNFSAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *SharingNFSAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SharingNFSAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.NFSShare, error]) *SharingNFSAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingNFSAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.NFSShare, error]) *SharingNFSAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *SharingNFSAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.NFSShare, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.NFSShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.NFSShare, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.NFSShare); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.NFSShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type SharingNFSAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SharingNFSAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *SharingNFSAPI_ListWithQuery_Call {
+	return &SharingNFSAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *SharingNFSAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SharingNFSAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_ListWithQuery_Call) Return(_a0 []truenas.NFSShare, _a1 error) *SharingNFSAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.NFSShare, error)) *SharingNFSAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Provision provides a mock function with given fields: ctx, req
+func (_m *SharingNFSAPI) Provision(ctx context.Context, req truenas.NFSProvisionRequest) (*truenas.NFSProvisionResult, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Provision")
+	}
+
+	var r0 *truenas.NFSProvisionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.NFSProvisionRequest) (*truenas.NFSProvisionResult, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.NFSProvisionRequest) *truenas.NFSProvisionResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NFSProvisionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.NFSProvisionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_Provision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Provision'
+type SharingNFSAPI_Provision_Call struct {
+	*mock.Call
+}
+
+// Provision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.NFSProvisionRequest
+func (_e *SharingNFSAPI_Expecter) Provision(ctx interface{}, req interface{}) *SharingNFSAPI_Provision_Call {
+	return &SharingNFSAPI_Provision_Call{Call: _e.mock.On("Provision", ctx, req)}
+}
+
+func (_c *SharingNFSAPI_Provision_Call) Run(run func(ctx context.Context, req truenas.NFSProvisionRequest)) *SharingNFSAPI_Provision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.NFSProvisionRequest))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_Provision_Call) Return(_a0 *truenas.NFSProvisionResult, _a1 error) *SharingNFSAPI_Provision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_Provision_Call) RunAndReturn(run func(context.Context, truenas.NFSProvisionRequest) (*truenas.NFSProvisionResult, error)) *SharingNFSAPI_Provision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProvisionWithProgress provides a mock function with given fields: ctx, req, fn
+func (_m *SharingNFSAPI) ProvisionWithProgress(ctx context.Context, req truenas.NFSProvisionRequest, fn truenas.ProvisionProgressFunc) (*truenas.NFSProvisionResult, error) {
+	ret := _m.Called(ctx, req, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProvisionWithProgress")
+	}
+
+	var r0 *truenas.NFSProvisionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.NFSProvisionRequest, truenas.ProvisionProgressFunc) (*truenas.NFSProvisionResult, error)); ok {
+		return rf(ctx, req, fn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.NFSProvisionRequest, truenas.ProvisionProgressFunc) *truenas.NFSProvisionResult); ok {
+		r0 = rf(ctx, req, fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NFSProvisionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.NFSProvisionRequest, truenas.ProvisionProgressFunc) error); ok {
+		r1 = rf(ctx, req, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_ProvisionWithProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProvisionWithProgress'
+type SharingNFSAPI_ProvisionWithProgress_Call struct {
+	*mock.Call
+}
+
+// ProvisionWithProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.NFSProvisionRequest
+//   - fn truenas.ProvisionProgressFunc
+func (_e *SharingNFSAPI_Expecter) ProvisionWithProgress(ctx interface{}, req interface{}, fn interface{}) *SharingNFSAPI_ProvisionWithProgress_Call {
+	return &SharingNFSAPI_ProvisionWithProgress_Call{Call: _e.mock.On("ProvisionWithProgress", ctx, req, fn)}
+}
+
+func (_c *SharingNFSAPI_ProvisionWithProgress_Call) Run(run func(ctx context.Context, req truenas.NFSProvisionRequest, fn truenas.ProvisionProgressFunc)) *SharingNFSAPI_ProvisionWithProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.NFSProvisionRequest), args[2].(truenas.ProvisionProgressFunc))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_ProvisionWithProgress_Call) Return(_a0 *truenas.NFSProvisionResult, _a1 error) *SharingNFSAPI_ProvisionWithProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_ProvisionWithProgress_Call) RunAndReturn(run func(context.Context, truenas.NFSProvisionRequest, truenas.ProvisionProgressFunc) (*truenas.NFSProvisionResult, error)) *SharingNFSAPI_ProvisionWithProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *SharingNFSAPI) Update(ctx context.Context, id int, req *truenas.NFSShareRequest) (*truenas.NFSShare, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.NFSShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.NFSShareRequest) (*truenas.NFSShare, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.NFSShareRequest) *truenas.NFSShare); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.NFSShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.NFSShareRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingNFSAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SharingNFSAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.NFSShareRequest
+func (_e *SharingNFSAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *SharingNFSAPI_Update_Call {
+	return &SharingNFSAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *SharingNFSAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.NFSShareRequest)) *SharingNFSAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.NFSShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_Update_Call) Return(_a0 *truenas.NFSShare, _a1 error) *SharingNFSAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingNFSAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.NFSShareRequest) (*truenas.NFSShare, error)) *SharingNFSAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Validate provides a mock function with given fields: ctx, req
+func (_m *SharingNFSAPI) Validate(ctx context.Context, req *truenas.NFSShareRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Validate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.NFSShareRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SharingNFSAPI_Validate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Validate'
+type SharingNFSAPI_Validate_Call struct {
+	*mock.Call
+}
+
+// Validate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.NFSShareRequest
+func (_e *SharingNFSAPI_Expecter) Validate(ctx interface{}, req interface{}) *SharingNFSAPI_Validate_Call {
+	return &SharingNFSAPI_Validate_Call{Call: _e.mock.On("Validate", ctx, req)}
+}
+
+func (_c *SharingNFSAPI_Validate_Call) Run(run func(ctx context.Context, req *truenas.NFSShareRequest)) *SharingNFSAPI_Validate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.NFSShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_Validate_Call) Return(_a0 error) *SharingNFSAPI_Validate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingNFSAPI_Validate_Call) RunAndReturn(run func(context.Context, *truenas.NFSShareRequest) error) *SharingNFSAPI_Validate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateUpdate provides a mock function with given fields: ctx, id, req
+func (_m *SharingNFSAPI) ValidateUpdate(ctx context.Context, id int, req *truenas.NFSShareRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.NFSShareRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SharingNFSAPI_ValidateUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateUpdate'
+type SharingNFSAPI_ValidateUpdate_Call struct {
+	*mock.Call
+}
+
+// ValidateUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.NFSShareRequest
+func (_e *SharingNFSAPI_Expecter) ValidateUpdate(ctx interface{}, id interface{}, req interface{}) *SharingNFSAPI_ValidateUpdate_Call {
+	return &SharingNFSAPI_ValidateUpdate_Call{Call: _e.mock.On("ValidateUpdate", ctx, id, req)}
+}
+
+func (_c *SharingNFSAPI_ValidateUpdate_Call) Run(run func(ctx context.Context, id int, req *truenas.NFSShareRequest)) *SharingNFSAPI_ValidateUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.NFSShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingNFSAPI_ValidateUpdate_Call) Return(_a0 error) *SharingNFSAPI_ValidateUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingNFSAPI_ValidateUpdate_Call) RunAndReturn(run func(context.Context, int, *truenas.NFSShareRequest) error) *SharingNFSAPI_ValidateUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSharingNFSAPI creates a new instance of SharingNFSAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSharingNFSAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SharingNFSAPI {
+	mock := &SharingNFSAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
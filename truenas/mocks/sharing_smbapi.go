@@ -0,0 +1,728 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// SharingSMBAPI is an autogenerated mock type for the SharingSMBAPI type
+type SharingSMBAPI struct {
+	mock.Mock
+}
+
+type SharingSMBAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SharingSMBAPI) EXPECT() *SharingSMBAPI_Expecter {
+	return &SharingSMBAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *SharingSMBAPI) Create(ctx context.Context, req *truenas.SMBShareRequest) (*truenas.SMBShare, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.SMBShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SMBShareRequest) (*truenas.SMBShare, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SMBShareRequest) *truenas.SMBShare); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SMBShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SMBShareRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SharingSMBAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SMBShareRequest
+func (_e *SharingSMBAPI_Expecter) Create(ctx interface{}, req interface{}) *SharingSMBAPI_Create_Call {
+	return &SharingSMBAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *SharingSMBAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.SMBShareRequest)) *SharingSMBAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SMBShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_Create_Call) Return(_a0 *truenas.SMBShare, _a1 error) *SharingSMBAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.SMBShareRequest) (*truenas.SMBShare, error)) *SharingSMBAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTimeMachine provides a mock function with given fields: ctx, path, name, quotaGiB
+func (_m *SharingSMBAPI) CreateTimeMachine(ctx context.Context, path string, name string, quotaGiB int) (*truenas.SMBShare, error) {
+	ret := _m.Called(ctx, path, name, quotaGiB)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTimeMachine")
+	}
+
+	var r0 *truenas.SMBShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) (*truenas.SMBShare, error)); ok {
+		return rf(ctx, path, name, quotaGiB)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) *truenas.SMBShare); ok {
+		r0 = rf(ctx, path, name, quotaGiB)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SMBShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int) error); ok {
+		r1 = rf(ctx, path, name, quotaGiB)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_CreateTimeMachine_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTimeMachine'
+type SharingSMBAPI_CreateTimeMachine_Call struct {
+	*mock.Call
+}
+
+// CreateTimeMachine is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - name string
+//   - quotaGiB int
+func (_e *SharingSMBAPI_Expecter) CreateTimeMachine(ctx interface{}, path interface{}, name interface{}, quotaGiB interface{}) *SharingSMBAPI_CreateTimeMachine_Call {
+	return &SharingSMBAPI_CreateTimeMachine_Call{Call: _e.mock.On("CreateTimeMachine", ctx, path, name, quotaGiB)}
+}
+
+func (_c *SharingSMBAPI_CreateTimeMachine_Call) Run(run func(ctx context.Context, path string, name string, quotaGiB int)) *SharingSMBAPI_CreateTimeMachine_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_CreateTimeMachine_Call) Return(_a0 *truenas.SMBShare, _a1 error) *SharingSMBAPI_CreateTimeMachine_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_CreateTimeMachine_Call) RunAndReturn(run func(context.Context, string, string, int) (*truenas.SMBShare, error)) *SharingSMBAPI_CreateTimeMachine_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *SharingSMBAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SharingSMBAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SharingSMBAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingSMBAPI_Expecter) Delete(ctx interface{}, id interface{}) *SharingSMBAPI_Delete_Call {
+	return &SharingSMBAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *SharingSMBAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *SharingSMBAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_Delete_Call) Return(_a0 error) *SharingSMBAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingSMBAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *SharingSMBAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Diff provides a mock function with given fields: ctx, id, desired
+func (_m *SharingSMBAPI) Diff(ctx context.Context, id int, desired *truenas.SMBShareRequest) ([]truenas.FieldDiff, error) {
+	ret := _m.Called(ctx, id, desired)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Diff")
+	}
+
+	var r0 []truenas.FieldDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.SMBShareRequest) ([]truenas.FieldDiff, error)); ok {
+		return rf(ctx, id, desired)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.SMBShareRequest) []truenas.FieldDiff); ok {
+		r0 = rf(ctx, id, desired)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.FieldDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.SMBShareRequest) error); ok {
+		r1 = rf(ctx, id, desired)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type SharingSMBAPI_Diff_Call struct {
+	*mock.Call
+}
+
+// Diff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - desired *truenas.SMBShareRequest
+func (_e *SharingSMBAPI_Expecter) Diff(ctx interface{}, id interface{}, desired interface{}) *SharingSMBAPI_Diff_Call {
+	return &SharingSMBAPI_Diff_Call{Call: _e.mock.On("Diff", ctx, id, desired)}
+}
+
+func (_c *SharingSMBAPI_Diff_Call) Run(run func(ctx context.Context, id int, desired *truenas.SMBShareRequest)) *SharingSMBAPI_Diff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.SMBShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_Diff_Call) Return(_a0 []truenas.FieldDiff, _a1 error) *SharingSMBAPI_Diff_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_Diff_Call) RunAndReturn(run func(context.Context, int, *truenas.SMBShareRequest) ([]truenas.FieldDiff, error)) *SharingSMBAPI_Diff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *SharingSMBAPI) Get(ctx context.Context, id int) (*truenas.SMBShare, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.SMBShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.SMBShare, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.SMBShare); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SMBShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type SharingSMBAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingSMBAPI_Expecter) Get(ctx interface{}, id interface{}) *SharingSMBAPI_Get_Call {
+	return &SharingSMBAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *SharingSMBAPI_Get_Call) Run(run func(ctx context.Context, id int)) *SharingSMBAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_Get_Call) Return(_a0 *truenas.SMBShare, _a1 error) *SharingSMBAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.SMBShare, error)) *SharingSMBAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPresets provides a mock function with given fields: ctx
+func (_m *SharingSMBAPI) GetPresets(ctx context.Context) ([]truenas.SMBPreset, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPresets")
+	}
+
+	var r0 []truenas.SMBPreset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.SMBPreset, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.SMBPreset); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SMBPreset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_GetPresets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPresets'
+type SharingSMBAPI_GetPresets_Call struct {
+	*mock.Call
+}
+
+// GetPresets is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SharingSMBAPI_Expecter) GetPresets(ctx interface{}) *SharingSMBAPI_GetPresets_Call {
+	return &SharingSMBAPI_GetPresets_Call{Call: _e.mock.On("GetPresets", ctx)}
+}
+
+func (_c *SharingSMBAPI_GetPresets_Call) Run(run func(ctx context.Context)) *SharingSMBAPI_GetPresets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_GetPresets_Call) Return(_a0 []truenas.SMBPreset, _a1 error) *SharingSMBAPI_GetPresets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_GetPresets_Call) RunAndReturn(run func(context.Context) ([]truenas.SMBPreset, error)) *SharingSMBAPI_GetPresets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *SharingSMBAPI) List(ctx context.Context) ([]truenas.SMBShare, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.SMBShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.SMBShare, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.SMBShare); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SMBShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type SharingSMBAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SharingSMBAPI_Expecter) List(ctx interface{}) *SharingSMBAPI_List_Call {
+	return &SharingSMBAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *SharingSMBAPI_List_Call) Run(run func(ctx context.Context)) *SharingSMBAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_List_Call) Return(_a0 []truenas.SMBShare, _a1 error) *SharingSMBAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.SMBShare, error)) *SharingSMBAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *SharingSMBAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.SMBShare, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.SMBShare, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.SMBShare, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.SMBShare, error])
+		}
+	}
+
+	return r0
+}
+
+// SharingSMBAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type SharingSMBAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SharingSMBAPI_Expecter) ListIter(ctx interface{}, q interface{}) *SharingSMBAPI_ListIter_Call {
+	return &SharingSMBAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *SharingSMBAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SharingSMBAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.SMBShare, error]) *SharingSMBAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingSMBAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.SMBShare, error]) *SharingSMBAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *SharingSMBAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.SMBShare, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.SMBShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.SMBShare, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.SMBShare); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SMBShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type SharingSMBAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SharingSMBAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *SharingSMBAPI_ListWithQuery_Call {
+	return &SharingSMBAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *SharingSMBAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SharingSMBAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_ListWithQuery_Call) Return(_a0 []truenas.SMBShare, _a1 error) *SharingSMBAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.SMBShare, error)) *SharingSMBAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Provision provides a mock function with given fields: ctx, req
+func (_m *SharingSMBAPI) Provision(ctx context.Context, req truenas.SMBProvisionRequest) (*truenas.SMBProvisionResult, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Provision")
+	}
+
+	var r0 *truenas.SMBProvisionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SMBProvisionRequest) (*truenas.SMBProvisionResult, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SMBProvisionRequest) *truenas.SMBProvisionResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SMBProvisionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.SMBProvisionRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_Provision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Provision'
+type SharingSMBAPI_Provision_Call struct {
+	*mock.Call
+}
+
+// Provision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.SMBProvisionRequest
+func (_e *SharingSMBAPI_Expecter) Provision(ctx interface{}, req interface{}) *SharingSMBAPI_Provision_Call {
+	return &SharingSMBAPI_Provision_Call{Call: _e.mock.On("Provision", ctx, req)}
+}
+
+func (_c *SharingSMBAPI_Provision_Call) Run(run func(ctx context.Context, req truenas.SMBProvisionRequest)) *SharingSMBAPI_Provision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.SMBProvisionRequest))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_Provision_Call) Return(_a0 *truenas.SMBProvisionResult, _a1 error) *SharingSMBAPI_Provision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_Provision_Call) RunAndReturn(run func(context.Context, truenas.SMBProvisionRequest) (*truenas.SMBProvisionResult, error)) *SharingSMBAPI_Provision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProvisionWithProgress provides a mock function with given fields: ctx, req, fn
+func (_m *SharingSMBAPI) ProvisionWithProgress(ctx context.Context, req truenas.SMBProvisionRequest, fn truenas.ProvisionProgressFunc) (*truenas.SMBProvisionResult, error) {
+	ret := _m.Called(ctx, req, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ProvisionWithProgress")
+	}
+
+	var r0 *truenas.SMBProvisionResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SMBProvisionRequest, truenas.ProvisionProgressFunc) (*truenas.SMBProvisionResult, error)); ok {
+		return rf(ctx, req, fn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SMBProvisionRequest, truenas.ProvisionProgressFunc) *truenas.SMBProvisionResult); ok {
+		r0 = rf(ctx, req, fn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SMBProvisionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.SMBProvisionRequest, truenas.ProvisionProgressFunc) error); ok {
+		r1 = rf(ctx, req, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_ProvisionWithProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProvisionWithProgress'
+type SharingSMBAPI_ProvisionWithProgress_Call struct {
+	*mock.Call
+}
+
+// ProvisionWithProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.SMBProvisionRequest
+//   - fn truenas.ProvisionProgressFunc
+func (_e *SharingSMBAPI_Expecter) ProvisionWithProgress(ctx interface{}, req interface{}, fn interface{}) *SharingSMBAPI_ProvisionWithProgress_Call {
+	return &SharingSMBAPI_ProvisionWithProgress_Call{Call: _e.mock.On("ProvisionWithProgress", ctx, req, fn)}
+}
+
+func (_c *SharingSMBAPI_ProvisionWithProgress_Call) Run(run func(ctx context.Context, req truenas.SMBProvisionRequest, fn truenas.ProvisionProgressFunc)) *SharingSMBAPI_ProvisionWithProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.SMBProvisionRequest), args[2].(truenas.ProvisionProgressFunc))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_ProvisionWithProgress_Call) Return(_a0 *truenas.SMBProvisionResult, _a1 error) *SharingSMBAPI_ProvisionWithProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_ProvisionWithProgress_Call) RunAndReturn(run func(context.Context, truenas.SMBProvisionRequest, truenas.ProvisionProgressFunc) (*truenas.SMBProvisionResult, error)) *SharingSMBAPI_ProvisionWithProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *SharingSMBAPI) Update(ctx context.Context, id int, req *truenas.SMBShareRequest) (*truenas.SMBShare, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.SMBShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.SMBShareRequest) (*truenas.SMBShare, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.SMBShareRequest) *truenas.SMBShare); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SMBShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.SMBShareRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingSMBAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SharingSMBAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.SMBShareRequest
+func (_e *SharingSMBAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *SharingSMBAPI_Update_Call {
+	return &SharingSMBAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *SharingSMBAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.SMBShareRequest)) *SharingSMBAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.SMBShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingSMBAPI_Update_Call) Return(_a0 *truenas.SMBShare, _a1 error) *SharingSMBAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingSMBAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.SMBShareRequest) (*truenas.SMBShare, error)) *SharingSMBAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSharingSMBAPI creates a new instance of SharingSMBAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSharingSMBAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SharingSMBAPI {
+	mock := &SharingSMBAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,430 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// SharingWebDAVAPI is an autogenerated mock type for the SharingWebDAVAPI type
+type SharingWebDAVAPI struct {
+	mock.Mock
+}
+
+type SharingWebDAVAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SharingWebDAVAPI) EXPECT() *SharingWebDAVAPI_Expecter {
+	return &SharingWebDAVAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *SharingWebDAVAPI) Create(ctx context.Context, req *truenas.WebDAVShareRequest) (*truenas.WebDAVShare, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.WebDAVShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.WebDAVShareRequest) (*truenas.WebDAVShare, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.WebDAVShareRequest) *truenas.WebDAVShare); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.WebDAVShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.WebDAVShareRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingWebDAVAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SharingWebDAVAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.WebDAVShareRequest
+func (_e *SharingWebDAVAPI_Expecter) Create(ctx interface{}, req interface{}) *SharingWebDAVAPI_Create_Call {
+	return &SharingWebDAVAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *SharingWebDAVAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.WebDAVShareRequest)) *SharingWebDAVAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.WebDAVShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_Create_Call) Return(_a0 *truenas.WebDAVShare, _a1 error) *SharingWebDAVAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.WebDAVShareRequest) (*truenas.WebDAVShare, error)) *SharingWebDAVAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *SharingWebDAVAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SharingWebDAVAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SharingWebDAVAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingWebDAVAPI_Expecter) Delete(ctx interface{}, id interface{}) *SharingWebDAVAPI_Delete_Call {
+	return &SharingWebDAVAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *SharingWebDAVAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *SharingWebDAVAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_Delete_Call) Return(_a0 error) *SharingWebDAVAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *SharingWebDAVAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *SharingWebDAVAPI) Get(ctx context.Context, id int) (*truenas.WebDAVShare, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.WebDAVShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.WebDAVShare, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.WebDAVShare); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.WebDAVShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingWebDAVAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type SharingWebDAVAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SharingWebDAVAPI_Expecter) Get(ctx interface{}, id interface{}) *SharingWebDAVAPI_Get_Call {
+	return &SharingWebDAVAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *SharingWebDAVAPI_Get_Call) Run(run func(ctx context.Context, id int)) *SharingWebDAVAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_Get_Call) Return(_a0 *truenas.WebDAVShare, _a1 error) *SharingWebDAVAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.WebDAVShare, error)) *SharingWebDAVAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *SharingWebDAVAPI) List(ctx context.Context) ([]truenas.WebDAVShare, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.WebDAVShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.WebDAVShare, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.WebDAVShare); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.WebDAVShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingWebDAVAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type SharingWebDAVAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SharingWebDAVAPI_Expecter) List(ctx interface{}) *SharingWebDAVAPI_List_Call {
+	return &SharingWebDAVAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *SharingWebDAVAPI_List_Call) Run(run func(ctx context.Context)) *SharingWebDAVAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_List_Call) Return(_a0 []truenas.WebDAVShare, _a1 error) *SharingWebDAVAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.WebDAVShare, error)) *SharingWebDAVAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *SharingWebDAVAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.WebDAVShare, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.WebDAVShare, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.WebDAVShare, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.WebDAVShare, error])
+		}
+	}
+
+	return r0
+}
+
+// SharingWebDAVAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type SharingWebDAVAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SharingWebDAVAPI_Expecter) ListIter(ctx interface{}, q interface{}) *SharingWebDAVAPI_ListIter_Call {
+	return &SharingWebDAVAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *SharingWebDAVAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SharingWebDAVAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.WebDAVShare, error]) *SharingWebDAVAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.WebDAVShare, error]) *SharingWebDAVAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *SharingWebDAVAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.WebDAVShare, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.WebDAVShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.WebDAVShare, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.WebDAVShare); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.WebDAVShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingWebDAVAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type SharingWebDAVAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SharingWebDAVAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *SharingWebDAVAPI_ListWithQuery_Call {
+	return &SharingWebDAVAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *SharingWebDAVAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SharingWebDAVAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_ListWithQuery_Call) Return(_a0 []truenas.WebDAVShare, _a1 error) *SharingWebDAVAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.WebDAVShare, error)) *SharingWebDAVAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *SharingWebDAVAPI) Update(ctx context.Context, id int, req *truenas.WebDAVShareRequest) (*truenas.WebDAVShare, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.WebDAVShare
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.WebDAVShareRequest) (*truenas.WebDAVShare, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.WebDAVShareRequest) *truenas.WebDAVShare); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.WebDAVShare)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.WebDAVShareRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SharingWebDAVAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SharingWebDAVAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.WebDAVShareRequest
+func (_e *SharingWebDAVAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *SharingWebDAVAPI_Update_Call {
+	return &SharingWebDAVAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *SharingWebDAVAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.WebDAVShareRequest)) *SharingWebDAVAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.WebDAVShareRequest))
+	})
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_Update_Call) Return(_a0 *truenas.WebDAVShare, _a1 error) *SharingWebDAVAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SharingWebDAVAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.WebDAVShareRequest) (*truenas.WebDAVShare, error)) *SharingWebDAVAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSharingWebDAVAPI creates a new instance of SharingWebDAVAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSharingWebDAVAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SharingWebDAVAPI {
+	mock := &SharingWebDAVAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,982 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	json "encoding/json"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// SmartAPI is an autogenerated mock type for the SmartAPI type
+type SmartAPI struct {
+	mock.Mock
+}
+
+type SmartAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SmartAPI) EXPECT() *SmartAPI_Expecter {
+	return &SmartAPI_Expecter{mock: &_m.Mock}
+}
+
+// CreateTest provides a mock function with given fields: ctx, req
+func (_m *SmartAPI) CreateTest(ctx context.Context, req *truenas.SmartTestCreateRequest) (*truenas.SmartTest, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTest")
+	}
+
+	var r0 *truenas.SmartTest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SmartTestCreateRequest) (*truenas.SmartTest, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SmartTestCreateRequest) *truenas.SmartTest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SmartTest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SmartTestCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_CreateTest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTest'
+type SmartAPI_CreateTest_Call struct {
+	*mock.Call
+}
+
+// CreateTest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SmartTestCreateRequest
+func (_e *SmartAPI_Expecter) CreateTest(ctx interface{}, req interface{}) *SmartAPI_CreateTest_Call {
+	return &SmartAPI_CreateTest_Call{Call: _e.mock.On("CreateTest", ctx, req)}
+}
+
+func (_c *SmartAPI_CreateTest_Call) Run(run func(ctx context.Context, req *truenas.SmartTestCreateRequest)) *SmartAPI_CreateTest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SmartTestCreateRequest))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_CreateTest_Call) Return(_a0 *truenas.SmartTest, _a1 error) *SmartAPI_CreateTest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_CreateTest_Call) RunAndReturn(run func(context.Context, *truenas.SmartTestCreateRequest) (*truenas.SmartTest, error)) *SmartAPI_CreateTest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTest provides a mock function with given fields: ctx, id
+func (_m *SmartAPI) DeleteTest(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SmartAPI_DeleteTest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTest'
+type SmartAPI_DeleteTest_Call struct {
+	*mock.Call
+}
+
+// DeleteTest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SmartAPI_Expecter) DeleteTest(ctx interface{}, id interface{}) *SmartAPI_DeleteTest_Call {
+	return &SmartAPI_DeleteTest_Call{Call: _e.mock.On("DeleteTest", ctx, id)}
+}
+
+func (_c *SmartAPI_DeleteTest_Call) Run(run func(ctx context.Context, id int)) *SmartAPI_DeleteTest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_DeleteTest_Call) Return(_a0 error) *SmartAPI_DeleteTest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SmartAPI_DeleteTest_Call) RunAndReturn(run func(context.Context, int) error) *SmartAPI_DeleteTest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllTestResults provides a mock function with given fields: ctx
+func (_m *SmartAPI) GetAllTestResults(ctx context.Context) ([]truenas.SmartTestResult, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTestResults")
+	}
+
+	var r0 []truenas.SmartTestResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.SmartTestResult, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.SmartTestResult); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SmartTestResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_GetAllTestResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllTestResults'
+type SmartAPI_GetAllTestResults_Call struct {
+	*mock.Call
+}
+
+// GetAllTestResults is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SmartAPI_Expecter) GetAllTestResults(ctx interface{}) *SmartAPI_GetAllTestResults_Call {
+	return &SmartAPI_GetAllTestResults_Call{Call: _e.mock.On("GetAllTestResults", ctx)}
+}
+
+func (_c *SmartAPI_GetAllTestResults_Call) Run(run func(ctx context.Context)) *SmartAPI_GetAllTestResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_GetAllTestResults_Call) Return(_a0 []truenas.SmartTestResult, _a1 error) *SmartAPI_GetAllTestResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_GetAllTestResults_Call) RunAndReturn(run func(context.Context) ([]truenas.SmartTestResult, error)) *SmartAPI_GetAllTestResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *SmartAPI) GetConfig(ctx context.Context) (*truenas.SmartConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.SmartConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SmartConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SmartConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SmartConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type SmartAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SmartAPI_Expecter) GetConfig(ctx interface{}) *SmartAPI_GetConfig_Call {
+	return &SmartAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *SmartAPI_GetConfig_Call) Run(run func(ctx context.Context)) *SmartAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_GetConfig_Call) Return(_a0 *truenas.SmartConfig, _a1 error) *SmartAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.SmartConfig, error)) *SmartAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDiskAttributes provides a mock function with given fields: ctx, diskName
+func (_m *SmartAPI) GetDiskAttributes(ctx context.Context, diskName string) ([]truenas.SmartAttributes, error) {
+	ret := _m.Called(ctx, diskName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiskAttributes")
+	}
+
+	var r0 []truenas.SmartAttributes
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]truenas.SmartAttributes, error)); ok {
+		return rf(ctx, diskName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []truenas.SmartAttributes); ok {
+		r0 = rf(ctx, diskName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SmartAttributes)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, diskName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_GetDiskAttributes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiskAttributes'
+type SmartAPI_GetDiskAttributes_Call struct {
+	*mock.Call
+}
+
+// GetDiskAttributes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - diskName string
+func (_e *SmartAPI_Expecter) GetDiskAttributes(ctx interface{}, diskName interface{}) *SmartAPI_GetDiskAttributes_Call {
+	return &SmartAPI_GetDiskAttributes_Call{Call: _e.mock.On("GetDiskAttributes", ctx, diskName)}
+}
+
+func (_c *SmartAPI_GetDiskAttributes_Call) Run(run func(ctx context.Context, diskName string)) *SmartAPI_GetDiskAttributes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_GetDiskAttributes_Call) Return(_a0 []truenas.SmartAttributes, _a1 error) *SmartAPI_GetDiskAttributes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_GetDiskAttributes_Call) RunAndReturn(run func(context.Context, string) ([]truenas.SmartAttributes, error)) *SmartAPI_GetDiskAttributes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDiskChoices provides a mock function with given fields: ctx, fullDisk
+func (_m *SmartAPI) GetDiskChoices(ctx context.Context, fullDisk bool) (any, error) {
+	ret := _m.Called(ctx, fullDisk)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiskChoices")
+	}
+
+	var r0 any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) (any, error)); ok {
+		return rf(ctx, fullDisk)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) any); ok {
+		r0 = rf(ctx, fullDisk)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, fullDisk)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_GetDiskChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiskChoices'
+type SmartAPI_GetDiskChoices_Call struct {
+	*mock.Call
+}
+
+// GetDiskChoices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fullDisk bool
+func (_e *SmartAPI_Expecter) GetDiskChoices(ctx interface{}, fullDisk interface{}) *SmartAPI_GetDiskChoices_Call {
+	return &SmartAPI_GetDiskChoices_Call{Call: _e.mock.On("GetDiskChoices", ctx, fullDisk)}
+}
+
+func (_c *SmartAPI_GetDiskChoices_Call) Run(run func(ctx context.Context, fullDisk bool)) *SmartAPI_GetDiskChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_GetDiskChoices_Call) Return(_a0 any, _a1 error) *SmartAPI_GetDiskChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_GetDiskChoices_Call) RunAndReturn(run func(context.Context, bool) (any, error)) *SmartAPI_GetDiskChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDiskSmartOutput provides a mock function with given fields: ctx, diskName
+func (_m *SmartAPI) GetDiskSmartOutput(ctx context.Context, diskName string) (json.RawMessage, error) {
+	ret := _m.Called(ctx, diskName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiskSmartOutput")
+	}
+
+	var r0 json.RawMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (json.RawMessage, error)); ok {
+		return rf(ctx, diskName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) json.RawMessage); ok {
+		r0 = rf(ctx, diskName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(json.RawMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, diskName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_GetDiskSmartOutput_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiskSmartOutput'
+type SmartAPI_GetDiskSmartOutput_Call struct {
+	*mock.Call
+}
+
+// GetDiskSmartOutput is a helper method to define mock.On call
+//   - ctx context.Context
+//   - diskName string
+func (_e *SmartAPI_Expecter) GetDiskSmartOutput(ctx interface{}, diskName interface{}) *SmartAPI_GetDiskSmartOutput_Call {
+	return &SmartAPI_GetDiskSmartOutput_Call{Call: _e.mock.On("GetDiskSmartOutput", ctx, diskName)}
+}
+
+func (_c *SmartAPI_GetDiskSmartOutput_Call) Run(run func(ctx context.Context, diskName string)) *SmartAPI_GetDiskSmartOutput_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_GetDiskSmartOutput_Call) Return(_a0 json.RawMessage, _a1 error) *SmartAPI_GetDiskSmartOutput_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_GetDiskSmartOutput_Call) RunAndReturn(run func(context.Context, string) (json.RawMessage, error)) *SmartAPI_GetDiskSmartOutput_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDiskTestResults provides a mock function with given fields: ctx, diskName
+func (_m *SmartAPI) GetDiskTestResults(ctx context.Context, diskName string) (*truenas.SmartTestResult, error) {
+	ret := _m.Called(ctx, diskName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDiskTestResults")
+	}
+
+	var r0 *truenas.SmartTestResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.SmartTestResult, error)); ok {
+		return rf(ctx, diskName)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.SmartTestResult); ok {
+		r0 = rf(ctx, diskName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SmartTestResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, diskName)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_GetDiskTestResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDiskTestResults'
+type SmartAPI_GetDiskTestResults_Call struct {
+	*mock.Call
+}
+
+// GetDiskTestResults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - diskName string
+func (_e *SmartAPI_Expecter) GetDiskTestResults(ctx interface{}, diskName interface{}) *SmartAPI_GetDiskTestResults_Call {
+	return &SmartAPI_GetDiskTestResults_Call{Call: _e.mock.On("GetDiskTestResults", ctx, diskName)}
+}
+
+func (_c *SmartAPI_GetDiskTestResults_Call) Run(run func(ctx context.Context, diskName string)) *SmartAPI_GetDiskTestResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_GetDiskTestResults_Call) Return(_a0 *truenas.SmartTestResult, _a1 error) *SmartAPI_GetDiskTestResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_GetDiskTestResults_Call) RunAndReturn(run func(context.Context, string) (*truenas.SmartTestResult, error)) *SmartAPI_GetDiskTestResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTest provides a mock function with given fields: ctx, id
+func (_m *SmartAPI) GetTest(ctx context.Context, id int) (*truenas.SmartTest, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTest")
+	}
+
+	var r0 *truenas.SmartTest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.SmartTest, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.SmartTest); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SmartTest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_GetTest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTest'
+type SmartAPI_GetTest_Call struct {
+	*mock.Call
+}
+
+// GetTest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SmartAPI_Expecter) GetTest(ctx interface{}, id interface{}) *SmartAPI_GetTest_Call {
+	return &SmartAPI_GetTest_Call{Call: _e.mock.On("GetTest", ctx, id)}
+}
+
+func (_c *SmartAPI_GetTest_Call) Run(run func(ctx context.Context, id int)) *SmartAPI_GetTest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_GetTest_Call) Return(_a0 *truenas.SmartTest, _a1 error) *SmartAPI_GetTest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_GetTest_Call) RunAndReturn(run func(context.Context, int) (*truenas.SmartTest, error)) *SmartAPI_GetTest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTests provides a mock function with given fields: ctx
+func (_m *SmartAPI) ListTests(ctx context.Context) ([]truenas.SmartTest, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTests")
+	}
+
+	var r0 []truenas.SmartTest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.SmartTest, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.SmartTest); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SmartTest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_ListTests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTests'
+type SmartAPI_ListTests_Call struct {
+	*mock.Call
+}
+
+// ListTests is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SmartAPI_Expecter) ListTests(ctx interface{}) *SmartAPI_ListTests_Call {
+	return &SmartAPI_ListTests_Call{Call: _e.mock.On("ListTests", ctx)}
+}
+
+func (_c *SmartAPI_ListTests_Call) Run(run func(ctx context.Context)) *SmartAPI_ListTests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_ListTests_Call) Return(_a0 []truenas.SmartTest, _a1 error) *SmartAPI_ListTests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_ListTests_Call) RunAndReturn(run func(context.Context) ([]truenas.SmartTest, error)) *SmartAPI_ListTests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTestsWithQuery provides a mock function with given fields: ctx, q
+func (_m *SmartAPI) ListTestsWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.SmartTest, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTestsWithQuery")
+	}
+
+	var r0 []truenas.SmartTest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.SmartTest, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.SmartTest); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SmartTest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_ListTestsWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTestsWithQuery'
+type SmartAPI_ListTestsWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListTestsWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SmartAPI_Expecter) ListTestsWithQuery(ctx interface{}, q interface{}) *SmartAPI_ListTestsWithQuery_Call {
+	return &SmartAPI_ListTestsWithQuery_Call{Call: _e.mock.On("ListTestsWithQuery", ctx, q)}
+}
+
+func (_c *SmartAPI_ListTestsWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SmartAPI_ListTestsWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_ListTestsWithQuery_Call) Return(_a0 []truenas.SmartTest, _a1 error) *SmartAPI_ListTestsWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_ListTestsWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.SmartTest, error)) *SmartAPI_ListTestsWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunManualTest provides a mock function with given fields: ctx, tests
+func (_m *SmartAPI) RunManualTest(ctx context.Context, tests []truenas.SmartManualTestRequest) ([]truenas.SmartManualTestResult, error) {
+	ret := _m.Called(ctx, tests)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunManualTest")
+	}
+
+	var r0 []truenas.SmartManualTestResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []truenas.SmartManualTestRequest) ([]truenas.SmartManualTestResult, error)); ok {
+		return rf(ctx, tests)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []truenas.SmartManualTestRequest) []truenas.SmartManualTestResult); ok {
+		r0 = rf(ctx, tests)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SmartManualTestResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []truenas.SmartManualTestRequest) error); ok {
+		r1 = rf(ctx, tests)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_RunManualTest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunManualTest'
+type SmartAPI_RunManualTest_Call struct {
+	*mock.Call
+}
+
+// RunManualTest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tests []truenas.SmartManualTestRequest
+func (_e *SmartAPI_Expecter) RunManualTest(ctx interface{}, tests interface{}) *SmartAPI_RunManualTest_Call {
+	return &SmartAPI_RunManualTest_Call{Call: _e.mock.On("RunManualTest", ctx, tests)}
+}
+
+func (_c *SmartAPI_RunManualTest_Call) Run(run func(ctx context.Context, tests []truenas.SmartManualTestRequest)) *SmartAPI_RunManualTest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]truenas.SmartManualTestRequest))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_RunManualTest_Call) Return(_a0 []truenas.SmartManualTestResult, _a1 error) *SmartAPI_RunManualTest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_RunManualTest_Call) RunAndReturn(run func(context.Context, []truenas.SmartManualTestRequest) ([]truenas.SmartManualTestResult, error)) *SmartAPI_RunManualTest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Smartctl provides a mock function with given fields: ctx, diskName, args
+func (_m *SmartAPI) Smartctl(ctx context.Context, diskName string, args ...string) (string, error) {
+	_va := make([]interface{}, len(args))
+	for _i := range args {
+		_va[_i] = args[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, diskName)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Smartctl")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) (string, error)); ok {
+		return rf(ctx, diskName, args...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...string) string); ok {
+		r0 = rf(ctx, diskName, args...)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...string) error); ok {
+		r1 = rf(ctx, diskName, args...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_Smartctl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Smartctl'
+type SmartAPI_Smartctl_Call struct {
+	*mock.Call
+}
+
+// Smartctl is a helper method to define mock.On call
+//   - ctx context.Context
+//   - diskName string
+//   - args ...string
+func (_e *SmartAPI_Expecter) Smartctl(ctx interface{}, diskName interface{}, args ...interface{}) *SmartAPI_Smartctl_Call {
+	return &SmartAPI_Smartctl_Call{Call: _e.mock.On("Smartctl",
+		append([]interface{}{ctx, diskName}, args...)...)}
+}
+
+func (_c *SmartAPI_Smartctl_Call) Run(run func(ctx context.Context, diskName string, args ...string)) *SmartAPI_Smartctl_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]string, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(string)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *SmartAPI_Smartctl_Call) Return(_a0 string, _a1 error) *SmartAPI_Smartctl_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_Smartctl_Call) RunAndReturn(run func(context.Context, string, ...string) (string, error)) *SmartAPI_Smartctl_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, config
+func (_m *SmartAPI) UpdateConfig(ctx context.Context, config *truenas.SmartConfig) (*truenas.SmartConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.SmartConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SmartConfig) (*truenas.SmartConfig, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SmartConfig) *truenas.SmartConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SmartConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SmartConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type SmartAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *truenas.SmartConfig
+func (_e *SmartAPI_Expecter) UpdateConfig(ctx interface{}, config interface{}) *SmartAPI_UpdateConfig_Call {
+	return &SmartAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, config)}
+}
+
+func (_c *SmartAPI_UpdateConfig_Call) Run(run func(ctx context.Context, config *truenas.SmartConfig)) *SmartAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SmartConfig))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_UpdateConfig_Call) Return(_a0 *truenas.SmartConfig, _a1 error) *SmartAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.SmartConfig) (*truenas.SmartConfig, error)) *SmartAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTest provides a mock function with given fields: ctx, id, req
+func (_m *SmartAPI) UpdateTest(ctx context.Context, id int, req *truenas.SmartTestCreateRequest) (*truenas.SmartTest, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTest")
+	}
+
+	var r0 *truenas.SmartTest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.SmartTestCreateRequest) (*truenas.SmartTest, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.SmartTestCreateRequest) *truenas.SmartTest); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SmartTest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.SmartTestCreateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_UpdateTest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTest'
+type SmartAPI_UpdateTest_Call struct {
+	*mock.Call
+}
+
+// UpdateTest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.SmartTestCreateRequest
+func (_e *SmartAPI_Expecter) UpdateTest(ctx interface{}, id interface{}, req interface{}) *SmartAPI_UpdateTest_Call {
+	return &SmartAPI_UpdateTest_Call{Call: _e.mock.On("UpdateTest", ctx, id, req)}
+}
+
+func (_c *SmartAPI_UpdateTest_Call) Run(run func(ctx context.Context, id int, req *truenas.SmartTestCreateRequest)) *SmartAPI_UpdateTest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.SmartTestCreateRequest))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_UpdateTest_Call) Return(_a0 *truenas.SmartTest, _a1 error) *SmartAPI_UpdateTest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_UpdateTest_Call) RunAndReturn(run func(context.Context, int, *truenas.SmartTestCreateRequest) (*truenas.SmartTest, error)) *SmartAPI_UpdateTest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WaitForTests provides a mock function with given fields: ctx, disks
+func (_m *SmartAPI) WaitForTests(ctx context.Context, disks []string) ([]truenas.SmartTestResult, error) {
+	ret := _m.Called(ctx, disks)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WaitForTests")
+	}
+
+	var r0 []truenas.SmartTestResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]truenas.SmartTestResult, error)); ok {
+		return rf(ctx, disks)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []truenas.SmartTestResult); ok {
+		r0 = rf(ctx, disks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SmartTestResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, disks)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SmartAPI_WaitForTests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WaitForTests'
+type SmartAPI_WaitForTests_Call struct {
+	*mock.Call
+}
+
+// WaitForTests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - disks []string
+func (_e *SmartAPI_Expecter) WaitForTests(ctx interface{}, disks interface{}) *SmartAPI_WaitForTests_Call {
+	return &SmartAPI_WaitForTests_Call{Call: _e.mock.On("WaitForTests", ctx, disks)}
+}
+
+func (_c *SmartAPI_WaitForTests_Call) Run(run func(ctx context.Context, disks []string)) *SmartAPI_WaitForTests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *SmartAPI_WaitForTests_Call) Return(_a0 []truenas.SmartTestResult, _a1 error) *SmartAPI_WaitForTests_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SmartAPI_WaitForTests_Call) RunAndReturn(run func(context.Context, []string) ([]truenas.SmartTestResult, error)) *SmartAPI_WaitForTests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSmartAPI creates a new instance of SmartAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSmartAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SmartAPI {
+	mock := &SmartAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,270 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SMBAPI is an autogenerated mock type for the SMBAPI type
+type SMBAPI struct {
+	mock.Mock
+}
+
+type SMBAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SMBAPI) EXPECT() *SMBAPI_Expecter {
+	return &SMBAPI_Expecter{mock: &_m.Mock}
+}
+
+// BindIPChoices provides a mock function with given fields: ctx
+func (_m *SMBAPI) BindIPChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BindIPChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SMBAPI_BindIPChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BindIPChoices'
+type SMBAPI_BindIPChoices_Call struct {
+	*mock.Call
+}
+
+// BindIPChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SMBAPI_Expecter) BindIPChoices(ctx interface{}) *SMBAPI_BindIPChoices_Call {
+	return &SMBAPI_BindIPChoices_Call{Call: _e.mock.On("BindIPChoices", ctx)}
+}
+
+func (_c *SMBAPI_BindIPChoices_Call) Run(run func(ctx context.Context)) *SMBAPI_BindIPChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SMBAPI_BindIPChoices_Call) Return(_a0 map[string]string, _a1 error) *SMBAPI_BindIPChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SMBAPI_BindIPChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *SMBAPI_BindIPChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *SMBAPI) GetConfig(ctx context.Context) (*truenas.SMBConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.SMBConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SMBConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SMBConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SMBConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SMBAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type SMBAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SMBAPI_Expecter) GetConfig(ctx interface{}) *SMBAPI_GetConfig_Call {
+	return &SMBAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *SMBAPI_GetConfig_Call) Run(run func(ctx context.Context)) *SMBAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SMBAPI_GetConfig_Call) Return(_a0 *truenas.SMBConfig, _a1 error) *SMBAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SMBAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.SMBConfig, error)) *SMBAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnixCharsetChoices provides a mock function with given fields: ctx
+func (_m *SMBAPI) UnixCharsetChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnixCharsetChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SMBAPI_UnixCharsetChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnixCharsetChoices'
+type SMBAPI_UnixCharsetChoices_Call struct {
+	*mock.Call
+}
+
+// UnixCharsetChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SMBAPI_Expecter) UnixCharsetChoices(ctx interface{}) *SMBAPI_UnixCharsetChoices_Call {
+	return &SMBAPI_UnixCharsetChoices_Call{Call: _e.mock.On("UnixCharsetChoices", ctx)}
+}
+
+func (_c *SMBAPI_UnixCharsetChoices_Call) Run(run func(ctx context.Context)) *SMBAPI_UnixCharsetChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SMBAPI_UnixCharsetChoices_Call) Return(_a0 map[string]string, _a1 error) *SMBAPI_UnixCharsetChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SMBAPI_UnixCharsetChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *SMBAPI_UnixCharsetChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, config
+func (_m *SMBAPI) UpdateConfig(ctx context.Context, config *truenas.SMBConfig) (*truenas.SMBConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.SMBConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SMBConfig) (*truenas.SMBConfig, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SMBConfig) *truenas.SMBConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SMBConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SMBConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SMBAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type SMBAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *truenas.SMBConfig
+func (_e *SMBAPI_Expecter) UpdateConfig(ctx interface{}, config interface{}) *SMBAPI_UpdateConfig_Call {
+	return &SMBAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, config)}
+}
+
+func (_c *SMBAPI_UpdateConfig_Call) Run(run func(ctx context.Context, config *truenas.SMBConfig)) *SMBAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SMBConfig))
+	})
+	return _c
+}
+
+func (_c *SMBAPI_UpdateConfig_Call) Return(_a0 *truenas.SMBConfig, _a1 error) *SMBAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SMBAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.SMBConfig) (*truenas.SMBConfig, error)) *SMBAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSMBAPI creates a new instance of SMBAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSMBAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SMBAPI {
+	mock := &SMBAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
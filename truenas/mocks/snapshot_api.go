@@ -0,0 +1,621 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// SnapshotAPI is an autogenerated mock type for the SnapshotAPI type
+type SnapshotAPI struct {
+	mock.Mock
+}
+
+type SnapshotAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SnapshotAPI) EXPECT() *SnapshotAPI_Expecter {
+	return &SnapshotAPI_Expecter{mock: &_m.Mock}
+}
+
+// Clone provides a mock function with given fields: ctx, req
+func (_m *SnapshotAPI) Clone(ctx context.Context, req *truenas.SnapshotCloneRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Clone")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SnapshotCloneRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapshotAPI_Clone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clone'
+type SnapshotAPI_Clone_Call struct {
+	*mock.Call
+}
+
+// Clone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SnapshotCloneRequest
+func (_e *SnapshotAPI_Expecter) Clone(ctx interface{}, req interface{}) *SnapshotAPI_Clone_Call {
+	return &SnapshotAPI_Clone_Call{Call: _e.mock.On("Clone", ctx, req)}
+}
+
+func (_c *SnapshotAPI_Clone_Call) Run(run func(ctx context.Context, req *truenas.SnapshotCloneRequest)) *SnapshotAPI_Clone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SnapshotCloneRequest))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_Clone_Call) Return(_a0 error) *SnapshotAPI_Clone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotAPI_Clone_Call) RunAndReturn(run func(context.Context, *truenas.SnapshotCloneRequest) error) *SnapshotAPI_Clone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *SnapshotAPI) Create(ctx context.Context, req *truenas.SnapshotCreateRequest) (*truenas.Snapshot, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.Snapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SnapshotCreateRequest) (*truenas.Snapshot, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SnapshotCreateRequest) *truenas.Snapshot); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SnapshotCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SnapshotAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SnapshotCreateRequest
+func (_e *SnapshotAPI_Expecter) Create(ctx interface{}, req interface{}) *SnapshotAPI_Create_Call {
+	return &SnapshotAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *SnapshotAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.SnapshotCreateRequest)) *SnapshotAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SnapshotCreateRequest))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_Create_Call) Return(_a0 *truenas.Snapshot, _a1 error) *SnapshotAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.SnapshotCreateRequest) (*truenas.Snapshot, error)) *SnapshotAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, options
+func (_m *SnapshotAPI) Delete(ctx context.Context, id string, options *truenas.SnapshotDeleteOptions) error {
+	ret := _m.Called(ctx, id, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.SnapshotDeleteOptions) error); ok {
+		r0 = rf(ctx, id, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapshotAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SnapshotAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - options *truenas.SnapshotDeleteOptions
+func (_e *SnapshotAPI_Expecter) Delete(ctx interface{}, id interface{}, options interface{}) *SnapshotAPI_Delete_Call {
+	return &SnapshotAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, options)}
+}
+
+func (_c *SnapshotAPI_Delete_Call) Run(run func(ctx context.Context, id string, options *truenas.SnapshotDeleteOptions)) *SnapshotAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.SnapshotDeleteOptions))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_Delete_Call) Return(_a0 error) *SnapshotAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotAPI_Delete_Call) RunAndReturn(run func(context.Context, string, *truenas.SnapshotDeleteOptions) error) *SnapshotAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *SnapshotAPI) Get(ctx context.Context, id string) (*truenas.Snapshot, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.Snapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.Snapshot, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.Snapshot); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type SnapshotAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SnapshotAPI_Expecter) Get(ctx interface{}, id interface{}) *SnapshotAPI_Get_Call {
+	return &SnapshotAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *SnapshotAPI_Get_Call) Run(run func(ctx context.Context, id string)) *SnapshotAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_Get_Call) Return(_a0 *truenas.Snapshot, _a1 error) *SnapshotAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotAPI_Get_Call) RunAndReturn(run func(context.Context, string) (*truenas.Snapshot, error)) *SnapshotAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hold provides a mock function with given fields: ctx, id, recursive
+func (_m *SnapshotAPI) Hold(ctx context.Context, id string, recursive bool) error {
+	ret := _m.Called(ctx, id, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hold")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, id, recursive)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapshotAPI_Hold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hold'
+type SnapshotAPI_Hold_Call struct {
+	*mock.Call
+}
+
+// Hold is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - recursive bool
+func (_e *SnapshotAPI_Expecter) Hold(ctx interface{}, id interface{}, recursive interface{}) *SnapshotAPI_Hold_Call {
+	return &SnapshotAPI_Hold_Call{Call: _e.mock.On("Hold", ctx, id, recursive)}
+}
+
+func (_c *SnapshotAPI_Hold_Call) Run(run func(ctx context.Context, id string, recursive bool)) *SnapshotAPI_Hold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_Hold_Call) Return(_a0 error) *SnapshotAPI_Hold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotAPI_Hold_Call) RunAndReturn(run func(context.Context, string, bool) error) *SnapshotAPI_Hold_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *SnapshotAPI) List(ctx context.Context) ([]truenas.Snapshot, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.Snapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.Snapshot, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.Snapshot); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type SnapshotAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SnapshotAPI_Expecter) List(ctx interface{}) *SnapshotAPI_List_Call {
+	return &SnapshotAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *SnapshotAPI_List_Call) Run(run func(ctx context.Context)) *SnapshotAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_List_Call) Return(_a0 []truenas.Snapshot, _a1 error) *SnapshotAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.Snapshot, error)) *SnapshotAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByDataset provides a mock function with given fields: ctx, dataset
+func (_m *SnapshotAPI) ListByDataset(ctx context.Context, dataset string) ([]truenas.Snapshot, error) {
+	ret := _m.Called(ctx, dataset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByDataset")
+	}
+
+	var r0 []truenas.Snapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]truenas.Snapshot, error)); ok {
+		return rf(ctx, dataset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []truenas.Snapshot); ok {
+		r0 = rf(ctx, dataset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, dataset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotAPI_ListByDataset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByDataset'
+type SnapshotAPI_ListByDataset_Call struct {
+	*mock.Call
+}
+
+// ListByDataset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dataset string
+func (_e *SnapshotAPI_Expecter) ListByDataset(ctx interface{}, dataset interface{}) *SnapshotAPI_ListByDataset_Call {
+	return &SnapshotAPI_ListByDataset_Call{Call: _e.mock.On("ListByDataset", ctx, dataset)}
+}
+
+func (_c *SnapshotAPI_ListByDataset_Call) Run(run func(ctx context.Context, dataset string)) *SnapshotAPI_ListByDataset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_ListByDataset_Call) Return(_a0 []truenas.Snapshot, _a1 error) *SnapshotAPI_ListByDataset_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotAPI_ListByDataset_Call) RunAndReturn(run func(context.Context, string) ([]truenas.Snapshot, error)) *SnapshotAPI_ListByDataset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *SnapshotAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.Snapshot, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.Snapshot, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.Snapshot, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.Snapshot, error])
+		}
+	}
+
+	return r0
+}
+
+// SnapshotAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type SnapshotAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SnapshotAPI_Expecter) ListIter(ctx interface{}, q interface{}) *SnapshotAPI_ListIter_Call {
+	return &SnapshotAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *SnapshotAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SnapshotAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.Snapshot, error]) *SnapshotAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.Snapshot, error]) *SnapshotAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *SnapshotAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.Snapshot, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.Snapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.Snapshot, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.Snapshot); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.Snapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type SnapshotAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SnapshotAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *SnapshotAPI_ListWithQuery_Call {
+	return &SnapshotAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *SnapshotAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SnapshotAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_ListWithQuery_Call) Return(_a0 []truenas.Snapshot, _a1 error) *SnapshotAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.Snapshot, error)) *SnapshotAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Release provides a mock function with given fields: ctx, id, recursive
+func (_m *SnapshotAPI) Release(ctx context.Context, id string, recursive bool) error {
+	ret := _m.Called(ctx, id, recursive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, id, recursive)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapshotAPI_Release_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Release'
+type SnapshotAPI_Release_Call struct {
+	*mock.Call
+}
+
+// Release is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - recursive bool
+func (_e *SnapshotAPI_Expecter) Release(ctx interface{}, id interface{}, recursive interface{}) *SnapshotAPI_Release_Call {
+	return &SnapshotAPI_Release_Call{Call: _e.mock.On("Release", ctx, id, recursive)}
+}
+
+func (_c *SnapshotAPI_Release_Call) Run(run func(ctx context.Context, id string, recursive bool)) *SnapshotAPI_Release_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_Release_Call) Return(_a0 error) *SnapshotAPI_Release_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotAPI_Release_Call) RunAndReturn(run func(context.Context, string, bool) error) *SnapshotAPI_Release_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rollback provides a mock function with given fields: ctx, id, options
+func (_m *SnapshotAPI) Rollback(ctx context.Context, id string, options *truenas.SnapshotRollbackOptions) error {
+	ret := _m.Called(ctx, id, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rollback")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *truenas.SnapshotRollbackOptions) error); ok {
+		r0 = rf(ctx, id, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapshotAPI_Rollback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rollback'
+type SnapshotAPI_Rollback_Call struct {
+	*mock.Call
+}
+
+// Rollback is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - options *truenas.SnapshotRollbackOptions
+func (_e *SnapshotAPI_Expecter) Rollback(ctx interface{}, id interface{}, options interface{}) *SnapshotAPI_Rollback_Call {
+	return &SnapshotAPI_Rollback_Call{Call: _e.mock.On("Rollback", ctx, id, options)}
+}
+
+func (_c *SnapshotAPI_Rollback_Call) Run(run func(ctx context.Context, id string, options *truenas.SnapshotRollbackOptions)) *SnapshotAPI_Rollback_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*truenas.SnapshotRollbackOptions))
+	})
+	return _c
+}
+
+func (_c *SnapshotAPI_Rollback_Call) Return(_a0 error) *SnapshotAPI_Rollback_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotAPI_Rollback_Call) RunAndReturn(run func(context.Context, string, *truenas.SnapshotRollbackOptions) error) *SnapshotAPI_Rollback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSnapshotAPI creates a new instance of SnapshotAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSnapshotAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SnapshotAPI {
+	mock := &SnapshotAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,477 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// SnapshotTaskAPI is an autogenerated mock type for the SnapshotTaskAPI type
+type SnapshotTaskAPI struct {
+	mock.Mock
+}
+
+type SnapshotTaskAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SnapshotTaskAPI) EXPECT() *SnapshotTaskAPI_Expecter {
+	return &SnapshotTaskAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *SnapshotTaskAPI) Create(ctx context.Context, req *truenas.SnapshotTaskRequest) (*truenas.SnapshotTask, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.SnapshotTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SnapshotTaskRequest) (*truenas.SnapshotTask, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SnapshotTaskRequest) *truenas.SnapshotTask); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SnapshotTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SnapshotTaskRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotTaskAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SnapshotTaskAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SnapshotTaskRequest
+func (_e *SnapshotTaskAPI_Expecter) Create(ctx interface{}, req interface{}) *SnapshotTaskAPI_Create_Call {
+	return &SnapshotTaskAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *SnapshotTaskAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.SnapshotTaskRequest)) *SnapshotTaskAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SnapshotTaskRequest))
+	})
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Create_Call) Return(_a0 *truenas.SnapshotTask, _a1 error) *SnapshotTaskAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.SnapshotTaskRequest) (*truenas.SnapshotTask, error)) *SnapshotTaskAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id
+func (_m *SnapshotTaskAPI) Delete(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapshotTaskAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SnapshotTaskAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SnapshotTaskAPI_Expecter) Delete(ctx interface{}, id interface{}) *SnapshotTaskAPI_Delete_Call {
+	return &SnapshotTaskAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+}
+
+func (_c *SnapshotTaskAPI_Delete_Call) Run(run func(ctx context.Context, id int)) *SnapshotTaskAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Delete_Call) Return(_a0 error) *SnapshotTaskAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Delete_Call) RunAndReturn(run func(context.Context, int) error) *SnapshotTaskAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *SnapshotTaskAPI) Get(ctx context.Context, id int) (*truenas.SnapshotTask, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.SnapshotTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.SnapshotTask, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.SnapshotTask); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SnapshotTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotTaskAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type SnapshotTaskAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SnapshotTaskAPI_Expecter) Get(ctx interface{}, id interface{}) *SnapshotTaskAPI_Get_Call {
+	return &SnapshotTaskAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *SnapshotTaskAPI_Get_Call) Run(run func(ctx context.Context, id int)) *SnapshotTaskAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Get_Call) Return(_a0 *truenas.SnapshotTask, _a1 error) *SnapshotTaskAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.SnapshotTask, error)) *SnapshotTaskAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *SnapshotTaskAPI) List(ctx context.Context) ([]truenas.SnapshotTask, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.SnapshotTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.SnapshotTask, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.SnapshotTask); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SnapshotTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotTaskAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type SnapshotTaskAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SnapshotTaskAPI_Expecter) List(ctx interface{}) *SnapshotTaskAPI_List_Call {
+	return &SnapshotTaskAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *SnapshotTaskAPI_List_Call) Run(run func(ctx context.Context)) *SnapshotTaskAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_List_Call) Return(_a0 []truenas.SnapshotTask, _a1 error) *SnapshotTaskAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.SnapshotTask, error)) *SnapshotTaskAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *SnapshotTaskAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.SnapshotTask, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.SnapshotTask, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.SnapshotTask, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.SnapshotTask, error])
+		}
+	}
+
+	return r0
+}
+
+// SnapshotTaskAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type SnapshotTaskAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SnapshotTaskAPI_Expecter) ListIter(ctx interface{}, q interface{}) *SnapshotTaskAPI_ListIter_Call {
+	return &SnapshotTaskAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *SnapshotTaskAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SnapshotTaskAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.SnapshotTask, error]) *SnapshotTaskAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.SnapshotTask, error]) *SnapshotTaskAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *SnapshotTaskAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.SnapshotTask, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.SnapshotTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.SnapshotTask, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.SnapshotTask); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.SnapshotTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotTaskAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type SnapshotTaskAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SnapshotTaskAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *SnapshotTaskAPI_ListWithQuery_Call {
+	return &SnapshotTaskAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *SnapshotTaskAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SnapshotTaskAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_ListWithQuery_Call) Return(_a0 []truenas.SnapshotTask, _a1 error) *SnapshotTaskAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.SnapshotTask, error)) *SnapshotTaskAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Run provides a mock function with given fields: ctx, id
+func (_m *SnapshotTaskAPI) Run(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapshotTaskAPI_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type SnapshotTaskAPI_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *SnapshotTaskAPI_Expecter) Run(ctx interface{}, id interface{}) *SnapshotTaskAPI_Run_Call {
+	return &SnapshotTaskAPI_Run_Call{Call: _e.mock.On("Run", ctx, id)}
+}
+
+func (_c *SnapshotTaskAPI_Run_Call) Run(run func(ctx context.Context, id int)) *SnapshotTaskAPI_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Run_Call) Return(_a0 error) *SnapshotTaskAPI_Run_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Run_Call) RunAndReturn(run func(context.Context, int) error) *SnapshotTaskAPI_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *SnapshotTaskAPI) Update(ctx context.Context, id int, req *truenas.SnapshotTaskRequest) (*truenas.SnapshotTask, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.SnapshotTask
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.SnapshotTaskRequest) (*truenas.SnapshotTask, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.SnapshotTaskRequest) *truenas.SnapshotTask); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SnapshotTask)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.SnapshotTaskRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SnapshotTaskAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type SnapshotTaskAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.SnapshotTaskRequest
+func (_e *SnapshotTaskAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *SnapshotTaskAPI_Update_Call {
+	return &SnapshotTaskAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *SnapshotTaskAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.SnapshotTaskRequest)) *SnapshotTaskAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.SnapshotTaskRequest))
+	})
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Update_Call) Return(_a0 *truenas.SnapshotTask, _a1 error) *SnapshotTaskAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SnapshotTaskAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.SnapshotTaskRequest) (*truenas.SnapshotTask, error)) *SnapshotTaskAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSnapshotTaskAPI creates a new instance of SnapshotTaskAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSnapshotTaskAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SnapshotTaskAPI {
+	mock := &SnapshotTaskAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SNMPAPI is an autogenerated mock type for the SNMPAPI type
+type SNMPAPI struct {
+	mock.Mock
+}
+
+type SNMPAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SNMPAPI) EXPECT() *SNMPAPI_Expecter {
+	return &SNMPAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *SNMPAPI) GetConfig(ctx context.Context) (*truenas.SNMPConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.SNMPConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SNMPConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SNMPConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SNMPConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SNMPAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type SNMPAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SNMPAPI_Expecter) GetConfig(ctx interface{}) *SNMPAPI_GetConfig_Call {
+	return &SNMPAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *SNMPAPI_GetConfig_Call) Run(run func(ctx context.Context)) *SNMPAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SNMPAPI_GetConfig_Call) Return(_a0 *truenas.SNMPConfig, _a1 error) *SNMPAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SNMPAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.SNMPConfig, error)) *SNMPAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, config
+func (_m *SNMPAPI) UpdateConfig(ctx context.Context, config *truenas.SNMPConfig) (*truenas.SNMPConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.SNMPConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SNMPConfig) (*truenas.SNMPConfig, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SNMPConfig) *truenas.SNMPConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SNMPConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SNMPConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SNMPAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type SNMPAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *truenas.SNMPConfig
+func (_e *SNMPAPI_Expecter) UpdateConfig(ctx interface{}, config interface{}) *SNMPAPI_UpdateConfig_Call {
+	return &SNMPAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, config)}
+}
+
+func (_c *SNMPAPI_UpdateConfig_Call) Run(run func(ctx context.Context, config *truenas.SNMPConfig)) *SNMPAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SNMPConfig))
+	})
+	return _c
+}
+
+func (_c *SNMPAPI_UpdateConfig_Call) Return(_a0 *truenas.SNMPConfig, _a1 error) *SNMPAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SNMPAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.SNMPConfig) (*truenas.SNMPConfig, error)) *SNMPAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSNMPAPI creates a new instance of SNMPAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSNMPAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SNMPAPI {
+	mock := &SNMPAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
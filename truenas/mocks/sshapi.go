@@ -0,0 +1,154 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SSHAPI is an autogenerated mock type for the SSHAPI type
+type SSHAPI struct {
+	mock.Mock
+}
+
+type SSHAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SSHAPI) EXPECT() *SSHAPI_Expecter {
+	return &SSHAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *SSHAPI) GetConfig(ctx context.Context) (*truenas.SSHConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.SSHConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SSHConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SSHConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SSHConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SSHAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type SSHAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SSHAPI_Expecter) GetConfig(ctx interface{}) *SSHAPI_GetConfig_Call {
+	return &SSHAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *SSHAPI_GetConfig_Call) Run(run func(ctx context.Context)) *SSHAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SSHAPI_GetConfig_Call) Return(_a0 *truenas.SSHConfig, _a1 error) *SSHAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SSHAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.SSHConfig, error)) *SSHAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, config
+func (_m *SSHAPI) UpdateConfig(ctx context.Context, config *truenas.SSHConfig) (*truenas.SSHConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.SSHConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SSHConfig) (*truenas.SSHConfig, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SSHConfig) *truenas.SSHConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SSHConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SSHConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SSHAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type SSHAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *truenas.SSHConfig
+func (_e *SSHAPI_Expecter) UpdateConfig(ctx interface{}, config interface{}) *SSHAPI_UpdateConfig_Call {
+	return &SSHAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, config)}
+}
+
+func (_c *SSHAPI_UpdateConfig_Call) Run(run func(ctx context.Context, config *truenas.SSHConfig)) *SSHAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SSHConfig))
+	})
+	return _c
+}
+
+func (_c *SSHAPI_UpdateConfig_Call) Return(_a0 *truenas.SSHConfig, _a1 error) *SSHAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SSHAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.SSHConfig) (*truenas.SSHConfig, error)) *SSHAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSSHAPI creates a new instance of SSHAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSSHAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SSHAPI {
+	mock := &SSHAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StorageAPI is an autogenerated mock type for the StorageAPI type
+type StorageAPI struct {
+	mock.Mock
+}
+
+type StorageAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StorageAPI) EXPECT() *StorageAPI_Expecter {
+	return &StorageAPI_Expecter{mock: &_m.Mock}
+}
+
+// Usage provides a mock function with given fields: ctx
+func (_m *StorageAPI) Usage(ctx context.Context) (*truenas.StorageUsage, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Usage")
+	}
+
+	var r0 *truenas.StorageUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.StorageUsage, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.StorageUsage); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.StorageUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StorageAPI_Usage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Usage'
+type StorageAPI_Usage_Call struct {
+	*mock.Call
+}
+
+// Usage is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *StorageAPI_Expecter) Usage(ctx interface{}) *StorageAPI_Usage_Call {
+	return &StorageAPI_Usage_Call{Call: _e.mock.On("Usage", ctx)}
+}
+
+func (_c *StorageAPI_Usage_Call) Run(run func(ctx context.Context)) *StorageAPI_Usage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *StorageAPI_Usage_Call) Return(_a0 *truenas.StorageUsage, _a1 error) *StorageAPI_Usage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StorageAPI_Usage_Call) RunAndReturn(run func(context.Context) (*truenas.StorageUsage, error)) *StorageAPI_Usage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStorageAPI creates a new instance of StorageAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStorageAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StorageAPI {
+	mock := &StorageAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,1803 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// SystemAPI is an autogenerated mock type for the SystemAPI type
+type SystemAPI struct {
+	mock.Mock
+}
+
+type SystemAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SystemAPI) EXPECT() *SystemAPI_Expecter {
+	return &SystemAPI_Expecter{mock: &_m.Mock}
+}
+
+// ActivateBootEnv provides a mock function with given fields: ctx, id
+func (_m *SystemAPI) ActivateBootEnv(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ActivateBootEnv")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_ActivateBootEnv_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ActivateBootEnv'
+type SystemAPI_ActivateBootEnv_Call struct {
+	*mock.Call
+}
+
+// ActivateBootEnv is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SystemAPI_Expecter) ActivateBootEnv(ctx interface{}, id interface{}) *SystemAPI_ActivateBootEnv_Call {
+	return &SystemAPI_ActivateBootEnv_Call{Call: _e.mock.On("ActivateBootEnv", ctx, id)}
+}
+
+func (_c *SystemAPI_ActivateBootEnv_Call) Run(run func(ctx context.Context, id string)) *SystemAPI_ActivateBootEnv_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_ActivateBootEnv_Call) Return(_a0 error) *SystemAPI_ActivateBootEnv_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_ActivateBootEnv_Call) RunAndReturn(run func(context.Context, string) error) *SystemAPI_ActivateBootEnv_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfigReset provides a mock function with given fields: ctx, reboot
+func (_m *SystemAPI) ConfigReset(ctx context.Context, reboot bool) error {
+	ret := _m.Called(ctx, reboot)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigReset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) error); ok {
+		r0 = rf(ctx, reboot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_ConfigReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigReset'
+type SystemAPI_ConfigReset_Call struct {
+	*mock.Call
+}
+
+// ConfigReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reboot bool
+func (_e *SystemAPI_Expecter) ConfigReset(ctx interface{}, reboot interface{}) *SystemAPI_ConfigReset_Call {
+	return &SystemAPI_ConfigReset_Call{Call: _e.mock.On("ConfigReset", ctx, reboot)}
+}
+
+func (_c *SystemAPI_ConfigReset_Call) Run(run func(ctx context.Context, reboot bool)) *SystemAPI_ConfigReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_ConfigReset_Call) Return(_a0 error) *SystemAPI_ConfigReset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_ConfigReset_Call) RunAndReturn(run func(context.Context, bool) error) *SystemAPI_ConfigReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfigSave provides a mock function with given fields: ctx, w, options
+func (_m *SystemAPI) ConfigSave(ctx context.Context, w io.Writer, options *truenas.ConfigSaveOptions) error {
+	ret := _m.Called(ctx, w, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigSave")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Writer, *truenas.ConfigSaveOptions) error); ok {
+		r0 = rf(ctx, w, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_ConfigSave_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigSave'
+type SystemAPI_ConfigSave_Call struct {
+	*mock.Call
+}
+
+// ConfigSave is a helper method to define mock.On call
+//   - ctx context.Context
+//   - w io.Writer
+//   - options *truenas.ConfigSaveOptions
+func (_e *SystemAPI_Expecter) ConfigSave(ctx interface{}, w interface{}, options interface{}) *SystemAPI_ConfigSave_Call {
+	return &SystemAPI_ConfigSave_Call{Call: _e.mock.On("ConfigSave", ctx, w, options)}
+}
+
+func (_c *SystemAPI_ConfigSave_Call) Run(run func(ctx context.Context, w io.Writer, options *truenas.ConfigSaveOptions)) *SystemAPI_ConfigSave_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Writer), args[2].(*truenas.ConfigSaveOptions))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_ConfigSave_Call) Return(_a0 error) *SystemAPI_ConfigSave_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_ConfigSave_Call) RunAndReturn(run func(context.Context, io.Writer, *truenas.ConfigSaveOptions) error) *SystemAPI_ConfigSave_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfigUpload provides a mock function with given fields: ctx, r
+func (_m *SystemAPI) ConfigUpload(ctx context.Context, r io.Reader) error {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfigUpload")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, io.Reader) error); ok {
+		r0 = rf(ctx, r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_ConfigUpload_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigUpload'
+type SystemAPI_ConfigUpload_Call struct {
+	*mock.Call
+}
+
+// ConfigUpload is a helper method to define mock.On call
+//   - ctx context.Context
+//   - r io.Reader
+func (_e *SystemAPI_Expecter) ConfigUpload(ctx interface{}, r interface{}) *SystemAPI_ConfigUpload_Call {
+	return &SystemAPI_ConfigUpload_Call{Call: _e.mock.On("ConfigUpload", ctx, r)}
+}
+
+func (_c *SystemAPI_ConfigUpload_Call) Run(run func(ctx context.Context, r io.Reader)) *SystemAPI_ConfigUpload_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_ConfigUpload_Call) Return(_a0 error) *SystemAPI_ConfigUpload_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_ConfigUpload_Call) RunAndReturn(run func(context.Context, io.Reader) error) *SystemAPI_ConfigUpload_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateBootEnv provides a mock function with given fields: ctx, name, source
+func (_m *SystemAPI) CreateBootEnv(ctx context.Context, name string, source string) (*truenas.BootEnv, error) {
+	ret := _m.Called(ctx, name, source)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBootEnv")
+	}
+
+	var r0 *truenas.BootEnv
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*truenas.BootEnv, error)); ok {
+		return rf(ctx, name, source)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *truenas.BootEnv); ok {
+		r0 = rf(ctx, name, source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.BootEnv)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, name, source)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_CreateBootEnv_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBootEnv'
+type SystemAPI_CreateBootEnv_Call struct {
+	*mock.Call
+}
+
+// CreateBootEnv is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - source string
+func (_e *SystemAPI_Expecter) CreateBootEnv(ctx interface{}, name interface{}, source interface{}) *SystemAPI_CreateBootEnv_Call {
+	return &SystemAPI_CreateBootEnv_Call{Call: _e.mock.On("CreateBootEnv", ctx, name, source)}
+}
+
+func (_c *SystemAPI_CreateBootEnv_Call) Run(run func(ctx context.Context, name string, source string)) *SystemAPI_CreateBootEnv_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_CreateBootEnv_Call) Return(_a0 *truenas.BootEnv, _a1 error) *SystemAPI_CreateBootEnv_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_CreateBootEnv_Call) RunAndReturn(run func(context.Context, string, string) (*truenas.BootEnv, error)) *SystemAPI_CreateBootEnv_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteBootEnv provides a mock function with given fields: ctx, id
+func (_m *SystemAPI) DeleteBootEnv(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBootEnv")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_DeleteBootEnv_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBootEnv'
+type SystemAPI_DeleteBootEnv_Call struct {
+	*mock.Call
+}
+
+// DeleteBootEnv is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *SystemAPI_Expecter) DeleteBootEnv(ctx interface{}, id interface{}) *SystemAPI_DeleteBootEnv_Call {
+	return &SystemAPI_DeleteBootEnv_Call{Call: _e.mock.On("DeleteBootEnv", ctx, id)}
+}
+
+func (_c *SystemAPI_DeleteBootEnv_Call) Run(run func(ctx context.Context, id string)) *SystemAPI_DeleteBootEnv_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_DeleteBootEnv_Call) Return(_a0 error) *SystemAPI_DeleteBootEnv_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_DeleteBootEnv_Call) RunAndReturn(run func(context.Context, string) error) *SystemAPI_DeleteBootEnv_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FeatureEnabled provides a mock function with given fields: ctx, feature
+func (_m *SystemAPI) FeatureEnabled(ctx context.Context, feature truenas.SystemFeature) (bool, error) {
+	ret := _m.Called(ctx, feature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FeatureEnabled")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SystemFeature) (bool, error)); ok {
+		return rf(ctx, feature)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SystemFeature) bool); ok {
+		r0 = rf(ctx, feature)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.SystemFeature) error); ok {
+		r1 = rf(ctx, feature)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_FeatureEnabled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FeatureEnabled'
+type SystemAPI_FeatureEnabled_Call struct {
+	*mock.Call
+}
+
+// FeatureEnabled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - feature truenas.SystemFeature
+func (_e *SystemAPI_Expecter) FeatureEnabled(ctx interface{}, feature interface{}) *SystemAPI_FeatureEnabled_Call {
+	return &SystemAPI_FeatureEnabled_Call{Call: _e.mock.On("FeatureEnabled", ctx, feature)}
+}
+
+func (_c *SystemAPI_FeatureEnabled_Call) Run(run func(ctx context.Context, feature truenas.SystemFeature)) *SystemAPI_FeatureEnabled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.SystemFeature))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_FeatureEnabled_Call) Return(_a0 bool, _a1 error) *SystemAPI_FeatureEnabled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_FeatureEnabled_Call) RunAndReturn(run func(context.Context, truenas.SystemFeature) (bool, error)) *SystemAPI_FeatureEnabled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAdvancedConfig provides a mock function with given fields: ctx
+func (_m *SystemAPI) GetAdvancedConfig(ctx context.Context) (*truenas.SystemAdvancedConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdvancedConfig")
+	}
+
+	var r0 *truenas.SystemAdvancedConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SystemAdvancedConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SystemAdvancedConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemAdvancedConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_GetAdvancedConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdvancedConfig'
+type SystemAPI_GetAdvancedConfig_Call struct {
+	*mock.Call
+}
+
+// GetAdvancedConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) GetAdvancedConfig(ctx interface{}) *SystemAPI_GetAdvancedConfig_Call {
+	return &SystemAPI_GetAdvancedConfig_Call{Call: _e.mock.On("GetAdvancedConfig", ctx)}
+}
+
+func (_c *SystemAPI_GetAdvancedConfig_Call) Run(run func(ctx context.Context)) *SystemAPI_GetAdvancedConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_GetAdvancedConfig_Call) Return(_a0 *truenas.SystemAdvancedConfig, _a1 error) *SystemAPI_GetAdvancedConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_GetAdvancedConfig_Call) RunAndReturn(run func(context.Context) (*truenas.SystemAdvancedConfig, error)) *SystemAPI_GetAdvancedConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetGeneralConfig provides a mock function with given fields: ctx
+func (_m *SystemAPI) GetGeneralConfig(ctx context.Context) (*truenas.SystemGeneralConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetGeneralConfig")
+	}
+
+	var r0 *truenas.SystemGeneralConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SystemGeneralConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SystemGeneralConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemGeneralConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_GetGeneralConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetGeneralConfig'
+type SystemAPI_GetGeneralConfig_Call struct {
+	*mock.Call
+}
+
+// GetGeneralConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) GetGeneralConfig(ctx interface{}) *SystemAPI_GetGeneralConfig_Call {
+	return &SystemAPI_GetGeneralConfig_Call{Call: _e.mock.On("GetGeneralConfig", ctx)}
+}
+
+func (_c *SystemAPI_GetGeneralConfig_Call) Run(run func(ctx context.Context)) *SystemAPI_GetGeneralConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_GetGeneralConfig_Call) Return(_a0 *truenas.SystemGeneralConfig, _a1 error) *SystemAPI_GetGeneralConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_GetGeneralConfig_Call) RunAndReturn(run func(context.Context) (*truenas.SystemGeneralConfig, error)) *SystemAPI_GetGeneralConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHostname provides a mock function with given fields: ctx
+func (_m *SystemAPI) GetHostname(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHostname")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_GetHostname_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHostname'
+type SystemAPI_GetHostname_Call struct {
+	*mock.Call
+}
+
+// GetHostname is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) GetHostname(ctx interface{}) *SystemAPI_GetHostname_Call {
+	return &SystemAPI_GetHostname_Call{Call: _e.mock.On("GetHostname", ctx)}
+}
+
+func (_c *SystemAPI_GetHostname_Call) Run(run func(ctx context.Context)) *SystemAPI_GetHostname_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_GetHostname_Call) Return(_a0 string, _a1 error) *SystemAPI_GetHostname_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_GetHostname_Call) RunAndReturn(run func(context.Context) (string, error)) *SystemAPI_GetHostname_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInfo provides a mock function with given fields: ctx
+func (_m *SystemAPI) GetInfo(ctx context.Context) (*truenas.SystemInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInfo")
+	}
+
+	var r0 *truenas.SystemInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SystemInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SystemInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_GetInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInfo'
+type SystemAPI_GetInfo_Call struct {
+	*mock.Call
+}
+
+// GetInfo is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) GetInfo(ctx interface{}) *SystemAPI_GetInfo_Call {
+	return &SystemAPI_GetInfo_Call{Call: _e.mock.On("GetInfo", ctx)}
+}
+
+func (_c *SystemAPI_GetInfo_Call) Run(run func(ctx context.Context)) *SystemAPI_GetInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_GetInfo_Call) Return(_a0 *truenas.SystemInfo, _a1 error) *SystemAPI_GetInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_GetInfo_Call) RunAndReturn(run func(context.Context) (*truenas.SystemInfo, error)) *SystemAPI_GetInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingUpdate provides a mock function with given fields: ctx
+func (_m *SystemAPI) GetPendingUpdate(ctx context.Context) (*truenas.UpdateInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingUpdate")
+	}
+
+	var r0 *truenas.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.UpdateInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.UpdateInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_GetPendingUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingUpdate'
+type SystemAPI_GetPendingUpdate_Call struct {
+	*mock.Call
+}
+
+// GetPendingUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) GetPendingUpdate(ctx interface{}) *SystemAPI_GetPendingUpdate_Call {
+	return &SystemAPI_GetPendingUpdate_Call{Call: _e.mock.On("GetPendingUpdate", ctx)}
+}
+
+func (_c *SystemAPI_GetPendingUpdate_Call) Run(run func(ctx context.Context)) *SystemAPI_GetPendingUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_GetPendingUpdate_Call) Return(_a0 *truenas.UpdateInfo, _a1 error) *SystemAPI_GetPendingUpdate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_GetPendingUpdate_Call) RunAndReturn(run func(context.Context) (*truenas.UpdateInfo, error)) *SystemAPI_GetPendingUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSerialPortChoices provides a mock function with given fields: ctx
+func (_m *SystemAPI) GetSerialPortChoices(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSerialPortChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_GetSerialPortChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSerialPortChoices'
+type SystemAPI_GetSerialPortChoices_Call struct {
+	*mock.Call
+}
+
+// GetSerialPortChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) GetSerialPortChoices(ctx interface{}) *SystemAPI_GetSerialPortChoices_Call {
+	return &SystemAPI_GetSerialPortChoices_Call{Call: _e.mock.On("GetSerialPortChoices", ctx)}
+}
+
+func (_c *SystemAPI_GetSerialPortChoices_Call) Run(run func(ctx context.Context)) *SystemAPI_GetSerialPortChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_GetSerialPortChoices_Call) Return(_a0 map[string]string, _a1 error) *SystemAPI_GetSerialPortChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_GetSerialPortChoices_Call) RunAndReturn(run func(context.Context) (map[string]string, error)) *SystemAPI_GetSerialPortChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVersion provides a mock function with given fields: ctx
+func (_m *SystemAPI) GetVersion(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVersion")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_GetVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersion'
+type SystemAPI_GetVersion_Call struct {
+	*mock.Call
+}
+
+// GetVersion is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) GetVersion(ctx interface{}) *SystemAPI_GetVersion_Call {
+	return &SystemAPI_GetVersion_Call{Call: _e.mock.On("GetVersion", ctx)}
+}
+
+func (_c *SystemAPI_GetVersion_Call) Run(run func(ctx context.Context)) *SystemAPI_GetVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_GetVersion_Call) Return(_a0 string, _a1 error) *SystemAPI_GetVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_GetVersion_Call) RunAndReturn(run func(context.Context) (string, error)) *SystemAPI_GetVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Hostname provides a mock function with given fields: ctx
+func (_m *SystemAPI) Hostname(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Hostname")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_Hostname_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Hostname'
+type SystemAPI_Hostname_Call struct {
+	*mock.Call
+}
+
+// Hostname is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) Hostname(ctx interface{}) *SystemAPI_Hostname_Call {
+	return &SystemAPI_Hostname_Call{Call: _e.mock.On("Hostname", ctx)}
+}
+
+func (_c *SystemAPI_Hostname_Call) Run(run func(ctx context.Context)) *SystemAPI_Hostname_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_Hostname_Call) Return(_a0 string, _a1 error) *SystemAPI_Hostname_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_Hostname_Call) RunAndReturn(run func(context.Context) (string, error)) *SystemAPI_Hostname_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Info provides a mock function with given fields: ctx
+func (_m *SystemAPI) Info(ctx context.Context) (*truenas.SystemInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Info")
+	}
+
+	var r0 *truenas.SystemInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SystemInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SystemInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_Info_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Info'
+type SystemAPI_Info_Call struct {
+	*mock.Call
+}
+
+// Info is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) Info(ctx interface{}) *SystemAPI_Info_Call {
+	return &SystemAPI_Info_Call{Call: _e.mock.On("Info", ctx)}
+}
+
+func (_c *SystemAPI_Info_Call) Run(run func(ctx context.Context)) *SystemAPI_Info_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_Info_Call) Return(_a0 *truenas.SystemInfo, _a1 error) *SystemAPI_Info_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_Info_Call) RunAndReturn(run func(context.Context) (*truenas.SystemInfo, error)) *SystemAPI_Info_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsStable provides a mock function with given fields: ctx
+func (_m *SystemAPI) IsStable(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsStable")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_IsStable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsStable'
+type SystemAPI_IsStable_Call struct {
+	*mock.Call
+}
+
+// IsStable is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) IsStable(ctx interface{}) *SystemAPI_IsStable_Call {
+	return &SystemAPI_IsStable_Call{Call: _e.mock.On("IsStable", ctx)}
+}
+
+func (_c *SystemAPI_IsStable_Call) Run(run func(ctx context.Context)) *SystemAPI_IsStable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_IsStable_Call) Return(_a0 bool, _a1 error) *SystemAPI_IsStable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_IsStable_Call) RunAndReturn(run func(context.Context) (bool, error)) *SystemAPI_IsStable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// License provides a mock function with given fields: ctx
+func (_m *SystemAPI) License(ctx context.Context) (*truenas.SystemLicense, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for License")
+	}
+
+	var r0 *truenas.SystemLicense
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SystemLicense, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SystemLicense); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemLicense)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_License_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'License'
+type SystemAPI_License_Call struct {
+	*mock.Call
+}
+
+// License is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) License(ctx interface{}) *SystemAPI_License_Call {
+	return &SystemAPI_License_Call{Call: _e.mock.On("License", ctx)}
+}
+
+func (_c *SystemAPI_License_Call) Run(run func(ctx context.Context)) *SystemAPI_License_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_License_Call) Return(_a0 *truenas.SystemLicense, _a1 error) *SystemAPI_License_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_License_Call) RunAndReturn(run func(context.Context) (*truenas.SystemLicense, error)) *SystemAPI_License_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBootEnvs provides a mock function with given fields: ctx
+func (_m *SystemAPI) ListBootEnvs(ctx context.Context) ([]truenas.BootEnv, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBootEnvs")
+	}
+
+	var r0 []truenas.BootEnv
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.BootEnv, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.BootEnv); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.BootEnv)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_ListBootEnvs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBootEnvs'
+type SystemAPI_ListBootEnvs_Call struct {
+	*mock.Call
+}
+
+// ListBootEnvs is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) ListBootEnvs(ctx interface{}) *SystemAPI_ListBootEnvs_Call {
+	return &SystemAPI_ListBootEnvs_Call{Call: _e.mock.On("ListBootEnvs", ctx)}
+}
+
+func (_c *SystemAPI_ListBootEnvs_Call) Run(run func(ctx context.Context)) *SystemAPI_ListBootEnvs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_ListBootEnvs_Call) Return(_a0 []truenas.BootEnv, _a1 error) *SystemAPI_ListBootEnvs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_ListBootEnvs_Call) RunAndReturn(run func(context.Context) ([]truenas.BootEnv, error)) *SystemAPI_ListBootEnvs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBootEnvsWithQuery provides a mock function with given fields: ctx, q
+func (_m *SystemAPI) ListBootEnvsWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.BootEnv, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBootEnvsWithQuery")
+	}
+
+	var r0 []truenas.BootEnv
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.BootEnv, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.BootEnv); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.BootEnv)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_ListBootEnvsWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBootEnvsWithQuery'
+type SystemAPI_ListBootEnvsWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListBootEnvsWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *SystemAPI_Expecter) ListBootEnvsWithQuery(ctx interface{}, q interface{}) *SystemAPI_ListBootEnvsWithQuery_Call {
+	return &SystemAPI_ListBootEnvsWithQuery_Call{Call: _e.mock.On("ListBootEnvsWithQuery", ctx, q)}
+}
+
+func (_c *SystemAPI_ListBootEnvsWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *SystemAPI_ListBootEnvsWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_ListBootEnvsWithQuery_Call) Return(_a0 []truenas.BootEnv, _a1 error) *SystemAPI_ListBootEnvsWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_ListBootEnvsWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.BootEnv, error)) *SystemAPI_ListBootEnvsWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ManualUpdate provides a mock function with given fields: ctx, path, rebootAfter
+func (_m *SystemAPI) ManualUpdate(ctx context.Context, path string, rebootAfter bool) error {
+	ret := _m.Called(ctx, path, rebootAfter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ManualUpdate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, path, rebootAfter)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_ManualUpdate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualUpdate'
+type SystemAPI_ManualUpdate_Call struct {
+	*mock.Call
+}
+
+// ManualUpdate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - rebootAfter bool
+func (_e *SystemAPI_Expecter) ManualUpdate(ctx interface{}, path interface{}, rebootAfter interface{}) *SystemAPI_ManualUpdate_Call {
+	return &SystemAPI_ManualUpdate_Call{Call: _e.mock.On("ManualUpdate", ctx, path, rebootAfter)}
+}
+
+func (_c *SystemAPI_ManualUpdate_Call) Run(run func(ctx context.Context, path string, rebootAfter bool)) *SystemAPI_ManualUpdate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_ManualUpdate_Call) Return(_a0 error) *SystemAPI_ManualUpdate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_ManualUpdate_Call) RunAndReturn(run func(context.Context, string, bool) error) *SystemAPI_ManualUpdate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Product provides a mock function with given fields: ctx
+func (_m *SystemAPI) Product(ctx context.Context) (truenas.ProductType, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Product")
+	}
+
+	var r0 truenas.ProductType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (truenas.ProductType, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) truenas.ProductType); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(truenas.ProductType)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_Product_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Product'
+type SystemAPI_Product_Call struct {
+	*mock.Call
+}
+
+// Product is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) Product(ctx interface{}) *SystemAPI_Product_Call {
+	return &SystemAPI_Product_Call{Call: _e.mock.On("Product", ctx)}
+}
+
+func (_c *SystemAPI_Product_Call) Run(run func(ctx context.Context)) *SystemAPI_Product_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_Product_Call) Return(_a0 truenas.ProductType, _a1 error) *SystemAPI_Product_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_Product_Call) RunAndReturn(run func(context.Context) (truenas.ProductType, error)) *SystemAPI_Product_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Ready provides a mock function with given fields: ctx
+func (_m *SystemAPI) Ready(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ready")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_Ready_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ready'
+type SystemAPI_Ready_Call struct {
+	*mock.Call
+}
+
+// Ready is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) Ready(ctx interface{}) *SystemAPI_Ready_Call {
+	return &SystemAPI_Ready_Call{Call: _e.mock.On("Ready", ctx)}
+}
+
+func (_c *SystemAPI_Ready_Call) Run(run func(ctx context.Context)) *SystemAPI_Ready_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_Ready_Call) Return(_a0 bool, _a1 error) *SystemAPI_Ready_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_Ready_Call) RunAndReturn(run func(context.Context) (bool, error)) *SystemAPI_Ready_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Reboot provides a mock function with given fields: ctx, delay
+func (_m *SystemAPI) Reboot(ctx context.Context, delay int) error {
+	ret := _m.Called(ctx, delay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reboot")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, delay)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_Reboot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reboot'
+type SystemAPI_Reboot_Call struct {
+	*mock.Call
+}
+
+// Reboot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delay int
+func (_e *SystemAPI_Expecter) Reboot(ctx interface{}, delay interface{}) *SystemAPI_Reboot_Call {
+	return &SystemAPI_Reboot_Call{Call: _e.mock.On("Reboot", ctx, delay)}
+}
+
+func (_c *SystemAPI_Reboot_Call) Run(run func(ctx context.Context, delay int)) *SystemAPI_Reboot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_Reboot_Call) Return(_a0 error) *SystemAPI_Reboot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_Reboot_Call) RunAndReturn(run func(context.Context, int) error) *SystemAPI_Reboot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebootWithOptions provides a mock function with given fields: ctx, options
+func (_m *SystemAPI) RebootWithOptions(ctx context.Context, options *truenas.SystemPowerOptions) error {
+	ret := _m.Called(ctx, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebootWithOptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SystemPowerOptions) error); ok {
+		r0 = rf(ctx, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_RebootWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebootWithOptions'
+type SystemAPI_RebootWithOptions_Call struct {
+	*mock.Call
+}
+
+// RebootWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options *truenas.SystemPowerOptions
+func (_e *SystemAPI_Expecter) RebootWithOptions(ctx interface{}, options interface{}) *SystemAPI_RebootWithOptions_Call {
+	return &SystemAPI_RebootWithOptions_Call{Call: _e.mock.On("RebootWithOptions", ctx, options)}
+}
+
+func (_c *SystemAPI_RebootWithOptions_Call) Run(run func(ctx context.Context, options *truenas.SystemPowerOptions)) *SystemAPI_RebootWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SystemPowerOptions))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_RebootWithOptions_Call) Return(_a0 error) *SystemAPI_RebootWithOptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_RebootWithOptions_Call) RunAndReturn(run func(context.Context, *truenas.SystemPowerOptions) error) *SystemAPI_RebootWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetBootEnvAttr provides a mock function with given fields: ctx, id, attrs
+func (_m *SystemAPI) SetBootEnvAttr(ctx context.Context, id string, attrs map[string]any) error {
+	ret := _m.Called(ctx, id, attrs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetBootEnvAttr")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]any) error); ok {
+		r0 = rf(ctx, id, attrs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_SetBootEnvAttr_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetBootEnvAttr'
+type SystemAPI_SetBootEnvAttr_Call struct {
+	*mock.Call
+}
+
+// SetBootEnvAttr is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - attrs map[string]any
+func (_e *SystemAPI_Expecter) SetBootEnvAttr(ctx interface{}, id interface{}, attrs interface{}) *SystemAPI_SetBootEnvAttr_Call {
+	return &SystemAPI_SetBootEnvAttr_Call{Call: _e.mock.On("SetBootEnvAttr", ctx, id, attrs)}
+}
+
+func (_c *SystemAPI_SetBootEnvAttr_Call) Run(run func(ctx context.Context, id string, attrs map[string]any)) *SystemAPI_SetBootEnvAttr_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string]any))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_SetBootEnvAttr_Call) Return(_a0 error) *SystemAPI_SetBootEnvAttr_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_SetBootEnvAttr_Call) RunAndReturn(run func(context.Context, string, map[string]any) error) *SystemAPI_SetBootEnvAttr_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHostname provides a mock function with given fields: ctx, hostname
+func (_m *SystemAPI) SetHostname(ctx context.Context, hostname string) error {
+	ret := _m.Called(ctx, hostname)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHostname")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, hostname)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_SetHostname_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHostname'
+type SystemAPI_SetHostname_Call struct {
+	*mock.Call
+}
+
+// SetHostname is a helper method to define mock.On call
+//   - ctx context.Context
+//   - hostname string
+func (_e *SystemAPI_Expecter) SetHostname(ctx interface{}, hostname interface{}) *SystemAPI_SetHostname_Call {
+	return &SystemAPI_SetHostname_Call{Call: _e.mock.On("SetHostname", ctx, hostname)}
+}
+
+func (_c *SystemAPI_SetHostname_Call) Run(run func(ctx context.Context, hostname string)) *SystemAPI_SetHostname_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_SetHostname_Call) Return(_a0 error) *SystemAPI_SetHostname_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_SetHostname_Call) RunAndReturn(run func(context.Context, string) error) *SystemAPI_SetHostname_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetUICertificate provides a mock function with given fields: ctx, certificateID
+func (_m *SystemAPI) SetUICertificate(ctx context.Context, certificateID int) error {
+	ret := _m.Called(ctx, certificateID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetUICertificate")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, certificateID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_SetUICertificate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUICertificate'
+type SystemAPI_SetUICertificate_Call struct {
+	*mock.Call
+}
+
+// SetUICertificate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - certificateID int
+func (_e *SystemAPI_Expecter) SetUICertificate(ctx interface{}, certificateID interface{}) *SystemAPI_SetUICertificate_Call {
+	return &SystemAPI_SetUICertificate_Call{Call: _e.mock.On("SetUICertificate", ctx, certificateID)}
+}
+
+func (_c *SystemAPI_SetUICertificate_Call) Run(run func(ctx context.Context, certificateID int)) *SystemAPI_SetUICertificate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_SetUICertificate_Call) Return(_a0 error) *SystemAPI_SetUICertificate_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_SetUICertificate_Call) RunAndReturn(run func(context.Context, int) error) *SystemAPI_SetUICertificate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Shutdown provides a mock function with given fields: ctx, delay
+func (_m *SystemAPI) Shutdown(ctx context.Context, delay int) error {
+	ret := _m.Called(ctx, delay)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Shutdown")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, delay)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_Shutdown_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Shutdown'
+type SystemAPI_Shutdown_Call struct {
+	*mock.Call
+}
+
+// Shutdown is a helper method to define mock.On call
+//   - ctx context.Context
+//   - delay int
+func (_e *SystemAPI_Expecter) Shutdown(ctx interface{}, delay interface{}) *SystemAPI_Shutdown_Call {
+	return &SystemAPI_Shutdown_Call{Call: _e.mock.On("Shutdown", ctx, delay)}
+}
+
+func (_c *SystemAPI_Shutdown_Call) Run(run func(ctx context.Context, delay int)) *SystemAPI_Shutdown_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_Shutdown_Call) Return(_a0 error) *SystemAPI_Shutdown_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_Shutdown_Call) RunAndReturn(run func(context.Context, int) error) *SystemAPI_Shutdown_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ShutdownWithOptions provides a mock function with given fields: ctx, options
+func (_m *SystemAPI) ShutdownWithOptions(ctx context.Context, options *truenas.SystemPowerOptions) error {
+	ret := _m.Called(ctx, options)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ShutdownWithOptions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SystemPowerOptions) error); ok {
+		r0 = rf(ctx, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SystemAPI_ShutdownWithOptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ShutdownWithOptions'
+type SystemAPI_ShutdownWithOptions_Call struct {
+	*mock.Call
+}
+
+// ShutdownWithOptions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options *truenas.SystemPowerOptions
+func (_e *SystemAPI_Expecter) ShutdownWithOptions(ctx interface{}, options interface{}) *SystemAPI_ShutdownWithOptions_Call {
+	return &SystemAPI_ShutdownWithOptions_Call{Call: _e.mock.On("ShutdownWithOptions", ctx, options)}
+}
+
+func (_c *SystemAPI_ShutdownWithOptions_Call) Run(run func(ctx context.Context, options *truenas.SystemPowerOptions)) *SystemAPI_ShutdownWithOptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SystemPowerOptions))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_ShutdownWithOptions_Call) Return(_a0 error) *SystemAPI_ShutdownWithOptions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SystemAPI_ShutdownWithOptions_Call) RunAndReturn(run func(context.Context, *truenas.SystemPowerOptions) error) *SystemAPI_ShutdownWithOptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAdvancedConfig provides a mock function with given fields: ctx, req
+func (_m *SystemAPI) UpdateAdvancedConfig(ctx context.Context, req *truenas.SystemAdvancedUpdateRequest) (*truenas.SystemAdvancedConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAdvancedConfig")
+	}
+
+	var r0 *truenas.SystemAdvancedConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SystemAdvancedUpdateRequest) (*truenas.SystemAdvancedConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SystemAdvancedUpdateRequest) *truenas.SystemAdvancedConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemAdvancedConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SystemAdvancedUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_UpdateAdvancedConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAdvancedConfig'
+type SystemAPI_UpdateAdvancedConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateAdvancedConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SystemAdvancedUpdateRequest
+func (_e *SystemAPI_Expecter) UpdateAdvancedConfig(ctx interface{}, req interface{}) *SystemAPI_UpdateAdvancedConfig_Call {
+	return &SystemAPI_UpdateAdvancedConfig_Call{Call: _e.mock.On("UpdateAdvancedConfig", ctx, req)}
+}
+
+func (_c *SystemAPI_UpdateAdvancedConfig_Call) Run(run func(ctx context.Context, req *truenas.SystemAdvancedUpdateRequest)) *SystemAPI_UpdateAdvancedConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SystemAdvancedUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_UpdateAdvancedConfig_Call) Return(_a0 *truenas.SystemAdvancedConfig, _a1 error) *SystemAPI_UpdateAdvancedConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_UpdateAdvancedConfig_Call) RunAndReturn(run func(context.Context, *truenas.SystemAdvancedUpdateRequest) (*truenas.SystemAdvancedConfig, error)) *SystemAPI_UpdateAdvancedConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateGeneralConfig provides a mock function with given fields: ctx, config
+func (_m *SystemAPI) UpdateGeneralConfig(ctx context.Context, config *truenas.SystemGeneralConfig) (*truenas.SystemGeneralConfig, error) {
+	ret := _m.Called(ctx, config)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateGeneralConfig")
+	}
+
+	var r0 *truenas.SystemGeneralConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SystemGeneralConfig) (*truenas.SystemGeneralConfig, error)); ok {
+		return rf(ctx, config)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SystemGeneralConfig) *truenas.SystemGeneralConfig); ok {
+		r0 = rf(ctx, config)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemGeneralConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SystemGeneralConfig) error); ok {
+		r1 = rf(ctx, config)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_UpdateGeneralConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateGeneralConfig'
+type SystemAPI_UpdateGeneralConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateGeneralConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - config *truenas.SystemGeneralConfig
+func (_e *SystemAPI_Expecter) UpdateGeneralConfig(ctx interface{}, config interface{}) *SystemAPI_UpdateGeneralConfig_Call {
+	return &SystemAPI_UpdateGeneralConfig_Call{Call: _e.mock.On("UpdateGeneralConfig", ctx, config)}
+}
+
+func (_c *SystemAPI_UpdateGeneralConfig_Call) Run(run func(ctx context.Context, config *truenas.SystemGeneralConfig)) *SystemAPI_UpdateGeneralConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SystemGeneralConfig))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_UpdateGeneralConfig_Call) Return(_a0 *truenas.SystemGeneralConfig, _a1 error) *SystemAPI_UpdateGeneralConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_UpdateGeneralConfig_Call) RunAndReturn(run func(context.Context, *truenas.SystemGeneralConfig) (*truenas.SystemGeneralConfig, error)) *SystemAPI_UpdateGeneralConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Version provides a mock function with given fields: ctx
+func (_m *SystemAPI) Version(ctx context.Context) (truenas.ServerVersion, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 truenas.ServerVersion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (truenas.ServerVersion, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) truenas.ServerVersion); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(truenas.ServerVersion)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemAPI_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type SystemAPI_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemAPI_Expecter) Version(ctx interface{}) *SystemAPI_Version_Call {
+	return &SystemAPI_Version_Call{Call: _e.mock.On("Version", ctx)}
+}
+
+func (_c *SystemAPI_Version_Call) Run(run func(ctx context.Context)) *SystemAPI_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemAPI_Version_Call) Return(_a0 truenas.ServerVersion, _a1 error) *SystemAPI_Version_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemAPI_Version_Call) RunAndReturn(run func(context.Context) (truenas.ServerVersion, error)) *SystemAPI_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSystemAPI creates a new instance of SystemAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSystemAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SystemAPI {
+	mock := &SystemAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
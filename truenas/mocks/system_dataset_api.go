@@ -0,0 +1,213 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SystemDatasetAPI is an autogenerated mock type for the SystemDatasetAPI type
+type SystemDatasetAPI struct {
+	mock.Mock
+}
+
+type SystemDatasetAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SystemDatasetAPI) EXPECT() *SystemDatasetAPI_Expecter {
+	return &SystemDatasetAPI_Expecter{mock: &_m.Mock}
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *SystemDatasetAPI) GetConfig(ctx context.Context) (*truenas.SystemDatasetConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.SystemDatasetConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.SystemDatasetConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.SystemDatasetConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemDatasetConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemDatasetAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type SystemDatasetAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SystemDatasetAPI_Expecter) GetConfig(ctx interface{}) *SystemDatasetAPI_GetConfig_Call {
+	return &SystemDatasetAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *SystemDatasetAPI_GetConfig_Call) Run(run func(ctx context.Context)) *SystemDatasetAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SystemDatasetAPI_GetConfig_Call) Return(_a0 *truenas.SystemDatasetConfig, _a1 error) *SystemDatasetAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemDatasetAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.SystemDatasetConfig, error)) *SystemDatasetAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PoolChoices provides a mock function with given fields: ctx, includeCurrentPool
+func (_m *SystemDatasetAPI) PoolChoices(ctx context.Context, includeCurrentPool bool) (map[string]string, error) {
+	ret := _m.Called(ctx, includeCurrentPool)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PoolChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) (map[string]string, error)); ok {
+		return rf(ctx, includeCurrentPool)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) map[string]string); ok {
+		r0 = rf(ctx, includeCurrentPool)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, includeCurrentPool)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemDatasetAPI_PoolChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PoolChoices'
+type SystemDatasetAPI_PoolChoices_Call struct {
+	*mock.Call
+}
+
+// PoolChoices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - includeCurrentPool bool
+func (_e *SystemDatasetAPI_Expecter) PoolChoices(ctx interface{}, includeCurrentPool interface{}) *SystemDatasetAPI_PoolChoices_Call {
+	return &SystemDatasetAPI_PoolChoices_Call{Call: _e.mock.On("PoolChoices", ctx, includeCurrentPool)}
+}
+
+func (_c *SystemDatasetAPI_PoolChoices_Call) Run(run func(ctx context.Context, includeCurrentPool bool)) *SystemDatasetAPI_PoolChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *SystemDatasetAPI_PoolChoices_Call) Return(_a0 map[string]string, _a1 error) *SystemDatasetAPI_PoolChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemDatasetAPI_PoolChoices_Call) RunAndReturn(run func(context.Context, bool) (map[string]string, error)) *SystemDatasetAPI_PoolChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, req
+func (_m *SystemDatasetAPI) UpdateConfig(ctx context.Context, req *truenas.SystemDatasetUpdateRequest) (*truenas.SystemDatasetConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.SystemDatasetConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SystemDatasetUpdateRequest) (*truenas.SystemDatasetConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.SystemDatasetUpdateRequest) *truenas.SystemDatasetConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.SystemDatasetConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.SystemDatasetUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SystemDatasetAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type SystemDatasetAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.SystemDatasetUpdateRequest
+func (_e *SystemDatasetAPI_Expecter) UpdateConfig(ctx interface{}, req interface{}) *SystemDatasetAPI_UpdateConfig_Call {
+	return &SystemDatasetAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, req)}
+}
+
+func (_c *SystemDatasetAPI_UpdateConfig_Call) Run(run func(ctx context.Context, req *truenas.SystemDatasetUpdateRequest)) *SystemDatasetAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.SystemDatasetUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *SystemDatasetAPI_UpdateConfig_Call) Return(_a0 *truenas.SystemDatasetConfig, _a1 error) *SystemDatasetAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SystemDatasetAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.SystemDatasetUpdateRequest) (*truenas.SystemDatasetConfig, error)) *SystemDatasetAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSystemDatasetAPI creates a new instance of SystemDatasetAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSystemDatasetAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SystemDatasetAPI {
+	mock := &SystemDatasetAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,212 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TrueCommandAPI is an autogenerated mock type for the TrueCommandAPI type
+type TrueCommandAPI struct {
+	mock.Mock
+}
+
+type TrueCommandAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TrueCommandAPI) EXPECT() *TrueCommandAPI_Expecter {
+	return &TrueCommandAPI_Expecter{mock: &_m.Mock}
+}
+
+// Connected provides a mock function with given fields: ctx
+func (_m *TrueCommandAPI) Connected(ctx context.Context) (*truenas.TrueCommandConnection, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Connected")
+	}
+
+	var r0 *truenas.TrueCommandConnection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.TrueCommandConnection, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.TrueCommandConnection); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.TrueCommandConnection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrueCommandAPI_Connected_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Connected'
+type TrueCommandAPI_Connected_Call struct {
+	*mock.Call
+}
+
+// Connected is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TrueCommandAPI_Expecter) Connected(ctx interface{}) *TrueCommandAPI_Connected_Call {
+	return &TrueCommandAPI_Connected_Call{Call: _e.mock.On("Connected", ctx)}
+}
+
+func (_c *TrueCommandAPI_Connected_Call) Run(run func(ctx context.Context)) *TrueCommandAPI_Connected_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TrueCommandAPI_Connected_Call) Return(_a0 *truenas.TrueCommandConnection, _a1 error) *TrueCommandAPI_Connected_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrueCommandAPI_Connected_Call) RunAndReturn(run func(context.Context) (*truenas.TrueCommandConnection, error)) *TrueCommandAPI_Connected_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *TrueCommandAPI) GetConfig(ctx context.Context) (*truenas.TrueCommandConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.TrueCommandConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.TrueCommandConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.TrueCommandConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.TrueCommandConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrueCommandAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type TrueCommandAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TrueCommandAPI_Expecter) GetConfig(ctx interface{}) *TrueCommandAPI_GetConfig_Call {
+	return &TrueCommandAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *TrueCommandAPI_GetConfig_Call) Run(run func(ctx context.Context)) *TrueCommandAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TrueCommandAPI_GetConfig_Call) Return(_a0 *truenas.TrueCommandConfig, _a1 error) *TrueCommandAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrueCommandAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.TrueCommandConfig, error)) *TrueCommandAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConfig provides a mock function with given fields: ctx, req
+func (_m *TrueCommandAPI) UpdateConfig(ctx context.Context, req *truenas.TrueCommandUpdateRequest) (*truenas.TrueCommandConfig, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConfig")
+	}
+
+	var r0 *truenas.TrueCommandConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.TrueCommandUpdateRequest) (*truenas.TrueCommandConfig, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.TrueCommandUpdateRequest) *truenas.TrueCommandConfig); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.TrueCommandConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.TrueCommandUpdateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrueCommandAPI_UpdateConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConfig'
+type TrueCommandAPI_UpdateConfig_Call struct {
+	*mock.Call
+}
+
+// UpdateConfig is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.TrueCommandUpdateRequest
+func (_e *TrueCommandAPI_Expecter) UpdateConfig(ctx interface{}, req interface{}) *TrueCommandAPI_UpdateConfig_Call {
+	return &TrueCommandAPI_UpdateConfig_Call{Call: _e.mock.On("UpdateConfig", ctx, req)}
+}
+
+func (_c *TrueCommandAPI_UpdateConfig_Call) Run(run func(ctx context.Context, req *truenas.TrueCommandUpdateRequest)) *TrueCommandAPI_UpdateConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.TrueCommandUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *TrueCommandAPI_UpdateConfig_Call) Return(_a0 *truenas.TrueCommandConfig, _a1 error) *TrueCommandAPI_UpdateConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TrueCommandAPI_UpdateConfig_Call) RunAndReturn(run func(context.Context, *truenas.TrueCommandUpdateRequest) (*truenas.TrueCommandConfig, error)) *TrueCommandAPI_UpdateConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTrueCommandAPI creates a new instance of TrueCommandAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTrueCommandAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TrueCommandAPI {
+	mock := &TrueCommandAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,481 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	truenas "github.com/715d/go-truenas/truenas"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UpdateAPI is an autogenerated mock type for the UpdateAPI type
+type UpdateAPI struct {
+	mock.Mock
+}
+
+type UpdateAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UpdateAPI) EXPECT() *UpdateAPI_Expecter {
+	return &UpdateAPI_Expecter{mock: &_m.Mock}
+}
+
+// Apply provides a mock function with given fields: ctx, options, fn
+func (_m *UpdateAPI) Apply(ctx context.Context, options *truenas.UpdateApplyOptions, fn truenas.JobProgressFunc) error {
+	ret := _m.Called(ctx, options, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Apply")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.UpdateApplyOptions, truenas.JobProgressFunc) error); ok {
+		r0 = rf(ctx, options, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAPI_Apply_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Apply'
+type UpdateAPI_Apply_Call struct {
+	*mock.Call
+}
+
+// Apply is a helper method to define mock.On call
+//   - ctx context.Context
+//   - options *truenas.UpdateApplyOptions
+//   - fn truenas.JobProgressFunc
+func (_e *UpdateAPI_Expecter) Apply(ctx interface{}, options interface{}, fn interface{}) *UpdateAPI_Apply_Call {
+	return &UpdateAPI_Apply_Call{Call: _e.mock.On("Apply", ctx, options, fn)}
+}
+
+func (_c *UpdateAPI_Apply_Call) Run(run func(ctx context.Context, options *truenas.UpdateApplyOptions, fn truenas.JobProgressFunc)) *UpdateAPI_Apply_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.UpdateApplyOptions), args[2].(truenas.JobProgressFunc))
+	})
+	return _c
+}
+
+func (_c *UpdateAPI_Apply_Call) Return(_a0 error) *UpdateAPI_Apply_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UpdateAPI_Apply_Call) RunAndReturn(run func(context.Context, *truenas.UpdateApplyOptions, truenas.JobProgressFunc) error) *UpdateAPI_Apply_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckAvailable provides a mock function with given fields: ctx, train
+func (_m *UpdateAPI) CheckAvailable(ctx context.Context, train string) (*truenas.UpdateInfo, error) {
+	ret := _m.Called(ctx, train)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckAvailable")
+	}
+
+	var r0 *truenas.UpdateInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.UpdateInfo, error)); ok {
+		return rf(ctx, train)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.UpdateInfo); ok {
+		r0 = rf(ctx, train)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.UpdateInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, train)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAPI_CheckAvailable_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckAvailable'
+type UpdateAPI_CheckAvailable_Call struct {
+	*mock.Call
+}
+
+// CheckAvailable is a helper method to define mock.On call
+//   - ctx context.Context
+//   - train string
+func (_e *UpdateAPI_Expecter) CheckAvailable(ctx interface{}, train interface{}) *UpdateAPI_CheckAvailable_Call {
+	return &UpdateAPI_CheckAvailable_Call{Call: _e.mock.On("CheckAvailable", ctx, train)}
+}
+
+func (_c *UpdateAPI_CheckAvailable_Call) Run(run func(ctx context.Context, train string)) *UpdateAPI_CheckAvailable_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UpdateAPI_CheckAvailable_Call) Return(_a0 *truenas.UpdateInfo, _a1 error) *UpdateAPI_CheckAvailable_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateAPI_CheckAvailable_Call) RunAndReturn(run func(context.Context, string) (*truenas.UpdateInfo, error)) *UpdateAPI_CheckAvailable_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Download provides a mock function with given fields: ctx, fn
+func (_m *UpdateAPI) Download(ctx context.Context, fn truenas.JobProgressFunc) (bool, error) {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Download")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.JobProgressFunc) (bool, error)); ok {
+		return rf(ctx, fn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.JobProgressFunc) bool); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.JobProgressFunc) error); ok {
+		r1 = rf(ctx, fn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAPI_Download_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Download'
+type UpdateAPI_Download_Call struct {
+	*mock.Call
+}
+
+// Download is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn truenas.JobProgressFunc
+func (_e *UpdateAPI_Expecter) Download(ctx interface{}, fn interface{}) *UpdateAPI_Download_Call {
+	return &UpdateAPI_Download_Call{Call: _e.mock.On("Download", ctx, fn)}
+}
+
+func (_c *UpdateAPI_Download_Call) Run(run func(ctx context.Context, fn truenas.JobProgressFunc)) *UpdateAPI_Download_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.JobProgressFunc))
+	})
+	return _c
+}
+
+func (_c *UpdateAPI_Download_Call) Return(_a0 bool, _a1 error) *UpdateAPI_Download_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateAPI_Download_Call) RunAndReturn(run func(context.Context, truenas.JobProgressFunc) (bool, error)) *UpdateAPI_Download_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConfig provides a mock function with given fields: ctx
+func (_m *UpdateAPI) GetConfig(ctx context.Context) (*truenas.UpdateConfig, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConfig")
+	}
+
+	var r0 *truenas.UpdateConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.UpdateConfig, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.UpdateConfig); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.UpdateConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAPI_GetConfig_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConfig'
+type UpdateAPI_GetConfig_Call struct {
+	*mock.Call
+}
+
+// GetConfig is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UpdateAPI_Expecter) GetConfig(ctx interface{}) *UpdateAPI_GetConfig_Call {
+	return &UpdateAPI_GetConfig_Call{Call: _e.mock.On("GetConfig", ctx)}
+}
+
+func (_c *UpdateAPI_GetConfig_Call) Run(run func(ctx context.Context)) *UpdateAPI_GetConfig_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UpdateAPI_GetConfig_Call) Return(_a0 *truenas.UpdateConfig, _a1 error) *UpdateAPI_GetConfig_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateAPI_GetConfig_Call) RunAndReturn(run func(context.Context) (*truenas.UpdateConfig, error)) *UpdateAPI_GetConfig_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPending provides a mock function with given fields: ctx
+func (_m *UpdateAPI) GetPending(ctx context.Context) ([]truenas.UpdatePendingChange, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPending")
+	}
+
+	var r0 []truenas.UpdatePendingChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.UpdatePendingChange, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.UpdatePendingChange); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.UpdatePendingChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAPI_GetPending_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPending'
+type UpdateAPI_GetPending_Call struct {
+	*mock.Call
+}
+
+// GetPending is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UpdateAPI_Expecter) GetPending(ctx interface{}) *UpdateAPI_GetPending_Call {
+	return &UpdateAPI_GetPending_Call{Call: _e.mock.On("GetPending", ctx)}
+}
+
+func (_c *UpdateAPI_GetPending_Call) Run(run func(ctx context.Context)) *UpdateAPI_GetPending_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UpdateAPI_GetPending_Call) Return(_a0 []truenas.UpdatePendingChange, _a1 error) *UpdateAPI_GetPending_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateAPI_GetPending_Call) RunAndReturn(run func(context.Context) ([]truenas.UpdatePendingChange, error)) *UpdateAPI_GetPending_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTrains provides a mock function with given fields: ctx
+func (_m *UpdateAPI) GetTrains(ctx context.Context) (*truenas.UpdateTrains, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTrains")
+	}
+
+	var r0 *truenas.UpdateTrains
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.UpdateTrains, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.UpdateTrains); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.UpdateTrains)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAPI_GetTrains_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTrains'
+type UpdateAPI_GetTrains_Call struct {
+	*mock.Call
+}
+
+// GetTrains is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UpdateAPI_Expecter) GetTrains(ctx interface{}) *UpdateAPI_GetTrains_Call {
+	return &UpdateAPI_GetTrains_Call{Call: _e.mock.On("GetTrains", ctx)}
+}
+
+func (_c *UpdateAPI_GetTrains_Call) Run(run func(ctx context.Context)) *UpdateAPI_GetTrains_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UpdateAPI_GetTrains_Call) Return(_a0 *truenas.UpdateTrains, _a1 error) *UpdateAPI_GetTrains_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateAPI_GetTrains_Call) RunAndReturn(run func(context.Context) (*truenas.UpdateTrains, error)) *UpdateAPI_GetTrains_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAutoCheck provides a mock function with given fields: ctx, autoCheck
+func (_m *UpdateAPI) SetAutoCheck(ctx context.Context, autoCheck bool) (*truenas.UpdateConfig, error) {
+	ret := _m.Called(ctx, autoCheck)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAutoCheck")
+	}
+
+	var r0 *truenas.UpdateConfig
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) (*truenas.UpdateConfig, error)); ok {
+		return rf(ctx, autoCheck)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) *truenas.UpdateConfig); ok {
+		r0 = rf(ctx, autoCheck)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.UpdateConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, autoCheck)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAPI_SetAutoCheck_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAutoCheck'
+type UpdateAPI_SetAutoCheck_Call struct {
+	*mock.Call
+}
+
+// SetAutoCheck is a helper method to define mock.On call
+//   - ctx context.Context
+//   - autoCheck bool
+func (_e *UpdateAPI_Expecter) SetAutoCheck(ctx interface{}, autoCheck interface{}) *UpdateAPI_SetAutoCheck_Call {
+	return &UpdateAPI_SetAutoCheck_Call{Call: _e.mock.On("SetAutoCheck", ctx, autoCheck)}
+}
+
+func (_c *UpdateAPI_SetAutoCheck_Call) Run(run func(ctx context.Context, autoCheck bool)) *UpdateAPI_SetAutoCheck_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *UpdateAPI_SetAutoCheck_Call) Return(_a0 *truenas.UpdateConfig, _a1 error) *UpdateAPI_SetAutoCheck_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UpdateAPI_SetAutoCheck_Call) RunAndReturn(run func(context.Context, bool) (*truenas.UpdateConfig, error)) *UpdateAPI_SetAutoCheck_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTrain provides a mock function with given fields: ctx, train
+func (_m *UpdateAPI) SetTrain(ctx context.Context, train string) error {
+	ret := _m.Called(ctx, train)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTrain")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, train)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateAPI_SetTrain_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTrain'
+type UpdateAPI_SetTrain_Call struct {
+	*mock.Call
+}
+
+// SetTrain is a helper method to define mock.On call
+//   - ctx context.Context
+//   - train string
+func (_e *UpdateAPI_Expecter) SetTrain(ctx interface{}, train interface{}) *UpdateAPI_SetTrain_Call {
+	return &UpdateAPI_SetTrain_Call{Call: _e.mock.On("SetTrain", ctx, train)}
+}
+
+func (_c *UpdateAPI_SetTrain_Call) Run(run func(ctx context.Context, train string)) *UpdateAPI_SetTrain_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UpdateAPI_SetTrain_Call) Return(_a0 error) *UpdateAPI_SetTrain_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UpdateAPI_SetTrain_Call) RunAndReturn(run func(context.Context, string) error) *UpdateAPI_SetTrain_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUpdateAPI creates a new instance of UpdateAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUpdateAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UpdateAPI {
+	mock := &UpdateAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
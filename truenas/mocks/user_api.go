@@ -0,0 +1,969 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// UserAPI is an autogenerated mock type for the UserAPI type
+type UserAPI struct {
+	mock.Mock
+}
+
+type UserAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserAPI) EXPECT() *UserAPI_Expecter {
+	return &UserAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *UserAPI) Create(ctx context.Context, req *truenas.UserCreateRequest) (*truenas.User, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.UserCreateRequest) (*truenas.User, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.UserCreateRequest) *truenas.User); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.UserCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type UserAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.UserCreateRequest
+func (_e *UserAPI_Expecter) Create(ctx interface{}, req interface{}) *UserAPI_Create_Call {
+	return &UserAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *UserAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.UserCreateRequest)) *UserAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.UserCreateRequest))
+	})
+	return _c
+}
+
+func (_c *UserAPI_Create_Call) Return(_a0 *truenas.User, _a1 error) *UserAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.UserCreateRequest) (*truenas.User, error)) *UserAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, req
+func (_m *UserAPI) Delete(ctx context.Context, id int, req *truenas.UserDeleteRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.UserDeleteRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type UserAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.UserDeleteRequest
+func (_e *UserAPI_Expecter) Delete(ctx interface{}, id interface{}, req interface{}) *UserAPI_Delete_Call {
+	return &UserAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, req)}
+}
+
+func (_c *UserAPI_Delete_Call) Run(run func(ctx context.Context, id int, req *truenas.UserDeleteRequest)) *UserAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.UserDeleteRequest))
+	})
+	return _c
+}
+
+func (_c *UserAPI_Delete_Call) Return(_a0 error) *UserAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserAPI_Delete_Call) RunAndReturn(run func(context.Context, int, *truenas.UserDeleteRequest) error) *UserAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *UserAPI) Get(ctx context.Context, id int) (*truenas.User, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.User, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.User); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type UserAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *UserAPI_Expecter) Get(ctx interface{}, id interface{}) *UserAPI_Get_Call {
+	return &UserAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *UserAPI_Get_Call) Run(run func(ctx context.Context, id int)) *UserAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *UserAPI_Get_Call) Return(_a0 *truenas.User, _a1 error) *UserAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.User, error)) *UserAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByUsername provides a mock function with given fields: ctx, username
+func (_m *UserAPI) GetByUsername(ctx context.Context, username string) (*truenas.User, error) {
+	ret := _m.Called(ctx, username)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByUsername")
+	}
+
+	var r0 *truenas.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*truenas.User, error)); ok {
+		return rf(ctx, username)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *truenas.User); ok {
+		r0 = rf(ctx, username)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, username)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_GetByUsername_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByUsername'
+type UserAPI_GetByUsername_Call struct {
+	*mock.Call
+}
+
+// GetByUsername is a helper method to define mock.On call
+//   - ctx context.Context
+//   - username string
+func (_e *UserAPI_Expecter) GetByUsername(ctx interface{}, username interface{}) *UserAPI_GetByUsername_Call {
+	return &UserAPI_GetByUsername_Call{Call: _e.mock.On("GetByUsername", ctx, username)}
+}
+
+func (_c *UserAPI_GetByUsername_Call) Run(run func(ctx context.Context, username string)) *UserAPI_GetByUsername_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserAPI_GetByUsername_Call) Return(_a0 *truenas.User, _a1 error) *UserAPI_GetByUsername_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_GetByUsername_Call) RunAndReturn(run func(context.Context, string) (*truenas.User, error)) *UserAPI_GetByUsername_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNextUID provides a mock function with given fields: ctx
+func (_m *UserAPI) GetNextUID(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNextUID")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_GetNextUID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNextUID'
+type UserAPI_GetNextUID_Call struct {
+	*mock.Call
+}
+
+// GetNextUID is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserAPI_Expecter) GetNextUID(ctx interface{}) *UserAPI_GetNextUID_Call {
+	return &UserAPI_GetNextUID_Call{Call: _e.mock.On("GetNextUID", ctx)}
+}
+
+func (_c *UserAPI_GetNextUID_Call) Run(run func(ctx context.Context)) *UserAPI_GetNextUID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserAPI_GetNextUID_Call) Return(_a0 int, _a1 error) *UserAPI_GetNextUID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_GetNextUID_Call) RunAndReturn(run func(context.Context) (int, error)) *UserAPI_GetNextUID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetShellChoices provides a mock function with given fields: ctx, userID
+func (_m *UserAPI) GetShellChoices(ctx context.Context, userID *int) (map[string]string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetShellChoices")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *int) (map[string]string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *int) map[string]string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *int) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_GetShellChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShellChoices'
+type UserAPI_GetShellChoices_Call struct {
+	*mock.Call
+}
+
+// GetShellChoices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID *int
+func (_e *UserAPI_Expecter) GetShellChoices(ctx interface{}, userID interface{}) *UserAPI_GetShellChoices_Call {
+	return &UserAPI_GetShellChoices_Call{Call: _e.mock.On("GetShellChoices", ctx, userID)}
+}
+
+func (_c *UserAPI_GetShellChoices_Call) Run(run func(ctx context.Context, userID *int)) *UserAPI_GetShellChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*int))
+	})
+	return _c
+}
+
+func (_c *UserAPI_GetShellChoices_Call) Return(_a0 map[string]string, _a1 error) *UserAPI_GetShellChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_GetShellChoices_Call) RunAndReturn(run func(context.Context, *int) (map[string]string, error)) *UserAPI_GetShellChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserObj provides a mock function with given fields: ctx, req
+func (_m *UserAPI) GetUserObj(ctx context.Context, req truenas.UserGetRequest) (map[string]any, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserObj")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.UserGetRequest) (map[string]any, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.UserGetRequest) map[string]any); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, truenas.UserGetRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_GetUserObj_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserObj'
+type UserAPI_GetUserObj_Call struct {
+	*mock.Call
+}
+
+// GetUserObj is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.UserGetRequest
+func (_e *UserAPI_Expecter) GetUserObj(ctx interface{}, req interface{}) *UserAPI_GetUserObj_Call {
+	return &UserAPI_GetUserObj_Call{Call: _e.mock.On("GetUserObj", ctx, req)}
+}
+
+func (_c *UserAPI_GetUserObj_Call) Run(run func(ctx context.Context, req truenas.UserGetRequest)) *UserAPI_GetUserObj_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.UserGetRequest))
+	})
+	return _c
+}
+
+func (_c *UserAPI_GetUserObj_Call) Return(_a0 map[string]any, _a1 error) *UserAPI_GetUserObj_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_GetUserObj_Call) RunAndReturn(run func(context.Context, truenas.UserGetRequest) (map[string]any, error)) *UserAPI_GetUserObj_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HasRootPassword provides a mock function with given fields: ctx
+func (_m *UserAPI) HasRootPassword(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HasRootPassword")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_HasRootPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasRootPassword'
+type UserAPI_HasRootPassword_Call struct {
+	*mock.Call
+}
+
+// HasRootPassword is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserAPI_Expecter) HasRootPassword(ctx interface{}) *UserAPI_HasRootPassword_Call {
+	return &UserAPI_HasRootPassword_Call{Call: _e.mock.On("HasRootPassword", ctx)}
+}
+
+func (_c *UserAPI_HasRootPassword_Call) Run(run func(ctx context.Context)) *UserAPI_HasRootPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserAPI_HasRootPassword_Call) Return(_a0 bool, _a1 error) *UserAPI_HasRootPassword_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_HasRootPassword_Call) RunAndReturn(run func(context.Context) (bool, error)) *UserAPI_HasRootPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *UserAPI) List(ctx context.Context) ([]truenas.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type UserAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserAPI_Expecter) List(ctx interface{}) *UserAPI_List_Call {
+	return &UserAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *UserAPI_List_Call) Run(run func(ctx context.Context)) *UserAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserAPI_List_Call) Return(_a0 []truenas.User, _a1 error) *UserAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.User, error)) *UserAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *UserAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.User, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.User, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.User, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.User, error])
+		}
+	}
+
+	return r0
+}
+
+// UserAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type UserAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *UserAPI_Expecter) ListIter(ctx interface{}, q interface{}) *UserAPI_ListIter_Call {
+	return &UserAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *UserAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *UserAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *UserAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.User, error]) *UserAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.User, error]) *UserAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithDSCache provides a mock function with given fields: ctx
+func (_m *UserAPI) ListWithDSCache(ctx context.Context) ([]truenas.User, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithDSCache")
+	}
+
+	var r0 []truenas.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.User, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.User); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_ListWithDSCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithDSCache'
+type UserAPI_ListWithDSCache_Call struct {
+	*mock.Call
+}
+
+// ListWithDSCache is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *UserAPI_Expecter) ListWithDSCache(ctx interface{}) *UserAPI_ListWithDSCache_Call {
+	return &UserAPI_ListWithDSCache_Call{Call: _e.mock.On("ListWithDSCache", ctx)}
+}
+
+func (_c *UserAPI_ListWithDSCache_Call) Run(run func(ctx context.Context)) *UserAPI_ListWithDSCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *UserAPI_ListWithDSCache_Call) Return(_a0 []truenas.User, _a1 error) *UserAPI_ListWithDSCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_ListWithDSCache_Call) RunAndReturn(run func(context.Context) ([]truenas.User, error)) *UserAPI_ListWithDSCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *UserAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.User, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.User, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.User); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type UserAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *UserAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *UserAPI_ListWithQuery_Call {
+	return &UserAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *UserAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *UserAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *UserAPI_ListWithQuery_Call) Return(_a0 []truenas.User, _a1 error) *UserAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.User, error)) *UserAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PopAttribute provides a mock function with given fields: ctx, id, key
+func (_m *UserAPI) PopAttribute(ctx context.Context, id int, key string) error {
+	ret := _m.Called(ctx, id, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PopAttribute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, id, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserAPI_PopAttribute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PopAttribute'
+type UserAPI_PopAttribute_Call struct {
+	*mock.Call
+}
+
+// PopAttribute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - key string
+func (_e *UserAPI_Expecter) PopAttribute(ctx interface{}, id interface{}, key interface{}) *UserAPI_PopAttribute_Call {
+	return &UserAPI_PopAttribute_Call{Call: _e.mock.On("PopAttribute", ctx, id, key)}
+}
+
+func (_c *UserAPI_PopAttribute_Call) Run(run func(ctx context.Context, id int, key string)) *UserAPI_PopAttribute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserAPI_PopAttribute_Call) Return(_a0 error) *UserAPI_PopAttribute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserAPI_PopAttribute_Call) RunAndReturn(run func(context.Context, int, string) error) *UserAPI_PopAttribute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetAttribute provides a mock function with given fields: ctx, id, key, value
+func (_m *UserAPI) SetAttribute(ctx context.Context, id int, key string, value any) error {
+	ret := _m.Called(ctx, id, key, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetAttribute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, any) error); ok {
+		r0 = rf(ctx, id, key, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserAPI_SetAttribute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetAttribute'
+type UserAPI_SetAttribute_Call struct {
+	*mock.Call
+}
+
+// SetAttribute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - key string
+//   - value any
+func (_e *UserAPI_Expecter) SetAttribute(ctx interface{}, id interface{}, key interface{}, value interface{}) *UserAPI_SetAttribute_Call {
+	return &UserAPI_SetAttribute_Call{Call: _e.mock.On("SetAttribute", ctx, id, key, value)}
+}
+
+func (_c *UserAPI_SetAttribute_Call) Run(run func(ctx context.Context, id int, key string, value any)) *UserAPI_SetAttribute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string), args[3].(any))
+	})
+	return _c
+}
+
+func (_c *UserAPI_SetAttribute_Call) Return(_a0 error) *UserAPI_SetAttribute_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserAPI_SetAttribute_Call) RunAndReturn(run func(context.Context, int, string, any) error) *UserAPI_SetAttribute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRootPassword provides a mock function with given fields: ctx, req
+func (_m *UserAPI) SetRootPassword(ctx context.Context, req truenas.SetRootPasswordRequest) error {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRootPassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, truenas.SetRootPasswordRequest) error); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserAPI_SetRootPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRootPassword'
+type UserAPI_SetRootPassword_Call struct {
+	*mock.Call
+}
+
+// SetRootPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req truenas.SetRootPasswordRequest
+func (_e *UserAPI_Expecter) SetRootPassword(ctx interface{}, req interface{}) *UserAPI_SetRootPassword_Call {
+	return &UserAPI_SetRootPassword_Call{Call: _e.mock.On("SetRootPassword", ctx, req)}
+}
+
+func (_c *UserAPI_SetRootPassword_Call) Run(run func(ctx context.Context, req truenas.SetRootPasswordRequest)) *UserAPI_SetRootPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(truenas.SetRootPasswordRequest))
+	})
+	return _c
+}
+
+func (_c *UserAPI_SetRootPassword_Call) Return(_a0 error) *UserAPI_SetRootPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserAPI_SetRootPassword_Call) RunAndReturn(run func(context.Context, truenas.SetRootPasswordRequest) error) *UserAPI_SetRootPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetRootPasswordSimple provides a mock function with given fields: ctx, password
+func (_m *UserAPI) SetRootPasswordSimple(ctx context.Context, password string) error {
+	ret := _m.Called(ctx, password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetRootPasswordSimple")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserAPI_SetRootPasswordSimple_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRootPasswordSimple'
+type UserAPI_SetRootPasswordSimple_Call struct {
+	*mock.Call
+}
+
+// SetRootPasswordSimple is a helper method to define mock.On call
+//   - ctx context.Context
+//   - password string
+func (_e *UserAPI_Expecter) SetRootPasswordSimple(ctx interface{}, password interface{}) *UserAPI_SetRootPasswordSimple_Call {
+	return &UserAPI_SetRootPasswordSimple_Call{Call: _e.mock.On("SetRootPasswordSimple", ctx, password)}
+}
+
+func (_c *UserAPI_SetRootPasswordSimple_Call) Run(run func(ctx context.Context, password string)) *UserAPI_SetRootPasswordSimple_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserAPI_SetRootPasswordSimple_Call) Return(_a0 error) *UserAPI_SetRootPasswordSimple_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *UserAPI_SetRootPasswordSimple_Call) RunAndReturn(run func(context.Context, string) error) *UserAPI_SetRootPasswordSimple_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *UserAPI) Update(ctx context.Context, id int, req *truenas.UserUpdateRequest) (*truenas.User, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.UserUpdateRequest) (*truenas.User, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.UserUpdateRequest) *truenas.User); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.UserUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type UserAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.UserUpdateRequest
+func (_e *UserAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *UserAPI_Update_Call {
+	return &UserAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *UserAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.UserUpdateRequest)) *UserAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.UserUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *UserAPI_Update_Call) Return(_a0 *truenas.User, _a1 error) *UserAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.UserUpdateRequest) (*truenas.User, error)) *UserAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserAPI creates a new instance of UserAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserAPI {
+	mock := &UserAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
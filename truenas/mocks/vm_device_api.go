@@ -0,0 +1,605 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// VMDeviceAPI is an autogenerated mock type for the VMDeviceAPI type
+type VMDeviceAPI struct {
+	mock.Mock
+}
+
+type VMDeviceAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VMDeviceAPI) EXPECT() *VMDeviceAPI_Expecter {
+	return &VMDeviceAPI_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *VMDeviceAPI) Create(ctx context.Context, req *truenas.VMDeviceCreateRequest) (*truenas.VMDevice, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.VMDevice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.VMDeviceCreateRequest) (*truenas.VMDevice, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.VMDeviceCreateRequest) *truenas.VMDevice); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VMDevice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.VMDeviceCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMDeviceAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type VMDeviceAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.VMDeviceCreateRequest
+func (_e *VMDeviceAPI_Expecter) Create(ctx interface{}, req interface{}) *VMDeviceAPI_Create_Call {
+	return &VMDeviceAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *VMDeviceAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.VMDeviceCreateRequest)) *VMDeviceAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.VMDeviceCreateRequest))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_Create_Call) Return(_a0 *truenas.VMDevice, _a1 error) *VMDeviceAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMDeviceAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.VMDeviceCreateRequest) (*truenas.VMDevice, error)) *VMDeviceAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, req
+func (_m *VMDeviceAPI) Delete(ctx context.Context, id int, req *truenas.VMDeviceDeleteRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.VMDeviceDeleteRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VMDeviceAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type VMDeviceAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.VMDeviceDeleteRequest
+func (_e *VMDeviceAPI_Expecter) Delete(ctx interface{}, id interface{}, req interface{}) *VMDeviceAPI_Delete_Call {
+	return &VMDeviceAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, req)}
+}
+
+func (_c *VMDeviceAPI_Delete_Call) Run(run func(ctx context.Context, id int, req *truenas.VMDeviceDeleteRequest)) *VMDeviceAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.VMDeviceDeleteRequest))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_Delete_Call) Return(_a0 error) *VMDeviceAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VMDeviceAPI_Delete_Call) RunAndReturn(run func(context.Context, int, *truenas.VMDeviceDeleteRequest) error) *VMDeviceAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *VMDeviceAPI) Get(ctx context.Context, id int) (*truenas.VMDevice, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.VMDevice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.VMDevice, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.VMDevice); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VMDevice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMDeviceAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type VMDeviceAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMDeviceAPI_Expecter) Get(ctx interface{}, id interface{}) *VMDeviceAPI_Get_Call {
+	return &VMDeviceAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *VMDeviceAPI_Get_Call) Run(run func(ctx context.Context, id int)) *VMDeviceAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_Get_Call) Return(_a0 *truenas.VMDevice, _a1 error) *VMDeviceAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMDeviceAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.VMDevice, error)) *VMDeviceAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetNICAttachChoices provides a mock function with given fields: ctx
+func (_m *VMDeviceAPI) GetNICAttachChoices(ctx context.Context) (map[string]any, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetNICAttachChoices")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]any, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]any); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMDeviceAPI_GetNICAttachChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNICAttachChoices'
+type VMDeviceAPI_GetNICAttachChoices_Call struct {
+	*mock.Call
+}
+
+// GetNICAttachChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMDeviceAPI_Expecter) GetNICAttachChoices(ctx interface{}) *VMDeviceAPI_GetNICAttachChoices_Call {
+	return &VMDeviceAPI_GetNICAttachChoices_Call{Call: _e.mock.On("GetNICAttachChoices", ctx)}
+}
+
+func (_c *VMDeviceAPI_GetNICAttachChoices_Call) Run(run func(ctx context.Context)) *VMDeviceAPI_GetNICAttachChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_GetNICAttachChoices_Call) Return(_a0 map[string]any, _a1 error) *VMDeviceAPI_GetNICAttachChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMDeviceAPI_GetNICAttachChoices_Call) RunAndReturn(run func(context.Context) (map[string]any, error)) *VMDeviceAPI_GetNICAttachChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPPTDevChoices provides a mock function with given fields: ctx
+func (_m *VMDeviceAPI) GetPPTDevChoices(ctx context.Context) (map[string]any, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPPTDevChoices")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]any, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]any); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMDeviceAPI_GetPPTDevChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPPTDevChoices'
+type VMDeviceAPI_GetPPTDevChoices_Call struct {
+	*mock.Call
+}
+
+// GetPPTDevChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMDeviceAPI_Expecter) GetPPTDevChoices(ctx interface{}) *VMDeviceAPI_GetPPTDevChoices_Call {
+	return &VMDeviceAPI_GetPPTDevChoices_Call{Call: _e.mock.On("GetPPTDevChoices", ctx)}
+}
+
+func (_c *VMDeviceAPI_GetPPTDevChoices_Call) Run(run func(ctx context.Context)) *VMDeviceAPI_GetPPTDevChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_GetPPTDevChoices_Call) Return(_a0 map[string]any, _a1 error) *VMDeviceAPI_GetPPTDevChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMDeviceAPI_GetPPTDevChoices_Call) RunAndReturn(run func(context.Context) (map[string]any, error)) *VMDeviceAPI_GetPPTDevChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVNCBindChoices provides a mock function with given fields: ctx
+func (_m *VMDeviceAPI) GetVNCBindChoices(ctx context.Context) (map[string]any, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVNCBindChoices")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]any, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]any); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMDeviceAPI_GetVNCBindChoices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVNCBindChoices'
+type VMDeviceAPI_GetVNCBindChoices_Call struct {
+	*mock.Call
+}
+
+// GetVNCBindChoices is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMDeviceAPI_Expecter) GetVNCBindChoices(ctx interface{}) *VMDeviceAPI_GetVNCBindChoices_Call {
+	return &VMDeviceAPI_GetVNCBindChoices_Call{Call: _e.mock.On("GetVNCBindChoices", ctx)}
+}
+
+func (_c *VMDeviceAPI_GetVNCBindChoices_Call) Run(run func(ctx context.Context)) *VMDeviceAPI_GetVNCBindChoices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_GetVNCBindChoices_Call) Return(_a0 map[string]any, _a1 error) *VMDeviceAPI_GetVNCBindChoices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMDeviceAPI_GetVNCBindChoices_Call) RunAndReturn(run func(context.Context) (map[string]any, error)) *VMDeviceAPI_GetVNCBindChoices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *VMDeviceAPI) List(ctx context.Context) ([]truenas.VMDevice, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.VMDevice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.VMDevice, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.VMDevice); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.VMDevice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMDeviceAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type VMDeviceAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMDeviceAPI_Expecter) List(ctx interface{}) *VMDeviceAPI_List_Call {
+	return &VMDeviceAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *VMDeviceAPI_List_Call) Run(run func(ctx context.Context)) *VMDeviceAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_List_Call) Return(_a0 []truenas.VMDevice, _a1 error) *VMDeviceAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMDeviceAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.VMDevice, error)) *VMDeviceAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *VMDeviceAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.VMDevice, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.VMDevice, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.VMDevice, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.VMDevice, error])
+		}
+	}
+
+	return r0
+}
+
+// VMDeviceAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type VMDeviceAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *VMDeviceAPI_Expecter) ListIter(ctx interface{}, q interface{}) *VMDeviceAPI_ListIter_Call {
+	return &VMDeviceAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *VMDeviceAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *VMDeviceAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.VMDevice, error]) *VMDeviceAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VMDeviceAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.VMDevice, error]) *VMDeviceAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *VMDeviceAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.VMDevice, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.VMDevice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.VMDevice, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.VMDevice); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.VMDevice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMDeviceAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type VMDeviceAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *VMDeviceAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *VMDeviceAPI_ListWithQuery_Call {
+	return &VMDeviceAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *VMDeviceAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *VMDeviceAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_ListWithQuery_Call) Return(_a0 []truenas.VMDevice, _a1 error) *VMDeviceAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMDeviceAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.VMDevice, error)) *VMDeviceAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *VMDeviceAPI) Update(ctx context.Context, id int, req *truenas.VMDeviceCreateRequest) (*truenas.VMDevice, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.VMDevice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.VMDeviceCreateRequest) (*truenas.VMDevice, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.VMDeviceCreateRequest) *truenas.VMDevice); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VMDevice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.VMDeviceCreateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMDeviceAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type VMDeviceAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.VMDeviceCreateRequest
+func (_e *VMDeviceAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *VMDeviceAPI_Update_Call {
+	return &VMDeviceAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *VMDeviceAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.VMDeviceCreateRequest)) *VMDeviceAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.VMDeviceCreateRequest))
+	})
+	return _c
+}
+
+func (_c *VMDeviceAPI_Update_Call) Return(_a0 *truenas.VMDevice, _a1 error) *VMDeviceAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMDeviceAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.VMDeviceCreateRequest) (*truenas.VMDevice, error)) *VMDeviceAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVMDeviceAPI creates a new instance of VMDeviceAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVMDeviceAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VMDeviceAPI {
+	mock := &VMDeviceAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
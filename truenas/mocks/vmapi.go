@@ -0,0 +1,1494 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	iter "iter"
+
+	mock "github.com/stretchr/testify/mock"
+
+	truenas "github.com/715d/go-truenas/truenas"
+)
+
+// VMAPI is an autogenerated mock type for the VMAPI type
+type VMAPI struct {
+	mock.Mock
+}
+
+type VMAPI_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *VMAPI) EXPECT() *VMAPI_Expecter {
+	return &VMAPI_Expecter{mock: &_m.Mock}
+}
+
+// Clone provides a mock function with given fields: ctx, id, name
+func (_m *VMAPI) Clone(ctx context.Context, id int, name string) (*truenas.VM, error) {
+	ret := _m.Called(ctx, id, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Clone")
+	}
+
+	var r0 *truenas.VM
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*truenas.VM, error)); ok {
+		return rf(ctx, id, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *truenas.VM); ok {
+		r0 = rf(ctx, id, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VM)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_Clone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Clone'
+type VMAPI_Clone_Call struct {
+	*mock.Call
+}
+
+// Clone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - name string
+func (_e *VMAPI_Expecter) Clone(ctx interface{}, id interface{}, name interface{}) *VMAPI_Clone_Call {
+	return &VMAPI_Clone_Call{Call: _e.mock.On("Clone", ctx, id, name)}
+}
+
+func (_c *VMAPI_Clone_Call) Run(run func(ctx context.Context, id int, name string)) *VMAPI_Clone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *VMAPI_Clone_Call) Return(_a0 *truenas.VM, _a1 error) *VMAPI_Clone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_Clone_Call) RunAndReturn(run func(context.Context, int, string) (*truenas.VM, error)) *VMAPI_Clone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, req
+func (_m *VMAPI) Create(ctx context.Context, req *truenas.VMCreateRequest) (*truenas.VM, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *truenas.VM
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.VMCreateRequest) (*truenas.VM, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.VMCreateRequest) *truenas.VM); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VM)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.VMCreateRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type VMAPI_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *truenas.VMCreateRequest
+func (_e *VMAPI_Expecter) Create(ctx interface{}, req interface{}) *VMAPI_Create_Call {
+	return &VMAPI_Create_Call{Call: _e.mock.On("Create", ctx, req)}
+}
+
+func (_c *VMAPI_Create_Call) Run(run func(ctx context.Context, req *truenas.VMCreateRequest)) *VMAPI_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.VMCreateRequest))
+	})
+	return _c
+}
+
+func (_c *VMAPI_Create_Call) Return(_a0 *truenas.VM, _a1 error) *VMAPI_Create_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_Create_Call) RunAndReturn(run func(context.Context, *truenas.VMCreateRequest) (*truenas.VM, error)) *VMAPI_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function with given fields: ctx, id, req
+func (_m *VMAPI) Delete(ctx context.Context, id int, req *truenas.VMDeleteRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.VMDeleteRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VMAPI_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type VMAPI_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.VMDeleteRequest
+func (_e *VMAPI_Expecter) Delete(ctx interface{}, id interface{}, req interface{}) *VMAPI_Delete_Call {
+	return &VMAPI_Delete_Call{Call: _e.mock.On("Delete", ctx, id, req)}
+}
+
+func (_c *VMAPI_Delete_Call) Run(run func(ctx context.Context, id int, req *truenas.VMDeleteRequest)) *VMAPI_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.VMDeleteRequest))
+	})
+	return _c
+}
+
+func (_c *VMAPI_Delete_Call) Return(_a0 error) *VMAPI_Delete_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VMAPI_Delete_Call) RunAndReturn(run func(context.Context, int, *truenas.VMDeleteRequest) error) *VMAPI_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateRandomMAC provides a mock function with given fields: ctx
+func (_m *VMAPI) GenerateRandomMAC(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateRandomMAC")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GenerateRandomMAC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateRandomMAC'
+type VMAPI_GenerateRandomMAC_Call struct {
+	*mock.Call
+}
+
+// GenerateRandomMAC is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMAPI_Expecter) GenerateRandomMAC(ctx interface{}) *VMAPI_GenerateRandomMAC_Call {
+	return &VMAPI_GenerateRandomMAC_Call{Call: _e.mock.On("GenerateRandomMAC", ctx)}
+}
+
+func (_c *VMAPI_GenerateRandomMAC_Call) Run(run func(ctx context.Context)) *VMAPI_GenerateRandomMAC_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GenerateRandomMAC_Call) Return(_a0 string, _a1 error) *VMAPI_GenerateRandomMAC_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GenerateRandomMAC_Call) RunAndReturn(run func(context.Context) (string, error)) *VMAPI_GenerateRandomMAC_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, id
+func (_m *VMAPI) Get(ctx context.Context, id int) (*truenas.VM, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *truenas.VM
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.VM, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.VM); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VM)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type VMAPI_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) Get(ctx interface{}, id interface{}) *VMAPI_Get_Call {
+	return &VMAPI_Get_Call{Call: _e.mock.On("Get", ctx, id)}
+}
+
+func (_c *VMAPI_Get_Call) Run(run func(ctx context.Context, id int)) *VMAPI_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_Get_Call) Return(_a0 *truenas.VM, _a1 error) *VMAPI_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_Get_Call) RunAndReturn(run func(context.Context, int) (*truenas.VM, error)) *VMAPI_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttachedInterfaces provides a mock function with given fields: ctx, id
+func (_m *VMAPI) GetAttachedInterfaces(ctx context.Context, id int) ([]string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachedInterfaces")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetAttachedInterfaces_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttachedInterfaces'
+type VMAPI_GetAttachedInterfaces_Call struct {
+	*mock.Call
+}
+
+// GetAttachedInterfaces is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) GetAttachedInterfaces(ctx interface{}, id interface{}) *VMAPI_GetAttachedInterfaces_Call {
+	return &VMAPI_GetAttachedInterfaces_Call{Call: _e.mock.On("GetAttachedInterfaces", ctx, id)}
+}
+
+func (_c *VMAPI_GetAttachedInterfaces_Call) Run(run func(ctx context.Context, id int)) *VMAPI_GetAttachedInterfaces_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetAttachedInterfaces_Call) Return(_a0 []string, _a1 error) *VMAPI_GetAttachedInterfaces_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetAttachedInterfaces_Call) RunAndReturn(run func(context.Context, int) ([]string, error)) *VMAPI_GetAttachedInterfaces_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAvailableMemory provides a mock function with given fields: ctx, overcommit
+func (_m *VMAPI) GetAvailableMemory(ctx context.Context, overcommit bool) (int, error) {
+	ret := _m.Called(ctx, overcommit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailableMemory")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) (int, error)); ok {
+		return rf(ctx, overcommit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) int); ok {
+		r0 = rf(ctx, overcommit)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, overcommit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetAvailableMemory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailableMemory'
+type VMAPI_GetAvailableMemory_Call struct {
+	*mock.Call
+}
+
+// GetAvailableMemory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - overcommit bool
+func (_e *VMAPI_Expecter) GetAvailableMemory(ctx interface{}, overcommit interface{}) *VMAPI_GetAvailableMemory_Call {
+	return &VMAPI_GetAvailableMemory_Call{Call: _e.mock.On("GetAvailableMemory", ctx, overcommit)}
+}
+
+func (_c *VMAPI_GetAvailableMemory_Call) Run(run func(ctx context.Context, overcommit bool)) *VMAPI_GetAvailableMemory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetAvailableMemory_Call) Return(_a0 int, _a1 error) *VMAPI_GetAvailableMemory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetAvailableMemory_Call) RunAndReturn(run func(context.Context, bool) (int, error)) *VMAPI_GetAvailableMemory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConsole provides a mock function with given fields: ctx, id
+func (_m *VMAPI) GetConsole(ctx context.Context, id int) (string, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConsole")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (string, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetConsole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConsole'
+type VMAPI_GetConsole_Call struct {
+	*mock.Call
+}
+
+// GetConsole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) GetConsole(ctx interface{}, id interface{}) *VMAPI_GetConsole_Call {
+	return &VMAPI_GetConsole_Call{Call: _e.mock.On("GetConsole", ctx, id)}
+}
+
+func (_c *VMAPI_GetConsole_Call) Run(run func(ctx context.Context, id int)) *VMAPI_GetConsole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetConsole_Call) Return(_a0 string, _a1 error) *VMAPI_GetConsole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetConsole_Call) RunAndReturn(run func(context.Context, int) (string, error)) *VMAPI_GetConsole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDisplayDevices provides a mock function with given fields: ctx, id
+func (_m *VMAPI) GetDisplayDevices(ctx context.Context, id int) ([]truenas.VMDevice, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisplayDevices")
+	}
+
+	var r0 []truenas.VMDevice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]truenas.VMDevice, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []truenas.VMDevice); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.VMDevice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetDisplayDevices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisplayDevices'
+type VMAPI_GetDisplayDevices_Call struct {
+	*mock.Call
+}
+
+// GetDisplayDevices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) GetDisplayDevices(ctx interface{}, id interface{}) *VMAPI_GetDisplayDevices_Call {
+	return &VMAPI_GetDisplayDevices_Call{Call: _e.mock.On("GetDisplayDevices", ctx, id)}
+}
+
+func (_c *VMAPI_GetDisplayDevices_Call) Run(run func(ctx context.Context, id int)) *VMAPI_GetDisplayDevices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetDisplayDevices_Call) Return(_a0 []truenas.VMDevice, _a1 error) *VMAPI_GetDisplayDevices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetDisplayDevices_Call) RunAndReturn(run func(context.Context, int) ([]truenas.VMDevice, error)) *VMAPI_GetDisplayDevices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDisplayURL provides a mock function with given fields: ctx, id
+func (_m *VMAPI) GetDisplayURL(ctx context.Context, id int) ([]truenas.VMDisplayURL, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDisplayURL")
+	}
+
+	var r0 []truenas.VMDisplayURL
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]truenas.VMDisplayURL, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []truenas.VMDisplayURL); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.VMDisplayURL)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetDisplayURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDisplayURL'
+type VMAPI_GetDisplayURL_Call struct {
+	*mock.Call
+}
+
+// GetDisplayURL is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) GetDisplayURL(ctx interface{}, id interface{}) *VMAPI_GetDisplayURL_Call {
+	return &VMAPI_GetDisplayURL_Call{Call: _e.mock.On("GetDisplayURL", ctx, id)}
+}
+
+func (_c *VMAPI_GetDisplayURL_Call) Run(run func(ctx context.Context, id int)) *VMAPI_GetDisplayURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetDisplayURL_Call) Return(_a0 []truenas.VMDisplayURL, _a1 error) *VMAPI_GetDisplayURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetDisplayURL_Call) RunAndReturn(run func(context.Context, int) ([]truenas.VMDisplayURL, error)) *VMAPI_GetDisplayURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlags provides a mock function with given fields: ctx
+func (_m *VMAPI) GetFlags(ctx context.Context) (map[string]any, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFlags")
+	}
+
+	var r0 map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string]any, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]any); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetFlags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlags'
+type VMAPI_GetFlags_Call struct {
+	*mock.Call
+}
+
+// GetFlags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMAPI_Expecter) GetFlags(ctx interface{}) *VMAPI_GetFlags_Call {
+	return &VMAPI_GetFlags_Call{Call: _e.mock.On("GetFlags", ctx)}
+}
+
+func (_c *VMAPI_GetFlags_Call) Run(run func(ctx context.Context)) *VMAPI_GetFlags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetFlags_Call) Return(_a0 map[string]any, _a1 error) *VMAPI_GetFlags_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetFlags_Call) RunAndReturn(run func(context.Context) (map[string]any, error)) *VMAPI_GetFlags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMemoryInUse provides a mock function with given fields: ctx
+func (_m *VMAPI) GetMemoryInUse(ctx context.Context) (*truenas.VMMemoryInfo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMemoryInUse")
+	}
+
+	var r0 *truenas.VMMemoryInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*truenas.VMMemoryInfo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *truenas.VMMemoryInfo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VMMemoryInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetMemoryInUse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMemoryInUse'
+type VMAPI_GetMemoryInUse_Call struct {
+	*mock.Call
+}
+
+// GetMemoryInUse is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMAPI_Expecter) GetMemoryInUse(ctx interface{}) *VMAPI_GetMemoryInUse_Call {
+	return &VMAPI_GetMemoryInUse_Call{Call: _e.mock.On("GetMemoryInUse", ctx)}
+}
+
+func (_c *VMAPI_GetMemoryInUse_Call) Run(run func(ctx context.Context)) *VMAPI_GetMemoryInUse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetMemoryInUse_Call) Return(_a0 *truenas.VMMemoryInfo, _a1 error) *VMAPI_GetMemoryInUse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetMemoryInUse_Call) RunAndReturn(run func(context.Context) (*truenas.VMMemoryInfo, error)) *VMAPI_GetMemoryInUse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatus provides a mock function with given fields: ctx, id
+func (_m *VMAPI) GetStatus(ctx context.Context, id int) (*truenas.VMStatus, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatus")
+	}
+
+	var r0 *truenas.VMStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*truenas.VMStatus, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *truenas.VMStatus); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VMStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatus'
+type VMAPI_GetStatus_Call struct {
+	*mock.Call
+}
+
+// GetStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) GetStatus(ctx interface{}, id interface{}) *VMAPI_GetStatus_Call {
+	return &VMAPI_GetStatus_Call{Call: _e.mock.On("GetStatus", ctx, id)}
+}
+
+func (_c *VMAPI_GetStatus_Call) Run(run func(ctx context.Context, id int)) *VMAPI_GetStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetStatus_Call) Return(_a0 *truenas.VMStatus, _a1 error) *VMAPI_GetStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetStatus_Call) RunAndReturn(run func(context.Context, int) (*truenas.VMStatus, error)) *VMAPI_GetStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVNC provides a mock function with given fields: ctx, id
+func (_m *VMAPI) GetVNC(ctx context.Context, id int) ([]map[string]any, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVNC")
+	}
+
+	var r0 []map[string]any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]map[string]any, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []map[string]any); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]map[string]any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetVNC_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVNC'
+type VMAPI_GetVNC_Call struct {
+	*mock.Call
+}
+
+// GetVNC is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) GetVNC(ctx interface{}, id interface{}) *VMAPI_GetVNC_Call {
+	return &VMAPI_GetVNC_Call{Call: _e.mock.On("GetVNC", ctx, id)}
+}
+
+func (_c *VMAPI_GetVNC_Call) Run(run func(ctx context.Context, id int)) *VMAPI_GetVNC_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetVNC_Call) Return(_a0 []map[string]any, _a1 error) *VMAPI_GetVNC_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetVNC_Call) RunAndReturn(run func(context.Context, int) ([]map[string]any, error)) *VMAPI_GetVNC_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVNCIPv4 provides a mock function with given fields: ctx
+func (_m *VMAPI) GetVNCIPv4(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVNCIPv4")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetVNCIPv4_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVNCIPv4'
+type VMAPI_GetVNCIPv4_Call struct {
+	*mock.Call
+}
+
+// GetVNCIPv4 is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMAPI_Expecter) GetVNCIPv4(ctx interface{}) *VMAPI_GetVNCIPv4_Call {
+	return &VMAPI_GetVNCIPv4_Call{Call: _e.mock.On("GetVNCIPv4", ctx)}
+}
+
+func (_c *VMAPI_GetVNCIPv4_Call) Run(run func(ctx context.Context)) *VMAPI_GetVNCIPv4_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetVNCIPv4_Call) Return(_a0 []string, _a1 error) *VMAPI_GetVNCIPv4_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetVNCIPv4_Call) RunAndReturn(run func(context.Context) ([]string, error)) *VMAPI_GetVNCIPv4_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVNCPortWizard provides a mock function with given fields: ctx
+func (_m *VMAPI) GetVNCPortWizard(ctx context.Context) (any, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVNCPortWizard")
+	}
+
+	var r0 any
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (any, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) any); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(any)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetVNCPortWizard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVNCPortWizard'
+type VMAPI_GetVNCPortWizard_Call struct {
+	*mock.Call
+}
+
+// GetVNCPortWizard is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMAPI_Expecter) GetVNCPortWizard(ctx interface{}) *VMAPI_GetVNCPortWizard_Call {
+	return &VMAPI_GetVNCPortWizard_Call{Call: _e.mock.On("GetVNCPortWizard", ctx)}
+}
+
+func (_c *VMAPI_GetVNCPortWizard_Call) Run(run func(ctx context.Context)) *VMAPI_GetVNCPortWizard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetVNCPortWizard_Call) Return(_a0 any, _a1 error) *VMAPI_GetVNCPortWizard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetVNCPortWizard_Call) RunAndReturn(run func(context.Context) (any, error)) *VMAPI_GetVNCPortWizard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVNCWeb provides a mock function with given fields: ctx, id, host
+func (_m *VMAPI) GetVNCWeb(ctx context.Context, id int, host string) ([]string, error) {
+	ret := _m.Called(ctx, id, host)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVNCWeb")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) ([]string, error)); ok {
+		return rf(ctx, id, host)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) []string); ok {
+		r0 = rf(ctx, id, host)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, host)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_GetVNCWeb_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVNCWeb'
+type VMAPI_GetVNCWeb_Call struct {
+	*mock.Call
+}
+
+// GetVNCWeb is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - host string
+func (_e *VMAPI_Expecter) GetVNCWeb(ctx interface{}, id interface{}, host interface{}) *VMAPI_GetVNCWeb_Call {
+	return &VMAPI_GetVNCWeb_Call{Call: _e.mock.On("GetVNCWeb", ctx, id, host)}
+}
+
+func (_c *VMAPI_GetVNCWeb_Call) Run(run func(ctx context.Context, id int, host string)) *VMAPI_GetVNCWeb_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *VMAPI_GetVNCWeb_Call) Return(_a0 []string, _a1 error) *VMAPI_GetVNCWeb_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_GetVNCWeb_Call) RunAndReturn(run func(context.Context, int, string) ([]string, error)) *VMAPI_GetVNCWeb_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IdentifyHypervisor provides a mock function with given fields: ctx
+func (_m *VMAPI) IdentifyHypervisor(ctx context.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IdentifyHypervisor")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_IdentifyHypervisor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IdentifyHypervisor'
+type VMAPI_IdentifyHypervisor_Call struct {
+	*mock.Call
+}
+
+// IdentifyHypervisor is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMAPI_Expecter) IdentifyHypervisor(ctx interface{}) *VMAPI_IdentifyHypervisor_Call {
+	return &VMAPI_IdentifyHypervisor_Call{Call: _e.mock.On("IdentifyHypervisor", ctx)}
+}
+
+func (_c *VMAPI_IdentifyHypervisor_Call) Run(run func(ctx context.Context)) *VMAPI_IdentifyHypervisor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMAPI_IdentifyHypervisor_Call) Return(_a0 bool, _a1 error) *VMAPI_IdentifyHypervisor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_IdentifyHypervisor_Call) RunAndReturn(run func(context.Context) (bool, error)) *VMAPI_IdentifyHypervisor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function with given fields: ctx
+func (_m *VMAPI) List(ctx context.Context) ([]truenas.VM, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []truenas.VM
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]truenas.VM, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []truenas.VM); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.VM)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type VMAPI_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *VMAPI_Expecter) List(ctx interface{}) *VMAPI_List_Call {
+	return &VMAPI_List_Call{Call: _e.mock.On("List", ctx)}
+}
+
+func (_c *VMAPI_List_Call) Run(run func(ctx context.Context)) *VMAPI_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *VMAPI_List_Call) Return(_a0 []truenas.VM, _a1 error) *VMAPI_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_List_Call) RunAndReturn(run func(context.Context) ([]truenas.VM, error)) *VMAPI_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListIter provides a mock function with given fields: ctx, q
+func (_m *VMAPI) ListIter(ctx context.Context, q *truenas.Query) iter.Seq2[truenas.VM, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListIter")
+	}
+
+	var r0 iter.Seq2[truenas.VM, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) iter.Seq2[truenas.VM, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[truenas.VM, error])
+		}
+	}
+
+	return r0
+}
+
+// VMAPI_ListIter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIter'
+type VMAPI_ListIter_Call struct {
+	*mock.Call
+}
+
+// ListIter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *VMAPI_Expecter) ListIter(ctx interface{}, q interface{}) *VMAPI_ListIter_Call {
+	return &VMAPI_ListIter_Call{Call: _e.mock.On("ListIter", ctx, q)}
+}
+
+func (_c *VMAPI_ListIter_Call) Run(run func(ctx context.Context, q *truenas.Query)) *VMAPI_ListIter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *VMAPI_ListIter_Call) Return(_a0 iter.Seq2[truenas.VM, error]) *VMAPI_ListIter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VMAPI_ListIter_Call) RunAndReturn(run func(context.Context, *truenas.Query) iter.Seq2[truenas.VM, error]) *VMAPI_ListIter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWithQuery provides a mock function with given fields: ctx, q
+func (_m *VMAPI) ListWithQuery(ctx context.Context, q *truenas.Query) ([]truenas.VM, error) {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithQuery")
+	}
+
+	var r0 []truenas.VM
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) ([]truenas.VM, error)); ok {
+		return rf(ctx, q)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *truenas.Query) []truenas.VM); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]truenas.VM)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *truenas.Query) error); ok {
+		r1 = rf(ctx, q)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_ListWithQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWithQuery'
+type VMAPI_ListWithQuery_Call struct {
+	*mock.Call
+}
+
+// ListWithQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *truenas.Query
+func (_e *VMAPI_Expecter) ListWithQuery(ctx interface{}, q interface{}) *VMAPI_ListWithQuery_Call {
+	return &VMAPI_ListWithQuery_Call{Call: _e.mock.On("ListWithQuery", ctx, q)}
+}
+
+func (_c *VMAPI_ListWithQuery_Call) Run(run func(ctx context.Context, q *truenas.Query)) *VMAPI_ListWithQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*truenas.Query))
+	})
+	return _c
+}
+
+func (_c *VMAPI_ListWithQuery_Call) Return(_a0 []truenas.VM, _a1 error) *VMAPI_ListWithQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_ListWithQuery_Call) RunAndReturn(run func(context.Context, *truenas.Query) ([]truenas.VM, error)) *VMAPI_ListWithQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PowerOff provides a mock function with given fields: ctx, id
+func (_m *VMAPI) PowerOff(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PowerOff")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VMAPI_PowerOff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PowerOff'
+type VMAPI_PowerOff_Call struct {
+	*mock.Call
+}
+
+// PowerOff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) PowerOff(ctx interface{}, id interface{}) *VMAPI_PowerOff_Call {
+	return &VMAPI_PowerOff_Call{Call: _e.mock.On("PowerOff", ctx, id)}
+}
+
+func (_c *VMAPI_PowerOff_Call) Run(run func(ctx context.Context, id int)) *VMAPI_PowerOff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_PowerOff_Call) Return(_a0 error) *VMAPI_PowerOff_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VMAPI_PowerOff_Call) RunAndReturn(run func(context.Context, int) error) *VMAPI_PowerOff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restart provides a mock function with given fields: ctx, id
+func (_m *VMAPI) Restart(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VMAPI_Restart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restart'
+type VMAPI_Restart_Call struct {
+	*mock.Call
+}
+
+// Restart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *VMAPI_Expecter) Restart(ctx interface{}, id interface{}) *VMAPI_Restart_Call {
+	return &VMAPI_Restart_Call{Call: _e.mock.On("Restart", ctx, id)}
+}
+
+func (_c *VMAPI_Restart_Call) Run(run func(ctx context.Context, id int)) *VMAPI_Restart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *VMAPI_Restart_Call) Return(_a0 error) *VMAPI_Restart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VMAPI_Restart_Call) RunAndReturn(run func(context.Context, int) error) *VMAPI_Restart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Start provides a mock function with given fields: ctx, id, req
+func (_m *VMAPI) Start(ctx context.Context, id int, req *truenas.VMStartRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Start")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.VMStartRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VMAPI_Start_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Start'
+type VMAPI_Start_Call struct {
+	*mock.Call
+}
+
+// Start is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.VMStartRequest
+func (_e *VMAPI_Expecter) Start(ctx interface{}, id interface{}, req interface{}) *VMAPI_Start_Call {
+	return &VMAPI_Start_Call{Call: _e.mock.On("Start", ctx, id, req)}
+}
+
+func (_c *VMAPI_Start_Call) Run(run func(ctx context.Context, id int, req *truenas.VMStartRequest)) *VMAPI_Start_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.VMStartRequest))
+	})
+	return _c
+}
+
+func (_c *VMAPI_Start_Call) Return(_a0 error) *VMAPI_Start_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VMAPI_Start_Call) RunAndReturn(run func(context.Context, int, *truenas.VMStartRequest) error) *VMAPI_Start_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Stop provides a mock function with given fields: ctx, id, req
+func (_m *VMAPI) Stop(ctx context.Context, id int, req *truenas.VMStopRequest) error {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Stop")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.VMStopRequest) error); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// VMAPI_Stop_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Stop'
+type VMAPI_Stop_Call struct {
+	*mock.Call
+}
+
+// Stop is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.VMStopRequest
+func (_e *VMAPI_Expecter) Stop(ctx interface{}, id interface{}, req interface{}) *VMAPI_Stop_Call {
+	return &VMAPI_Stop_Call{Call: _e.mock.On("Stop", ctx, id, req)}
+}
+
+func (_c *VMAPI_Stop_Call) Run(run func(ctx context.Context, id int, req *truenas.VMStopRequest)) *VMAPI_Stop_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.VMStopRequest))
+	})
+	return _c
+}
+
+func (_c *VMAPI_Stop_Call) Return(_a0 error) *VMAPI_Stop_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *VMAPI_Stop_Call) RunAndReturn(run func(context.Context, int, *truenas.VMStopRequest) error) *VMAPI_Stop_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function with given fields: ctx, id, req
+func (_m *VMAPI) Update(ctx context.Context, id int, req *truenas.VMUpdateRequest) (*truenas.VM, error) {
+	ret := _m.Called(ctx, id, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 *truenas.VM
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.VMUpdateRequest) (*truenas.VM, error)); ok {
+		return rf(ctx, id, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, *truenas.VMUpdateRequest) *truenas.VM); ok {
+		r0 = rf(ctx, id, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*truenas.VM)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, *truenas.VMUpdateRequest) error); ok {
+		r1 = rf(ctx, id, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VMAPI_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type VMAPI_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+//   - req *truenas.VMUpdateRequest
+func (_e *VMAPI_Expecter) Update(ctx interface{}, id interface{}, req interface{}) *VMAPI_Update_Call {
+	return &VMAPI_Update_Call{Call: _e.mock.On("Update", ctx, id, req)}
+}
+
+func (_c *VMAPI_Update_Call) Run(run func(ctx context.Context, id int, req *truenas.VMUpdateRequest)) *VMAPI_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(*truenas.VMUpdateRequest))
+	})
+	return _c
+}
+
+func (_c *VMAPI_Update_Call) Return(_a0 *truenas.VM, _a1 error) *VMAPI_Update_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *VMAPI_Update_Call) RunAndReturn(run func(context.Context, int, *truenas.VMUpdateRequest) (*truenas.VM, error)) *VMAPI_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewVMAPI creates a new instance of VMAPI. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVMAPI(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VMAPI {
+	mock := &VMAPI{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
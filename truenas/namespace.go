@@ -0,0 +1,69 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// Namespace calls the methods of a middleware namespace, such as "sharing.smb", by their
+// short names. It lets endpoints without type-safe methods yet be used with the client's
+// job handling, error mapping, retries and interceptors.
+type Namespace struct {
+	client *Client
+	name   string
+}
+
+// Namespace returns accessors for the methods of the named namespace, e.g.
+// client.Namespace("sharing.smb").Call(ctx, "query", nil, &shares)
+func (c *Client) Namespace(name string) *Namespace {
+	return &Namespace{client: c, name: strings.TrimSuffix(name, ".")}
+}
+
+// Namespace returns accessors for a namespace nested in n, e.g. Namespace("pool").Namespace("dataset")
+func (n *Namespace) Namespace(name string) *Namespace {
+	return n.client.Namespace(n.Method(name))
+}
+
+// Name returns the namespace's name
+func (n *Namespace) Name() string {
+	return n.name
+}
+
+// Method returns the full name of a method of the namespace, e.g. "sharing.smb.query" for "query"
+func (n *Namespace) Method(method string) string {
+	return n.name + "." + method
+}
+
+// Call calls a method of the namespace. If v is not nil, the result will be unmarshaled into it.
+func (n *Namespace) Call(ctx context.Context, method string, params []any, v any) error {
+	return n.client.Call(ctx, n.Method(method), params, v)
+}
+
+// CallRaw calls a method of the namespace and returns its undecoded JSON result
+func (n *Namespace) CallRaw(ctx context.Context, method string, params []any) (json.RawMessage, error) {
+	return n.client.CallRaw(ctx, n.Method(method), params)
+}
+
+// CallStream calls a query method of the namespace, such as "query", a page of records at a
+// time and passes each record to fn
+func (n *Namespace) CallStream(ctx context.Context, method string, q *Query, fn func(json.RawMessage) error) error {
+	return n.client.CallStream(ctx, n.Method(method), q, fn)
+}
+
+// CallJob calls a job method of the namespace and waits for completion.
+// If v is not nil, the result will be unmarshaled into it.
+func (n *Namespace) CallJob(ctx context.Context, method string, params []any, v any) error {
+	return n.client.CallJob(ctx, n.Method(method), params, v)
+}
+
+// CallJobWithProgress calls a job method of the namespace and waits for completion, invoking
+// fn whenever the job's state or progress changes. fn may be nil.
+func (n *Namespace) CallJobWithProgress(ctx context.Context, method string, params []any, v any, fn JobProgressFunc) error {
+	return n.client.CallJobWithProgress(ctx, n.Method(method), params, v, fn)
+}
+
+// Query calls the namespace's query method with q and unmarshals the matching records into v
+func (n *Namespace) Query(ctx context.Context, q *Query, v any) error {
+	return n.Call(ctx, "query", q.Params(), v)
+}
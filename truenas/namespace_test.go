@@ -0,0 +1,59 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespace_Call(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("sharing.smb.query", []SMBShare{{ID: 1, Name: "media"}})
+	server.SetResponse("sharing.smb.getacl", map[string]any{"share_name": "media"})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	smb := client.Namespace("sharing.smb.")
+	assert.Equal(t, "sharing.smb", smb.Name())
+	assert.Equal(t, "sharing.smb.query", smb.Method("query"))
+
+	ctx := NewTestContext(t)
+	var shares []SMBShare
+	require.NoError(t, smb.Query(ctx, NewQuery(Eq("enabled", true)), &shares))
+	require.Len(t, shares, 1)
+	assert.Equal(t, "media", shares[0].Name)
+	server.AssertCalled(t, "sharing.smb.query", Filters(Eq("enabled", true)), QueryOptions{})
+
+	raw, err := smb.CallRaw(ctx, "getacl", []any{map[string]any{"share_name": "media"}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"share_name": "media"}`, string(raw))
+
+	// Errors are mapped as for typed calls
+	server.SetError("sharing.smb.delete", errnoENOENT, "Share not found")
+	err = smb.Call(ctx, "delete", []any{9}, nil)
+	var errMsg *ErrorMsg
+	require.ErrorAs(t, err, &errMsg)
+	assert.Equal(t, errnoENOENT, errMsg.Code)
+}
+
+func TestNamespace_CallJob(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetJobResponse("pool.dataset.export_keys", "keys.json")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	dataset := client.Namespace("pool").Namespace("dataset")
+	assert.Equal(t, "pool.dataset", dataset.Name())
+
+	var result string
+	require.NoError(t, dataset.CallJob(NewTestContext(t), "export_keys", []any{"tank"}, &result))
+	assert.Equal(t, "keys.json", result)
+	server.AssertCalled(t, "pool.dataset.export_keys", "tank")
+}
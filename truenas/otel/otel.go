@@ -0,0 +1,97 @@
+// Package otel provides OpenTelemetry tracing for TrueNAS client calls.
+//
+// Install the interceptor when creating the client:
+//
+//	client, err := truenas.NewClient(endpoint, truenas.Options{
+//		APIKey:       apiKey,
+//		Interceptors: []truenas.Interceptor{otel.Interceptor()},
+//	})
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/715d/go-truenas/truenas"
+)
+
+// ScopeName is the instrumentation scope of the tracer
+const ScopeName = "github.com/715d/go-truenas/truenas/otel"
+
+// Span attribute keys
+const (
+	AttrJobID     = attribute.Key("truenas.job_id")
+	AttrErrorCode = attribute.Key("truenas.error_code")
+	AttrErrorType = attribute.Key("truenas.error_type")
+)
+
+type config struct {
+	provider trace.TracerProvider
+}
+
+// Option configures the interceptor
+type Option func(*config)
+
+// WithTracerProvider sets the provider used to create the tracer. It defaults to the global provider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(c *config) {
+		c.provider = provider
+	}
+}
+
+// Interceptor returns a truenas.Interceptor that records a client span per call, named after
+// the method. Calls made while the span is active, such as job polling, become its children
+// when they use the context passed to them. Calls that start jobs record the job ID, and
+// middleware errors record their error code and type.
+func Interceptor(opts ...Option) truenas.Interceptor {
+	cfg := config{provider: otel.GetTracerProvider()}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	tracer := cfg.provider.Tracer(ScopeName)
+
+	return func(ctx context.Context, method string, params []any, invoke truenas.Invoker) (json.RawMessage, error) {
+		service := method
+		if i := strings.LastIndex(method, "."); i >= 0 {
+			service = method[:i]
+		}
+		ctx, span := tracer.Start(ctx, method,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "truenas"),
+				attribute.String("rpc.service", service),
+				attribute.String("rpc.method", method),
+			),
+		)
+		defer span.End()
+
+		result, err := invoke(ctx, method, params)
+		if err != nil {
+			var errMsg *truenas.ErrorMsg
+			if errors.As(err, &errMsg) {
+				span.SetAttributes(AttrErrorCode.Int(errMsg.Code))
+				if errMsg.Type != "" {
+					span.SetAttributes(AttrErrorType.String(errMsg.Type))
+				}
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return result, err
+		}
+
+		if truenas.IsJobCall(ctx) {
+			var jobID int
+			if json.Unmarshal(result, &jobID) == nil {
+				span.SetAttributes(AttrJobID.Int(jobID))
+			}
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,101 @@
+package otel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/715d/go-truenas/truenas"
+)
+
+func newRecorder() (*tracetest.SpanRecorder, trace.TracerProvider) {
+	recorder := tracetest.NewSpanRecorder()
+	return recorder, sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestInterceptor_Job(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2.0/system/version", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"TrueNAS-SCALE-24.04.1"`))
+	})
+	mux.HandleFunc("POST /api/v2.0/pool/scrub/run", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`42`))
+	})
+	mux.HandleFunc("GET /api/v2.0/core/get_jobs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": 42, "method": "pool.scrub.run", "state": "SUCCESS", "result": null}]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	recorder, provider := newRecorder()
+	client, err := truenas.NewClient(server.URL, truenas.Options{
+		APIKey:       "key",
+		Transport:    truenas.TransportREST,
+		Interceptors: []truenas.Interceptor{Interceptor(WithTracerProvider(provider))},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CallJob(context.Background(), "pool.scrub.run", []any{"tank"}, nil))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "system.version", spans[0].Name())
+	assert.Equal(t, "pool.scrub.run", spans[1].Name())
+	assert.Equal(t, "core.get_jobs", spans[2].Name())
+
+	job := spans[1]
+	assert.Equal(t, trace.SpanKindClient, job.SpanKind())
+	attrs := attributes(job)
+	assert.Equal(t, "truenas", attrs["rpc.system"].AsString())
+	assert.Equal(t, "pool.scrub", attrs["rpc.service"].AsString())
+	assert.Equal(t, "pool.scrub.run", attrs["rpc.method"].AsString())
+	assert.Equal(t, int64(42), attrs[AttrJobID].AsInt64())
+	assert.NotContains(t, attributes(spans[2]), AttrJobID)
+}
+
+func TestInterceptor_Error(t *testing.T) {
+	t.Parallel()
+	recorder, provider := newRecorder()
+	interceptor := Interceptor(WithTracerProvider(provider))
+
+	parent, span := provider.Tracer("test").Start(context.Background(), "parent")
+	_, err := interceptor(parent, "pool.create", nil, func(ctx context.Context, method string, params []any) (json.RawMessage, error) {
+		assert.Equal(t, span.SpanContext().TraceID(), trace.SpanContextFromContext(ctx).TraceID())
+		return nil, &truenas.ErrorMsg{Code: 22, Message: "Invalid argument", Type: "EINVAL"}
+	})
+	span.End()
+	var errMsg *truenas.ErrorMsg
+	require.True(t, errors.As(err, &errMsg))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	call := spans[0]
+	assert.Equal(t, span.SpanContext().SpanID(), call.Parent().SpanID())
+	assert.Equal(t, codes.Error, call.Status().Code)
+	attrs := attributes(call)
+	assert.Equal(t, int64(22), attrs[AttrErrorCode].AsInt64())
+	assert.Equal(t, "EINVAL", attrs[AttrErrorType].AsString())
+	require.Len(t, call.Events(), 1)
+	assert.Equal(t, "exception", call.Events()[0].Name)
+}
@@ -0,0 +1,37 @@
+package truenas
+
+import "fmt"
+
+// dialPeers opens the extra connections requested by Options.Connections. Each peer
+// is a client of its own that authenticates and reconnects independently.
+func (c *Client) dialPeers() error {
+	opts := c.opts
+	opts.Connections = 1
+	opts.Interceptors = nil
+	opts.RateLimit = nil
+	opts.DisableJobEvents = true
+	opts.OnConnect, opts.OnDisconnect, opts.OnReconnect = nil, nil, nil
+
+	for i := 1; i < c.opts.Connections; i++ {
+		peer, err := newClient(c.url, opts, c)
+		if err != nil {
+			return fmt.Errorf("connection %d: %w", i+1, err)
+		}
+		c.peers = append(c.peers, peer)
+	}
+	return nil
+}
+
+// pick returns the connected client with the fewest calls in flight, preferring this one
+func (c *Client) pick() *Client {
+	best := c
+	for _, peer := range c.peers {
+		if peer.State() != StateConnected {
+			continue
+		}
+		if best.State() != StateConnected || peer.pending.Size() < best.pending.Size() {
+			best = peer
+		}
+	}
+	return best
+}
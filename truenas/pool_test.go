@@ -0,0 +1,48 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Connections(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithConnectionTracking(), WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "filesystem.slow" {
+			time.Sleep(time.Second)
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:    "test",
+		Password:    "test",
+		Connections: 3,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	server.connMutex.Lock()
+	assert.Len(t, server.connections, 3)
+	server.connMutex.Unlock()
+
+	slow := make(chan error, 1)
+	go func() {
+		slow <- client.Call(NewTestContext(t), "filesystem.slow", nil, nil)
+	}()
+	require.Eventually(t, func() bool { return client.pending.Size() == 1 }, time.Second, time.Millisecond)
+
+	// Calls avoid the connection busy with the slow call
+	start := time.Now()
+	require.NoError(t, client.Call(NewTestContext(t), "system.ready", nil, nil))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+	require.NoError(t, <-slow)
+
+	require.NoError(t, client.Close())
+	assert.Equal(t, StateClosed, client.peers[0].State())
+}
@@ -0,0 +1,194 @@
+// Package prometheus provides Prometheus metrics for TrueNAS client operations.
+//
+// Create a collector, install its interceptor when creating the client, then
+// add the client and register the collector:
+//
+//	metrics := prometheus.NewCollector()
+//	client, err := truenas.NewClient(endpoint, truenas.Options{
+//		APIKey:       apiKey,
+//		Interceptors: []truenas.Interceptor{metrics.Interceptor()},
+//	})
+//	metrics.AddClient(client)
+//	registry.MustRegister(metrics)
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/715d/go-truenas/truenas"
+)
+
+const namespace = "truenas_client"
+
+// Call status label values
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+// Collector records call counts, call latency, in-flight calls, job durations,
+// reconnects and rate limiting. It implements prometheus.Collector.
+type Collector struct {
+	calls            *prometheus.CounterVec
+	duration         *prometheus.HistogramVec
+	inFlight         prometheus.Gauge
+	jobDuration      *prometheus.HistogramVec
+	reconnects       prometheus.CounterFunc
+	rateLimitWaiting prometheus.GaugeFunc
+	rateLimited      prometheus.CounterFunc
+
+	mu      sync.Mutex
+	clients []*truenas.Client
+	jobs    map[int]startedJob // Jobs started through the interceptor, keyed by job ID
+}
+
+// startedJob is a job whose completion has not been observed yet
+type startedJob struct {
+	method  string
+	started time.Time
+}
+
+// NewCollector creates a collector with the default histogram buckets
+func NewCollector() *Collector {
+	c := &Collector{
+		calls: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "calls_total",
+			Help:      "Number of calls by method and status.",
+		}, []string{"method", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "call_duration_seconds",
+			Help:      "Latency of calls by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		inFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "calls_in_flight",
+			Help:      "Number of calls awaiting a reply.",
+		}),
+		jobDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "job_duration_seconds",
+			Help:      "Time from starting a job until it was seen to finish, by method and final state.",
+			Buckets:   prometheus.ExponentialBuckets(0.5, 2, 14),
+		}, []string{"method", "state"}),
+		jobs: map[int]startedJob{},
+	}
+	c.reconnects = prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reconnects_total",
+		Help:      "Number of successful reconnects of the added clients.",
+	}, c.sumStats(func(s truenas.Stats) int64 { return s.Reconnects }))
+	c.rateLimitWaiting = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "rate_limit_waiting",
+		Help:      "Number of calls of the added clients waiting for their rate limit.",
+	}, c.sumStats(func(s truenas.Stats) int64 { return s.RateLimitWaiting }))
+	c.rateLimited = prometheus.NewCounterFunc(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_total",
+		Help:      "Number of calls of the added clients delayed by their rate limit.",
+	}, c.sumStats(func(s truenas.Stats) int64 { return s.RateLimited }))
+	return c
+}
+
+// AddClient includes the client's reconnects and rate limiting in the collector's metrics
+func (c *Collector) AddClient(client *truenas.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.clients = append(c.clients, client)
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.calls.Describe(ch)
+	c.duration.Describe(ch)
+	c.inFlight.Describe(ch)
+	c.jobDuration.Describe(ch)
+	c.reconnects.Describe(ch)
+	c.rateLimitWaiting.Describe(ch)
+	c.rateLimited.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.calls.Collect(ch)
+	c.duration.Collect(ch)
+	c.inFlight.Collect(ch)
+	c.jobDuration.Collect(ch)
+	c.reconnects.Collect(ch)
+	c.rateLimitWaiting.Collect(ch)
+	c.rateLimited.Collect(ch)
+}
+
+// Interceptor returns a truenas.Interceptor that records the collector's call and job metrics.
+// Job durations are observed when polling core.get_jobs reports a job started through it as finished.
+func (c *Collector) Interceptor() truenas.Interceptor {
+	return func(ctx context.Context, method string, params []any, invoke truenas.Invoker) (json.RawMessage, error) {
+		c.inFlight.Inc()
+		start := time.Now()
+		result, err := invoke(ctx, method, params)
+		c.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+		c.inFlight.Dec()
+
+		if err != nil {
+			c.calls.WithLabelValues(method, StatusError).Inc()
+			return result, err
+		}
+		c.calls.WithLabelValues(method, StatusOK).Inc()
+
+		switch {
+		case truenas.IsJobCall(ctx):
+			c.jobStarted(method, start, result)
+		case method == "core.get_jobs":
+			c.jobsPolled(result)
+		}
+		return result, nil
+	}
+}
+
+func (c *Collector) jobStarted(method string, start time.Time, result json.RawMessage) {
+	var jobID int
+	if json.Unmarshal(result, &jobID) != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.jobs[jobID] = startedJob{method: method, started: start}
+}
+
+func (c *Collector) jobsPolled(result json.RawMessage) {
+	var jobs []truenas.Job
+	if json.Unmarshal(result, &jobs) != nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, job := range jobs {
+		started, ok := c.jobs[job.ID]
+		if !ok || !job.IsCompleted() {
+			continue
+		}
+		delete(c.jobs, job.ID)
+		c.jobDuration.WithLabelValues(started.method, job.State).Observe(time.Since(started.started).Seconds())
+	}
+}
+
+// sumStats returns a function totalling a statistic of the added clients
+func (c *Collector) sumStats(stat func(truenas.Stats) int64) func() float64 {
+	return func() float64 {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		var total int64
+		for _, client := range c.clients {
+			total += stat(client.Stats())
+		}
+		return float64(total)
+	}
+}
@@ -0,0 +1,80 @@
+package prometheus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/715d/go-truenas/truenas"
+)
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2.0/system/version", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`"TrueNAS-SCALE-24.04.1"`))
+	})
+	mux.HandleFunc("GET /api/v2.0/pool", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"message": "Not authorized", "errno": 13}`, http.StatusForbidden)
+	})
+	mux.HandleFunc("POST /api/v2.0/pool/scrub/run", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`42`))
+	})
+	mux.HandleFunc("GET /api/v2.0/core/get_jobs", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`[{"id": 42, "method": "pool.scrub.run", "state": "SUCCESS", "result": null}]`))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	metrics := NewCollector()
+	client, err := truenas.NewClient(server.URL, truenas.Options{
+		APIKey:       "key",
+		Transport:    truenas.TransportREST,
+		Interceptors: []truenas.Interceptor{metrics.Interceptor()},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+	metrics.AddClient(client)
+
+	registry := prometheus.NewPedanticRegistry()
+	require.NoError(t, registry.Register(metrics))
+
+	ctx := context.Background()
+	_, err = client.Pool.List(ctx)
+	require.Error(t, err)
+	require.NoError(t, client.CallJob(ctx, "pool.scrub.run", []any{"tank"}, nil))
+
+	err = testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP truenas_client_calls_total Number of calls by method and status.
+# TYPE truenas_client_calls_total counter
+truenas_client_calls_total{method="core.get_jobs",status="ok"} 1
+truenas_client_calls_total{method="pool.query",status="error"} 1
+truenas_client_calls_total{method="pool.scrub.run",status="ok"} 1
+truenas_client_calls_total{method="system.version",status="ok"} 1
+# HELP truenas_client_calls_in_flight Number of calls awaiting a reply.
+# TYPE truenas_client_calls_in_flight gauge
+truenas_client_calls_in_flight 0
+# HELP truenas_client_reconnects_total Number of successful reconnects of the added clients.
+# TYPE truenas_client_reconnects_total counter
+truenas_client_reconnects_total 0
+# HELP truenas_client_rate_limit_waiting Number of calls of the added clients waiting for their rate limit.
+# TYPE truenas_client_rate_limit_waiting gauge
+truenas_client_rate_limit_waiting 0
+# HELP truenas_client_rate_limited_total Number of calls of the added clients delayed by their rate limit.
+# TYPE truenas_client_rate_limited_total counter
+truenas_client_rate_limited_total 0
+`), "truenas_client_calls_total", "truenas_client_calls_in_flight", "truenas_client_reconnects_total",
+		"truenas_client_rate_limit_waiting", "truenas_client_rate_limited_total")
+	assert.NoError(t, err)
+
+	assert.Equal(t, 4, testutil.CollectAndCount(metrics, "truenas_client_call_duration_seconds"))
+	assert.Equal(t, 1, testutil.CollectAndCount(metrics, "truenas_client_job_duration_seconds"))
+	assert.Empty(t, metrics.jobs)
+}
@@ -0,0 +1,306 @@
+package truenas
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"path"
+)
+
+// ProvisionStep is a step of provisioning a share, reported to a ProvisionProgressFunc
+type ProvisionStep string
+
+const (
+	ProvisionStepCreateDataset  ProvisionStep = "CREATE_DATASET"
+	ProvisionStepSetACL         ProvisionStep = "SET_ACL"
+	ProvisionStepSetOwner       ProvisionStep = "SET_OWNER"
+	ProvisionStepCreateShare    ProvisionStep = "CREATE_SHARE"
+	ProvisionStepEnableService  ProvisionStep = "ENABLE_SERVICE"
+	ProvisionStepRestartService ProvisionStep = "RESTART_SERVICE"
+	ProvisionStepVerify         ProvisionStep = "VERIFY"
+)
+
+// ProvisionProgressFunc is called as each step of provisioning a share starts
+type ProvisionProgressFunc func(step ProvisionStep)
+
+// SMBProvisionRequest describes a dataset and SMB share created by SharingSMBClient.Provision
+type SMBProvisionRequest struct {
+	Dataset        string         // Dataset to create, such as "tank/shares/media"
+	Name           string         // Share name, the last component of Dataset if empty
+	Comment        string         // Share description
+	Purpose        SMBPurpose     // Share preset, DEFAULT_SHARE if empty
+	ACL            DefaultACLType // ACL template applied to the dataset, OPEN if empty
+	RestartService bool           // Restart the SMB service if it is already running
+}
+
+// SMBProvisionResult holds what SharingSMBClient.Provision created
+type SMBProvisionResult struct {
+	Dataset *Dataset
+	Share   *SMBShare
+	Service *Service
+}
+
+// Provision creates a dataset for SMB, applies the SMB preset of an ACL template to it,
+// shares it, and enables and starts the SMB service, as the UI's share wizard does
+func (s *SharingSMBClient) Provision(ctx context.Context, req SMBProvisionRequest) (*SMBProvisionResult, error) {
+	return s.ProvisionWithProgress(ctx, req, nil)
+}
+
+// ProvisionWithProgress provisions an SMB share like Provision, calling fn as each step
+// starts. fn may be nil.
+//
+// If applying the ACL or creating the share fails, the new dataset is deleted again. Once
+// the share exists, it is kept if enabling or starting the service fails, and the returned
+// result holds what was created along with the error.
+func (s *SharingSMBClient) ProvisionWithProgress(ctx context.Context, req SMBProvisionRequest, fn ProvisionProgressFunc) (*SMBProvisionResult, error) {
+	if req.Dataset == "" {
+		return nil, errors.New("provision SMB share: dataset is required")
+	}
+	if req.Name == "" {
+		req.Name = path.Base(req.Dataset)
+	}
+	if req.Purpose == "" {
+		req.Purpose = SMBPurposeDefaultShare
+	}
+	if req.ACL == "" {
+		req.ACL = DefaultACLTypeOpen
+	}
+	progress := func(step ProvisionStep) {
+		if fn != nil {
+			fn(step)
+		}
+	}
+
+	var result SMBProvisionResult
+	progress(ProvisionStepCreateDataset)
+	dataset, err := s.client.Dataset.Create(ctx, &DatasetCreateRequest{
+		Name:      req.Dataset,
+		ShareType: Ptr(DatasetShareTypeSMB),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("provision SMB share: create dataset %s: %w", req.Dataset, err)
+	}
+	result.Dataset = dataset
+	mountpoint := datasetMountpoint(dataset)
+
+	progress(ProvisionStepSetACL)
+	if err := s.setShareACL(ctx, mountpoint, req.ACL); err != nil {
+		return nil, s.client.Dataset.rollback(ctx, req.Dataset, fmt.Errorf("provision SMB share: set ACL on %s: %w", mountpoint, err))
+	}
+
+	progress(ProvisionStepCreateShare)
+	share, err := s.Create(ctx, &SMBShareRequest{
+		Purpose:       req.Purpose,
+		Path:          mountpoint,
+		Name:          req.Name,
+		Comment:       req.Comment,
+		Browsable:     true,
+		ACL:           true,
+		DurableHandle: true,
+		ShadowCopy:    true,
+		Streams:       true,
+		Enabled:       true,
+	})
+	if err != nil {
+		return nil, s.client.Dataset.rollback(ctx, req.Dataset, fmt.Errorf("provision SMB share: create share %s: %w", req.Name, err))
+	}
+	result.Share = share
+
+	service, err := startShareService(ctx, s.client, "cifs", req.RestartService, progress)
+	result.Service = service
+	if err != nil {
+		return &result, fmt.Errorf("provision SMB share: %w", err)
+	}
+	return &result, nil
+}
+
+// setShareACL applies the SMB variant of an ACL template to path
+func (s *SharingSMBClient) setShareACL(ctx context.Context, path string, aclType DefaultACLType) error {
+	acl, err := s.client.Filesystem.GetDefaultACL(ctx, aclType, ShareTypeSMB)
+	if err != nil {
+		return err
+	}
+	return s.client.Filesystem.SetACL(ctx, &SetACLRequest{
+		Path:    path,
+		DACL:    acl.ACL,
+		ACLType: ACLType(acl.ACLType),
+	})
+}
+
+// rollback deletes a dataset created by a provisioning step that failed with err, and
+// returns err joined with any error deleting it
+func (d *DatasetClient) rollback(ctx context.Context, id string, err error) error {
+	if delErr := d.Delete(context.WithoutCancel(ctx), id, DatasetDeleteRequest{}); delErr != nil {
+		return errors.Join(err, fmt.Errorf("delete dataset %s: %w", id, delErr))
+	}
+	return err
+}
+
+// NFSProvisionRequest describes a dataset and NFS export created by SharingNFSClient.Provision
+type NFSProvisionRequest struct {
+	Dataset        string   // Dataset to create, such as "tank/exports/backups"
+	Comment        string   // Export description
+	Networks       []string // Networks allowed to mount the export, any if empty
+	Hosts          []string // Hosts allowed to mount the export, any if empty
+	RO             bool
+	MapRootUser    string   // User that root on clients is mapped to
+	MapRootGroup   string   // Group that root on clients is mapped to
+	MapAllUser     string   // User that every client user is mapped to
+	MapAllGroup    string   // Group that every client user is mapped to
+	Security       []string // Security flavors, such as "SYS" or "KRB5"; the server default if empty
+	RestartService bool     // Restart the NFS service if it is already running
+}
+
+// NFSProvisionResult holds what SharingNFSClient.Provision created
+type NFSProvisionResult struct {
+	Dataset *Dataset
+	Share   *NFSShare
+	Service *Service
+}
+
+// Provision creates a dataset, makes it owned by the users clients are mapped to, exports
+// it over NFS, enables and starts the NFS service, and checks that the export is active
+func (n *SharingNFSClient) Provision(ctx context.Context, req NFSProvisionRequest) (*NFSProvisionResult, error) {
+	return n.ProvisionWithProgress(ctx, req, nil)
+}
+
+// ProvisionWithProgress provisions an NFS export like Provision, calling fn as each step
+// starts. fn may be nil.
+//
+// The dataset is owned by MapAllUser and MapAllGroup, or failing those MapRootUser and
+// MapRootGroup, so that mapped clients can write to it. If setting the owner or creating
+// the export fails, the new dataset is deleted again. Once the export exists, it is kept if
+// a later step fails, and the returned result holds what was created along with the error.
+func (n *SharingNFSClient) ProvisionWithProgress(ctx context.Context, req NFSProvisionRequest, fn ProvisionProgressFunc) (*NFSProvisionResult, error) {
+	if req.Dataset == "" {
+		return nil, errors.New("provision NFS export: dataset is required")
+	}
+	if req.Security == nil {
+		req.Security = []string{}
+	}
+	progress := func(step ProvisionStep) {
+		if fn != nil {
+			fn(step)
+		}
+	}
+
+	var result NFSProvisionResult
+	progress(ProvisionStepCreateDataset)
+	dataset, err := n.client.Dataset.Create(ctx, &DatasetCreateRequest{Name: req.Dataset})
+	if err != nil {
+		return nil, fmt.Errorf("provision NFS export: create dataset %s: %w", req.Dataset, err)
+	}
+	result.Dataset = dataset
+	mountpoint := datasetMountpoint(dataset)
+
+	owner, group := cmp.Or(req.MapAllUser, req.MapRootUser), cmp.Or(req.MapAllGroup, req.MapRootGroup)
+	if owner != "" || group != "" {
+		progress(ProvisionStepSetOwner)
+		if err := n.setOwner(ctx, mountpoint, owner, group); err != nil {
+			return nil, n.client.Dataset.rollback(ctx, req.Dataset, fmt.Errorf("provision NFS export: set owner of %s: %w", mountpoint, err))
+		}
+	}
+
+	progress(ProvisionStepCreateShare)
+	share, err := n.Create(ctx, &NFSShareRequest{
+		Path:         mountpoint,
+		Comment:      req.Comment,
+		Networks:     req.Networks,
+		Hosts:        req.Hosts,
+		RO:           req.RO,
+		MapRootUser:  optional(req.MapRootUser),
+		MapRootGroup: optional(req.MapRootGroup),
+		MapAllUser:   optional(req.MapAllUser),
+		MapAllGroup:  optional(req.MapAllGroup),
+		Security:     req.Security,
+		Enabled:      true,
+	})
+	if err != nil {
+		return nil, n.client.Dataset.rollback(ctx, req.Dataset, fmt.Errorf("provision NFS export: create export of %s: %w", mountpoint, err))
+	}
+	result.Share = share
+
+	service, err := startShareService(ctx, n.client, "nfs", req.RestartService, progress)
+	result.Service = service
+	if err != nil {
+		return &result, fmt.Errorf("provision NFS export: %w", err)
+	}
+
+	// The middleware has no method listing the exports in effect, so check what would keep
+	// the export out of them: the service not running or the dataset being locked.
+	progress(ProvisionStepVerify)
+	share, err = n.Get(ctx, share.ID)
+	if err != nil {
+		return &result, fmt.Errorf("provision NFS export: verify export %d: %w", result.Share.ID, err)
+	}
+	result.Share = share
+	switch {
+	case service.State != ServiceStateRunning:
+		return &result, fmt.Errorf("provision NFS export: nfs service is %s", service.State)
+	case share.Locked:
+		return &result, fmt.Errorf("provision NFS export: export of %s is inactive because its dataset is locked", share.Path)
+	}
+	return &result, nil
+}
+
+// setOwner changes the owner of path to the named user and group. Either may be empty.
+func (n *SharingNFSClient) setOwner(ctx context.Context, path, user, group string) error {
+	req := &ChownRequest{Path: path}
+	if user != "" {
+		u, err := n.client.User.GetByUsername(ctx, user)
+		if err != nil {
+			return err
+		}
+		req.UID = &u.UID
+	}
+	if group != "" {
+		g, err := n.client.Group.GetByName(ctx, group)
+		if err != nil {
+			return err
+		}
+		req.GID = &g.GID
+	}
+	return n.client.Filesystem.ChangeOwner(ctx, req)
+}
+
+// optional returns a pointer to s, or nil if s is empty
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// startShareService enables a sharing service to start on boot and starts it, or restarts it
+// if it is already running and restart is set
+func startShareService(ctx context.Context, c *Client, name string, restart bool, progress ProvisionProgressFunc) (*Service, error) {
+	progress(ProvisionStepEnableService)
+	service, err := c.Service.SetEnabled(ctx, name, true)
+	if err != nil {
+		return nil, fmt.Errorf("enable %s service: %w", name, err)
+	}
+	switch {
+	case service.State != ServiceStateRunning:
+		if err := c.Service.Start(ctx, name); err != nil {
+			return service, fmt.Errorf("start %s service: %w", name, err)
+		}
+	case restart:
+		progress(ProvisionStepRestartService)
+		if err := c.Service.Restart(ctx, name); err != nil {
+			return service, fmt.Errorf("restart %s service: %w", name, err)
+		}
+	default:
+		return service, nil
+	}
+	return c.Service.GetByName(ctx, name)
+}
+
+// datasetMountpoint returns where a dataset is mounted, assuming the default of
+// /mnt/<name> if it does not say
+func datasetMountpoint(d *Dataset) string {
+	if mountpoint, ok := d.Mountpoint.(string); ok && mountpoint != "" {
+		return mountpoint
+	}
+	return "/mnt/" + d.Name
+}
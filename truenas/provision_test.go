@@ -0,0 +1,244 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSMBDefaultACL = ACL{
+	ACLType: "NFS4",
+	ACL: []ACLEntry{{
+		Tag:   "builtin_users",
+		Type:  "ALLOW",
+		Perms: ACLPerms{Basic: "MODIFY"},
+		Flags: ACLFlags{Basic: "INHERIT"},
+	}},
+}
+
+func setSMBProvisionResponses(server *TestServer, state string) {
+	server.SetResponse("pool.dataset.create", Dataset{ID: "tank/media", Name: "tank/media", Mountpoint: "/mnt/tank/media"})
+	server.SetResponse("filesystem.get_default_acl", testSMBDefaultACL)
+	server.SetJobResponse("filesystem.setacl", nil)
+	server.SetResponse("sharing.smb.create", SMBShare{ID: 3, Name: "media", Path: "/mnt/tank/media", Enabled: true})
+	server.SetResponse("service.query", []Service{{ID: 4, Service: "cifs", Enable: false, State: state}})
+	server.SetResponse("service.update", Service{ID: 4, Service: "cifs", Enable: true, State: state})
+	server.SetResponse("service.start", true)
+	server.SetResponse("service.restart", true)
+	server.SetResponse("pool.dataset.delete", true)
+}
+
+func TestSharingSMBClient_Provision(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setSMBProvisionResponses(server, ServiceStateStopped)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	var steps []ProvisionStep
+	result, err := client.Sharing.SMB.ProvisionWithProgress(NewTestContext(t), SMBProvisionRequest{
+		Dataset: "tank/media",
+		Comment: "Media library",
+	}, func(step ProvisionStep) { steps = append(steps, step) })
+	require.NoError(t, err)
+	assert.Equal(t, "tank/media", result.Dataset.ID)
+	assert.Equal(t, 3, result.Share.ID)
+	require.NotNil(t, result.Service)
+	assert.Equal(t, []ProvisionStep{ProvisionStepCreateDataset, ProvisionStepSetACL, ProvisionStepCreateShare, ProvisionStepEnableService}, steps)
+
+	server.AssertCalled(t, "pool.dataset.create", DatasetCreateRequest{Name: "tank/media", ShareType: Ptr(DatasetShareTypeSMB)})
+	server.AssertCalled(t, "filesystem.get_default_acl", "OPEN", "SMB")
+	server.AssertCalled(t, "filesystem.setacl", "/mnt/tank/media", nil, nil, testSMBDefaultACL.ACL, nil, "NFS4", SetACLOptions{})
+	server.AssertCalled(t, "sharing.smb.create", SMBShareRequest{
+		Purpose:       SMBPurposeDefaultShare,
+		Path:          "/mnt/tank/media",
+		Name:          "media",
+		Comment:       "Media library",
+		Browsable:     true,
+		ACL:           true,
+		DurableHandle: true,
+		ShadowCopy:    true,
+		Streams:       true,
+		Enabled:       true,
+	})
+	server.AssertCalled(t, "service.update", 4, ServiceUpdateRequest{Enable: true})
+	server.AssertCalled(t, "service.start", "cifs")
+	server.AssertNotCalled(t, "service.restart")
+	server.AssertNotCalled(t, "pool.dataset.delete")
+}
+
+func TestSharingSMBClient_Provision_Restart(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setSMBProvisionResponses(server, ServiceStateRunning)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Sharing.SMB.Provision(ctx, SMBProvisionRequest{Dataset: "tank/media"})
+	require.NoError(t, err)
+	server.AssertNotCalled(t, "service.start")
+	server.AssertNotCalled(t, "service.restart")
+
+	_, err = client.Sharing.SMB.Provision(ctx, SMBProvisionRequest{Dataset: "tank/media", RestartService: true})
+	require.NoError(t, err)
+	server.AssertCalled(t, "service.restart", "cifs")
+}
+
+func TestSharingSMBClient_Provision_RollsBackDataset(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setSMBProvisionResponses(server, ServiceStateStopped)
+	server.SetError("sharing.smb.create", 22, "Share name already exists")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	result, err := client.Sharing.SMB.Provision(NewTestContext(t), SMBProvisionRequest{Dataset: "tank/media"})
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "create share media")
+
+	var apiErr *ErrorMsg
+	assert.ErrorAs(t, err, &apiErr)
+	server.AssertCalled(t, "pool.dataset.delete", "tank/media", DatasetDeleteRequest{})
+	server.AssertNotCalled(t, "service.update")
+}
+
+func TestSharingSMBClient_Provision_KeepsShare(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setSMBProvisionResponses(server, ServiceStateStopped)
+	server.SetError("service.start", 500, "smbd failed to start")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	result, err := client.Sharing.SMB.Provision(NewTestContext(t), SMBProvisionRequest{Dataset: "tank/media"})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "start cifs service")
+	require.NotNil(t, result)
+	assert.Equal(t, 3, result.Share.ID)
+	server.AssertNotCalled(t, "pool.dataset.delete")
+}
+
+func TestSharingSMBClient_Provision_RequiresDataset(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Sharing.SMB.Provision(NewTestContext(t), SMBProvisionRequest{})
+	require.Error(t, err)
+	server.AssertNotCalled(t, "pool.dataset.create")
+}
+
+func setNFSProvisionResponses(server *TestServer, state string) {
+	server.SetResponse("pool.dataset.create", Dataset{ID: "tank/backups", Name: "tank/backups", Mountpoint: "/mnt/tank/backups"})
+	server.SetResponse("user.query", []User{{ID: 40, UID: 3000, Username: "backup"}})
+	server.SetResponse("group.query", []Group{{ID: 41, GID: 3001, Name: "backup"}})
+	server.SetJobResponse("filesystem.chown", nil)
+	server.SetResponse("sharing.nfs.create", NFSShare{ID: 5, Path: "/mnt/tank/backups", Enabled: true})
+	server.SetResponse("sharing.nfs.query", []NFSShare{{ID: 5, Path: "/mnt/tank/backups", Enabled: true}})
+	server.SetResponse("service.query", []Service{{ID: 6, Service: "nfs", State: state}})
+	server.SetResponse("service.update", Service{ID: 6, Service: "nfs", Enable: true, State: state})
+	server.SetResponse("service.start", true)
+	server.SetResponse("pool.dataset.delete", true)
+}
+
+func TestSharingNFSClient_Provision(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setNFSProvisionResponses(server, ServiceStateRunning)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	var steps []ProvisionStep
+	result, err := client.Sharing.NFS.ProvisionWithProgress(NewTestContext(t), NFSProvisionRequest{
+		Dataset:     "tank/backups",
+		Networks:    []string{"10.0.0.0/24"},
+		MapAllUser:  "backup",
+		MapAllGroup: "backup",
+	}, func(step ProvisionStep) { steps = append(steps, step) })
+	require.NoError(t, err)
+	assert.Equal(t, 5, result.Share.ID)
+	assert.Equal(t, "/mnt/tank/backups", result.Share.Path)
+	assert.Equal(t, []ProvisionStep{ProvisionStepCreateDataset, ProvisionStepSetOwner, ProvisionStepCreateShare, ProvisionStepEnableService, ProvisionStepVerify}, steps)
+
+	server.AssertCalled(t, "pool.dataset.create", DatasetCreateRequest{Name: "tank/backups"})
+	server.AssertCalled(t, "filesystem.chown", "/mnt/tank/backups", 3000, 3001, ChownOptions{})
+	server.AssertCalled(t, "sharing.nfs.create", NFSShareRequest{
+		Path:        "/mnt/tank/backups",
+		Networks:    []string{"10.0.0.0/24"},
+		MapAllUser:  Ptr("backup"),
+		MapAllGroup: Ptr("backup"),
+		Security:    []string{},
+		Enabled:     true,
+	})
+	server.AssertNotCalled(t, "service.start")
+}
+
+func TestSharingNFSClient_Provision_NoMapping(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setNFSProvisionResponses(server, ServiceStateStopped)
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	// The service is reported stopped even after starting it
+	result, err := client.Sharing.NFS.Provision(NewTestContext(t), NFSProvisionRequest{Dataset: "tank/backups"})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "nfs service is STOPPED")
+	require.NotNil(t, result)
+	assert.Equal(t, 5, result.Share.ID)
+	server.AssertCalled(t, "service.start", "nfs")
+	server.AssertNotCalled(t, "filesystem.chown")
+	server.AssertNotCalled(t, "user.query")
+}
+
+func TestSharingNFSClient_Provision_Locked(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setNFSProvisionResponses(server, ServiceStateRunning)
+	server.SetResponse("sharing.nfs.query", []NFSShare{{ID: 5, Path: "/mnt/tank/backups", Enabled: true, Locked: true}})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	_, err := client.Sharing.NFS.Provision(NewTestContext(t), NFSProvisionRequest{Dataset: "tank/backups"})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "dataset is locked")
+	server.AssertNotCalled(t, "pool.dataset.delete")
+}
+
+func TestSharingNFSClient_Provision_RollsBackDataset(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	setNFSProvisionResponses(server, ServiceStateRunning)
+	server.SetResponse("user.query", []User{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	result, err := client.Sharing.NFS.Provision(NewTestContext(t), NFSProvisionRequest{Dataset: "tank/backups", MapRootUser: "nobody2"})
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorContains(t, err, "set owner of /mnt/tank/backups")
+	server.AssertCalled(t, "pool.dataset.delete", "tank/backups", DatasetDeleteRequest{})
+	server.AssertNotCalled(t, "sharing.nfs.create")
+}
@@ -0,0 +1,200 @@
+package truenas
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// DefaultPageSize is the number of records ListIter methods fetch per call unless
+// the query sets its own with PageSize
+const DefaultPageSize = 500
+
+// Query describes the filters and options passed to *.query methods so that
+// filtering, sorting and paging happen on the server
+type Query struct {
+	filters  []any
+	options  QueryOptions
+	pageSize int // Records fetched per call by ListIter methods
+}
+
+// QueryOptions represents the options accepted by *.query methods
+type QueryOptions struct {
+	OrderBy []string       `json:"order_by,omitempty"`
+	Select  []string       `json:"select,omitempty"`
+	Limit   int            `json:"limit,omitempty"`
+	Offset  int            `json:"offset,omitempty"`
+	Count   bool           `json:"count,omitempty"`
+	Extra   map[string]any `json:"extra,omitempty"`
+}
+
+// NewQuery creates a query from filters and options such as OrderBy. Without
+// any it matches all records.
+//
+//	q := NewQuery(Eq("locked", false), In("uid", uids), OrderBy("-uid")).Limit(50)
+func NewQuery(parts ...QueryPart) *Query {
+	q := &Query{}
+	for _, part := range parts {
+		part.applyTo(q)
+	}
+	return q
+}
+
+// Filter adds a filter such as Filter("enabled", "=", true). Filters are combined with AND.
+func (q *Query) Filter(field, op string, value any) *Query {
+	q.filters = append(q.filters, []any{field, op, value})
+	return q
+}
+
+// Where adds filters built with Eq, In, Or and the other constructors, combined with AND
+func (q *Query) Where(filters ...Filter) *Query {
+	for _, filter := range filters {
+		filter.applyTo(q)
+	}
+	return q
+}
+
+// OrderBy sorts results by the given fields. Prefix a field with "-" to sort descending.
+func (q *Query) OrderBy(fields ...string) *Query {
+	q.options.OrderBy = append(q.options.OrderBy, fields...)
+	return q
+}
+
+// Select limits the fields returned for each record
+func (q *Query) Select(fields ...string) *Query {
+	q.options.Select = append(q.options.Select, fields...)
+	return q
+}
+
+// Limit sets the maximum number of records returned
+func (q *Query) Limit(n int) *Query {
+	q.options.Limit = n
+	return q
+}
+
+// Offset skips the first n records
+func (q *Query) Offset(n int) *Query {
+	q.options.Offset = n
+	return q
+}
+
+// PageSize sets the number of records ListIter methods fetch per call. It is not sent to the server.
+func (q *Query) PageSize(n int) *Query {
+	q.pageSize = n
+	return q
+}
+
+// Extra sets a method-specific extra option, e.g. Extra("search_dscache", true) for user.query
+func (q *Query) Extra(key string, value any) *Query {
+	if q.options.Extra == nil {
+		q.options.Extra = map[string]any{}
+	}
+	q.options.Extra[key] = value
+	return q
+}
+
+// Params returns the query as call parameters. A nil query matches all records.
+func (q *Query) Params() []any {
+	if q == nil {
+		return []any{}
+	}
+	filters, options := q.parts()
+	return []any{filters, options}
+}
+
+// parts returns the query's filters and options for methods that take them as named fields
+func (q *Query) parts() ([]any, QueryOptions) {
+	if q == nil {
+		return []any{}, QueryOptions{}
+	}
+	filters := q.filters
+	if filters == nil {
+		filters = []any{}
+	}
+	return filters, q.options
+}
+
+// withCount returns a copy of the query that asks for the number of matching records
+func (q *Query) withCount() *Query {
+	count := &Query{}
+	if q != nil {
+		count.filters = q.filters
+		count.options = q.options
+	}
+	count.options.Count = true
+	return count
+}
+
+// Count returns the number of records a query method such as "pool.dataset.query" would return for q
+func (c *Client) Count(ctx context.Context, method string, q *Query) (int, error) {
+	var result int
+	if err := c.Call(ctx, method, q.withCount().Params(), &result); err != nil {
+		return 0, fmt.Errorf("count %s: %w", method, err)
+	}
+	return result, nil
+}
+
+// query calls a *.query method with q and decodes the resulting records
+func query[T any](ctx context.Context, c *Client, method string, q *Query) ([]T, error) {
+	var result []T
+	err := c.Call(ctx, method, q.Params(), &result)
+	return result, err
+}
+
+// withFilter returns a copy of the query with an additional filter
+func (q *Query) withFilter(field, op string, value any) *Query {
+	filtered := &Query{}
+	if q != nil {
+		filtered.filters = append(filtered.filters, q.filters...)
+		filtered.options = q.options
+	}
+	return filtered.Filter(field, op, value)
+}
+
+// paginate returns an iterator over the records matching q, fetching them with list a page
+// at a time. The query's offset and limit bound the records iterated over. The first error
+// ends the iteration. Sort by a unique field, such as OrderBy("id"), so that records do not
+// move between pages while iterating.
+func paginate[T any](ctx context.Context, q *Query, list func(context.Context, *Query) ([]T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		page := &Query{}
+		if q != nil {
+			page.filters = q.filters
+			page.options = q.options
+			page.pageSize = q.pageSize
+		}
+		size := page.pageSize
+		if size <= 0 {
+			size = DefaultPageSize
+		}
+		remaining := page.options.Limit // Zero iterates over all records
+
+		for {
+			page.options.Limit = size
+			if remaining > 0 {
+				page.options.Limit = min(size, remaining)
+			}
+			records, err := list(ctx, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, record := range records {
+				if !yield(record, nil) {
+					return
+				}
+			}
+			if len(records) < page.options.Limit {
+				return
+			}
+			page.options.Offset += len(records)
+			if remaining > 0 {
+				remaining -= len(records)
+				if remaining == 0 {
+					return
+				}
+			}
+		}
+	}
+}
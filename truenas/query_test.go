@@ -0,0 +1,172 @@
+package truenas
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_Params(t *testing.T) {
+	t.Parallel()
+
+	q := NewQuery().
+		Filter("enabled", "=", true).
+		Filter("name", "^", "tank/").
+		OrderBy("-name").
+		Select("id", "name").
+		Limit(50).
+		Offset(100).
+		Extra("flat", false)
+
+	assert.JSONEq(t, `[
+		[["enabled", "=", true], ["name", "^", "tank/"]],
+		{"order_by": ["-name"], "select": ["id", "name"], "limit": 50, "offset": 100, "extra": {"flat": false}}
+	]`, tryMarshal(q.Params()))
+}
+
+func TestQuery_Params_Empty(t *testing.T) {
+	t.Parallel()
+
+	var q *Query
+	assert.Equal(t, []any{}, q.Params())
+	assert.JSONEq(t, `[[], {}]`, tryMarshal(NewQuery().Params()))
+}
+
+func TestSharingSMBClient_ListWithQuery(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "sharing.smb.query" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`[{"id": 1, "name": "media", "enabled": true}]`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	shares, err := client.Sharing.SMB.ListWithQuery(ctx, NewQuery().Filter("enabled", "=", true).Limit(50))
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+	assert.Equal(t, "media", shares[0].Name)
+
+	params := <-received
+	assert.JSONEq(t, `[[["enabled", "=", true]], {"limit": 50}]`, tryMarshal(params))
+}
+
+func TestClient_Count(t *testing.T) {
+	t.Parallel()
+	received := make(chan any, 1)
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		if msg.Method == "pool.dataset.query" {
+			received <- msg.Params
+			response.Result = json.RawMessage(`1234`)
+		}
+		return response, true
+	}))
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	q := NewQuery().Filter("pool", "=", "tank")
+	count, err := client.Count(NewTestContext(t), "pool.dataset.query", q)
+	require.NoError(t, err)
+	assert.Equal(t, 1234, count)
+	assert.JSONEq(t, `[[["pool", "=", "tank"]], {"count": true}]`, tryMarshal(<-received))
+
+	// The original query is left untouched
+	assert.False(t, q.options.Count)
+}
+
+// snapshotPages answers zfs.snapshot.query from total snapshots, honouring limit and offset
+func snapshotPages(total int) func(params []any) any {
+	return func(params []any) any {
+		options, _ := params[1].(map[string]any)
+		offset, _ := options["offset"].(float64)
+		limit, _ := options["limit"].(float64)
+		snapshots := []Snapshot{}
+		for i := int(offset); i < total && i < int(offset+limit); i++ {
+			snapshots = append(snapshots, Snapshot{ID: fmt.Sprintf("tank@snap-%d", i)})
+		}
+		return snapshots
+	}
+}
+
+func TestSnapshotClient_ListIter(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponseFunc("zfs.snapshot.query", snapshotPages(25))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	var ids []string
+	for snapshot, err := range client.Snapshot.ListIter(ctx, NewQuery().Filter("pool", "=", "tank").OrderBy("id").PageSize(10)) {
+		require.NoError(t, err)
+		ids = append(ids, snapshot.ID)
+	}
+	require.Len(t, ids, 25)
+	assert.Equal(t, "tank@snap-24", ids[24])
+
+	calls := server.Calls("zfs.snapshot.query")
+	require.Len(t, calls, 3)
+	assert.JSONEq(t, `[[["pool", "=", "tank"]], {"order_by": ["id"], "limit": 10, "offset": 20}]`, tryMarshal(calls[2].Params))
+}
+
+func TestSnapshotClient_ListIter_LimitAndBreak(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponseFunc("zfs.snapshot.query", snapshotPages(100))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	// The query's offset and limit bound the iteration, and the last page is shortened
+	ctx := NewTestContext(t)
+	count := 0
+	for snapshot, err := range client.Snapshot.ListIter(ctx, NewQuery().Offset(5).Limit(15).PageSize(10)) {
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("tank@snap-%d", count+5), snapshot.ID)
+		count++
+	}
+	assert.Equal(t, 15, count)
+	server.AssertCalled(t, "zfs.snapshot.query", []any{}, QueryOptions{Limit: 5, Offset: 15})
+
+	// Stopping early fetches no further pages
+	for range client.Snapshot.ListIter(ctx, NewQuery().PageSize(10)) {
+		break
+	}
+	assert.Equal(t, 3, server.CallCount("zfs.snapshot.query"))
+}
+
+func TestSnapshotClient_ListIter_Error(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetError("zfs.snapshot.query", 22, "Invalid filter")
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	count := 0
+	for snapshot, err := range client.Snapshot.ListIter(NewTestContext(t), nil) {
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Invalid filter")
+		assert.Empty(t, snapshot.ID)
+		count++
+	}
+	assert.Equal(t, 1, count)
+	server.AssertCalled(t, "zfs.snapshot.query", []any{}, QueryOptions{Limit: DefaultPageSize})
+}
@@ -0,0 +1,111 @@
+package truenas
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RateLimit limits how fast a client sends calls with a token bucket, so that mass
+// operations such as creating thousands of datasets do not overwhelm the middleware.
+// Calls over the limit wait for a token or for their context to be done.
+type RateLimit struct {
+	Rate  float64 // Calls per second; zero or less disables the limit
+	Burst int     // Calls that may be sent at once after an idle period, defaults to 1
+	// Methods gives methods their own bucket instead of the client's. A nil value
+	// exempts the method, such as core.get_jobs when polling many jobs. Methods of
+	// the nested limits are ignored.
+	Methods map[string]*RateLimit
+}
+
+// rateLimiter holds the token buckets of Options.RateLimit
+type rateLimiter struct {
+	all     *tokenBucket
+	methods map[string]*tokenBucket // A nil bucket exempts the method
+	waiting atomic.Int64            // Calls waiting for a token
+	limited atomic.Int64            // Calls that had to wait for a token
+}
+
+func newRateLimiter(limit *RateLimit) *rateLimiter {
+	if limit == nil {
+		return nil
+	}
+	r := &rateLimiter{all: newTokenBucket(limit), methods: map[string]*tokenBucket{}}
+	for method, l := range limit.Methods {
+		r.methods[method] = newTokenBucket(l)
+	}
+	return r
+}
+
+// wait blocks until the call may be sent or ctx is done
+func (r *rateLimiter) wait(ctx context.Context, method string) error {
+	if r == nil {
+		return nil
+	}
+	bucket, ok := r.methods[method]
+	if !ok {
+		bucket = r.all
+	}
+	if bucket == nil {
+		return nil
+	}
+	delay := bucket.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	r.limited.Add(1)
+	r.waiting.Add(1)
+	defer r.waiting.Add(-1)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		bucket.release()
+		return ctx.Err()
+	}
+}
+
+// tokenBucket refills at rate tokens per second up to burst tokens
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full bucket for limit, or nil if it does not limit calls
+func newTokenBucket(limit *RateLimit) *tokenBucket {
+	if limit == nil || limit.Rate <= 0 {
+		return nil
+	}
+	burst := float64(max(limit.Burst, 1))
+	return &tokenBucket{rate: limit.Rate, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// reserve takes a token and returns how long to wait until it is available. Tokens
+// may go negative, so that waiting calls are spaced out in the order they arrived.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if now.After(b.last) {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+		b.last = now
+	}
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// release returns a token reserved by a call that gave up waiting for it
+func (b *tokenBucket) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = min(b.burst, b.tokens+1)
+}
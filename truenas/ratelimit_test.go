@@ -0,0 +1,96 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	t.Parallel()
+	bucket := newTokenBucket(&RateLimit{Rate: 10, Burst: 2})
+	now := bucket.last
+
+	// The burst is available at once, then tokens are spaced 100ms apart
+	assert.Zero(t, bucket.reserve(now))
+	assert.Zero(t, bucket.reserve(now))
+	assert.Equal(t, 100*time.Millisecond, bucket.reserve(now))
+	assert.Equal(t, 200*time.Millisecond, bucket.reserve(now))
+
+	// A call that gives up returns its token
+	bucket.release()
+	assert.Equal(t, 200*time.Millisecond, bucket.reserve(now))
+
+	// Refilling stops at the burst
+	assert.Zero(t, bucket.reserve(now.Add(10*time.Second)))
+	assert.Zero(t, bucket.reserve(now.Add(10*time.Second)))
+	assert.Equal(t, 100*time.Millisecond, bucket.reserve(now.Add(10*time.Second)))
+
+	assert.Nil(t, newTokenBucket(&RateLimit{}))
+	assert.Nil(t, newTokenBucket(nil))
+}
+
+func TestClient_RateLimit(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		RateLimit: &RateLimit{
+			Rate:  20,
+			Burst: 1,
+			Methods: map[string]*RateLimit{
+				"core.get_jobs": nil,
+				"pool.query":    {Rate: 1000, Burst: 10},
+			},
+		},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// Exempt methods and methods with their own bucket are not held up
+	ctx := NewTestContext(t)
+	start := time.Now()
+	for range 5 {
+		require.NoError(t, client.Call(ctx, "core.get_jobs", nil, nil))
+		require.NoError(t, client.Call(ctx, "pool.query", nil, nil))
+	}
+	assert.Less(t, time.Since(start), 50*time.Millisecond)
+
+	// Other methods share the client's bucket, 50ms apart once its burst is spent
+	start = time.Now()
+	for range 4 {
+		require.NoError(t, client.Call(ctx, "system.info", nil, nil))
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 140*time.Millisecond)
+	assert.GreaterOrEqual(t, client.Stats().RateLimited, int64(3))
+	assert.Zero(t, client.Stats().RateLimitWaiting)
+}
+
+func TestClient_RateLimit_ContextDone(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		RateLimit: &RateLimit{Rate: 0.1},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// system.version at connect used the only token
+	ctx, cancel := context.WithCancel(NewTestContext(t))
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- client.Call(ctx, "system.info", nil, nil)
+	}()
+	require.Eventually(t, func() bool { return client.Stats().RateLimitWaiting == 1 }, time.Second, time.Millisecond)
+	cancel()
+	require.ErrorIs(t, <-errCh, context.Canceled)
+	assert.Zero(t, client.Stats().RateLimitWaiting)
+	assert.Equal(t, 1, server.CallCount("system.version"))
+	assert.Zero(t, server.CallCount("system.info"))
+}
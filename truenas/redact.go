@@ -0,0 +1,191 @@
+package truenas
+
+import (
+	"encoding/json"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// redacted replaces secret values in logged params
+const redacted = "[REDACTED]"
+
+var (
+	redactMu sync.RWMutex
+
+	// secretParams lists the positional params of methods that are secrets
+	secretParams = map[string][]int{
+		"auth.login":                     {1},
+		"auth.login_with_api_key":        {0},
+		"auth.login_with_token":          {0},
+		"auth.check_password":            {1},
+		"auth.check_user":                {1},
+		"auth.two_factor_auth":           {1},
+		"user.set_root_password":         {0},
+		"user.setup_local_administrator": {1},
+	}
+
+	// secretFields lists object fields that are secrets in the params of a method, in
+	// addition to those matched by isSecretField. The empty method applies to all methods.
+	secretFields = map[string][]string{
+		"kerberos.keytab.create": {"file"},
+		"kerberos.keytab.update": {"file"},
+		"ups.update":             {"monpwd"},
+	}
+
+	// secretResults lists methods whose whole result is a secret
+	secretResults = map[string]bool{
+		"auth.generate_token": true,
+	}
+)
+
+// urlTokenPattern matches the auth_token query param of a URL, such as the download URL
+// returned by core.download
+var urlTokenPattern = regexp.MustCompile(`(auth_token=)[^&#\s"]+`)
+
+// RegisterSecretParams marks positional params of method as secrets, so that they are
+// redacted from logs. Methods the client knows, such as auth.login, are registered already.
+func RegisterSecretParams(method string, positions ...int) {
+	redactMu.Lock()
+	defer redactMu.Unlock()
+	secretParams[method] = append(secretParams[method], positions...)
+}
+
+// RegisterSecretFields marks object fields in the params of method as secrets, so that
+// they are redacted from logs wherever they appear. An empty method marks them for all
+// methods. Fields named like passwords, passphrases, tokens and keys are redacted already.
+func RegisterSecretFields(method string, fields ...string) {
+	redactMu.Lock()
+	defer redactMu.Unlock()
+	for _, field := range fields {
+		secretFields[method] = append(secretFields[method], strings.ToLower(field))
+	}
+}
+
+// RegisterSecretResult marks the result of method as a secret, so that it is redacted from
+// logs. Methods the client knows, such as auth.generate_token, are registered already.
+func RegisterSecretResult(method string) {
+	redactMu.Lock()
+	defer redactMu.Unlock()
+	secretResults[method] = true
+}
+
+// isSecretField reports whether an object field name holds a secret
+func isSecretField(name string, extra []string) bool {
+	name = strings.ToLower(name)
+	switch name {
+	case "key", "api_key", "apikey", "pass", "privatekey", "private_key", "bindpw":
+		return true
+	}
+	for _, part := range []string{"password", "passphrase", "secret", "token", "private"} {
+		if strings.Contains(name, part) {
+			return true
+		}
+	}
+	return slices.Contains(extra, name)
+}
+
+// RedactParams returns a copy of params suitable for logging, with secret positional
+// params and secret object fields replaced by "[REDACTED]". Interceptors that log or
+// export params should pass them through it.
+func RedactParams(method string, params []any) any {
+	if len(params) == 0 {
+		return params
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return redacted
+	}
+	var args []any
+	if err := unmarshalJSON(b, &args); err != nil {
+		return redacted
+	}
+
+	redactMu.RLock()
+	positions := secretParams[method]
+	fields := slices.Concat(secretFields[""], secretFields[method])
+	redactMu.RUnlock()
+
+	for _, i := range positions {
+		if i < len(args) {
+			args[i] = redacted
+		}
+	}
+	for i := range args {
+		args[i] = redactValue(args[i], fields)
+	}
+	return args
+}
+
+// redactMessage returns a copy of a protocol message for debug output, with secrets in its
+// params, result and collection fields replaced. method is the method the message calls or,
+// for a reply, the method of the call it answers; it may be empty if not known.
+func redactMessage(msg *Message, method string) *Message {
+	out := *msg
+	if params, ok := msg.Params.([]any); ok {
+		out.Params = RedactParams(msg.Method, params)
+	} else if msg.Params != nil {
+		out.Params = redactRaw("", json.RawMessage(tryMarshal(msg.Params)))
+	}
+	out.Result = redactRaw(method, msg.Result)
+	out.Fields = redactRaw("", msg.Fields)
+	return &out
+}
+
+// redactRaw replaces secret object fields in a JSON value, or the whole value if it is the
+// result of a method registered with RegisterSecretResult. Without a method, only fields
+// registered for all methods are added to those matched by name.
+func redactRaw(method string, raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return raw
+	}
+	redactMu.RLock()
+	secret := secretResults[method]
+	fields := slices.Concat(secretFields[""], secretFields[method])
+	redactMu.RUnlock()
+	if secret {
+		return json.RawMessage(`"` + redacted + `"`)
+	}
+	b, err := json.Marshal(redactValue(decodeValue(string(raw)), fields))
+	if err != nil {
+		return json.RawMessage(`"` + redacted + `"`)
+	}
+	return b
+}
+
+// redactURL replaces the auth_token query param in a URL or in text containing one
+func redactURL(s string) string {
+	return urlTokenPattern.ReplaceAllString(s, "${1}"+redacted)
+}
+
+// decodeValue decodes a JSON document, returning "[REDACTED]" if it is malformed
+func decodeValue(s string) any {
+	var v any
+	if err := unmarshalJSON([]byte(s), &v); err != nil {
+		return redacted
+	}
+	return v
+}
+
+// redactValue walks a decoded JSON value and replaces non-empty strings in secret object
+// fields and the auth tokens of URLs
+func redactValue(v any, fields []string) any {
+	switch v := v.(type) {
+	case string:
+		return redactURL(v)
+	case map[string]any:
+		for key, field := range v {
+			if s, ok := field.(string); ok && s != "" && isSecretField(key, fields) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field, fields)
+			}
+		}
+	case []any:
+		for i := range v {
+			v[i] = redactValue(v[i], fields)
+		}
+	}
+	return v
+}
@@ -0,0 +1,83 @@
+package truenas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactParams(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		method string
+		params []any
+		want   any
+	}{
+		{"empty", "system.info", []any{}, []any{}},
+		{"positional", "auth.login", []any{"root", "hunter2"}, []any{"root", redacted}},
+		{"api_key", "auth.login_with_api_key", []any{"1-abc"}, []any{redacted}},
+		{
+			"fields", "cloudsync.credentials.create",
+			[]any{map[string]any{"name": "s3", "attributes": map[string]any{"access_key_id": "AKIA", "secret_access_key": "shh"}}},
+			[]any{map[string]any{"name": "s3", "attributes": map[string]any{"access_key_id": "AKIA", "secret_access_key": redacted}}},
+		},
+		{
+			"struct", "user.update",
+			[]any{4, UserUpdateRequest{Password: "hunter2", PasswordDisabled: Ptr(false)}},
+			[]any{json.Number("4"), map[string]any{"password": redacted, "password_disabled": false}},
+		},
+		{"empty_secret_kept", "user.create", []any{map[string]any{"password": ""}}, []any{map[string]any{"password": ""}}},
+		{
+			"method_fields", "kerberos.keytab.create",
+			[]any{map[string]any{"name": "host", "file": "BQIAAABH"}},
+			[]any{map[string]any{"name": "host", "file": redacted}},
+		},
+		{"method_fields_other_method", "filesystem.put", []any{"/mnt/tank/a", map[string]any{"file": "x"}}, []any{"/mnt/tank/a", map[string]any{"file": "x"}}},
+		{"bindpw", "ldap.update", []any{map[string]any{"binddn": "cn=admin", "bindpw": "s3cret"}}, []any{map[string]any{"binddn": "cn=admin", "bindpw": redacted}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RedactParams(tt.method, tt.params))
+		})
+	}
+}
+
+func TestRegisterSecrets(t *testing.T) {
+	t.Parallel()
+	RegisterSecretParams("test.redact.positional", 2)
+	RegisterSecretFields("test.redact.fields", "PIN")
+	RegisterSecretFields("", "test_redact_everywhere")
+
+	assert.Equal(t, []any{"a", "b", redacted}, RedactParams("test.redact.positional", []any{"a", "b", "c"}))
+	assert.Equal(t,
+		[]any{map[string]any{"pin": redacted, "name": "x"}},
+		RedactParams("test.redact.fields", []any{map[string]any{"pin": "1234", "name": "x"}}))
+	assert.Equal(t,
+		[]any{map[string]any{"pin": "1234"}},
+		RedactParams("test.redact.other", []any{map[string]any{"pin": "1234"}}))
+	assert.Equal(t,
+		[]any{map[string]any{"test_redact_everywhere": redacted}},
+		RedactParams("test.redact.other", []any{map[string]any{"test_redact_everywhere": "v"}}))
+
+	// Received results only know the fields registered for all methods
+	msg := redactMessage(&Message{ID: "1", Result: []byte(`{"pin": "1234", "test_redact_everywhere": "v", "token": "t"}`)}, "")
+	assert.JSONEq(t, `{"pin": "1234", "test_redact_everywhere": "[REDACTED]", "token": "[REDACTED]"}`, string(msg.Result))
+}
+
+func TestRedactMessage_Results(t *testing.T) {
+	t.Parallel()
+	msg := redactMessage(&Message{ID: "1", Result: []byte(`"tok-sekrit"`)}, "auth.generate_token")
+	assert.JSONEq(t, `"[REDACTED]"`, string(msg.Result))
+
+	msg = redactMessage(&Message{ID: "2", Result: []byte(`[7, "/_download/7?auth_token=tok-sekrit"]`)}, "core.download")
+	assert.JSONEq(t, `[7, "/_download/7?auth_token=[REDACTED]"]`, string(msg.Result))
+
+	msg = redactMessage(&Message{ID: "3", Result: []byte(`"tok-sekrit"`)}, "")
+	assert.JSONEq(t, `"tok-sekrit"`, string(msg.Result))
+
+	RegisterSecretResult("test.redact.result")
+	msg = redactMessage(&Message{ID: "4", Result: []byte(`{"id": 1}`)}, "test.redact.result")
+	assert.JSONEq(t, `"[REDACTED]"`, string(msg.Result))
+}
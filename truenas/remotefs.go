@@ -0,0 +1,271 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+// FS returns a read-only fs.FS of the tree at root on the server, such as "/mnt/tank/media",
+// so that fs.WalkDir, fs.Glob, template.ParseFS or an archive writer can read remote files.
+// Paths are stat'ed with filesystem.stat, directories listed with filesystem.listdir, and
+// files streamed with Download as they are read. Every call the FS makes is bound to ctx.
+func (f *FilesystemClient) FS(ctx context.Context, root string) fs.FS {
+	return &remoteFS{client: f, ctx: ctx, root: root}
+}
+
+var (
+	_ fs.StatFS    = (*remoteFS)(nil)
+	_ fs.ReadDirFS = (*remoteFS)(nil)
+)
+
+// remoteFS is the fs.FS returned by FilesystemClient.FS
+type remoteFS struct {
+	client *FilesystemClient
+	ctx    context.Context
+	root   string
+}
+
+// Open implements fs.FS
+func (r *remoteFS) Open(name string) (fs.File, error) {
+	info, err := r.stat("open", name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return &remoteDir{fs: r, name: name, info: info}, nil
+	}
+	return &remoteFile{fs: r, name: name, info: info}, nil
+}
+
+// Stat implements fs.StatFS
+func (r *remoteFS) Stat(name string) (fs.FileInfo, error) {
+	return r.stat("stat", name)
+}
+
+// ReadDir implements fs.ReadDirFS
+func (r *remoteFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	full, err := r.path("readdir", name)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := r.client.ListDir(r.ctx, full)
+	if err != nil {
+		return nil, pathError("readdir", name, err)
+	}
+	result := make([]fs.DirEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = dirEntryInfo(entry)
+	}
+	slices.SortFunc(result, func(a, b fs.DirEntry) int { return strings.Compare(a.Name(), b.Name()) })
+	return result, nil
+}
+
+func (r *remoteFS) stat(op, name string) (*fileInfo, error) {
+	full, err := r.path(op, name)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := r.client.Stat(r.ctx, full)
+	if err != nil {
+		return nil, pathError(op, name, err)
+	}
+	return &fileInfo{
+		name:  path.Base(full),
+		size:  stat.Size,
+		mode:  statFileMode(stat),
+		mtime: stat.Mtime,
+		sys:   stat,
+	}, nil
+}
+
+// path returns the path on the server of name, which must be valid per fs.ValidPath
+func (r *remoteFS) path(op, name string) (string, error) {
+	if !fs.ValidPath(name) {
+		return "", &fs.PathError{Op: op, Path: name, Err: fs.ErrInvalid}
+	}
+	return path.Join(r.root, name), nil
+}
+
+// pathError wraps an error of a call for name, matching fs.ErrNotExist if the path is missing
+func pathError(op, name string, err error) error {
+	if IsNotFound(err) {
+		err = fmt.Errorf("%w: %w", fs.ErrNotExist, err)
+	}
+	return &fs.PathError{Op: op, Path: name, Err: err}
+}
+
+// remoteFile is a regular file opened by remoteFS, downloaded on the first Read
+type remoteFile struct {
+	fs     *remoteFS
+	name   string
+	info   *fileInfo
+	body   *io.PipeReader
+	cancel context.CancelFunc
+	closed bool
+}
+
+// Stat implements fs.File
+func (f *remoteFile) Stat() (fs.FileInfo, error) {
+	return f.info, nil
+}
+
+// Read implements fs.File
+func (f *remoteFile) Read(p []byte) (int, error) {
+	if f.closed {
+		return 0, &fs.PathError{Op: "read", Path: f.name, Err: fs.ErrClosed}
+	}
+	if f.body == nil {
+		ctx, cancel := context.WithCancel(f.fs.ctx)
+		pr, pw := io.Pipe()
+		f.body, f.cancel = pr, cancel
+		go func() {
+			pw.CloseWithError(f.fs.client.Download(ctx, path.Join(f.fs.root, f.name), pw))
+		}()
+	}
+	n, err := f.body.Read(p)
+	if err != nil && err != io.EOF {
+		err = &fs.PathError{Op: "read", Path: f.name, Err: err}
+	}
+	return n, err
+}
+
+// Close implements fs.File, stopping a download in progress
+func (f *remoteFile) Close() error {
+	if f.closed {
+		return &fs.PathError{Op: "close", Path: f.name, Err: fs.ErrClosed}
+	}
+	f.closed = true
+	if f.body != nil {
+		f.cancel()
+		return f.body.Close()
+	}
+	return nil
+}
+
+// remoteDir is a directory opened by remoteFS, listed on the first ReadDir
+type remoteDir struct {
+	fs      *remoteFS
+	name    string
+	info    *fileInfo
+	entries []fs.DirEntry
+	listed  bool
+	closed  bool
+}
+
+// Stat implements fs.File
+func (d *remoteDir) Stat() (fs.FileInfo, error) {
+	return d.info, nil
+}
+
+// Read implements fs.File, failing as directories cannot be read
+func (d *remoteDir) Read([]byte) (int, error) {
+	return 0, &fs.PathError{Op: "read", Path: d.name, Err: errors.New("is a directory")}
+}
+
+// Close implements fs.File
+func (d *remoteDir) Close() error {
+	if d.closed {
+		return &fs.PathError{Op: "close", Path: d.name, Err: fs.ErrClosed}
+	}
+	d.closed = true
+	return nil
+}
+
+// ReadDir implements fs.ReadDirFile
+func (d *remoteDir) ReadDir(n int) ([]fs.DirEntry, error) {
+	if d.closed {
+		return nil, &fs.PathError{Op: "readdir", Path: d.name, Err: fs.ErrClosed}
+	}
+	if !d.listed {
+		entries, err := d.fs.ReadDir(d.name)
+		if err != nil {
+			return nil, err
+		}
+		d.entries, d.listed = entries, true
+	}
+	if n <= 0 {
+		entries := d.entries
+		d.entries = nil
+		return entries, nil
+	}
+	if len(d.entries) == 0 {
+		return nil, io.EOF
+	}
+	n = min(n, len(d.entries))
+	entries := d.entries[:n]
+	d.entries = d.entries[n:]
+	return entries, nil
+}
+
+// fileInfo implements fs.FileInfo and fs.DirEntry for a FilesystemStat or DirEntry, which
+// Sys returns
+type fileInfo struct {
+	name  string
+	size  int64
+	mode  fs.FileMode
+	mtime time.Time
+	sys   any
+}
+
+func (i *fileInfo) Name() string               { return i.name }
+func (i *fileInfo) Size() int64                { return i.size }
+func (i *fileInfo) Mode() fs.FileMode          { return i.mode }
+func (i *fileInfo) ModTime() time.Time         { return i.mtime }
+func (i *fileInfo) IsDir() bool                { return i.mode.IsDir() }
+func (i *fileInfo) Sys() any                   { return i.sys }
+func (i *fileInfo) Type() fs.FileMode          { return i.mode.Type() }
+func (i *fileInfo) Info() (fs.FileInfo, error) { return i, nil }
+
+func dirEntryInfo(entry DirEntry) *fileInfo {
+	mode := unixPerm(entry.Mode)
+	switch entry.Type {
+	case DirEntryTypeDirectory:
+		mode |= fs.ModeDir
+	case DirEntryTypeSymlink:
+		mode |= fs.ModeSymlink
+	case DirEntryTypeOther:
+		mode |= fs.ModeIrregular
+	}
+	return &fileInfo{name: entry.Name, size: entry.Size, mode: mode, mtime: entry.Mtime, sys: &entry}
+}
+
+func statFileMode(stat *FilesystemStat) fs.FileMode {
+	mode := unixPerm(stat.Mode)
+	switch {
+	case stat.IsDir:
+		mode |= fs.ModeDir
+	case stat.IsSymlink:
+		mode |= fs.ModeSymlink
+	case stat.IsCharDev:
+		mode |= fs.ModeDevice | fs.ModeCharDevice
+	case stat.IsBlockDev:
+		mode |= fs.ModeDevice
+	case stat.IsFIFO:
+		mode |= fs.ModeNamedPipe
+	case stat.IsSocket:
+		mode |= fs.ModeSocket
+	}
+	return mode
+}
+
+// unixPerm converts the permission bits of a Unix st_mode to an fs.FileMode
+func unixPerm(mode int) fs.FileMode {
+	perm := fs.FileMode(mode) & fs.ModePerm
+	if mode&0o4000 != 0 {
+		perm |= fs.ModeSetuid
+	}
+	if mode&0o2000 != 0 {
+		perm |= fs.ModeSetgid
+	}
+	if mode&0o1000 != 0 {
+		perm |= fs.ModeSticky
+	}
+	return perm
+}
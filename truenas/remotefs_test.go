@@ -0,0 +1,124 @@
+package truenas
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRemoteFSServer serves a tree under /mnt/tank of a directory "docs" with "a.txt" and
+// "b.md", and a file "readme.txt"
+func newRemoteFSServer(t *testing.T) *TestServer {
+	t.Helper()
+	server := NewTestServer(t)
+	mtime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	files := map[string]FilesystemStat{
+		"/mnt/tank":            {Mode: 0o755, IsDir: true, Mtime: mtime},
+		"/mnt/tank/docs":       {Mode: 0o1777, IsDir: true, Mtime: mtime},
+		"/mnt/tank/docs/a.txt": {Size: 5, Mode: 0o644, IsFile: true, Mtime: mtime},
+		"/mnt/tank/docs/b.md":  {Size: 5, Mode: 0o600, IsFile: true, Mtime: mtime},
+		"/mnt/tank/readme.txt": {Size: 5, Mode: 0o644, IsFile: true, Mtime: mtime},
+	}
+	dirs := map[string][]DirEntry{
+		"/mnt/tank": {
+			{Name: "readme.txt", Type: DirEntryTypeFile, Size: 5, Mode: 0o644, Mtime: mtime},
+			{Name: "docs", Type: DirEntryTypeDirectory, Mode: 0o1777, Mtime: mtime},
+		},
+		"/mnt/tank/docs": {
+			{Name: "b.md", Type: DirEntryTypeFile, Size: 5, Mode: 0o600, Mtime: mtime},
+			{Name: "a.txt", Type: DirEntryTypeFile, Size: 5, Mode: 0o644, Mtime: mtime},
+		},
+	}
+	server.SetResponseFunc("filesystem.stat", func(params []any) any {
+		if stat, ok := files[params[0].(string)]; ok {
+			return stat
+		}
+		return &ErrorMsg{Code: errnoENOENT, Message: "Path does not exist"}
+	})
+	server.SetResponseFunc("filesystem.listdir", func(params []any) any {
+		if entries, ok := dirs[params[0].(string)]; ok {
+			return entries
+		}
+		return &ErrorMsg{Code: errnoENOENT, Message: "Directory does not exist"}
+	})
+	server.SetDownload("filesystem.get", []byte("hello"))
+	return server
+}
+
+func TestFilesystemClient_FS(t *testing.T) {
+	t.Parallel()
+	server := newRemoteFSServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	fsys := client.Filesystem.FS(NewTestContext(t), "/mnt/tank")
+	require.NoError(t, fstest.TestFS(fsys, "readme.txt", "docs/a.txt", "docs/b.md"))
+
+	matches, err := fs.Glob(fsys, "docs/*.txt")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"docs/a.txt"}, matches)
+
+	var walked []string
+	err = fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		walked = append(walked, p)
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{".", "docs", "docs/a.txt", "docs/b.md", "readme.txt"}, walked)
+
+	info, err := fs.Stat(fsys, "docs")
+	require.NoError(t, err)
+	assert.Equal(t, "docs", info.Name())
+	assert.True(t, info.IsDir())
+	assert.Equal(t, fs.ModeDir|fs.ModeSticky|0o777, info.Mode())
+	assert.IsType(t, &FilesystemStat{}, info.Sys())
+
+	data, err := fs.ReadFile(fsys, "readme.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(data))
+	server.AssertCalled(t, "core.download", "filesystem.get", []any{"/mnt/tank/readme.txt"}, "readme.txt")
+}
+
+func TestFilesystemClient_FS_Errors(t *testing.T) {
+	t.Parallel()
+	server := newRemoteFSServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	fsys := client.Filesystem.FS(NewTestContext(t), "/mnt/tank")
+
+	_, err := fs.Stat(fsys, "missing.txt")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	var pathErr *fs.PathError
+	require.ErrorAs(t, err, &pathErr)
+	assert.Equal(t, "missing.txt", pathErr.Path)
+
+	_, err = fs.ReadDir(fsys, "missing")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+
+	stats := server.CallCount("filesystem.stat")
+	_, err = fsys.Open("../etc/passwd")
+	assert.ErrorIs(t, err, fs.ErrInvalid)
+	assert.Equal(t, stats, server.CallCount("filesystem.stat"))
+
+	dir, err := fsys.Open("docs")
+	require.NoError(t, err)
+	_, err = dir.Read(make([]byte, 1))
+	assert.Error(t, err)
+	require.NoError(t, dir.Close())
+
+	file, err := fsys.Open("readme.txt")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	_, err = file.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, fs.ErrClosed)
+	server.AssertNotCalled(t, "core.download")
+}
@@ -0,0 +1,287 @@
+package truenas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// Transport selects how the client talks to the middleware
+type Transport string
+
+const (
+	TransportWebSocket Transport = "websocket" // DDP over WebSocket, the default
+	TransportREST      Transport = "rest"      // REST v2.0 API over HTTP, for networks that block WebSockets
+)
+
+// ErrTransportUnsupported is returned for operations the selected transport cannot perform,
+// such as event subscriptions over REST
+var ErrTransportUnsupported = errors.New("not supported by the transport")
+
+// restOperators maps query filter operators to the suffixes understood by REST query strings
+var restOperators = map[string]string{
+	"=":  "",
+	"!=": "__neq",
+	">":  "__gt",
+	">=": "__gte",
+	"<":  "__lt",
+	"<=": "__lte",
+	"~":  "__regex",
+}
+
+// restRequest is an API call translated into an HTTP request against /api/v2.0
+type restRequest struct {
+	verb  string
+	path  []string // Unescaped path segments below /api/v2.0
+	query url.Values
+	body  any
+}
+
+// newRESTRequest maps a method call onto the REST v2.0 API:
+//
+//   - "<ns>.query" and "core.get_jobs" become GET /<ns> with filters in the query string
+//   - "<ns>.create" becomes POST /<ns>
+//   - "<ns>.update" becomes PUT /<ns>/id/<id>, or PUT /<ns> for config services
+//   - "<ns>.delete" becomes DELETE /<ns>/id/<id>
+//   - "<ns>.get_instance" becomes GET /<ns>/id/<id>
+//   - any other method becomes GET /<ns>/<method> without parameters, or POST with them
+//
+// Namespaces map dots to path segments, so "pool.dataset.query" is GET /pool/dataset.
+// A single parameter is sent as the request body; several are sent as a JSON array.
+func newRESTRequest(method string, params []any) (*restRequest, error) {
+	i := strings.LastIndex(method, ".")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid method name %q", method)
+	}
+	ns := strings.Split(method[:i], ".")
+	name := method[i+1:]
+
+	// Decode the parameters generically so structs and typed queries look alike.
+	var args []any
+	if len(params) > 0 {
+		b, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("marshal params: %w", err)
+		}
+		if err := unmarshalJSON(b, &args); err != nil {
+			return nil, fmt.Errorf("unmarshal params: %w", err)
+		}
+	}
+
+	switch {
+	case name == "query" || method == "core.get_jobs":
+		path := ns
+		if method == "core.get_jobs" {
+			path = []string{"core", "get_jobs"}
+		}
+		query, err := restQuery(args)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", method, err)
+		}
+		return &restRequest{verb: http.MethodGet, path: path, query: query}, nil
+	case name == "create" && len(args) == 1:
+		return &restRequest{verb: http.MethodPost, path: ns, body: args[0]}, nil
+	case name == "update" && len(args) == 1:
+		return &restRequest{verb: http.MethodPut, path: ns, body: args[0]}, nil
+	case name == "update" && len(args) == 2:
+		return &restRequest{verb: http.MethodPut, path: itemPath(ns, args[0]), body: args[1]}, nil
+	case name == "delete" && len(args) >= 1:
+		req := &restRequest{verb: http.MethodDelete, path: itemPath(ns, args[0])}
+		if len(args) == 2 {
+			req.body = args[1]
+		} else if len(args) > 2 {
+			req.body = args[1:]
+		}
+		return req, nil
+	case name == "get_instance" && len(args) >= 1:
+		return &restRequest{verb: http.MethodGet, path: itemPath(ns, args[0])}, nil
+	}
+
+	path := append(append([]string{}, ns...), name)
+	switch len(args) {
+	case 0:
+		return &restRequest{verb: http.MethodGet, path: path}, nil
+	case 1:
+		return &restRequest{verb: http.MethodPost, path: path, body: args[0]}, nil
+	default:
+		return &restRequest{verb: http.MethodPost, path: path, body: args}, nil
+	}
+}
+
+// itemPath returns the path of a single record, e.g. /pool/dataset/id/tank%2Fdata
+func itemPath(ns []string, id any) []string {
+	return append(append([]string{}, ns...), "id", fmt.Sprint(id))
+}
+
+// restQuery converts query filters and options into REST query string parameters.
+// Only AND-ed comparisons and the limit, offset, order_by and count options can be expressed.
+func restQuery(args []any) (url.Values, error) {
+	values := url.Values{}
+	if len(args) > 0 && args[0] != nil {
+		filters, ok := args[0].([]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected query filters: %v", args[0])
+		}
+		for _, f := range filters {
+			filter, ok := f.([]any)
+			if !ok || len(filter) != 3 {
+				return nil, fmt.Errorf("filter %v: %w", f, ErrTransportUnsupported)
+			}
+			field, _ := filter[0].(string)
+			op, _ := filter[1].(string)
+			suffix, ok := restOperators[op]
+			if field == "" || !ok {
+				return nil, fmt.Errorf("filter %v: %w", f, ErrTransportUnsupported)
+			}
+			values.Add(field+suffix, restValue(filter[2]))
+		}
+	}
+	if len(args) > 1 && args[1] != nil {
+		options, ok := args[1].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected query options: %v", args[1])
+		}
+		for key, v := range options {
+			switch key {
+			case "limit", "offset":
+				values.Set(key, restValue(v))
+			case "count":
+				if v == true {
+					values.Set("count", "true")
+				}
+			case "order_by":
+				fields, _ := v.([]any)
+				sort := make([]string, len(fields))
+				for i, field := range fields {
+					sort[i] = fmt.Sprint(field)
+				}
+				values.Set("sort", strings.Join(sort, ","))
+			default:
+				return nil, fmt.Errorf("query option %q: %w", key, ErrTransportUnsupported)
+			}
+		}
+	}
+	return values, nil
+}
+
+// restValue formats a filter value for a query string
+func restValue(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+// restCall performs a method call over the REST v2.0 API
+func (c *Client) restCall(ctx context.Context, method string, params []any) (json.RawMessage, error) {
+	r, err := newRESTRequest(method, params)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := c.restURL(r.path, r.query)
+	if err != nil {
+		return nil, err
+	}
+
+	var body io.Reader
+	if r.body != nil {
+		b, err := json.Marshal(r.body)
+		if err != nil {
+			return nil, fmt.Errorf("marshal body: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, r.verb, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	c.setHTTPAuth(req)
+
+	if c.opts.Debug {
+		c.logger.Printf("rest: %s %s %s\n", r.verb, req.URL.RequestURI(), tryMarshal(RedactParams(method, params)))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", r.verb, req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	result, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, restError(resp, result)
+	}
+	if len(bytes.TrimSpace(result)) == 0 {
+		result = []byte(`null`)
+	}
+	return result, nil
+}
+
+// restURL builds the URL of an /api/v2.0 path with escaped segments
+func (c *Client) restURL(path []string, query url.Values) (string, error) {
+	base, err := c.httpURL("/api/v2.0")
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL: %w", err)
+	}
+	escaped := make([]string, len(path))
+	for i, segment := range path {
+		escaped[i] = url.PathEscape(segment)
+	}
+	u.Path += "/" + strings.Join(path, "/")
+	u.RawPath = "/api/v2.0/" + strings.Join(escaped, "/")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// restError converts an unsuccessful REST response into an ErrorMsg, wrapped in
+// the typed error for its kind
+func restError(resp *http.Response, body []byte) error {
+	errMsg := &ErrorMsg{Reason: resp.Status}
+	var payload struct {
+		Message string `json:"message"`
+		Errno   int    `json:"errno"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Message != "" {
+		errMsg.Message = payload.Message
+		errMsg.Code = payload.Errno
+	} else {
+		errMsg.Message = strings.TrimSpace(string(body))
+	}
+
+	// Validation failures map each attribute to its messages
+	var fields map[string][]struct {
+		Message string `json:"message"`
+		Errno   int    `json:"errno"`
+	}
+	if resp.StatusCode == http.StatusUnprocessableEntity && json.Unmarshal(body, &fields) == nil {
+		var extra [][]any
+		for _, attribute := range slices.Sorted(maps.Keys(fields)) {
+			for _, m := range fields[attribute] {
+				extra = append(extra, []any{attribute, m.Message, m.Errno})
+			}
+		}
+		errMsg.Code = errnoEINVAL
+		errMsg.Type = "VALIDATION"
+		errMsg.Extra, _ = json.Marshal(extra)
+	}
+	return apiError(errMsg)
+}
@@ -0,0 +1,166 @@
+package truenas
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRESTRequest(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		method string
+		params []any
+		verb   string
+		path   []string
+		query  url.Values
+		body   any
+	}{
+		{"no_params", "system.info", []any{}, http.MethodGet, []string{"system", "info"}, nil, nil},
+		{"query_all", "pool.query", []any{}, http.MethodGet, []string{"pool"}, url.Values{}, nil},
+		{
+			"query_filters", "pool.dataset.query", NewQuery().Filter("pool", "=", "tank").Filter("used", ">", 10).Limit(5).OrderBy("-name").Params(),
+			http.MethodGet, []string{"pool", "dataset"},
+			url.Values{"pool": {"tank"}, "used__gt": {"10"}, "limit": {"5"}, "sort": {"-name"}}, nil,
+		},
+		{"get_jobs", "core.get_jobs", []any{[]any{[]any{"id", "=", 7}}}, http.MethodGet, []string{"core", "get_jobs"}, url.Values{"id": {"7"}}, nil},
+		{"create", "user.create", []any{map[string]any{"username": "bob"}}, http.MethodPost, []string{"user"}, nil, map[string]any{"username": "bob"}},
+		{"update_item", "user.update", []any{3, map[string]any{"full_name": "Bob"}}, http.MethodPut, []string{"user", "id", "3"}, nil, map[string]any{"full_name": "Bob"}},
+		{"update_config", "ssh.update", []any{map[string]any{"tcpport": 2222}}, http.MethodPut, []string{"ssh"}, nil, map[string]any{"tcpport": json.Number("2222")}},
+		{"delete", "pool.dataset.delete", []any{"tank/data", map[string]any{"recursive": true}}, http.MethodDelete, []string{"pool", "dataset", "id", "tank/data"}, nil, map[string]any{"recursive": true}},
+		{"get_instance", "vm.get_instance", []any{2}, http.MethodGet, []string{"vm", "id", "2"}, nil, nil},
+		{"single_param", "service.start", []any{"ssh"}, http.MethodPost, []string{"service", "start"}, nil, "ssh"},
+		{"several_params", "auth.login", []any{"root", "secret"}, http.MethodPost, []string{"auth", "login"}, nil, []any{"root", "secret"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := newRESTRequest(tt.method, tt.params)
+			require.NoError(t, err)
+			assert.Equal(t, tt.verb, req.verb)
+			assert.Equal(t, tt.path, req.path)
+			assert.Equal(t, tt.query, req.query)
+			assert.Equal(t, tt.body, req.body)
+		})
+	}
+
+	t.Run("unsupported_filter", func(t *testing.T) {
+		_, err := newRESTRequest("pool.query", NewQuery().Filter("name", "in", []string{"a", "b"}).Params())
+		assert.ErrorIs(t, err, ErrTransportUnsupported)
+	})
+
+	t.Run("unsupported_option", func(t *testing.T) {
+		_, err := newRESTRequest("pool.query", NewQuery().Select("name").Params())
+		assert.ErrorIs(t, err, ErrTransportUnsupported)
+	})
+}
+
+// restRequestLog records the requests received by a REST test server
+type restRequestLog struct {
+	mu       sync.Mutex
+	requests []string
+	auth     []string
+}
+
+func (l *restRequestLog) add(r *http.Request) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests = append(l.requests, r.Method+" "+r.URL.RequestURI())
+	l.auth = append(l.auth, r.Header.Get("Authorization"))
+}
+
+func (l *restRequestLog) get() ([]string, []string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]string{}, l.requests...), append([]string{}, l.auth...)
+}
+
+func newRESTTestServer(t *testing.T, log *restRequestLog) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v2.0/system/version", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		if r.Header.Get("Authorization") != "Bearer good-key" {
+			http.Error(w, `{"message": "Not authenticated"}`, http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`"TrueNAS-SCALE-24.04.1"`))
+	})
+	mux.HandleFunc("GET /api/v2.0/pool", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		_, _ = w.Write([]byte(`[{"id": 1, "name": "tank"}]`))
+	})
+	mux.HandleFunc("POST /api/v2.0/pool/scrub/run", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `["tank", 7]`, string(body))
+		_, _ = w.Write([]byte(`42`))
+	})
+	mux.HandleFunc("GET /api/v2.0/core/get_jobs", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		_ = json.NewEncoder(w).Encode([]Job{{ID: 42, Method: "pool.scrub.run", State: "SUCCESS", Result: true}})
+	})
+	mux.HandleFunc("DELETE /api/v2.0/pool/dataset/id/{id}", func(w http.ResponseWriter, r *http.Request) {
+		log.add(r)
+		http.Error(w, `{"message": "Dataset is busy", "errno": 16}`, http.StatusUnprocessableEntity)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClient_RESTTransport(t *testing.T) {
+	t.Parallel()
+	log := &restRequestLog{}
+	server := newRESTTestServer(t, log)
+
+	client, err := NewClient(server.URL, Options{APIKey: "good-key", Transport: TransportREST})
+	require.NoError(t, err)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	pools, err := client.Pool.ListWithQuery(ctx, NewQuery().Filter("name", "=", "tank"))
+	require.NoError(t, err)
+	require.Len(t, pools, 1)
+	assert.Equal(t, "tank", pools[0].Name)
+
+	var ok bool
+	require.NoError(t, client.CallJob(ctx, "pool.scrub.run", []any{"tank", 7}, &ok))
+	assert.True(t, ok)
+
+	err = client.Call(ctx, "pool.dataset.delete", []any{"tank/data"}, nil)
+	var errMsg *ErrorMsg
+	require.ErrorAs(t, err, &errMsg)
+	assert.Equal(t, 16, errMsg.Code)
+	assert.Equal(t, "Dataset is busy", errMsg.Message)
+
+	_, err = client.Subscribe.Watch(ctx, "alert.list")
+	assert.ErrorIs(t, err, ErrTransportUnsupported)
+
+	requests, auth := log.get()
+	assert.Equal(t, []string{
+		"GET /api/v2.0/system/version",
+		"GET /api/v2.0/pool?name=tank",
+		"POST /api/v2.0/pool/scrub/run",
+		"GET /api/v2.0/core/get_jobs?id=42",
+		"DELETE /api/v2.0/pool/dataset/id/tank%2Fdata",
+	}, requests)
+	for _, header := range auth {
+		assert.Equal(t, "Bearer good-key", header)
+	}
+}
+
+func TestClient_RESTTransport_AuthFailure(t *testing.T) {
+	t.Parallel()
+	server := newRESTTestServer(t, &restRequestLog{})
+
+	_, err := NewClient(server.URL, Options{APIKey: "bad-key", Transport: TransportREST})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Not authenticated")
+}
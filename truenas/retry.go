@@ -0,0 +1,127 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/gorilla/websocket"
+)
+
+var (
+	// ErrNotConnected is returned when a call is made while the client has no connection
+	ErrNotConnected = errors.New("not connected")
+	// ErrConnectionLost is returned to calls that were in flight when the connection dropped
+	ErrConnectionLost = errors.New("connection lost")
+	// ErrClientClosed is returned to calls made after Shutdown started or abandoned by Close
+	ErrClientClosed = errors.New("client closed")
+)
+
+// RetryPolicy controls how calls are retried after transient failures such as a
+// reconnect in progress or a connection reset mid-call. Calls that were in flight
+// when the connection dropped may already have run on the server, so policies
+// that retry them are best suited to idempotent methods.
+type RetryPolicy struct {
+	MaxAttempts     int              // Total attempts including the first; values below 2 disable retries
+	InitialInterval time.Duration    // Delay before the first retry, defaults to 100ms
+	MaxInterval     time.Duration    // Upper bound on the delay between retries, defaults to 5s
+	Multiplier      float64          // Growth factor of the delay, defaults to 2
+	Retryable       func(error) bool // Classifies errors as transient, defaults to IsRetryable
+}
+
+// DefaultRetryPolicy returns a policy making up to 5 attempts with exponential backoff
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:     5,
+		InitialInterval: 100 * time.Millisecond,
+		MaxInterval:     5 * time.Second,
+		Multiplier:      2,
+	}
+}
+
+// IsRetryable reports whether err is a transient connection failure worth retrying.
+// Errors returned by the middleware and context errors are never retryable.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var errMsg *ErrorMsg
+	if errors.As(err, &errMsg) || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, ErrNotConnected) || errors.Is(err, ErrConnectionLost) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, net.ErrClosed) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		return true
+	}
+	return strings.Contains(err.Error(), "connection reset") || strings.Contains(err.Error(), "broken pipe")
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy returns a context that overrides Options.RetryPolicy for calls made with it.
+// A nil policy disables retries.
+func WithRetryPolicy(ctx context.Context, policy *RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// retryPolicy returns the policy that applies to calls made with ctx
+func (c *Client) retryPolicy(ctx context.Context) *RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(*RetryPolicy); ok {
+		return policy
+	}
+	return c.opts.RetryPolicy
+}
+
+// retry runs op until it succeeds, returns an error the policy does not consider
+// transient, the attempts are exhausted, ctx is done or the client is closed.
+func (c *Client) retry(ctx context.Context, op func() error) error {
+	policy := c.retryPolicy(ctx)
+	if policy == nil || policy.MaxAttempts < 2 {
+		return op()
+	}
+
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+	return backoff.Retry(func() error {
+		err := op()
+		if err != nil && (c.closed.Load() || !retryable(err)) {
+			return backoff.Permanent(err)
+		}
+		return err
+	}, policy.backOff(ctx))
+}
+
+// backOff builds the backoff schedule for the policy
+func (p *RetryPolicy) backOff(ctx context.Context) backoff.BackOff {
+	bo := backoff.NewExponentialBackOff()
+	bo.MaxElapsedTime = 0
+	if p.InitialInterval > 0 {
+		bo.InitialInterval = p.InitialInterval
+	} else {
+		bo.InitialInterval = 100 * time.Millisecond
+	}
+	if p.MaxInterval > 0 {
+		bo.MaxInterval = p.MaxInterval
+	} else {
+		bo.MaxInterval = 5 * time.Second
+	}
+	if p.Multiplier > 0 {
+		bo.Multiplier = p.Multiplier
+	} else {
+		bo.Multiplier = 2
+	}
+	bo.Reset()
+	return backoff.WithContext(backoff.WithMaxRetries(bo, uint64(p.MaxAttempts-1)), ctx)
+}
@@ -0,0 +1,113 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"not_connected", ErrNotConnected, true},
+		{"connection_lost", fmt.Errorf("call: %w", ErrConnectionLost), true},
+		{"econnreset", fmt.Errorf("read message: %w", syscall.ECONNRESET), true},
+		{"eof", io.EOF, true},
+		{"close_error", &websocket.CloseError{Code: websocket.CloseAbnormalClosure}, true},
+		{"broken_pipe_text", errors.New("write tcp: broken pipe"), true},
+		{"middleware_error", &ErrorMsg{Code: 22, Message: "Invalid argument"}, false},
+		{"context_canceled", context.Canceled, false},
+		{"deadline_exceeded", context.DeadlineExceeded, false},
+		{"other", errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsRetryable(tt.err))
+		})
+	}
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithDroppedCalls("system.info", 1))
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:    "test",
+		Password:    "test",
+		RetryPolicy: &RetryPolicy{MaxAttempts: 10, InitialInterval: 20 * time.Millisecond, MaxInterval: 100 * time.Millisecond},
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// The first attempt is dropped mid-call and retried once the client reconnects
+	var result map[string]any
+	err = client.Call(NewTestContext(t), "system.info", nil, &result)
+	require.NoError(t, err)
+	assert.Equal(t, "test-truenas", result["hostname"])
+	assert.Eventually(t, func() bool { return client.Stats().Reconnects == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func TestClient_RetryPolicy_MiddlewareErrorNotRetried(t *testing.T) {
+	t.Parallel()
+	attempts := 0
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "pool.query" {
+			attempts++
+			return Message{ID: msg.ID, Error: &ErrorMsg{Code: 22, Message: "Invalid argument"}}, true
+		}
+		return Message{ID: msg.ID, Result: []byte(`true`)}, true
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:    "test",
+		Password:    "test",
+		RetryPolicy: DefaultRetryPolicy(),
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	err = client.Call(NewTestContext(t), "pool.query", nil, nil)
+	var errMsg *ErrorMsg
+	require.ErrorAs(t, err, &errMsg)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestClient_WithRetryPolicy(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithDroppedCalls("system.info", 1))
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:    "test",
+		Password:    "test",
+		RetryPolicy: DefaultRetryPolicy(),
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	// A nil policy on the context disables retries for the call
+	ctx := WithRetryPolicy(NewTestContext(t), nil)
+	err = client.Call(ctx, "system.info", nil, nil)
+	assert.ErrorIs(t, err, ErrConnectionLost)
+
+	// Later calls still use the client's policy
+	var result map[string]any
+	err = client.Call(NewTestContext(t), "system.info", nil, &result)
+	require.NoError(t, err)
+	assert.Equal(t, "test-truenas", result["hostname"])
+}
@@ -0,0 +1,197 @@
+package truenas
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// scheduleHorizon bounds the search for the next run of a schedule that may never match, such
+// as February 30th
+const scheduleHorizon = 8 * 366 * 24 * time.Hour
+
+// cronField describes the values allowed in one field of a schedule
+type cronField struct {
+	name     string
+	min, max int
+	names    []string // Names of the values from min, e.g. month or weekday abbreviations
+}
+
+var (
+	cronMinute = cronField{name: "minute", min: 0, max: 59}
+	cronHour   = cronField{name: "hour", min: 0, max: 23}
+	cronDOM    = cronField{name: "dom", min: 1, max: 31}
+	cronMonth  = cronField{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	// Both 0 and 7 are Sunday
+	cronDOW = cronField{name: "dow", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+)
+
+// cronSchedule is a parsed Schedule with a bit set for each matching value of each field
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	anyDOM, anyDOW                bool
+}
+
+// Validate checks that every field of the schedule is a valid cron expression
+func (s Schedule) Validate() error {
+	_, err := s.parse()
+	return err
+}
+
+// NextRuns returns the next n times after from at which the schedule runs, in from's location
+func (s Schedule) NextRuns(n int, from time.Time) ([]time.Time, error) {
+	cs, err := s.parse()
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]time.Time, 0, n)
+	t := from.Truncate(time.Minute).Add(time.Minute)
+	for len(runs) < n {
+		var ok bool
+		if t, ok = cs.next(t, from.Add(scheduleHorizon)); !ok {
+			break
+		}
+		runs = append(runs, t)
+		t = t.Add(time.Minute)
+	}
+	return runs, nil
+}
+
+func (s Schedule) parse() (*cronSchedule, error) {
+	var (
+		cs   cronSchedule
+		errs []string
+	)
+	for _, field := range []struct {
+		expr string
+		spec cronField
+		bits *uint64
+	}{
+		{s.Minute, cronMinute, &cs.minute},
+		{s.Hour, cronHour, &cs.hour},
+		{s.DOM, cronDOM, &cs.dom},
+		{s.Month, cronMonth, &cs.month},
+		{s.DOW, cronDOW, &cs.dow},
+	} {
+		bits, err := field.spec.parse(field.expr)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %v", field.spec.name, err))
+		}
+		*field.bits = bits
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("invalid schedule: %s", strings.Join(errs, "; "))
+	}
+	if cs.dow&(1<<7) != 0 {
+		cs.dow |= 1
+	}
+	cs.anyDOM = isWildcard(s.DOM)
+	cs.anyDOW = isWildcard(s.DOW)
+	return &cs, nil
+}
+
+// isWildcard reports whether a field matches every value, for day of month and day of week
+// matching. An empty field is treated as "*".
+func isWildcard(expr string) bool {
+	return expr == "" || expr == "*" || expr == "*/1"
+}
+
+// parse parses a comma-separated list of values, ranges and steps such as "1-5,*/15"
+func (f cronField) parse(expr string) (uint64, error) {
+	if expr == "" {
+		expr = "*"
+	}
+	var bits uint64
+	for _, part := range strings.Split(expr, ",") {
+		rng, step, hasStep := strings.Cut(part, "/")
+		stride := 1
+		if hasStep {
+			n, err := strconv.Atoi(step)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step %q", step)
+			}
+			stride = n
+		}
+
+		var lo, hi int
+		switch first, last, isRange := strings.Cut(rng, "-"); {
+		case rng == "*":
+			lo, hi = f.min, f.max
+		case isRange:
+			var err error
+			if lo, err = f.value(first); err != nil {
+				return 0, err
+			}
+			if hi, err = f.value(last); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+		default:
+			var err error
+			if lo, err = f.value(rng); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if hasStep {
+				hi = f.max
+			}
+		}
+		for v := lo; v <= hi; v += stride {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// value parses a single number or name within the field's bounds
+func (f cronField) value(s string) (int, error) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, nil
+		}
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("%q is not a value between %d and %d", s, f.min, f.max)
+	}
+	return v, nil
+}
+
+func (cs *cronSchedule) matchDay(t time.Time) bool {
+	dom := cs.dom&(1<<t.Day()) != 0
+	dow := cs.dow&(1<<int(t.Weekday())) != 0
+	switch {
+	case cs.anyDOM && cs.anyDOW:
+		return true
+	case cs.anyDOM:
+		return dow
+	case cs.anyDOW:
+		return dom
+	}
+	// Like cron, a day matches if either restricted field matches
+	return dom || dow
+}
+
+// next returns the first time at or after t that matches the schedule, skipping whole months,
+// days and hours that don't match
+func (cs *cronSchedule) next(t, limit time.Time) (time.Time, bool) {
+	loc := t.Location()
+	for !t.After(limit) {
+		switch {
+		case cs.month&(1<<int(t.Month())) == 0:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+		case !cs.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+		case cs.hour&(1<<t.Hour()) == 0:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+		case cs.minute&(1<<t.Minute()) == 0:
+			t = t.Truncate(time.Minute).Add(time.Minute)
+		default:
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,103 @@
+package truenas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedule_NextRuns(t *testing.T) {
+	t.Parallel()
+	// Wednesday, 15 January 2025
+	from := time.Date(2025, time.January, 15, 10, 30, 45, 0, time.UTC)
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2025, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		schedule Schedule
+		n        int
+		want     []time.Time
+	}{
+		{
+			name:     "hourly",
+			schedule: NewHourlySchedule("00"),
+			n:        3,
+			want:     []time.Time{at(1, 15, 11, 0), at(1, 15, 12, 0), at(1, 15, 13, 0)},
+		},
+		{
+			name:     "every_fifteen_minutes",
+			schedule: NewCustomSchedule("*/15", "*", "*", "*", "*"),
+			n:        3,
+			want:     []time.Time{at(1, 15, 10, 45), at(1, 15, 11, 0), at(1, 15, 11, 15)},
+		},
+		{
+			name:     "daily_today_and_tomorrow",
+			schedule: NewDailySchedule("22", "5"),
+			n:        2,
+			want:     []time.Time{at(1, 15, 22, 5), at(1, 16, 22, 5)},
+		},
+		{
+			name:     "weekdays",
+			schedule: NewWeeklySchedule("mon-fri", "9", "0"),
+			n:        3,
+			want:     []time.Time{at(1, 16, 9, 0), at(1, 17, 9, 0), at(1, 20, 9, 0)},
+		},
+		{
+			name:     "sunday_as_seven",
+			schedule: NewWeeklySchedule("7", "0", "0"),
+			n:        1,
+			want:     []time.Time{at(1, 19, 0, 0)},
+		},
+		{
+			name:     "month_list",
+			schedule: NewCustomSchedule("0", "0", "1", "mar,6", "*"),
+			n:        3,
+			want:     []time.Time{at(3, 1, 0, 0), at(6, 1, 0, 0), time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			// Both day fields restricted: runs on the 1st and on Sundays
+			name:     "day_of_month_or_week",
+			schedule: NewCustomSchedule("0", "12", "1", "*", "sun"),
+			n:        3,
+			want:     []time.Time{at(1, 19, 12, 0), at(1, 26, 12, 0), at(2, 1, 12, 0)},
+		},
+		{
+			name:     "leap_day",
+			schedule: NewMonthlySchedule("29", "0", "0"),
+			n:        2,
+			want:     []time.Time{at(1, 29, 0, 0), at(3, 29, 0, 0)},
+		},
+		{
+			name:     "never",
+			schedule: NewCustomSchedule("0", "0", "30", "feb", "*"),
+			n:        1,
+			want:     []time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runs, err := tt.schedule.NextRuns(tt.n, from)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, runs)
+		})
+	}
+}
+
+func TestSchedule_Validate(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, NewCustomSchedule("0,30", "8-18/2", "*/5", "jan-jun", "MON-FRI").Validate())
+	assert.NoError(t, Schedule{}.Validate())
+
+	err := NewCustomSchedule("60", "*/0", "0", "13", "8").Validate()
+	require.Error(t, err)
+	for _, field := range []string{"minute", "hour", "dom", "month", "dow"} {
+		assert.Contains(t, err.Error(), field+":")
+	}
+	assert.Error(t, NewCustomSchedule("30-10", "*", "*", "*", "*").Validate())
+
+	_, err = NewCustomSchedule("x", "*", "*", "*", "*").NextRuns(1, time.Now())
+	assert.Error(t, err)
+}
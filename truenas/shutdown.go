@@ -0,0 +1,71 @@
+package truenas
+
+import (
+	"context"
+	"sync"
+)
+
+// Shutdown stops the client accepting new calls, waits for the calls and jobs in flight to
+// finish or for ctx to be done, then closes the client. Calls made once Shutdown has started
+// fail with ErrClientClosed, except those made on behalf of calls in flight, such as polling
+// a job. If ctx is done first, Shutdown closes the client anyway, failing the remaining calls
+// with ErrClientClosed, and returns ctx's error.
+func (c *Client) Shutdown(ctx context.Context) error {
+	var err error
+	select {
+	case <-c.calls.drain():
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	_ = c.Close()
+	return err
+}
+
+type trackedCallKey struct{}
+
+// callTracker counts the calls and jobs in flight so that Shutdown can wait for them
+type callTracker struct {
+	mu       sync.Mutex
+	active   int
+	draining bool
+	idle     chan struct{} // Closed once draining with no calls in flight
+}
+
+// track registers a call in flight, returning a context marking calls made on its behalf and
+// a function to call when it finishes. Calls made with a marked context are not counted again
+// and are accepted while draining. It returns false once Shutdown has started.
+func (t *callTracker) track(ctx context.Context) (context.Context, func(), bool) {
+	if ctx.Value(trackedCallKey{}) != nil {
+		return ctx, func() {}, true
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.draining {
+		return ctx, nil, false
+	}
+	t.active++
+	return context.WithValue(ctx, trackedCallKey{}, true), t.done, true
+}
+
+func (t *callTracker) done() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.active--
+	if t.draining && t.active == 0 {
+		close(t.idle)
+	}
+}
+
+// drain stops new calls being tracked and returns a channel closed once none are in flight
+func (t *callTracker) drain() <-chan struct{} {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.draining {
+		t.draining = true
+		t.idle = make(chan struct{})
+		if t.active == 0 {
+			close(t.idle)
+		}
+	}
+	return t.idle
+}
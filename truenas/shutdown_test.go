@@ -0,0 +1,124 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newShutdownServer answers filesystem.slow after a delay and never answers filesystem.hang
+func newShutdownServer(t *testing.T) *TestServer {
+	return NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		switch msg.Method {
+		case "filesystem.slow":
+			time.Sleep(200 * time.Millisecond)
+		case "filesystem.hang":
+			return Message{}, false
+		}
+		return Message{ID: msg.ID, Result: json.RawMessage(`true`)}, true
+	}))
+}
+
+func TestClient_Shutdown(t *testing.T) {
+	t.Parallel()
+	server := newShutdownServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	slow := make(chan error, 1)
+	go func() {
+		slow <- client.Call(NewTestContext(t), "filesystem.slow", nil, nil)
+	}()
+	require.Eventually(t, func() bool { return client.pending.Size() == 1 }, time.Second, time.Millisecond)
+
+	require.NoError(t, client.Shutdown(NewTestContext(t)))
+	require.NoError(t, <-slow)
+	assert.Equal(t, StateClosed, client.State())
+	assert.ErrorIs(t, client.Call(NewTestContext(t), "system.info", nil, nil), ErrClientClosed)
+}
+
+func TestClient_Shutdown_NewCallsRejected(t *testing.T) {
+	t.Parallel()
+	server := newShutdownServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	slow := make(chan error, 1)
+	go func() {
+		slow <- client.Call(NewTestContext(t), "filesystem.slow", nil, nil)
+	}()
+	require.Eventually(t, func() bool { return client.pending.Size() == 1 }, time.Second, time.Millisecond)
+
+	shutdown := make(chan error, 1)
+	go func() {
+		shutdown <- client.Shutdown(NewTestContext(t))
+	}()
+	require.Eventually(t, func() bool {
+		return client.Call(NewTestContext(t), "system.info", nil, nil) != nil
+	}, time.Second, time.Millisecond)
+	assert.ErrorIs(t, client.Call(NewTestContext(t), "system.info", nil, nil), ErrClientClosed)
+
+	require.NoError(t, <-slow)
+	require.NoError(t, <-shutdown)
+}
+
+func TestClient_Shutdown_Deadline(t *testing.T) {
+	t.Parallel()
+	server := newShutdownServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	hung := make(chan error, 1)
+	go func() {
+		hung <- client.Call(NewTestContext(t), "filesystem.hang", nil, nil)
+	}()
+	require.Eventually(t, func() bool { return client.pending.Size() == 1 }, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, client.Shutdown(ctx), context.DeadlineExceeded)
+	assert.ErrorIs(t, <-hung, ErrClientClosed)
+	assert.Equal(t, StateClosed, client.State())
+}
+
+func TestClient_Shutdown_WaitsForJobs(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetJobResponse("pool.scrub.run", true, WithJobProgress(50), WithJobDelay(100*time.Millisecond))
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:         "test",
+		Password:         "test",
+		JobPollInterval:  10 * time.Millisecond,
+		DisableJobEvents: true,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	progress := make(chan struct{}, 1)
+	job := make(chan error, 1)
+	go func() {
+		job <- client.CallJobWithProgress(NewTestContext(t), "pool.scrub.run", []any{"tank"}, nil, func(*Job) {
+			select {
+			case progress <- struct{}{}:
+			default:
+			}
+		})
+	}()
+	<-progress
+
+	// Polling the job continues while shutting down
+	require.NoError(t, client.Shutdown(NewTestContext(t)))
+	require.NoError(t, <-job)
+}
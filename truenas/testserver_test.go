@@ -0,0 +1,211 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestServer_QueueResponses(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	// Create then get: the dataset is missing before the create and present after it
+	server.QueueResponses("pool.dataset.query", []Dataset{}, []Dataset{TestDataset})
+	server.QueueResponses("pool.dataset.create", &ErrorMsg{Code: errnoEEXIST, Message: "Dataset exists"})
+	server.SetResponse("pool.dataset.create", TestDataset)
+	server.SetResponse("pool.dataset.query", []Dataset{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	_, err := client.Dataset.Get(ctx, TestDataset.ID)
+	assert.True(t, IsNotFound(err))
+
+	_, err = client.Dataset.Create(ctx, &DatasetCreateRequest{Name: TestDataset.Name})
+	var errMsg *ErrorMsg
+	require.ErrorAs(t, err, &errMsg)
+	assert.Equal(t, errnoEEXIST, errMsg.Code)
+	_, err = client.Dataset.Create(ctx, &DatasetCreateRequest{Name: TestDataset.Name})
+	require.NoError(t, err)
+
+	dataset, err := client.Dataset.Get(ctx, TestDataset.ID)
+	require.NoError(t, err)
+	assert.Equal(t, TestDataset.Name, dataset.Name)
+
+	// The queue is used up, so the static response applies again
+	_, err = client.Dataset.Get(ctx, TestDataset.ID)
+	assert.True(t, IsNotFound(err))
+	assert.Equal(t, 3, server.CallCount("pool.dataset.query"))
+	assert.Equal(t, 2, server.CallCount("pool.dataset.create"))
+}
+
+func TestTestServer_SetResponseFunc(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponseFunc("user.get_user_obj", func(params []any) any {
+		query, _ := params[0].(map[string]any)
+		if query["username"] != "root" {
+			return &ErrorMsg{Code: errnoENOENT, Message: "User not found"}
+		}
+		return map[string]any{"pw_name": "root", "pw_uid": 0}
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	var user map[string]any
+	require.NoError(t, client.Call(ctx, "user.get_user_obj", []any{map[string]any{"username": "root"}}, &user))
+	assert.Equal(t, "root", user["pw_name"])
+
+	err := client.Call(ctx, "user.get_user_obj", []any{map[string]any{"username": "nobody"}}, nil)
+	var errMsg *ErrorMsg
+	require.ErrorAs(t, err, &errMsg)
+	assert.Equal(t, errnoENOENT, errMsg.Code)
+}
+
+func TestTestServer_AssertCalled(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	require.NoError(t, client.Call(ctx, "service.start", []any{"ssh", map[string]any{"silent": false}}, nil))
+
+	server.AssertCalled(t, "service.start")
+	server.AssertCalled(t, "service.start", "ssh", map[string]any{"silent": false})
+	server.AssertNotCalled(t, "service.stop")
+	assert.Equal(t, 1, server.CallCount("auth.login"))
+
+	// Failed assertions are reported on the given test
+	mock := &testing.T{}
+	assert.False(t, server.AssertCalled(mock, "service.start", "nfs"))
+	assert.False(t, server.AssertCalled(mock, "service.stop"))
+	assert.False(t, server.AssertNotCalled(mock, "service.start"))
+	assert.True(t, mock.Failed())
+}
+
+func TestTestServer_JobLifecycle(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobResponse("pool.create", TestPool,
+		WithJobSteps(JobStep{State: "WAITING", Delay: 20 * time.Millisecond}),
+		WithJobProgress(25, 75),
+	)
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:        "test",
+		Password:        "test",
+		JobPollInterval: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	var states []string
+	var percents []float64
+	var pool Pool
+	err = client.CallJobWithProgress(NewTestContext(t), "pool.create", []any{}, &pool, func(job *Job) {
+		states = append(states, job.State)
+		if job.Progress != nil {
+			percents = append(percents, job.Progress.Percent)
+		}
+	})
+	require.NoError(t, err)
+	assert.Equal(t, TestPool.Name, pool.Name)
+	assert.Equal(t, []string{"WAITING", "RUNNING", "RUNNING", "SUCCESS"}, states)
+	assert.Equal(t, []float64{25, 75}, percents)
+}
+
+func TestTestServer_JobDelay(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("pool.scrub.run", "Pool is exported", WithJobProgress(10), WithJobDelay(time.Hour))
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:        "test",
+		Password:        "test",
+		JobPollInterval: 5 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(NewTestContext(t), 100*time.Millisecond)
+	defer cancel()
+	var last *Job
+	err = client.CallJobWithProgress(ctx, "pool.scrub.run", []any{"tank"}, nil, func(job *Job) {
+		last = job
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.NotNil(t, last)
+	assert.Equal(t, "RUNNING", last.State)
+	assert.Greater(t, server.CallCount("core.get_jobs"), 2)
+}
+
+func TestTestServer_JobFailure(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetJobError("pool.scrub.run", "Pool is exported", WithJobProgress(50))
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	err := client.CallJob(NewTestContext(t), "pool.scrub.run", []any{"tank"}, nil)
+	var jobErr *JobError
+	require.ErrorAs(t, err, &jobErr)
+	assert.Equal(t, "Pool is exported", jobErr.Reason)
+}
+
+func TestTestServer_EmitEvent(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	ctx := NewTestContext(t)
+	assert.Zero(t, server.EmitEvent("alert.list", TestAlert))
+
+	sub, err := client.Subscribe.Watch(ctx, "alert.list")
+	require.NoError(t, err)
+	realtime, err := client.Subscribe.Watch(ctx, `reporting.realtime:{"interval": 2}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, server.EmitEventType(EventTypeAdded, "alert.list", TestAlert.UUID, TestAlert))
+	assert.Equal(t, 1, server.EmitEventType(EventTypeRemoved, "alert.list", TestAlert.UUID, nil))
+	assert.Equal(t, 1, server.EmitEvent("reporting.realtime", map[string]any{"cpu": map[string]any{}}))
+
+	event := <-sub.Events()
+	assert.Equal(t, EventTypeAdded, event.Type)
+	assert.Equal(t, TestAlert.UUID, event.ID)
+	var alert Alert
+	require.NoError(t, event.Unmarshal(&alert))
+	assert.Equal(t, TestAlert.Formatted, alert.Formatted)
+	event = <-sub.Events()
+	assert.Equal(t, EventTypeRemoved, event.Type)
+
+	event = <-realtime.Events()
+	assert.Equal(t, EventTypeChanged, event.Type)
+	assert.Equal(t, "reporting.realtime", event.Collection)
+
+	// Events are no longer sent once the client unsubscribes
+	require.NoError(t, sub.Unsubscribe(ctx))
+	require.Eventually(t, func() bool { return server.EmitEvent("alert.list", TestAlert) == 0 }, time.Second, 10*time.Millisecond)
+}
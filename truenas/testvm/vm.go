@@ -6,7 +6,9 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	mathrand "math/rand/v2"
 	"net"
 	"net/http"
 	"os"
@@ -16,6 +18,7 @@ import (
 	"runtime"
 	"slices"
 	"strings"
+	"sync"
 	"syscall"
 	"testing"
 	"time"
@@ -26,7 +29,35 @@ import (
 // If the test fails and debug mode is enabled, it will keep the VM running and wait for user input.
 func RunWithVM(t *testing.T, f func(*Manager)) {
 	t.Helper()
-	m := NewManager(t, DefaultConfig())
+	runWithConfig(t, DefaultConfig(), f)
+}
+
+// RunWithVersions runs a test function as a subtest per TrueNAS version, each against a VM
+// booted from that version's image in Config.ImageDir. Versions without an image are skipped.
+// With no versions given, it uses Versions.
+func RunWithVersions(t *testing.T, versions []string, f func(*Manager)) {
+	t.Helper()
+	if len(versions) == 0 {
+		versions = Versions()
+	}
+	for _, version := range versions {
+		t.Run(version, func(t *testing.T) {
+			c := DefaultConfig()
+			c.Version = version
+			if strings.HasPrefix(version, "CORE-") {
+				c.Username = "root"
+			}
+			if !imageAvailable(c.image()) {
+				t.Skipf("no image for %s in %s", version, c.ImageDir)
+			}
+			runWithConfig(t, c, f)
+		})
+	}
+}
+
+func runWithConfig(t *testing.T, c *Config, f func(*Manager)) {
+	t.Helper()
+	m := NewManager(t, c)
 
 	// Setup VM
 	t.Log("Starting VM")
@@ -50,15 +81,106 @@ func RunWithVM(t *testing.T, f func(*Manager)) {
 	f(m)
 }
 
+// RunWithCluster runs a test function against n VMs booted concurrently and attached to a
+// shared network, in addition to each VM's own user-mode network. The managers are passed in
+// node order. The shared network is not configured inside TrueNAS: tests assign each node's
+// ConnectionInfo.ClusterAddress to the interface with its ClusterMAC.
+func RunWithCluster(t *testing.T, n int, f func([]*Manager)) {
+	t.Helper()
+	id := mathrand.IntN(256)
+	network := fmt.Sprintf("230.0.%d.1:%d", id, 20000+mathrand.IntN(40000))
+
+	managers := make([]*Manager, n)
+	for i := range managers {
+		c := DefaultConfig()
+		c.CPUs = max(1, c.CPUs/n)
+		c.ClusterNetwork = network
+		c.ClusterMAC = fmt.Sprintf("52:54:00:c1:%02x:%02x", id, i+1)
+		c.ClusterAddress = fmt.Sprintf("10.213.%d.%d/24", id, i+1)
+		managers[i] = NewManager(t, c)
+	}
+
+	// Reassemble the shared disk image once, before the VMs start using it
+	if err := managers[0].ensureDiskImage(); err != nil {
+		t.Fatalf("ensure disk image: %v", err)
+	}
+
+	t.Logf("Starting %d VMs on cluster network %s", n, network)
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i, m := range managers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := m.Start(); err != nil {
+				errs[i] = fmt.Errorf("start vm %d: %w", i, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	t.Cleanup(func() {
+		if t.Failed() && os.Getenv("DEBUG_VM") != "" {
+			for _, m := range managers {
+				m.logConnectionInfo()
+			}
+			managers[0].waitForUserInput()
+		}
+		for i, m := range managers {
+			if errs[i] != nil {
+				continue // Start already stopped it
+			}
+			if err := m.Stop(); err != nil {
+				t.Errorf("Cleanup VM %d: %v", i, err)
+			}
+		}
+	})
+	if err := errors.Join(errs...); err != nil {
+		t.Fatal(err)
+	}
+	t.Log("Cluster is running")
+
+	f(managers)
+}
+
+// TrueNAS versions with images for Config.Version
+const (
+	VersionCore13    = "CORE-13.0"
+	VersionScale2310 = "SCALE-23.10"
+	VersionScale2410 = "SCALE-24.10"
+)
+
+// Versions returns the versions listed in TRUENAS_VM_VERSIONS, separated by commas,
+// or all known versions if it is unset
+func Versions() []string {
+	if env := os.Getenv("TRUENAS_VM_VERSIONS"); env != "" {
+		return strings.Split(env, ",")
+	}
+	return []string{VersionCore13, VersionScale2310, VersionScale2410}
+}
+
 type Config struct {
 	MemoryMB     uint
 	CPUs         int
-	Snapshot     string
+	Snapshot     string // Disk image, or its split parts with a .part* suffix; ignored when Version is set
 	Username     string
 	Password     string
 	WebPort      int
 	SSLPort      int
 	NumPCIePorts int
+
+	// Version selects the image truenas-<Version>.qcow2 in ImageDir, such as
+	// truenas-SCALE-24.10.qcow2, so several versions can be cached side by side
+	Version string
+	// ImageDir holds the versioned images. Defaults to TRUENAS_VM_IMAGE_DIR, or
+	// go-truenas/testvm in the user cache directory.
+	ImageDir string
+
+	// ClusterNetwork attaches a second NIC to the QEMU multicast socket network at this
+	// address, e.g. "230.0.0.1:1234", shared with the other VMs attached to it. Optional.
+	ClusterNetwork string
+	ClusterMAC     string // MAC address of the cluster NIC
+	ClusterAddress string // Address in CIDR notation reserved for the VM on the cluster network
 }
 
 func DefaultConfig() *Config {
@@ -68,7 +190,13 @@ func DefaultConfig() *Config {
 		webPort, sslPort = ports[0], ports[1]
 	}
 
+	imageDir := os.Getenv("TRUENAS_VM_IMAGE_DIR")
+	if cache, err := os.UserCacheDir(); imageDir == "" && err == nil {
+		imageDir = filepath.Join(cache, "go-truenas", "testvm")
+	}
+
 	return &Config{
+		ImageDir:     imageDir,
 		CPUs:         runtime.NumCPU(),
 		MemoryMB:     8192,
 		WebPort:      webPort,
@@ -92,10 +220,19 @@ type Manager struct {
 	availablePCIePorts []string
 }
 
+// image returns the path of the disk image the config selects
+func (c *Config) image() string {
+	if c.Version == "" {
+		return c.Snapshot
+	}
+	return filepath.Join(c.ImageDir, "truenas-"+c.Version+".qcow2")
+}
+
 func NewManager(t *testing.T, c *Config) *Manager {
 	if c == nil {
 		c = DefaultConfig()
 	}
+	c.Snapshot = c.image()
 	return &Manager{
 		T:       t,
 		config:  c,
@@ -104,19 +241,28 @@ func NewManager(t *testing.T, c *Config) *Manager {
 	}
 }
 
+// Version returns the TrueNAS version the VM was booted from, or "" for the default image
+func (m *Manager) Version() string {
+	return m.config.Version
+}
+
 // ConnectionInfo contains the information needed to connect to the TrueNAS VM
 type ConnectionInfo struct {
-	WebSocketURL string
-	Username     string
-	Password     string
+	WebSocketURL   string
+	Username       string
+	Password       string
+	ClusterMAC     string // Empty unless the VM is attached to a cluster network
+	ClusterAddress string
 }
 
 // GetConnectionInfo returns the connection information needed to create a TrueNAS client
 func (m *Manager) GetConnectionInfo() ConnectionInfo {
 	return ConnectionInfo{
-		WebSocketURL: fmt.Sprintf("ws://localhost:%d/websocket", m.config.WebPort),
-		Username:     m.config.Username,
-		Password:     m.config.Password,
+		WebSocketURL:   fmt.Sprintf("ws://localhost:%d/websocket", m.config.WebPort),
+		Username:       m.config.Username,
+		Password:       m.config.Password,
+		ClusterMAC:     m.config.ClusterMAC,
+		ClusterAddress: m.config.ClusterAddress,
 	}
 }
 
@@ -151,6 +297,13 @@ func (m *Manager) Start() error {
 		"-nographic", "-display", "none",
 	}
 
+	if m.config.ClusterNetwork != "" {
+		args = append(args,
+			"-netdev", fmt.Sprintf("socket,id=net1,mcast=%s", m.config.ClusterNetwork),
+			"-device", fmt.Sprintf("virtio-net,netdev=net1,mac=%s", m.config.ClusterMAC),
+		)
+	}
+
 	if runtime.GOOS == "linux" {
 		args = append(args, "-enable-kvm")
 	}
@@ -350,20 +503,15 @@ func getRandomAvailablePorts(count int) ([]int, error) {
 
 // waitForUserInput prompts the user and waits for input before proceeding with cleanup
 func (m *Manager) waitForUserInput() {
-	connInfo := m.GetConnectionInfo()
 	separator := strings.Repeat("=", 80)
 
 	m.Log(separator)
 	m.Log("TEST FAILED - VM DEBUGGING MODE ENABLED")
 	m.Log(separator)
 	m.Log("The TrueNAS VM is still running for debugging purposes.")
-	m.Log("Connection Information:")
-	m.Logf("  WebSocket URL: %s", connInfo.WebSocketURL)
-	m.Logf("  Username:      %s", connInfo.Username)
-	m.Logf("  Password:      %s", connInfo.Password)
-	m.Logf("  Web UI:        http://localhost:%d", m.config.WebPort)
-	m.Logf("  SSL Web UI:    https://localhost:%d", m.config.SSLPort)
-	m.Logf("  VM Process ID: %d", m.vmProcess.Pid)
+	if m.config.ClusterNetwork == "" {
+		m.logConnectionInfo()
+	}
 	m.Log("You can now:")
 	m.Log("  - Connect to the VM for debugging")
 	m.Log("  - Run additional tests manually")
@@ -379,6 +527,23 @@ func (m *Manager) waitForUserInput() {
 	m.Log("Proceeding with VM cleanup...")
 }
 
+// logConnectionInfo logs how to reach the VM
+func (m *Manager) logConnectionInfo() {
+	connInfo := m.GetConnectionInfo()
+	m.Log("Connection Information:")
+	m.Logf("  WebSocket URL: %s", connInfo.WebSocketURL)
+	m.Logf("  Username:      %s", connInfo.Username)
+	m.Logf("  Password:      %s", connInfo.Password)
+	m.Logf("  Web UI:        http://localhost:%d", m.config.WebPort)
+	m.Logf("  SSL Web UI:    https://localhost:%d", m.config.SSLPort)
+	if connInfo.ClusterMAC != "" {
+		m.Logf("  Cluster NIC:   %s (%s)", connInfo.ClusterMAC, connInfo.ClusterAddress)
+	}
+	if m.vmProcess != nil {
+		m.Logf("  VM Process ID: %d", m.vmProcess.Pid)
+	}
+}
+
 func randStr() string {
 	b := make([]byte, 8)
 	_, _ = rand.Read(b)
@@ -441,6 +606,15 @@ func (m *Manager) ensureDiskImage() error {
 	return nil
 }
 
+// imageAvailable reports whether the disk image or its split parts exist
+func imageAvailable(path string) bool {
+	if _, err := os.Stat(path); err == nil {
+		return true
+	}
+	matches, _ := filepath.Glob(path + ".part*")
+	return len(matches) > 0
+}
+
 // verifyDiskImage checks if the disk image is valid and readable by QEMU
 func (m *Manager) verifyDiskImage() error {
 	cmd := exec.Command("qemu-img", "info", m.config.Snapshot)
@@ -0,0 +1,35 @@
+package truenas
+
+import (
+	"context"
+	"time"
+)
+
+type callTimeoutKey struct{}
+
+// WithCallTimeout returns a context that gives each call made with it its own timeout,
+// overriding Options.DefaultCallTimeout. A deadline already set on ctx takes precedence.
+func WithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, callTimeoutKey{}, timeout)
+}
+
+// callContext applies the call timeout to ctx unless it already has a deadline
+func (c *Client) callContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	timeout := c.opts.DefaultCallTimeout
+	if d, ok := ctx.Value(callTimeoutKey{}).(time.Duration); ok {
+		timeout = d
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// jobContext applies Options.DefaultJobTimeout to ctx unless it already has a deadline
+// or no job timeout is configured
+func (c *Client) jobContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.opts.DefaultJobTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.opts.DefaultJobTimeout)
+}
@@ -0,0 +1,88 @@
+package truenas
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func slowServer(t *testing.T, delay time.Duration) *TestServer {
+	return NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "system.info" {
+			time.Sleep(delay)
+		}
+		return Message{ID: msg.ID, Result: []byte(`true`)}, true
+	}))
+}
+
+func TestClient_DefaultCallTimeout(t *testing.T) {
+	t.Parallel()
+	server := slowServer(t, 200*time.Millisecond)
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:           "test",
+		Password:           "test",
+		DefaultCallTimeout: 50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	err = client.Call(context.Background(), "system.info", nil, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// A deadline on the context takes precedence over the default
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, client.Call(ctx, "system.info", nil, nil))
+}
+
+func TestClient_WithCallTimeout(t *testing.T) {
+	t.Parallel()
+	server := slowServer(t, 200*time.Millisecond)
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:           "test",
+		Password:           "test",
+		DefaultCallTimeout: 50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := WithCallTimeout(context.Background(), 5*time.Second)
+	require.NoError(t, client.Call(ctx, "system.info", nil, nil))
+
+	_, err = client.CallRaw(WithCallTimeout(context.Background(), 10*time.Millisecond), "system.info", nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestClient_DefaultJobTimeout(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		if msg.Method == "core.get_jobs" {
+			return Message{ID: msg.ID, Result: []byte(`[{"id": 1, "state": "RUNNING"}]`)}, true
+		}
+		if msg.Method == "pool.scrub.run" {
+			return Message{ID: msg.ID, Result: []byte(`1`)}, true
+		}
+		return Message{ID: msg.ID, Result: []byte(`true`)}, true
+	}))
+	defer server.Close()
+
+	client, err := NewClient(server.GetWebSocketURL(), Options{
+		Username:          "test",
+		Password:          "test",
+		DefaultJobTimeout: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	defer client.Close()
+
+	start := time.Now()
+	err = client.CallJob(context.Background(), "pool.scrub.run", []any{"tank"}, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 2*time.Second)
+}
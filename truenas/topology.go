@@ -0,0 +1,221 @@
+package truenas
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// vdevMinDisks is the number of disks pool.create requires in each type of vdev
+var vdevMinDisks = map[VDevType]int{
+	VDevTypeStripe: 1,
+	VDevTypeMirror: 2,
+	VDevTypeRaidz1: 3,
+	VDevTypeRaidz2: 4,
+	VDevTypeRaidz3: 5,
+}
+
+// vdevClassTypes lists the vdev types allowed in each class of a pool's topology
+var vdevClassTypes = map[string][]VDevType{
+	"data":    {VDevTypeStripe, VDevTypeMirror, VDevTypeRaidz1, VDevTypeRaidz2, VDevTypeRaidz3},
+	"special": {VDevTypeStripe, VDevTypeMirror, VDevTypeRaidz1, VDevTypeRaidz2, VDevTypeRaidz3},
+	"dedup":   {VDevTypeStripe, VDevTypeMirror, VDevTypeRaidz1, VDevTypeRaidz2, VDevTypeRaidz3},
+	"log":     {VDevTypeStripe, VDevTypeMirror},
+	"cache":   {VDevTypeStripe},
+}
+
+// Stripe returns a vdev of disks without redundancy
+func Stripe(disks ...string) VDevCreate {
+	return VDevCreate{Type: VDevTypeStripe, Disks: disks}
+}
+
+// Mirror returns a vdev that mirrors data across disks
+func Mirror(disks ...string) VDevCreate {
+	return VDevCreate{Type: VDevTypeMirror, Disks: disks}
+}
+
+// Raidz1 returns a vdev of disks with single parity
+func Raidz1(disks ...string) VDevCreate {
+	return VDevCreate{Type: VDevTypeRaidz1, Disks: disks}
+}
+
+// Raidz2 returns a vdev of disks with double parity
+func Raidz2(disks ...string) VDevCreate {
+	return VDevCreate{Type: VDevTypeRaidz2, Disks: disks}
+}
+
+// Raidz3 returns a vdev of disks with triple parity
+func Raidz3(disks ...string) VDevCreate {
+	return VDevCreate{Type: VDevTypeRaidz3, Disks: disks}
+}
+
+// Topology builds the layout of a new pool, such as
+//
+//	NewTopology().Data(Raidz2("sda", "sdb", "sdc", "sdd")).Log(Mirror("nvme0n1", "nvme1n1")).Spare("sde")
+//
+// Build checks the layout against the rules pool.create enforces before it is submitted.
+type Topology struct {
+	topology PoolTopologyCreate
+	sizes    map[string]int64
+}
+
+// NewTopology returns an empty pool topology
+func NewTopology() *Topology {
+	return &Topology{}
+}
+
+// Data adds vdevs that hold the pool's data
+func (t *Topology) Data(vdevs ...VDevCreate) *Topology {
+	t.topology.Data = append(t.topology.Data, vdevs...)
+	return t
+}
+
+// Log adds separate intent log (SLOG) vdevs
+func (t *Topology) Log(vdevs ...VDevCreate) *Topology {
+	t.topology.Log = append(t.topology.Log, vdevs...)
+	return t
+}
+
+// Cache adds L2ARC read cache vdevs
+func (t *Topology) Cache(vdevs ...VDevCreate) *Topology {
+	t.topology.Cache = append(t.topology.Cache, vdevs...)
+	return t
+}
+
+// Special adds vdevs for metadata and small blocks
+func (t *Topology) Special(vdevs ...VDevCreate) *Topology {
+	t.topology.Special = append(t.topology.Special, vdevs...)
+	return t
+}
+
+// Dedup adds vdevs for deduplication tables
+func (t *Topology) Dedup(vdevs ...VDevCreate) *Topology {
+	t.topology.Dedup = append(t.topology.Dedup, vdevs...)
+	return t
+}
+
+// Spare adds hot spare disks
+func (t *Topology) Spare(disks ...string) *Topology {
+	t.topology.Spares = append(t.topology.Spares, disks...)
+	return t
+}
+
+// DiskSizes records the sizes of disks in bytes, such as from Disk.ListUnused, so that
+// Warnings can report vdevs that mix disk sizes
+func (t *Topology) DiskSizes(disks ...UnusedDisk) *Topology {
+	if t.sizes == nil {
+		t.sizes = make(map[string]int64, len(disks))
+	}
+	for _, disk := range disks {
+		t.sizes[disk.Name] = disk.Size
+	}
+	return t
+}
+
+// Validate checks that the topology has data vdevs, that each vdev is of a type allowed in
+// its class with at least the disks pool.create requires, and that no disk is used twice
+func (t *Topology) Validate() error {
+	var errs []string
+	if len(t.topology.Data) == 0 {
+		errs = append(errs, "no data vdevs")
+	}
+	used := make(map[string]bool)
+	useDisk := func(where, disk string) {
+		switch {
+		case disk == "":
+			errs = append(errs, where+": empty disk name")
+		case used[disk]:
+			errs = append(errs, fmt.Sprintf("%s: disk %s is used more than once", where, disk))
+		}
+		used[disk] = true
+	}
+	for class, vdevs := range t.classes() {
+		for i, vdev := range vdevs {
+			where := fmt.Sprintf("%s vdev %d", class, i+1)
+			if !slices.Contains(vdevClassTypes[class], vdev.Type) {
+				errs = append(errs, fmt.Sprintf("%s: type %s is not allowed", where, vdev.Type))
+			} else if n := vdevMinDisks[vdev.Type]; len(vdev.Disks) < n {
+				errs = append(errs, fmt.Sprintf("%s: %s needs at least %d disks, got %d", where, vdev.Type, n, len(vdev.Disks)))
+			}
+			for _, disk := range vdev.Disks {
+				useDisk(where, disk)
+			}
+		}
+	}
+	for _, disk := range t.topology.Spares {
+		useDisk("spare", disk)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid topology: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// Warnings describes what pool.create accepts but is likely a mistake: data vdevs of
+// different layouts, and, if DiskSizes was called, vdevs that mix disk sizes and spares
+// smaller than the data disks they would replace
+func (t *Topology) Warnings() []string {
+	var warnings []string
+	if len(t.topology.Data) > 1 {
+		first := t.topology.Data[0]
+		for i, vdev := range t.topology.Data[1:] {
+			if vdev.Type != first.Type || len(vdev.Disks) != len(first.Disks) {
+				warnings = append(warnings, fmt.Sprintf("data vdev %d is %s of %d disks but data vdev 1 is %s of %d disks",
+					i+2, vdev.Type, len(vdev.Disks), first.Type, len(first.Disks)))
+			}
+		}
+	}
+	if t.sizes == nil {
+		return warnings
+	}
+
+	var largestData int64
+	for class, vdevs := range t.classes() {
+		for i, vdev := range vdevs {
+			var smallest, largest int64
+			for _, disk := range vdev.Disks {
+				size, ok := t.sizes[disk]
+				if !ok {
+					continue
+				}
+				if smallest == 0 || size < smallest {
+					smallest = size
+				}
+				largest = max(largest, size)
+			}
+			if smallest != largest {
+				warnings = append(warnings, fmt.Sprintf("%s vdev %d mixes disk sizes; every disk is limited to the smallest, %d bytes",
+					class, i+1, smallest))
+			}
+			if class == "data" {
+				largestData = max(largestData, largest)
+			}
+		}
+	}
+	for _, disk := range t.topology.Spares {
+		if size, ok := t.sizes[disk]; ok && size < largestData {
+			warnings = append(warnings, fmt.Sprintf("spare %s is smaller than the largest data disk and cannot replace it", disk))
+		}
+	}
+	return warnings
+}
+
+// Build validates the topology and returns it for PoolCreateRequest.Topology
+func (t *Topology) Build() (PoolTopologyCreate, error) {
+	if err := t.Validate(); err != nil {
+		return PoolTopologyCreate{}, err
+	}
+	return t.topology, nil
+}
+
+// classes yields the vdevs of each class, in the order they are reported
+func (t *Topology) classes() iter.Seq2[string, []VDevCreate] {
+	return func(yield func(string, []VDevCreate) bool) {
+		_ = yield("data", t.topology.Data) &&
+			yield("special", t.topology.Special) &&
+			yield("dedup", t.topology.Dedup) &&
+			yield("log", t.topology.Log) &&
+			yield("cache", t.topology.Cache)
+	}
+}
@@ -0,0 +1,78 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopology_Build(t *testing.T) {
+	t.Parallel()
+
+	topology, err := NewTopology().
+		Data(Raidz2("sda", "sdb", "sdc", "sdd")).
+		Log(Mirror("nvme0n1", "nvme1n1")).
+		Cache(Stripe("nvme2n1")).
+		Spare("sde").
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, PoolTopologyCreate{
+		Data:   []VDevCreate{{Type: VDevTypeRaidz2, Disks: []string{"sda", "sdb", "sdc", "sdd"}}},
+		Log:    []VDevCreate{{Type: VDevTypeMirror, Disks: []string{"nvme0n1", "nvme1n1"}}},
+		Cache:  []VDevCreate{{Type: VDevTypeStripe, Disks: []string{"nvme2n1"}}},
+		Spares: []string{"sde"},
+	}, topology)
+}
+
+func TestTopology_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		topology *Topology
+		wantErr  string
+	}{
+		{"no data", NewTopology().Spare("sda"), "no data vdevs"},
+		{"short mirror", NewTopology().Data(Mirror("sda")), "data vdev 1: MIRROR needs at least 2 disks, got 1"},
+		{"short raidz1", NewTopology().Data(Raidz1("sda", "sdb")), "RAIDZ1 needs at least 3 disks, got 2"},
+		{"short raidz2", NewTopology().Data(Raidz2("sda", "sdb", "sdc")), "RAIDZ2 needs at least 4 disks, got 3"},
+		{"short raidz3", NewTopology().Data(Raidz3("sda", "sdb", "sdc", "sdd")), "RAIDZ3 needs at least 5 disks, got 4"},
+		{"raidz log", NewTopology().Data(Stripe("sda")).Log(Raidz1("sdb", "sdc", "sdd")), "log vdev 1: type RAIDZ1 is not allowed"},
+		{"mirrored cache", NewTopology().Data(Stripe("sda")).Cache(Mirror("sdb", "sdc")), "cache vdev 1: type MIRROR is not allowed"},
+		{"reused disk", NewTopology().Data(Mirror("sda", "sdb")).Spare("sdb"), "spare: disk sdb is used more than once"},
+		{"empty disk", NewTopology().Data(Mirror("sda", "")), "data vdev 1: empty disk name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := tt.topology.Build()
+			require.Error(t, err)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestTopology_Warnings(t *testing.T) {
+	t.Parallel()
+
+	topology := NewTopology().
+		Data(Mirror("sda", "sdb"), Raidz1("sdc", "sdd", "sde")).
+		Spare("sdf").
+		DiskSizes(
+			UnusedDisk{Name: "sda", Size: 4 << 40},
+			UnusedDisk{Name: "sdb", Size: 2 << 40},
+			UnusedDisk{Name: "sdc", Size: 4 << 40},
+			UnusedDisk{Name: "sdd", Size: 4 << 40},
+			UnusedDisk{Name: "sde", Size: 4 << 40},
+			UnusedDisk{Name: "sdf", Size: 2 << 40},
+		)
+	require.NoError(t, topology.Validate())
+	assert.Equal(t, []string{
+		"data vdev 2 is RAIDZ1 of 3 disks but data vdev 1 is MIRROR of 2 disks",
+		"data vdev 1 mixes disk sizes; every disk is limited to the smallest, 2199023255552 bytes",
+		"spare sdf is smaller than the largest data disk and cannot replace it",
+	}, topology.Warnings())
+
+	assert.Empty(t, NewTopology().Data(Mirror("sda", "sdb"), Mirror("sdc", "sdd")).Warnings())
+}
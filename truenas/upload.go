@@ -0,0 +1,143 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// UploadProgressFunc receives the total number of bytes sent so far during an upload
+type UploadProgressFunc func(sent int64)
+
+// CallUpload calls a job method that reads its input from an uploaded file, such as
+// filesystem.put, streaming r to the middleware's /_upload endpoint. It then waits
+// for the job to complete and, if v is not nil, unmarshals the result into it.
+// fn may be nil; when set it is called from the uploading goroutine as data is sent.
+func (c *Client) CallUpload(ctx context.Context, method string, params []any, r io.Reader, v any, fn UploadProgressFunc) error {
+	jobID, err := c.upload(ctx, method, params, r, fn)
+	if err != nil {
+		return fmt.Errorf("upload %s: %w", method, err)
+	}
+	return c.waitJob(ctx, method, jobID, v, nil)
+}
+
+// upload posts a multipart request containing the job call and the file contents
+// and returns the ID of the job started by the middleware.
+func (c *Client) upload(ctx context.Context, method string, params []any, r io.Reader, fn UploadProgressFunc) (int, error) {
+	endpoint, err := c.httpURL("/_upload")
+	if err != nil {
+		return 0, err
+	}
+	if params == nil {
+		params = []any{}
+	}
+	data, err := json.Marshal(map[string]any{"method": method, "params": params})
+	if err != nil {
+		return 0, fmt.Errorf("marshal upload data: %w", err)
+	}
+
+	// Stream the body through a pipe so large files are never buffered in memory.
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeUploadBody(mw, data, &progressReader{r: r, fn: fn}))
+	}()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, pr)
+	if err != nil {
+		_ = pr.Close()
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	c.setHTTPAuth(req)
+
+	if c.opts.Debug {
+		c.logger.Printf("upload: %s %s %s\n", endpoint, method, tryMarshal(RedactParams(method, params)))
+	}
+
+	resp, err := c.httpClient.Do(req)
+	// Unblock the writer if the request ended before the body was consumed.
+	_ = pr.Close()
+	if err != nil {
+		return 0, fmt.Errorf("post %s: %w", endpoint, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status %s: %s", resp.Status, string(body))
+	}
+
+	var result struct {
+		JobID int `json:"job_id"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return 0, fmt.Errorf("unmarshal response: %s: %w", string(body), err)
+	}
+	return result.JobID, nil
+}
+
+func writeUploadBody(mw *multipart.Writer, data []byte, r io.Reader) error {
+	if err := mw.WriteField("data", string(data)); err != nil {
+		return fmt.Errorf("write data field: %w", err)
+	}
+	part, err := mw.CreateFormFile("file", "file")
+	if err != nil {
+		return fmt.Errorf("create file field: %w", err)
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return fmt.Errorf("copy file: %w", err)
+	}
+	return mw.Close()
+}
+
+// httpURL converts the websocket endpoint into an HTTP URL for the given path.
+func (c *Client) httpURL(path string) (string, error) {
+	u, err := url.Parse(c.url)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL: %w", err)
+	}
+	switch u.Scheme {
+	case "wss", "https":
+		u.Scheme = "https"
+	default:
+		u.Scheme = "http"
+	}
+	u.Path = path
+	u.RawQuery = ""
+	return u.String(), nil
+}
+
+// setHTTPAuth applies the client's credentials to an HTTP request.
+func (c *Client) setHTTPAuth(req *http.Request) {
+	if c.opts.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.opts.APIKey)
+	} else if c.opts.Token != "" {
+		req.Header.Set("Authorization", "Token "+c.opts.Token)
+	} else if c.opts.Username != "" || c.opts.Password != "" {
+		req.SetBasicAuth(c.opts.Username, c.opts.Password)
+	}
+}
+
+// progressReader reports the number of bytes read to fn.
+type progressReader struct {
+	r    io.Reader
+	fn   UploadProgressFunc
+	sent int64
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 && p.fn != nil {
+		p.sent += int64(n)
+		p.fn(p.sent)
+	}
+	return n, err
+}
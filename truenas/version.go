@@ -0,0 +1,190 @@
+package truenas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ServerVersion is the TrueNAS version a client is connected to
+type ServerVersion struct {
+	Raw     string      // As reported by system.version, e.g. "TrueNAS-SCALE-24.10.2"
+	Product ProductType // ProductSCALE or ProductCORE
+	Major   int
+	Minor   int
+	Patch   int // Point release, or the update number of CORE releases such as 13.0-U6
+}
+
+// ProductType is a TrueNAS product, as reported in ServerVersion.Product or, with the
+// Enterprise editions, by System.Product
+type ProductType string
+
+const (
+	ProductSCALE           ProductType = "SCALE"
+	ProductSCALEEnterprise ProductType = "SCALE_ENTERPRISE"
+	ProductCORE            ProductType = "CORE"
+	ProductEnterprise      ProductType = "ENTERPRISE" // CORE Enterprise
+)
+
+// ParseServerVersion parses a version string reported by system.version, such as
+// "TrueNAS-SCALE-24.04.1", "TrueNAS-13.0-U6.1" or "TrueNAS-25.04.0"
+func ParseServerVersion(s string) (ServerVersion, error) {
+	v := ServerVersion{Raw: s}
+	rest, ok := strings.CutPrefix(s, "TrueNAS-")
+	if !ok {
+		return v, fmt.Errorf("parse version %q: not a TrueNAS version", s)
+	}
+	if rest, ok = strings.CutPrefix(rest, "SCALE-"); ok {
+		v.Product = ProductSCALE
+	}
+
+	release, update, _ := strings.Cut(rest, "-")
+	parts := strings.Split(release, ".")
+	numbers := make([]int, 3)
+	for i := range min(len(parts), 3) {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return v, fmt.Errorf("parse version %q: %w", s, err)
+		}
+		numbers[i] = n
+	}
+	v.Major, v.Minor, v.Patch = numbers[0], numbers[1], numbers[2]
+
+	// CORE is the only product numbered below 20, and SCALE dropped the product name from 25.04
+	if v.Product == "" {
+		v.Product = ProductSCALE
+		if v.Major < 20 {
+			v.Product = ProductCORE
+		}
+	}
+	if u, ok := strings.CutPrefix(update, "U"); ok && v.Product == ProductCORE {
+		n, _, _ := strings.Cut(u, ".")
+		v.Patch, _ = strconv.Atoi(n)
+	}
+	return v, nil
+}
+
+// String returns the version as reported by the server
+func (v ServerVersion) String() string {
+	return v.Raw
+}
+
+// AtLeast reports whether the version is major.minor or later
+func (v ServerVersion) AtLeast(major, minor int) bool {
+	return v.Major > major || v.Major == major && v.Minor >= minor
+}
+
+// Capability is a feature whose availability depends on the server version
+type Capability string
+
+const (
+	CapabilityAFP        Capability = "afp"        // sharing.afp, CORE only
+	CapabilityWebDAV     Capability = "webdav"     // sharing.webdav, removed in SCALE 24.04
+	CapabilityKubernetes Capability = "kubernetes" // kubernetes.* and chart.release.*, removed in SCALE 24.10
+	CapabilityDocker     Capability = "docker"     // docker.*, added in SCALE 24.10
+	CapabilityJails      Capability = "jails"      // jail.* and plugin.*, CORE only
+)
+
+// capabilities reports which versions support each capability
+var capabilities = map[Capability]func(v ServerVersion) bool{
+	CapabilityAFP: func(v ServerVersion) bool {
+		return v.Product == ProductCORE
+	},
+	CapabilityWebDAV: func(v ServerVersion) bool {
+		return v.Product == ProductCORE || !v.AtLeast(24, 4)
+	},
+	CapabilityKubernetes: func(v ServerVersion) bool {
+		return v.Product == ProductSCALE && !v.AtLeast(24, 10)
+	},
+	CapabilityDocker: func(v ServerVersion) bool {
+		return v.Product == ProductSCALE && v.AtLeast(24, 10)
+	},
+	CapabilityJails: func(v ServerVersion) bool {
+		return v.Product == ProductCORE
+	},
+}
+
+// methodCapabilities maps method name prefixes to the capability they need
+var methodCapabilities = map[string]Capability{
+	"sharing.afp.":    CapabilityAFP,
+	"sharing.webdav.": CapabilityWebDAV,
+	"webdav.":         CapabilityWebDAV,
+	"kubernetes.":     CapabilityKubernetes,
+	"chart.release.":  CapabilityKubernetes,
+	"docker.":         CapabilityDocker,
+	"jail.":           CapabilityJails,
+	"plugin.":         CapabilityJails,
+}
+
+// Supports reports whether the version supports the capability
+func (v ServerVersion) Supports(capability Capability) bool {
+	supported, ok := capabilities[capability]
+	return !ok || supported(v)
+}
+
+// ErrUnsupportedVersion is matched by errors.Is for calls that the server's version does not support
+var ErrUnsupportedVersion = errors.New("not supported by server version")
+
+// UnsupportedVersionError is returned instead of calling a method the server's version does not support
+type UnsupportedVersionError struct {
+	Method     string
+	Capability Capability
+	Version    ServerVersion
+}
+
+// Error implements the error interface
+func (e *UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("%s: %s is not supported by %s", e.Method, e.Capability, e.Version)
+}
+
+// Is implements error matching for errors.Is()
+func (e *UnsupportedVersionError) Is(target error) bool {
+	return target == ErrUnsupportedVersion
+}
+
+// ServerVersion returns the version of the server, detected when the client connected,
+// or nil if it could not be determined
+func (c *Client) ServerVersion() *ServerVersion {
+	return c.version.Load()
+}
+
+// Supports reports whether the server supports the capability. It reports true when the
+// server version is unknown.
+func (c *Client) Supports(capability Capability) bool {
+	v := c.ServerVersion()
+	return v == nil || v.Supports(capability)
+}
+
+// checkVersion returns an UnsupportedVersionError if the server's version does not support method
+func (c *Client) checkVersion(method string) error {
+	v := c.ServerVersion()
+	if v == nil {
+		return nil
+	}
+	for prefix, capability := range methodCapabilities {
+		if strings.HasPrefix(method, prefix) && !v.Supports(capability) {
+			return &UnsupportedVersionError{Method: method, Capability: capability, Version: *v}
+		}
+	}
+	return nil
+}
+
+// detectVersion calls system.version and records the server version. Unrecognised versions
+// leave it unknown, so no calls are refused.
+func (c *Client) detectVersion(ctx context.Context) error {
+	var raw json.RawMessage
+	if err := c.Call(ctx, "system.version", []any{}, &raw); err != nil {
+		return err
+	}
+	var s string
+	if json.Unmarshal(raw, &s) != nil {
+		return nil
+	}
+	if v, err := ParseServerVersion(s); err == nil {
+		c.version.Store(&v)
+	}
+	return nil
+}
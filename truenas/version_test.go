@@ -0,0 +1,104 @@
+package truenas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		raw  string
+		want ServerVersion
+	}{
+		{"TrueNAS-SCALE-24.04.1", ServerVersion{Product: ProductSCALE, Major: 24, Minor: 4, Patch: 1}},
+		{"TrueNAS-SCALE-24.10.2.1", ServerVersion{Product: ProductSCALE, Major: 24, Minor: 10, Patch: 2}},
+		{"TrueNAS-SCALE-23.10", ServerVersion{Product: ProductSCALE, Major: 23, Minor: 10}},
+		{"TrueNAS-13.0-U6.1", ServerVersion{Product: ProductCORE, Major: 13, Minor: 0, Patch: 6}},
+		{"TrueNAS-13.3-RELEASE", ServerVersion{Product: ProductCORE, Major: 13, Minor: 3}},
+		{"TrueNAS-25.04.0", ServerVersion{Product: ProductSCALE, Major: 25, Minor: 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			got, err := ParseServerVersion(tt.raw)
+			require.NoError(t, err)
+			tt.want.Raw = tt.raw
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, raw := range []string{"", "FreeNAS-11.3-U5", "TrueNAS-SCALE-next"} {
+		_, err := ParseServerVersion(raw)
+		assert.Error(t, err, raw)
+	}
+}
+
+func TestServerVersion_Supports(t *testing.T) {
+	t.Parallel()
+	core, _ := ParseServerVersion("TrueNAS-13.0-U6.1")
+	dragonfish, _ := ParseServerVersion("TrueNAS-SCALE-24.04.2")
+	electricEel, _ := ParseServerVersion("TrueNAS-SCALE-24.10.0")
+
+	assert.True(t, core.Supports(CapabilityAFP))
+	assert.True(t, core.Supports(CapabilityWebDAV))
+	assert.False(t, core.Supports(CapabilityDocker))
+	assert.True(t, core.Supports(CapabilityJails))
+	assert.False(t, electricEel.Supports(CapabilityJails))
+	assert.False(t, dragonfish.Supports(CapabilityAFP))
+	assert.False(t, dragonfish.Supports(CapabilityWebDAV))
+	assert.True(t, dragonfish.Supports(CapabilityKubernetes))
+	assert.False(t, electricEel.Supports(CapabilityKubernetes))
+	assert.True(t, electricEel.Supports(CapabilityDocker))
+	assert.True(t, electricEel.AtLeast(24, 4))
+	assert.False(t, dragonfish.AtLeast(24, 10))
+}
+
+func TestClient_ServerVersion(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("system.version", "TrueNAS-SCALE-24.10.1")
+	server.SetResponse("docker.status", DockerStatus{Status: DockerStateRunning})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	version := client.ServerVersion()
+	require.NotNil(t, version)
+	assert.Equal(t, "TrueNAS-SCALE-24.10.1", version.String())
+	assert.True(t, client.Supports(CapabilityDocker))
+
+	ctx := NewTestContext(t)
+	_, err := client.Docker.Status(ctx)
+	require.NoError(t, err)
+
+	// Removed services fail without calling the server
+	_, err = client.Chart.List(ctx)
+	require.ErrorIs(t, err, ErrUnsupportedVersion)
+	var unsupported *UnsupportedVersionError
+	require.ErrorAs(t, err, &unsupported)
+	assert.Equal(t, CapabilityKubernetes, unsupported.Capability)
+	assert.Equal(t, "chart.release.query", unsupported.Method)
+	server.AssertNotCalled(t, "chart.release.query")
+
+	err = client.Call(ctx, "sharing.webdav.query", nil, nil)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestClient_ServerVersion_Unknown(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+	server.SetResponse("sharing.webdav.query", []WebDAVShare{})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	// Unrecognised versions refuse nothing
+	assert.Nil(t, client.ServerVersion())
+	assert.True(t, client.Supports(CapabilityAFP))
+	_, err := client.Sharing.WebDAV.List(NewTestContext(t))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,158 @@
+package truenas
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// walkConcurrency is the number of directories Walk lists at once
+const walkConcurrency = 4
+
+// WalkFunc is called by Filesystem.Walk for each file or directory visited, following the
+// semantics of fs.WalkDirFunc: entry is nil if the root cannot be stat'ed, a directory is
+// visited a second time with err set if it cannot be listed, and returning fs.SkipDir or
+// fs.SkipAll skips a directory or the rest of the walk.
+type WalkFunc func(path string, entry *DirEntry, err error) error
+
+// Walk walks the tree rooted at root in lexical order, calling fn for each file or directory.
+// Directories are listed a few entries ahead of the walk with filesystem.listdir. Symbolic
+// links are reported but not followed, and directories whose real path was already visited,
+// such as bind mounts of an ancestor, are not descended into.
+func (f *FilesystemClient) Walk(ctx context.Context, root string, fn WalkFunc) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stat, err := f.Stat(ctx, root)
+	if err != nil {
+		err = fn(root, nil, err)
+	} else {
+		w := &walker{fs: f, ctx: ctx, fn: fn, sem: make(chan struct{}, walkConcurrency), visited: map[string]bool{}}
+		entry := &DirEntry{
+			Name:     path.Base(root),
+			Path:     root,
+			RealPath: stat.RealPath,
+			Type:     statType(stat),
+			Size:     stat.Size,
+			Mode:     stat.Mode,
+			UID:      stat.UID,
+			GID:      stat.GID,
+			Mtime:    stat.Mtime,
+			HasACL:   stat.Acl,
+		}
+		err = w.walk(root, entry, w.list(entry))
+	}
+	if errors.Is(err, fs.SkipDir) || errors.Is(err, fs.SkipAll) {
+		return nil
+	}
+	return err
+}
+
+func statType(stat *FilesystemStat) string {
+	switch {
+	case stat.IsDir:
+		return DirEntryTypeDirectory
+	case stat.IsSymlink:
+		return DirEntryTypeSymlink
+	case stat.IsFile:
+		return DirEntryTypeFile
+	}
+	return DirEntryTypeOther
+}
+
+type walker struct {
+	fs      *FilesystemClient
+	ctx     context.Context
+	fn      WalkFunc
+	sem     chan struct{}   // Bounds concurrent listings
+	visited map[string]bool // Real paths of directories already walked
+}
+
+// listing is the result of a directory listing started ahead of the walk
+type listing struct {
+	done    chan struct{}
+	entries []DirEntry
+	err     error
+}
+
+// realPath returns the path a directory entry resolves to, to recognise bind mounts and loops
+func realPath(entry *DirEntry) string {
+	if entry.RealPath != "" {
+		return path.Clean(entry.RealPath)
+	}
+	return path.Clean(entry.Path)
+}
+
+// list starts listing a directory ahead of the walk, returning nil for anything that is not
+// a directory or was already walked. A listing only saves time; walk decides whether to
+// descend into the directory.
+func (w *walker) list(entry *DirEntry) *listing {
+	if entry.Type != DirEntryTypeDirectory || w.visited[realPath(entry)] {
+		return nil
+	}
+
+	l := &listing{done: make(chan struct{})}
+	go func() {
+		defer close(l.done)
+		select {
+		case w.sem <- struct{}{}:
+		case <-w.ctx.Done():
+			l.err = w.ctx.Err()
+			return
+		}
+		defer func() { <-w.sem }()
+		l.entries, l.err = w.fs.ListDir(w.ctx, entry.Path)
+		slices.SortFunc(l.entries, func(a, b DirEntry) int { return strings.Compare(a.Name, b.Name) })
+	}()
+	return l
+}
+
+// walk visits an entry and, if it is a directory whose real path was not walked yet, its
+// entries, using the listing l if one was started ahead of the walk
+func (w *walker) walk(p string, entry *DirEntry, l *listing) error {
+	if err := w.fn(p, entry, nil); err != nil {
+		if errors.Is(err, fs.SkipDir) && entry.Type == DirEntryTypeDirectory {
+			err = nil
+		}
+		return err
+	}
+	if l == nil {
+		l = w.list(entry)
+	}
+	if l == nil || w.visited[realPath(entry)] {
+		return nil
+	}
+	w.visited[realPath(entry)] = true
+
+	<-l.done
+	if l.err != nil {
+		if err := w.fn(p, entry, l.err); err != nil {
+			if errors.Is(err, fs.SkipDir) {
+				err = nil
+			}
+			return err
+		}
+	}
+
+	// List the subdirectories a few entries ahead of the walk, so that listings overlap with
+	// visiting their siblings without starting one for every subdirectory at once
+	children := make([]*listing, len(l.entries))
+	next := 0
+	for i := range l.entries {
+		for ; next < min(i+walkConcurrency, len(l.entries)); next++ {
+			children[next] = w.list(&l.entries[next])
+		}
+		child := &l.entries[i]
+		if err := w.walk(path.Join(p, child.Name), child, children[i]); err != nil {
+			if errors.Is(err, fs.SkipDir) {
+				break
+			}
+			return err
+		}
+		children[i] = nil
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package truenas
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWalkServer(t *testing.T) *TestServer {
+	listings := map[string]string{
+		`["/mnt/tank"]`: `[
+			{"name": "z.txt", "path": "/mnt/tank/z.txt", "realpath": "/mnt/tank/z.txt", "type": "FILE"},
+			{"name": "b", "path": "/mnt/tank/b", "realpath": "/mnt/tank/b", "type": "DIRECTORY"},
+			{"name": "link", "path": "/mnt/tank/link", "realpath": "/mnt/tank/a", "type": "SYMLINK"},
+			{"name": "a", "path": "/mnt/tank/a", "realpath": "/mnt/tank/a", "type": "DIRECTORY"}
+		]`,
+		`["/mnt/tank/a"]`: `[
+			{"name": "loop", "path": "/mnt/tank/a/loop", "realpath": "/mnt/tank", "type": "DIRECTORY"},
+			{"name": "1.txt", "path": "/mnt/tank/a/1.txt", "realpath": "/mnt/tank/a/1.txt", "type": "FILE"}
+		]`,
+		`["/mnt/tank/b"]`: `[
+			{"name": "c", "path": "/mnt/tank/b/c", "realpath": "/mnt/tank/b/c", "type": "DIRECTORY"}
+		]`,
+	}
+	return NewTestServer(t, WithCustomHandler(func(msg Message) (Message, bool) {
+		response := Message{ID: msg.ID, Result: json.RawMessage(`true`)}
+		params, _ := json.Marshal(msg.Params)
+		switch msg.Method {
+		case "filesystem.stat":
+			if string(params) == `["/mnt/tank"]` {
+				response.Result = json.RawMessage(`{"is_dir": true, "realpath": "/mnt/tank"}`)
+			} else {
+				response.Result = nil
+				response.Error = &ErrorMsg{Code: errnoENOENT, Errname: "ENOENT", Message: "Path not found"}
+			}
+		case "filesystem.listdir":
+			if listing, ok := listings[string(params)]; ok {
+				response.Result = json.RawMessage(listing)
+			} else {
+				response.Result = nil
+				response.Error = &ErrorMsg{Code: errnoEACCES, Errname: "EACCES", Message: "Permission denied"}
+			}
+		}
+		return response, true
+	}))
+}
+
+func TestFilesystemClient_Walk(t *testing.T) {
+	t.Parallel()
+	server := newWalkServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	var visited, failed []string
+	err := client.Filesystem.Walk(ctx, "/mnt/tank", func(path string, entry *DirEntry, err error) error {
+		if err != nil {
+			assert.True(t, IsPermission(err))
+			failed = append(failed, path)
+			return nil
+		}
+		visited = append(visited, path)
+		return nil
+	})
+	require.NoError(t, err)
+	// The symlink and the directory looping back to the root are visited but not descended into
+	assert.Equal(t, []string{
+		"/mnt/tank",
+		"/mnt/tank/a",
+		"/mnt/tank/a/1.txt",
+		"/mnt/tank/a/loop",
+		"/mnt/tank/b",
+		"/mnt/tank/b/c",
+		"/mnt/tank/link",
+		"/mnt/tank/z.txt",
+	}, visited)
+	assert.Equal(t, []string{"/mnt/tank/b/c"}, failed)
+}
+
+func TestFilesystemClient_Walk_Skip(t *testing.T) {
+	t.Parallel()
+	server := newWalkServer(t)
+	defer server.Close()
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+	ctx := NewTestContext(t)
+
+	var visited []string
+	err := client.Filesystem.Walk(ctx, "/mnt/tank", func(path string, entry *DirEntry, err error) error {
+		visited = append(visited, path)
+		switch {
+		case path == "/mnt/tank/a":
+			return fs.SkipDir
+		case path == "/mnt/tank/b/c":
+			return fs.SkipAll
+		}
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/mnt/tank", "/mnt/tank/a", "/mnt/tank/b", "/mnt/tank/b/c"}, visited)
+
+	// A root that cannot be stat'ed is reported to fn with a nil entry
+	var rootErr error
+	err = client.Filesystem.Walk(ctx, "/mnt/missing", func(path string, entry *DirEntry, err error) error {
+		assert.Nil(t, entry)
+		rootErr = err
+		return err
+	})
+	require.Error(t, err)
+	assert.True(t, IsNotFound(rootErr))
+}
+
+func TestFilesystemClient_Walk_ListsAhead(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("filesystem.stat", FilesystemStat{IsDir: true, RealPath: "/mnt/tank"})
+	server.SetResponseFunc("filesystem.listdir", func(params []any) any {
+		if params[0] != "/mnt/tank" {
+			return []DirEntry{}
+		}
+		entries := make([]DirEntry, 50)
+		for i := range entries {
+			name := fmt.Sprintf("d%02d", i)
+			entries[i] = DirEntry{Name: name, Path: "/mnt/tank/" + name, Type: DirEntryTypeDirectory}
+		}
+		return entries
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	var visited int
+	err := client.Filesystem.Walk(NewTestContext(t), "/mnt/tank", func(path string, entry *DirEntry, err error) error {
+		visited++
+		switch path {
+		case "/mnt/tank/d00":
+			// Give listings started ahead of the walk time to reach the server
+			time.Sleep(100 * time.Millisecond)
+		case "/mnt/tank/d01":
+			return fs.SkipAll
+		}
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, visited)
+	// The root and at most the subdirectories within walkConcurrency of those visited
+	assert.LessOrEqual(t, server.CallCount("filesystem.listdir"), 1+1+walkConcurrency)
+}
+
+func TestFilesystemClient_Walk_SkippedBindMount(t *testing.T) {
+	t.Parallel()
+	server := NewTestServer(t)
+	defer server.Close()
+
+	server.SetResponse("filesystem.stat", FilesystemStat{IsDir: true, RealPath: "/mnt/tank"})
+	server.SetResponseFunc("filesystem.listdir", func(params []any) any {
+		switch params[0] {
+		case "/mnt/tank":
+			return []DirEntry{
+				{Name: "a", Path: "/mnt/tank/a", RealPath: "/mnt/tank/a", Type: DirEntryTypeDirectory},
+				{Name: "b", Path: "/mnt/tank/b", RealPath: "/mnt/tank/a", Type: DirEntryTypeDirectory},
+			}
+		case "/mnt/tank/a", "/mnt/tank/b":
+			return []DirEntry{{Name: "1.txt", Type: DirEntryTypeFile}}
+		}
+		return []DirEntry{}
+	})
+
+	client := server.CreateTestClient(t)
+	defer client.Close()
+
+	// Skipping a directory after its listing was started leaves its bind mount to be walked
+	var visited []string
+	err := client.Filesystem.Walk(NewTestContext(t), "/mnt/tank", func(path string, entry *DirEntry, err error) error {
+		visited = append(visited, path)
+		if path == "/mnt/tank/a" {
+			return fs.SkipDir
+		}
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/mnt/tank", "/mnt/tank/a", "/mnt/tank/b", "/mnt/tank/b/1.txt"}, visited)
+}